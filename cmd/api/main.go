@@ -9,26 +9,46 @@ import (
 	"os/signal"
 	"time"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/dian"
 	analyticsHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/analytics"
 	auditHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/audit"
 	authHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/auth"
+	campaignHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/campaign"
+	cashSessionHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/cash_session"
 	categoryHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/category"
+	commissionHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/commission"
 	customerHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/customer"
+	electronicInvoiceHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/electronic_invoice"
 	financialTransactionHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/financial_transaction"
+	ledgerHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/ledger"
+	loyaltyHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/loyalty"
 	orderHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/order"
+	ownershipHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/ownership"
 	paymentMethodHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/payment_method"
+	portalHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/portal"
 	productHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/product"
+	reportHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/report"
+	roleHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/role"
 	sizeHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/size"
 	supplierHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/supplier"
 	swaggerHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/swagger"
 	userHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/user"
 	userPermissionHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/user_permission"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/routes"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/notification"
+	analyticsRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/analytics"
 	auditRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/audit"
+	campaignRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/campaign"
+	cashSessionRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/cash_session"
 	categoryRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/category"
+	commissionRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/commission"
 	customerRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/customer"
+	electronicInvoiceRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/electronic_invoice"
 	financialTransactionRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/financial_transaction"
+	ledgerRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/ledger"
+	loyaltyRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/loyalty"
 	orderRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/order"
+	ownershipRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/ownership"
 	paymentMethodRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/payment_method"
 	productRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/product"
 	sizeRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/size"
@@ -36,19 +56,37 @@ import (
 	userRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/user"
 	userCategoryPermissionRepo "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/repositories/user_category_permission"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/storage"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/cashregister"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/einvoice"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/event_handlers"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/ledger"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/throttle"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases"
+	analyticsUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/analytics"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/auth"
+	campaignUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/campaign"
+	cashSessionUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/cash_session"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/category"
+	commissionUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/commission"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/customer"
+	electronicInvoiceUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/electronic_invoice"
 	financialTransactionUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/financial_transaction"
+	ledgerUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/ledger"
+	loyaltyUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/loyalty"
 	orderUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/order"
+	ownershipUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/ownership"
 	paymentMethodUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/payment_method"
+	portalUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/portal"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/product"
+	reportUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/report"
+	roleUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/role"
 	sizeUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/size"
 	supplierUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/supplier"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/user"
 	userPermissionUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/user_permission"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/events"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 	"github.com/bryanarroyaveortiz/fashion-blue/pkg/config"
@@ -77,6 +115,13 @@ func main() {
 
 	// Inicializar repositorios
 	userRepository := userRepo.NewUserRepository(db)
+	userSessionRepository := userRepo.NewUserSessionRepository(db)
+	userInvitationRepository := userRepo.NewUserInvitationRepository(db)
+	loginThrottleRepository := userRepo.NewLoginThrottleRepository(db)
+	passwordResetTokenRepository := userRepo.NewPasswordResetTokenRepository(db)
+	userTwoFactorRepository := userRepo.NewUserTwoFactorRepository(db)
+	roleRepository := userRepo.NewRoleRepository(db)
+	apiKeyRepository := userRepo.NewAPIKeyRepository(db)
 	categoryRepository := categoryRepo.NewCategoryRepository(db)
 	productRepository := productRepo.NewProductRepository(db)
 	productVariantRepository := productRepo.NewProductVariantRepository(db)
@@ -85,12 +130,60 @@ func main() {
 	paymentMethodRepository := paymentMethodRepo.NewPaymentMethodRepository(db)
 	customerRepository := customerRepo.NewCustomerRepository(db)
 	customerTransactionRepository := customerRepo.NewCustomerTransactionRepository(db)
+	customerAccessLinkRepository := customerRepo.NewCustomerAccessLinkRepository(db)
+	customerMergeRepository := customerRepo.NewCustomerMergeRepository(db)
 	auditLogRepository := auditRepo.NewAuditLogRepository(db)
 	supplierRepository := supplierRepo.NewSupplierRepository(db)
 	financialTransactionRepository := financialTransactionRepo.NewFinancialTransactionRepository(db)
+	recurringTransactionRepository := financialTransactionRepo.NewRecurringTransactionRepository(db)
+	budgetRepository := financialTransactionRepo.NewBudgetRepository(db)
+	cashAccountRepository := paymentMethodRepo.NewCashAccountRepository(db)
+	bankStatementRepository := paymentMethodRepo.NewBankStatementRepository(db)
+	cashSessionRepository := cashSessionRepo.NewCashSessionRepository(db)
+	ledgerAccountRepository := ledgerRepo.NewLedgerAccountRepository(db)
+	journalRepository := ledgerRepo.NewJournalRepository(db)
+	invoiceResolutionRepository := electronicInvoiceRepo.NewInvoiceResolutionRepository(db)
+	electronicDocumentRepository := electronicInvoiceRepo.NewElectronicDocumentRepository(db)
 	orderRepository := orderRepo.NewOrderRepository(db)
 	orderItemRepository := orderRepo.NewOrderItemRepository(db)
 	userCategoryPermissionRepository := userCategoryPermissionRepo.NewUserCategoryPermissionRepository(db)
+	campaignRepository := campaignRepo.NewCampaignRepository(db)
+	campaignCouponRepository := campaignRepo.NewCampaignCouponRepository(db)
+	loyaltyRuleRepository := loyaltyRepo.NewLoyaltyRuleRepository(db)
+	loyaltyPointsRepository := loyaltyRepo.NewLoyaltyPointsRepository(db)
+	commissionRuleRepository := commissionRepo.NewCommissionRuleRepository(db)
+	commissionEntryRepository := commissionRepo.NewCommissionEntryRepository(db)
+	commissionPayoutRepository := commissionRepo.NewCommissionPayoutRepository(db)
+	recordShareRepository := ownershipRepo.NewRecordShareRepository(db)
+	analyticsRepository := analyticsRepo.NewAnalyticsRepository(db)
+	salesReportRepository := analyticsRepo.NewSalesReportRepository(db)
+	marginReportRepository := analyticsRepo.NewMarginReportRepository(db)
+	cashFlowRepository := analyticsRepo.NewCashFlowRepository(db)
+
+	// Alcance de propiedad de órdenes y clientes por vendedor
+	accessGuard := access.NewGuard(customerRepository, recordShareRepository)
+
+	// Historial de cambios de productos, clientes, movimientos, usuarios y permisos
+	auditRecorder := audittrail.NewRecorder(auditLogRepository)
+
+	// Caja abierta del usuario: marca abonos y ventas y bloquea lo de cajas cerradas
+	cashRegister := cashregister.NewRegister(cashSessionRepository)
+
+	// Límite de solicitudes de los endpoints públicos que envían mensajes (portal, recuperación de contraseña)
+	requestLimiter := throttle.NewLimiter(loginThrottleRepository)
+
+	// Libro mayor: asientos por partida doble de ventas, abonos, ingresos, gastos y traslados
+	ledgerPoster := ledger.NewPoster(ledgerAccountRepository, journalRepository, paymentMethodRepository)
+
+	// Crear los roles por defecto que falten (no pisa los permisos editados)
+	if err := roleRepository.EnsureDefaults(context.Background(), entities.DefaultRoles()); err != nil {
+		log.Fatal("Failed to seed default roles:", err)
+	}
+
+	// Crear las cuentas contables que usan los asientos automáticos
+	if err := ledgerAccountRepository.EnsureDefaults(context.Background(), entities.DefaultLedgerAccounts()); err != nil {
+		log.Fatal("Failed to seed default ledger accounts:", err)
+	}
 
 	// Inicializar almacenamiento de archivos
 	var fileStorage ports.FileStorage
@@ -115,10 +208,91 @@ func main() {
 		log.Println("Using local file storage")
 	}
 
+	// Inicializar envío de mensajes a clientes
+	messageSender := notification.NewLogMessageSender()
+
+	// Inicializar notificaciones a usuarios del back office
+	var userNotifier ports.Notifier
+	if cfg.Notifier.Channel == "email" {
+		userNotifier = notification.NewSMTPNotifier(notification.SMTPConfig{
+			Host:     cfg.Notifier.SMTPHost,
+			Port:     cfg.Notifier.SMTPPort,
+			Username: cfg.Notifier.SMTPUsername,
+			Password: cfg.Notifier.SMTPPassword,
+			From:     cfg.Notifier.SMTPFrom,
+		})
+		log.Println("Sending user notifications by email")
+	} else {
+		userNotifier = notification.NewLogNotifier()
+		log.Println("Logging user notifications (no email configured)")
+	}
+
+	// Facturación electrónica: firma local del XML UBL y proveedor que lo envía a la DIAN
+	documentSigner, err := dian.NewLocalSigner(cfg.Invoicing.CertificatePath, cfg.Invoicing.PrivateKeyPath)
+	if err != nil {
+		log.Fatal("Failed to load electronic invoice signing certificate:", err)
+	}
+	var invoiceProvider ports.ElectronicInvoiceProvider
+	switch cfg.Invoicing.Provider {
+	case "test":
+		invoiceProvider = dian.NewTestProvider()
+		log.Println("Electronic invoices are validated locally (test provider, not sent to DIAN)")
+	default:
+		log.Fatalf("Unknown electronic invoice provider %q", cfg.Invoicing.Provider)
+	}
+	invoiceGenerator := einvoice.NewGenerator(cfg.Invoicing.GetIssuer(), einvoice.Settings{
+		Environment:         cfg.Invoicing.Environment,
+		SoftwareID:          cfg.Invoicing.SoftwareID,
+		SoftwarePIN:         cfg.Invoicing.SoftwarePIN,
+		SoftwareProviderNIT: cfg.Invoicing.SoftwareProviderNIT,
+		IVARate:             cfg.Invoicing.IVARate,
+		PricesIncludeIVA:    cfg.Invoicing.PricesIncludeIVA,
+	}, documentSigner)
+
 	// Inicializar casos de uso - Auth
-	loginUC := auth.NewLoginUseCase(userRepository, cfg.JWT.Secret, cfg.JWT.GetExpiration())
-	registerUC := auth.NewRegisterUseCase(userRepository, cfg.JWT.Secret, cfg.JWT.GetExpiration())
-	validateTokenUC := auth.NewValidateTokenUseCase(userRepository, cfg.JWT.Secret)
+	tokenConfig := auth.TokenConfig{
+		Secret:        cfg.JWT.Secret,
+		AccessExpiry:  cfg.JWT.GetExpiration(),
+		RefreshExpiry: cfg.JWT.GetRefreshExpiration(),
+	}
+	passwordPolicy := cfg.Auth.GetPasswordPolicy()
+	loginLimits := auth.LoginLimits{
+		Account: cfg.Auth.GetAccountLockoutPolicy(),
+		IP:      cfg.Auth.GetIPLockoutPolicy(),
+	}
+	twoFactorConfig := auth.TwoFactorConfig{
+		Issuer:                cfg.TwoFactor.Issuer,
+		EncryptionKey:         cfg.TwoFactor.EncryptionKey,
+		ChallengeExpiry:       cfg.TwoFactor.GetChallengeExpiration(),
+		RequiredForSuperAdmin: cfg.TwoFactor.RequiredForSuperAdmin,
+	}
+	if twoFactorConfig.EncryptionKey == "" {
+		twoFactorConfig.EncryptionKey = cfg.JWT.Secret
+	}
+	loginUC := auth.NewLoginUseCase(userRepository, userSessionRepository, loginThrottleRepository, userTwoFactorRepository, auditLogRepository, tokenConfig, twoFactorConfig, loginLimits)
+	registerUC := auth.NewRegisterUseCase(userRepository, userSessionRepository, userTwoFactorRepository, tokenConfig, twoFactorConfig, passwordPolicy, cfg.Auth.AllowPublicRegistration)
+	refreshTokenUC := auth.NewRefreshTokenUseCase(userRepository, userSessionRepository, tokenConfig)
+	logoutUC := auth.NewLogoutUseCase(userSessionRepository)
+	listSessionsUC := auth.NewListSessionsUseCase(userSessionRepository)
+	revokeSessionUC := auth.NewRevokeSessionUseCase(userSessionRepository)
+	setupTwoFactorUC := auth.NewSetupTwoFactorUseCase(userRepository, userTwoFactorRepository, twoFactorConfig)
+	enableTwoFactorUC := auth.NewEnableTwoFactorUseCase(userRepository, userTwoFactorRepository, auditLogRepository, twoFactorConfig)
+	disableTwoFactorUC := auth.NewDisableTwoFactorUseCase(userRepository, roleRepository, userTwoFactorRepository, auditLogRepository, twoFactorConfig)
+	regenerateRecoveryCodesUC := auth.NewRegenerateRecoveryCodesUseCase(userRepository, userTwoFactorRepository, auditLogRepository, twoFactorConfig)
+	getTwoFactorStatusUC := auth.NewGetTwoFactorStatusUseCase(userRepository, userTwoFactorRepository, twoFactorConfig)
+	verifyTwoFactorUC := auth.NewVerifyTwoFactorUseCase(userRepository, userSessionRepository, loginThrottleRepository, userTwoFactorRepository, auditLogRepository, setupTwoFactorUC, enableTwoFactorUC, tokenConfig, twoFactorConfig, loginLimits)
+	passwordResetLimits := auth.LoginLimits{
+		Account: cfg.Auth.GetPasswordResetPolicy(),
+		IP:      cfg.Auth.GetPasswordResetIPPolicy(),
+	}
+	requestPasswordResetUC := auth.NewRequestPasswordResetUseCase(userRepository, passwordResetTokenRepository, auditLogRepository, userNotifier, requestLimiter, passwordResetLimits, cfg.Auth.PasswordResetURL, cfg.Auth.GetPasswordResetExpiration())
+	confirmPasswordResetUC := auth.NewConfirmPasswordResetUseCase(userRepository, passwordResetTokenRepository, userSessionRepository, loginThrottleRepository, auditLogRepository, passwordPolicy)
+	validateTokenUC := auth.NewValidateTokenUseCase(userRepository, userSessionRepository, roleRepository, cfg.JWT.Secret)
+	validateAPIKeyUC := auth.NewValidateAPIKeyUseCase(apiKeyRepository, userRepository, roleRepository)
+	createAPIKeyUC := auth.NewCreateAPIKeyUseCase(apiKeyRepository, userRepository, roleRepository, auditLogRepository)
+	listAPIKeysUC := auth.NewListAPIKeysUseCase(apiKeyRepository)
+	rotateAPIKeyUC := auth.NewRotateAPIKeyUseCase(apiKeyRepository, userRepository, roleRepository, auditLogRepository)
+	revokeAPIKeyUC := auth.NewRevokeAPIKeyUseCase(apiKeyRepository, userRepository, roleRepository, auditLogRepository)
 
 	// Inicializar Event Bus
 	eventBus := events.NewEventBus()
@@ -130,9 +304,6 @@ func main() {
 	notificationHandler := event_handlers.NewNotificationHandler(eventBus)
 	notificationHandler.Start()
 
-	analyticsEventHandler := event_handlers.NewAnalyticsHandler(eventBus)
-	analyticsEventHandler.Start()
-
 	auditEventHandler := event_handlers.NewAuditHandler(eventBus, auditLogRepository)
 	auditEventHandler.Start()
 
@@ -148,6 +319,22 @@ func main() {
 	financialIncomeHandler := event_handlers.NewFinancialIncomeHandler(eventBus, financialTransactionRepository)
 	financialIncomeHandler.Start()
 
+	// Loyalty points handler para acumular puntos por ventas completadas
+	loyaltyPointsHandler := event_handlers.NewLoyaltyPointsHandler(eventBus, loyaltyRuleRepository, loyaltyPointsRepository, customerRepository, orderRepository, cfg.Loyalty.ExpirationMonths)
+	loyaltyPointsHandler.Start()
+
+	// Coupon redemption handler para liberar el cupón de las órdenes canceladas
+	couponRedemptionHandler := event_handlers.NewCouponRedemptionHandler(eventBus, campaignCouponRepository)
+	couponRedemptionHandler.Start()
+
+	// Commission handler para causar comisiones de vendedores y reversarlas al cancelar
+	commissionEventHandler := event_handlers.NewCommissionHandler(eventBus, commissionRuleRepository, commissionEntryRepository, orderRepository)
+	commissionEventHandler.Start()
+
+	// Ledger handler para contabilizar las ventas entregadas (ingreso y costo de la mercancía)
+	ledgerEventHandler := event_handlers.NewLedgerHandler(eventBus, ledgerPoster, orderRepository)
+	ledgerEventHandler.Start()
+
 	// Webhook handler (opcional - configurar según necesidad)
 	webhookConfig := event_handlers.WebhookConfig{
 		URL:     "", // Configurar URL si se necesita
@@ -160,24 +347,34 @@ func main() {
 	log.Println("✅ Event handlers initialized and started")
 
 	// Inicializar casos de uso - User
-	createUserUC := user.NewCreateUserUseCase(userRepository)
+	createUserUC := user.NewCreateUserUseCase(userRepository, roleRepository, passwordPolicy, auditRecorder)
 	getUserUC := user.NewGetUserUseCase(userRepository)
 	listUsersUC := user.NewListUsersUseCase(userRepository)
-	updateUserUC := user.NewUpdateUserUseCase(userRepository)
-	deleteUserUC := user.NewDeleteUserUseCase(userRepository)
-	changePasswordUC := user.NewChangePasswordUseCase(userRepository)
+	updateUserUC := user.NewUpdateUserUseCase(userRepository, userSessionRepository, roleRepository, auditRecorder)
+	deleteUserUC := user.NewDeleteUserUseCase(userRepository, roleRepository, auditRecorder)
+	changePasswordUC := user.NewChangePasswordUseCase(userRepository, userSessionRepository, auditLogRepository, passwordPolicy)
+	createInvitationUC := user.NewCreateInvitationUseCase(userInvitationRepository, userRepository, roleRepository, categoryRepository, cfg.Auth.InvitationURL, cfg.Auth.GetInvitationExpiration())
+	listInvitationsUC := user.NewListInvitationsUseCase(userInvitationRepository)
+	revokeInvitationUC := user.NewRevokeInvitationUseCase(userInvitationRepository)
+	acceptInvitationUC := user.NewAcceptInvitationUseCase(userInvitationRepository, userRepository, passwordPolicy)
+
+	// Inicializar casos de uso - Roles
+	createRoleUC := roleUseCases.NewCreateRoleUseCase(roleRepository, auditRecorder)
+	listRolesUC := roleUseCases.NewListRolesUseCase(roleRepository)
+	updateRoleUC := roleUseCases.NewUpdateRoleUseCase(roleRepository, auditRecorder)
+	deleteRoleUC := roleUseCases.NewDeleteRoleUseCase(roleRepository, auditRecorder)
 
 	// Inicializar casos de uso - User Permissions
 	checkCategoryPermissionUC := userPermissionUseCases.NewCheckCategoryPermissionUseCase(userCategoryPermissionRepository, userRepository)
 	getAllowedCategoriesUC := userPermissionUseCases.NewGetUserAllowedCategoriesUseCase(userCategoryPermissionRepository, categoryRepository, userRepository)
-	manageUserPermissionsUC := userPermissionUseCases.NewManageUserPermissionsUseCase(userCategoryPermissionRepository, categoryRepository, userRepository)
+	manageUserPermissionsUC := userPermissionUseCases.NewManageUserPermissionsUseCase(userCategoryPermissionRepository, categoryRepository, userRepository, auditRecorder)
 
 	// Inicializar casos de uso - Product
-	createProductUC := product.NewCreateProductUseCase(productRepository, productVariantRepository)
+	createProductUC := product.NewCreateProductUseCase(productRepository, productVariantRepository, auditRecorder)
 	getProductUC := product.NewGetProductUseCase(productRepository)
 	listProductsUC := product.NewListProductsUseCase(productRepository)
-	updateProductUC := product.NewUpdateProductUseCase(productRepository)
-	deleteProductUC := product.NewDeleteProductUseCase(productRepository)
+	updateProductUC := product.NewUpdateProductUseCase(productRepository, auditRecorder)
+	deleteProductUC := product.NewDeleteProductUseCase(productRepository, auditRecorder)
 	getLowStockUC := product.NewGetLowStockProductsUseCase(productRepository)
 	uploadProductPhotoUC := product.NewUploadProductPhotoUseCase(productPhotoRepository, fileStorage)
 	uploadMultiplePhotosUC := product.NewUploadMultiplePhotosUseCase(productPhotoRepository, fileStorage)
@@ -200,18 +397,115 @@ func main() {
 	// Inicializar casos de uso - PaymentMethod
 	listPaymentMethodsUC := paymentMethodUseCases.NewListPaymentMethodsUseCase(paymentMethodRepository)
 
+	// Inicializar casos de uso - Cuentas y conciliación
+	createPaymentMethodUC := paymentMethodUseCases.NewCreatePaymentMethodUseCase(paymentMethodRepository, ledgerPoster, auditRecorder)
+	updatePaymentMethodUC := paymentMethodUseCases.NewUpdatePaymentMethodUseCase(paymentMethodRepository, ledgerPoster, auditRecorder)
+	listAccountBalancesUC := paymentMethodUseCases.NewListAccountBalancesUseCase(paymentMethodRepository, cashAccountRepository)
+	getAccountLedgerUC := paymentMethodUseCases.NewGetAccountLedgerUseCase(paymentMethodRepository, cashAccountRepository)
+	createAccountTransferUC := paymentMethodUseCases.NewCreateAccountTransferUseCase(paymentMethodRepository, cashAccountRepository, ledgerPoster, auditRecorder)
+	listAccountTransfersUC := paymentMethodUseCases.NewListAccountTransfersUseCase(cashAccountRepository)
+	importBankStatementUC := paymentMethodUseCases.NewImportBankStatementUseCase(paymentMethodRepository, cashAccountRepository, bankStatementRepository)
+	listBankStatementsUC := paymentMethodUseCases.NewListBankStatementsUseCase(bankStatementRepository)
+	getBankReconciliationUC := paymentMethodUseCases.NewGetBankReconciliationUseCase(cashAccountRepository, bankStatementRepository)
+	matchStatementLineUC := paymentMethodUseCases.NewMatchStatementLineUseCase(cashAccountRepository, bankStatementRepository)
+	ignoreStatementLineUC := paymentMethodUseCases.NewIgnoreStatementLineUseCase(bankStatementRepository)
+	resetStatementLineUC := paymentMethodUseCases.NewResetStatementLineUseCase(bankStatementRepository)
+
+	// Cash Session (apertura y cierre de caja) Use Cases
+	openCashSessionUC := cashSessionUseCases.NewOpenCashSessionUseCase(cashSessionRepository, auditRecorder)
+	getCashSessionUC := cashSessionUseCases.NewGetCashSessionUseCase(cashSessionRepository)
+	closeCashSessionUC := cashSessionUseCases.NewCloseCashSessionUseCase(cashSessionRepository, auditRecorder)
+	listCashSessionsUC := cashSessionUseCases.NewListCashSessionsUseCase(cashSessionRepository)
+	generateClosingPDFUC := cashSessionUseCases.NewGenerateClosingPDFUseCase(cashSessionRepository)
+
+	// Ledger (contabilidad por partida doble) Use Cases
+	listLedgerAccountsUC := ledgerUseCases.NewListLedgerAccountsUseCase(ledgerAccountRepository)
+	createLedgerAccountUC := ledgerUseCases.NewCreateLedgerAccountUseCase(ledgerAccountRepository, auditRecorder)
+	updateLedgerAccountUC := ledgerUseCases.NewUpdateLedgerAccountUseCase(ledgerAccountRepository, auditRecorder)
+	listJournalEntriesUC := ledgerUseCases.NewListJournalEntriesUseCase(journalRepository)
+	getJournalEntryUC := ledgerUseCases.NewGetJournalEntryUseCase(journalRepository)
+	createManualEntryUC := ledgerUseCases.NewCreateManualEntryUseCase(ledgerAccountRepository, journalRepository, auditRecorder)
+	reverseManualEntryUC := ledgerUseCases.NewReverseManualEntryUseCase(journalRepository, auditRecorder)
+	getTrialBalanceUC := ledgerUseCases.NewGetTrialBalanceUseCase(ledgerAccountRepository, journalRepository)
+	getIncomeStatementUC := ledgerUseCases.NewGetIncomeStatementUseCase(ledgerAccountRepository, journalRepository)
+	getBalanceSheetUC := ledgerUseCases.NewGetBalanceSheetUseCase(ledgerAccountRepository, journalRepository)
+	getLedgerCrossCheckUC := ledgerUseCases.NewGetLedgerCrossCheckUseCase(ledgerAccountRepository, journalRepository, customerRepository, paymentMethodRepository, cashAccountRepository)
+	syncLedgerUC := ledgerUseCases.NewSyncLedgerUseCase(ledgerPoster)
+
+	// Facturación electrónica (DIAN) Use Cases
+	listInvoiceResolutionsUC := electronicInvoiceUseCases.NewListInvoiceResolutionsUseCase(invoiceResolutionRepository)
+	createInvoiceResolutionUC := electronicInvoiceUseCases.NewCreateInvoiceResolutionUseCase(invoiceResolutionRepository, auditRecorder)
+	updateInvoiceResolutionUC := electronicInvoiceUseCases.NewUpdateInvoiceResolutionUseCase(invoiceResolutionRepository, auditRecorder)
+	issueInvoiceUC := electronicInvoiceUseCases.NewIssueInvoiceUseCase(orderRepository, customerRepository, paymentMethodRepository, electronicDocumentRepository, invoiceGenerator, invoiceProvider, accessGuard, auditRecorder)
+	issueCreditNoteUC := electronicInvoiceUseCases.NewIssueCreditNoteUseCase(electronicDocumentRepository, invoiceGenerator, invoiceProvider, auditRecorder)
+	listElectronicDocumentsUC := electronicInvoiceUseCases.NewListElectronicDocumentsUseCase(electronicDocumentRepository)
+	getElectronicDocumentUC := electronicInvoiceUseCases.NewGetElectronicDocumentUseCase(electronicDocumentRepository)
+	submitElectronicDocumentUC := electronicInvoiceUseCases.NewSubmitElectronicDocumentUseCase(electronicDocumentRepository, invoiceProvider)
+
 	// Inicializar casos de uso - Customer
-	createCustomerUC := customer.NewCreateCustomerUseCase(customerRepository)
-	getCustomerUC := customer.NewGetCustomerUseCase(customerRepository)
-	listCustomersUC := customer.NewListCustomersUseCase(customerRepository)
-	updateCustomerUC := customer.NewUpdateCustomerUseCase(customerRepository)
-	deleteCustomerUC := customer.NewDeleteCustomerUseCase(customerRepository)
-	getCustomerHistoryUC := customer.NewGetCustomerHistoryUseCase(customerTransactionRepository)
-	createPaymentUC := customer.NewCreatePaymentUseCase(customerTransactionRepository, customerRepository)
-	getUpcomingPaymentsUC := customer.NewGetUpcomingPaymentsUseCase(customerRepository)
-	getCustomerBalanceUC := customer.NewGetCustomerBalanceUseCase(customerRepository)
-	addTransactionUC := customer.NewAddTransactionUseCase(customerTransactionRepository, customerRepository)
-	generateCustomerStatementUC := usecases.NewGenerateCustomerStatementUseCase(customerRepository, customerTransactionRepository)
+	createCustomerUC := customer.NewCreateCustomerUseCase(customerRepository, accessGuard, auditRecorder)
+	getCustomerUC := customer.NewGetCustomerUseCase(customerRepository, accessGuard)
+	listCustomersUC := customer.NewListCustomersUseCase(customerRepository, accessGuard)
+	updateCustomerUC := customer.NewUpdateCustomerUseCase(customerRepository, accessGuard, auditRecorder)
+	deleteCustomerUC := customer.NewDeleteCustomerUseCase(customerRepository, accessGuard, auditRecorder)
+	getCustomerHistoryUC := customer.NewGetCustomerHistoryUseCase(customerTransactionRepository, accessGuard)
+	createPaymentUC := customer.NewCreatePaymentUseCase(customerTransactionRepository, customerRepository, accessGuard, cashRegister, ledgerPoster, auditRecorder)
+	getUpcomingPaymentsUC := customer.NewGetUpcomingPaymentsUseCase(customerRepository, accessGuard)
+	getCustomerBalanceUC := customer.NewGetCustomerBalanceUseCase(customerRepository, accessGuard)
+	addTransactionUC := customer.NewAddTransactionUseCase(customerTransactionRepository, customerRepository, accessGuard, cashRegister, ledgerPoster, auditRecorder)
+	reverseTransactionUC := customer.NewReverseTransactionUseCase(customerTransactionRepository, auditLogRepository, accessGuard, cashRegister, ledgerPoster, auditRecorder)
+	generateCustomerStatementUC := usecases.NewGenerateCustomerStatementUseCase(customerRepository, customerTransactionRepository, accessGuard)
+	findDuplicatesUC := customer.NewFindDuplicatesUseCase(customerRepository)
+	mergeCustomersUC := customer.NewMergeCustomersUseCase(customerRepository, customerMergeRepository)
+	listCustomerMergesUC := customer.NewListCustomerMergesUseCase(customerMergeRepository)
+
+	// Inicializar casos de uso - Campaign
+	createCampaignUC := campaignUseCases.NewCreateCampaignUseCase(campaignRepository)
+	getCampaignUC := campaignUseCases.NewGetCampaignUseCase(campaignRepository, campaignCouponRepository)
+	listCampaignsUC := campaignUseCases.NewListCampaignsUseCase(campaignRepository)
+	previewAudienceUC := campaignUseCases.NewPreviewAudienceUseCase(campaignRepository)
+	generateCouponsUC := campaignUseCases.NewGenerateCouponsUseCase(campaignRepository, campaignCouponRepository)
+	validateCouponUC := campaignUseCases.NewValidateCouponUseCase(campaignCouponRepository)
+	listCustomerCouponsUC := campaignUseCases.NewListCustomerCouponsUseCase(campaignCouponRepository, accessGuard)
+
+	// Inicializar casos de uso - Analytics
+	getOrderAnalyticsUC := analyticsUseCases.NewGetOrderAnalyticsUseCase(analyticsRepository)
+
+	// Inicializar casos de uso - Reportes
+	getSalesReportUC := reportUseCases.NewGetSalesReportUseCase(salesReportRepository)
+	exportSalesReportUC := reportUseCases.NewExportSalesReportUseCase(getSalesReportUC)
+	getMarginReportUC := reportUseCases.NewGetMarginReportUseCase(marginReportRepository)
+	exportMarginReportUC := reportUseCases.NewExportMarginReportUseCase(getMarginReportUC)
+
+	// Inicializar casos de uso - Comisiones
+	createCommissionRuleUC := commissionUseCases.NewCreateRuleUseCase(commissionRuleRepository)
+	listCommissionRulesUC := commissionUseCases.NewListRulesUseCase(commissionRuleRepository)
+	updateCommissionRuleUC := commissionUseCases.NewUpdateRuleUseCase(commissionRuleRepository)
+	deleteCommissionRuleUC := commissionUseCases.NewDeleteRuleUseCase(commissionRuleRepository)
+	getCommissionStatementUC := commissionUseCases.NewGetStatementUseCase(commissionEntryRepository, commissionPayoutRepository, userRepository)
+	payCommissionsUC := commissionUseCases.NewPayCommissionsUseCase(commissionEntryRepository, commissionPayoutRepository, userRepository, ledgerPoster, auditRecorder)
+	listCommissionPayoutsUC := commissionUseCases.NewListPayoutsUseCase(commissionPayoutRepository)
+	clawbackCommissionUC := commissionUseCases.NewClawbackCommissionUseCase(commissionEntryRepository)
+
+	// Inicializar casos de uso - Loyalty
+	createLoyaltyRuleUC := loyaltyUseCases.NewCreateRuleUseCase(loyaltyRuleRepository)
+	listLoyaltyRulesUC := loyaltyUseCases.NewListRulesUseCase(loyaltyRuleRepository)
+	updateLoyaltyRuleUC := loyaltyUseCases.NewUpdateRuleUseCase(loyaltyRuleRepository)
+	deleteLoyaltyRuleUC := loyaltyUseCases.NewDeleteRuleUseCase(loyaltyRuleRepository)
+	getPointsStatementUC := loyaltyUseCases.NewGetPointsStatementUseCase(loyaltyPointsRepository, customerRepository, accessGuard, cfg.Loyalty.PointValue)
+	expirePointsUC := loyaltyUseCases.NewExpirePointsUseCase(loyaltyPointsRepository)
+
+	// Inicializar casos de uso - Portal de clientes
+	linkRequestLimits := portalUseCases.LinkRequestLimits{
+		Phone: cfg.Portal.GetPhoneLinkPolicy(),
+		IP:    cfg.Portal.GetIPLinkPolicy(),
+	}
+	requestAccessLinkUC := portalUseCases.NewRequestAccessLinkUseCase(customerRepository, customerAccessLinkRepository, messageSender, requestLimiter, linkRequestLimits, cfg.Portal.URL, cfg.Portal.GetLinkExpiration())
+	createAccessLinkUC := portalUseCases.NewCreateAccessLinkUseCase(customerRepository, customerAccessLinkRepository, accessGuard, cfg.Portal.URL, cfg.Portal.GetLinkExpiration())
+	verifyAccessLinkUC := portalUseCases.NewVerifyAccessLinkUseCase(customerAccessLinkRepository, customerRepository, cfg.JWT.Secret, cfg.Portal.GetSessionExpiration())
+	validatePortalTokenUC := portalUseCases.NewValidatePortalTokenUseCase(customerRepository, cfg.JWT.Secret)
+	listPortalOrdersUC := portalUseCases.NewListOrdersUseCase(orderRepository)
+	getPortalOrderUC := portalUseCases.NewGetOrderUseCase(orderRepository)
 
 	// Inicializar casos de uso - Supplier
 	createSupplierUC := supplierUseCases.NewCreateSupplierUseCase(supplierRepository)
@@ -221,44 +515,98 @@ func main() {
 	deleteSupplierUC := supplierUseCases.NewDeleteSupplierUseCase(supplierRepository)
 
 	// Inicializar casos de uso - FinancialTransaction
-	createTransactionUC := financialTransactionUseCases.NewCreateTransactionUseCase(financialTransactionRepository)
-	updateTransactionUC := financialTransactionUseCases.NewUpdateTransactionUseCase(financialTransactionRepository)
+	createTransactionUC := financialTransactionUseCases.NewCreateTransactionUseCase(financialTransactionRepository, paymentMethodRepository, ledgerPoster, auditRecorder)
+	updateTransactionUC := financialTransactionUseCases.NewUpdateTransactionUseCase(financialTransactionRepository, paymentMethodRepository, bankStatementRepository, ledgerPoster, auditRecorder)
 	getTransactionUC := financialTransactionUseCases.NewGetTransactionUseCase(financialTransactionRepository)
 	listTransactionsUC := financialTransactionUseCases.NewListTransactionsUseCase(financialTransactionRepository)
 	getBalanceUC := financialTransactionUseCases.NewGetBalanceUseCase(financialTransactionRepository)
 	generatePDFUC := financialTransactionUseCases.NewGeneratePDFUseCase(financialTransactionRepository)
+	getCashFlowProjectionUC := financialTransactionUseCases.NewGetCashFlowProjectionUseCase(financialTransactionRepository, cashFlowRepository, recurringTransactionRepository)
+
+	// Inicializar casos de uso - Transacciones recurrentes y presupuestos
+	createRecurringTransactionUC := financialTransactionUseCases.NewCreateRecurringTransactionUseCase(recurringTransactionRepository, auditRecorder)
+	listRecurringTransactionsUC := financialTransactionUseCases.NewListRecurringTransactionsUseCase(recurringTransactionRepository)
+	updateRecurringTransactionUC := financialTransactionUseCases.NewUpdateRecurringTransactionUseCase(recurringTransactionRepository, auditRecorder)
+	deleteRecurringTransactionUC := financialTransactionUseCases.NewDeleteRecurringTransactionUseCase(recurringTransactionRepository, auditRecorder)
+	generateRecurringTransactionsUC := financialTransactionUseCases.NewGenerateRecurringTransactionsUseCase(recurringTransactionRepository, ledgerPoster, auditRecorder)
+	setBudgetUC := financialTransactionUseCases.NewSetBudgetUseCase(budgetRepository, auditRecorder)
+	deleteBudgetUC := financialTransactionUseCases.NewDeleteBudgetUseCase(budgetRepository, auditRecorder)
+	getBudgetReportUC := financialTransactionUseCases.NewGetBudgetReportUseCase(budgetRepository, cashFlowRepository)
+	checkBudgetAlertsUC := financialTransactionUseCases.NewCheckBudgetAlertsUseCase(budgetRepository, cashFlowRepository, userNotifier, cfg.Finance.GetBudgetAlertRecipients())
 
 	// Inicializar casos de uso - Order
-	createOrderUC := orderUseCases.NewCreateOrderUseCase(orderRepository, productRepository, productVariantRepository, eventBus)
-	getOrderUC := orderUseCases.NewGetOrderUseCase(orderRepository)
-	listOrdersUC := orderUseCases.NewListOrdersUseCase(orderRepository)
+	createOrderUC := orderUseCases.NewCreateOrderUseCase(orderRepository, productRepository, productVariantRepository, campaignCouponRepository, loyaltyPointsRepository, cfg.Loyalty.PointValue, eventBus, accessGuard, cashRegister)
+	getOrderUC := orderUseCases.NewGetOrderUseCase(orderRepository, accessGuard)
+	listOrdersUC := orderUseCases.NewListOrdersUseCase(orderRepository, accessGuard)
 	updateOrderStatusUC := orderUseCases.NewUpdateOrderStatusUseCase(orderRepository)
-	addOrderItemUC := orderUseCases.NewAddOrderItemUseCase(orderRepository, orderItemRepository, productRepository, productVariantRepository)
-	updateOrderItemUC := orderUseCases.NewUpdateOrderItemUseCase(orderRepository, orderItemRepository)
-	removeOrderItemUC := orderUseCases.NewRemoveOrderItemUseCase(orderRepository, orderItemRepository)
-	changeOrderStatusUC := orderUseCases.NewChangeOrderStatusUseCase(orderRepository, orderItemRepository, productRepository, productVariantRepository, eventBus)
-	generateAccountStatementUC := orderUseCases.NewGenerateAccountStatementUseCase(orderRepository)
+	addOrderItemUC := orderUseCases.NewAddOrderItemUseCase(orderRepository, orderItemRepository, productRepository, productVariantRepository, accessGuard)
+	updateOrderItemUC := orderUseCases.NewUpdateOrderItemUseCase(orderRepository, orderItemRepository, accessGuard)
+	removeOrderItemUC := orderUseCases.NewRemoveOrderItemUseCase(orderRepository, orderItemRepository, accessGuard)
+	changeOrderStatusUC := orderUseCases.NewChangeOrderStatusUseCase(orderRepository, orderItemRepository, productRepository, productVariantRepository, eventBus, accessGuard, cashRegister)
+	generateAccountStatementUC := orderUseCases.NewGenerateAccountStatementUseCase(orderRepository, accessGuard, cfg.Invoicing.GetIssuer())
+
+	// Inicializar casos de uso - Ownership
+	shareRecordUC := ownershipUseCases.NewShareRecordUseCase(recordShareRepository, userRepository, orderRepository, customerRepository)
+	unshareRecordUC := ownershipUseCases.NewUnshareRecordUseCase(recordShareRepository)
+	listRecordSharesUC := ownershipUseCases.NewListRecordSharesUseCase(recordShareRepository)
+	reassignOrderUC := ownershipUseCases.NewReassignOrderUseCase(orderRepository, userRepository)
+	reassignCustomerUC := ownershipUseCases.NewReassignCustomerUseCase(customerRepository, userRepository, auditRecorder)
 
 	// Inicializar handlers
-	authHandlerInstance := authHandler.NewAuthHandler(loginUC, registerUC)
+	authHandlerInstance := authHandler.NewAuthHandler(loginUC, registerUC, refreshTokenUC, logoutUC, listSessionsUC, revokeSessionUC)
+	passwordResetHandlerInstance := authHandler.NewPasswordResetHandler(requestPasswordResetUC, confirmPasswordResetUC)
+	twoFactorHandlerInstance := authHandler.NewTwoFactorHandler(verifyTwoFactorUC, setupTwoFactorUC, enableTwoFactorUC, disableTwoFactorUC, regenerateRecoveryCodesUC, getTwoFactorStatusUC)
 	userHandlerInstance := userHandler.NewUserHandler(createUserUC, getUserUC, listUsersUC, updateUserUC, deleteUserUC, changePasswordUC)
+	invitationHandlerInstance := userHandler.NewInvitationHandler(createInvitationUC, listInvitationsUC, revokeInvitationUC, acceptInvitationUC)
+	roleHandlerInstance := roleHandler.NewRoleHandler(createRoleUC, listRolesUC, updateRoleUC, deleteRoleUC)
+	apiKeyHandlerInstance := authHandler.NewAPIKeyHandler(createAPIKeyUC, listAPIKeysUC, rotateAPIKeyUC, revokeAPIKeyUC)
 	userPermissionHandlerInstance := userPermissionHandler.NewUserPermissionHandler(manageUserPermissionsUC, checkCategoryPermissionUC, getAllowedCategoriesUC)
 	productHandlerInstance := productHandler.NewProductHandler(createProductUC, getProductUC, listProductsUC, updateProductUC, deleteProductUC, getLowStockUC, uploadProductPhotoUC, uploadMultiplePhotosUC, getProductPhotosUC, deleteProductPhotoUC, setPrimaryPhotoUC)
 	categoryHandlerInstance := categoryHandler.NewCategoryHandler(createCategoryUC, getCategoryUC, listCategoriesUC, updateCategoryUC, deleteCategoryUC)
 	sizeHandlerInstance := sizeHandler.NewSizeHandler(listSizesUC, getSizeUC, getSizesByTypeUC)
 	paymentMethodHandlerInstance := paymentMethodHandler.NewPaymentMethodHandler(listPaymentMethodsUC)
-	customerHandlerInstance := customerHandler.NewCustomerHandler(createCustomerUC, getCustomerUC, listCustomersUC, updateCustomerUC, deleteCustomerUC, getCustomerHistoryUC, createPaymentUC, getUpcomingPaymentsUC, getCustomerBalanceUC, addTransactionUC)
+	cashAccountHandlerInstance := paymentMethodHandler.NewCashAccountHandler(createPaymentMethodUC, updatePaymentMethodUC, listAccountBalancesUC, getAccountLedgerUC, createAccountTransferUC, listAccountTransfersUC, importBankStatementUC, listBankStatementsUC, getBankReconciliationUC, matchStatementLineUC, ignoreStatementLineUC, resetStatementLineUC)
+	cashSessionHandlerInstance := cashSessionHandler.NewCashSessionHandler(openCashSessionUC, getCashSessionUC, closeCashSessionUC, listCashSessionsUC, generateClosingPDFUC)
+	ledgerHandlerInstance := ledgerHandler.NewLedgerHandler(listLedgerAccountsUC, createLedgerAccountUC, updateLedgerAccountUC, listJournalEntriesUC, getJournalEntryUC, createManualEntryUC, reverseManualEntryUC, getTrialBalanceUC, getIncomeStatementUC, getBalanceSheetUC, getLedgerCrossCheckUC, syncLedgerUC)
+	electronicInvoiceHandlerInstance := electronicInvoiceHandler.NewElectronicInvoiceHandler(listInvoiceResolutionsUC, createInvoiceResolutionUC, updateInvoiceResolutionUC, issueInvoiceUC, issueCreditNoteUC, listElectronicDocumentsUC, getElectronicDocumentUC, submitElectronicDocumentUC)
+	customerHandlerInstance := customerHandler.NewCustomerHandler(createCustomerUC, getCustomerUC, listCustomersUC, updateCustomerUC, deleteCustomerUC, getCustomerHistoryUC, createPaymentUC, getUpcomingPaymentsUC, getCustomerBalanceUC, addTransactionUC, reverseTransactionUC)
 	statementHandlerInstance := customerHandler.NewStatementHandler(generateCustomerStatementUC)
+	mergeHandlerInstance := customerHandler.NewMergeHandler(findDuplicatesUC, mergeCustomersUC, listCustomerMergesUC)
+	campaignHandlerInstance := campaignHandler.NewCampaignHandler(createCampaignUC, getCampaignUC, listCampaignsUC, previewAudienceUC, generateCouponsUC, validateCouponUC, listCustomerCouponsUC)
+	loyaltyHandlerInstance := loyaltyHandler.NewLoyaltyHandler(createLoyaltyRuleUC, listLoyaltyRulesUC, updateLoyaltyRuleUC, deleteLoyaltyRuleUC, getPointsStatementUC, expirePointsUC)
+	commissionHandlerInstance := commissionHandler.NewCommissionHandler(createCommissionRuleUC, listCommissionRulesUC, updateCommissionRuleUC, deleteCommissionRuleUC, getCommissionStatementUC, payCommissionsUC, listCommissionPayoutsUC, clawbackCommissionUC)
+	portalHandlerInstance := portalHandler.NewPortalHandler(requestAccessLinkUC, createAccessLinkUC, verifyAccessLinkUC, listPortalOrdersUC, getPortalOrderUC, getCustomerHistoryUC, getCustomerBalanceUC, generateCustomerStatementUC)
 	orderHandlerInstance := orderHandler.NewOrderHandler(createOrderUC, getOrderUC, listOrdersUC, updateOrderStatusUC, addOrderItemUC, updateOrderItemUC, removeOrderItemUC, changeOrderStatusUC, generateAccountStatementUC)
+	ownershipHandlerInstance := ownershipHandler.NewOwnershipHandler(shareRecordUC, unshareRecordUC, listRecordSharesUC, reassignOrderUC, reassignCustomerUC)
 	supplierHandlerInstance := supplierHandler.NewSupplierHandler(createSupplierUC, getSupplierUC, listSuppliersUC, updateSupplierUC, deleteSupplierUC)
-	financialTransactionHandlerInstance := financialTransactionHandler.NewFinancialTransactionHandler(createTransactionUC, updateTransactionUC, getTransactionUC, listTransactionsUC, getBalanceUC, generatePDFUC)
-	analyticsHTTPHandlerInstance := analyticsHandler.NewAnalyticsHTTPHandler(analyticsEventHandler)
+	financialTransactionHandlerInstance := financialTransactionHandler.NewFinancialTransactionHandler(createTransactionUC, updateTransactionUC, getTransactionUC, listTransactionsUC, getBalanceUC, generatePDFUC, getCashFlowProjectionUC)
+	recurringTransactionHandlerInstance := financialTransactionHandler.NewRecurringTransactionHandler(createRecurringTransactionUC, listRecurringTransactionsUC, updateRecurringTransactionUC, deleteRecurringTransactionUC, generateRecurringTransactionsUC)
+	budgetHandlerInstance := financialTransactionHandler.NewBudgetHandler(setBudgetUC, deleteBudgetUC, getBudgetReportUC)
+	analyticsHTTPHandlerInstance := analyticsHandler.NewAnalyticsHTTPHandler(getOrderAnalyticsUC)
+	salesReportHandlerInstance := reportHandler.NewSalesReportHandler(getSalesReportUC, exportSalesReportUC)
+	marginReportHandlerInstance := reportHandler.NewMarginReportHandler(getMarginReportUC, exportMarginReportUC)
 	auditHTTPHandlerInstance := auditHandler.NewAuditHTTPHandler(auditLogRepository)
 	swaggerHandlerInstance := swaggerHandler.NewSwaggerHandler("docs/swagger.json")
 
 	// Crear instancia de Echo
 	e := echo.New()
 
+	// La IP del cliente (bloqueo de login, sesiones, auditoría) solo se toma de X-Forwarded-For
+	// cuando la conexión viene de un proxy de confianza; sin proxies configurados se usa la IP de la conexión
+	trustedProxies, err := cfg.App.GetTrustedProxies()
+	if err != nil {
+		log.Fatal("Invalid APP_TRUSTED_PROXIES:", err)
+	}
+	if len(trustedProxies) == 0 {
+		e.IPExtractor = echo.ExtractIPDirect()
+	} else {
+		trustOptions := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+		for _, ipRange := range trustedProxies {
+			trustOptions = append(trustOptions, echo.TrustIPRange(ipRange))
+		}
+		e.IPExtractor = echo.ExtractIPFromXFFHeader(trustOptions...)
+	}
+
 	// Middleware
 	e.Use(middleware.Logger())
 	e.Use(middleware.Recover())
@@ -279,21 +627,91 @@ func main() {
 	// Configurar rutas
 	routes.SetupRoutes(e, routes.Handlers{
 		Analytics:            analyticsHTTPHandlerInstance,
+		SalesReport:          salesReportHandlerInstance,
+		MarginReport:         marginReportHandlerInstance,
 		Audit:                auditHTTPHandlerInstance,
 		Auth:                 authHandlerInstance,
+		PasswordReset:        passwordResetHandlerInstance,
+		TwoFactor:            twoFactorHandlerInstance,
+		APIKey:               apiKeyHandlerInstance,
+		Campaign:             campaignHandlerInstance,
+		Commission:           commissionHandlerInstance,
+		Loyalty:              loyaltyHandlerInstance,
+		Portal:               portalHandlerInstance,
 		User:                 userHandlerInstance,
+		UserInvitation:       invitationHandlerInstance,
+		Role:                 roleHandlerInstance,
 		UserPermission:       userPermissionHandlerInstance,
 		Product:              productHandlerInstance,
 		Category:             categoryHandlerInstance,
 		Size:                 sizeHandlerInstance,
 		PaymentMethod:        paymentMethodHandlerInstance,
+		CashAccount:          cashAccountHandlerInstance,
+		CashSession:          cashSessionHandlerInstance,
 		Customer:             customerHandlerInstance,
 		CustomerStatement:    statementHandlerInstance,
+		CustomerMerge:        mergeHandlerInstance,
 		Order:                orderHandlerInstance,
+		Ownership:            ownershipHandlerInstance,
 		Supplier:             supplierHandlerInstance,
 		FinancialTransaction: financialTransactionHandlerInstance,
+		RecurringTransaction: recurringTransactionHandlerInstance,
+		Budget:               budgetHandlerInstance,
+		Ledger:               ledgerHandlerInstance,
+		ElectronicInvoice:    electronicInvoiceHandlerInstance,
 		Swagger:              swaggerHandlerInstance,
-	}, validateTokenUC)
+	}, validateTokenUC, validateAPIKeyUC, validatePortalTokenUC)
+
+	// Las tareas programadas no tienen usuario: usan el alcance de sistema explícitamente
+	systemCtx := access.WithScope(context.Background(), access.System())
+
+	// Vencer puntos de fidelización una vez al día
+	stopLoyaltyExpiration := make(chan bool)
+	go func() {
+		ticker := time.NewTicker(24 * time.Hour)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if expired, err := expirePointsUC.Execute(systemCtx); err != nil {
+					log.Printf("❌ [LOYALTY ERROR] Failed to expire points: %v", err)
+				} else if expired > 0 {
+					log.Printf("⭐ [LOYALTY] Expired %d points", expired)
+				}
+			case <-stopLoyaltyExpiration:
+				return
+			}
+		}
+	}()
+
+	// Generar transacciones recurrentes vencidas y revisar presupuestos cada hora
+	stopFinanceJobs := make(chan bool)
+	go func() {
+		runFinanceJobs := func() {
+			if generated, err := generateRecurringTransactionsUC.Execute(systemCtx); err != nil {
+				log.Printf("❌ [FINANCE ERROR] Failed to generate recurring transactions: %v", err)
+			} else if generated > 0 {
+				log.Printf("🔁 [FINANCE] Generated %d recurring transactions", generated)
+			}
+			if alerted, err := checkBudgetAlertsUC.Execute(systemCtx); err != nil {
+				log.Printf("❌ [FINANCE ERROR] Failed to check budget alerts: %v", err)
+			} else if alerted > 0 {
+				log.Printf("⚠️ [FINANCE] %d categories over budget", alerted)
+			}
+		}
+		runFinanceJobs()
+
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				runFinanceJobs()
+			case <-stopFinanceJobs:
+				return
+			}
+		}
+	}()
 
 	// Iniciar servidor
 	addr := fmt.Sprintf("%s:%s", cfg.App.Host, cfg.App.Port)
@@ -317,10 +735,14 @@ func main() {
 	log.Println("Stopping event handlers...")
 	loggingHandler.Stop()
 	notificationHandler.Stop()
-	analyticsEventHandler.Stop()
 	auditEventHandler.Stop()
 	productCreationHandler.Stop()
 	webhookHandler.Stop()
+	loyaltyPointsHandler.Stop()
+	commissionEventHandler.Stop()
+	ledgerEventHandler.Stop()
+	stopLoyaltyExpiration <- true
+	stopFinanceJobs <- true
 
 	// Cerrar event bus
 	eventBus.Close()
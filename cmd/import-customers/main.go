@@ -404,12 +404,16 @@ func customerExists(db *gorm.DB, name, phone string) (bool, uint, error) {
 
 	query := db.Model(&models.CustomerModel{})
 
+	// Comparar teléfonos normalizados para no duplicar "+57 300..." y "300..."
+	phone = entities.NormalizeColombianPhone(phone)
+	phoneMatch := "RIGHT(regexp_replace(phone, '[^0-9]', '', 'g'), 10) = ?"
+
 	if name != "" && phone != "" {
-		query = query.Where("LOWER(name) = LOWER(?) OR phone = ?", name, phone)
+		query = query.Where("LOWER(name) = LOWER(?) OR "+phoneMatch, name, phone)
 	} else if name != "" {
 		query = query.Where("LOWER(name) = LOWER(?)", name)
 	} else if phone != "" {
-		query = query.Where("phone = ?", phone)
+		query = query.Where(phoneMatch, phone)
 	} else {
 		return false, 0, nil
 	}
@@ -0,0 +1,282 @@
+package dian
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// Algoritmos y política de firma exigidos por la DIAN (XAdES-EPES)
+const (
+	algorithmC14N       = "http://www.w3.org/TR/2001/REC-xml-c14n-20010315"
+	algorithmRSASHA256  = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	algorithmSHA256     = "http://www.w3.org/2001/04/xmlenc#sha256"
+	algorithmEnveloped  = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+	signedPropsType     = "http://uri.etsi.org/01903#SignedProperties"
+	signaturePolicyURL  = "https://facturaelectronica.dian.gov.co/politicadefirma/v2/politicadefirmav2.pdf"
+	signaturePolicyHash = "dMoMvtcG5aIzgYo0tIsSQeVJBDnUnfSOfBpxXrmor0Y="
+)
+
+// signaturePlaceholder es la extensión vacía que deja el generador UBL para la firma
+var signaturePlaceholder = []byte("<ext:ExtensionContent></ext:ExtensionContent>")
+
+// localSigner firma los documentos con el certificado del facturador sin salir del servidor
+// El digest se calcula sobre el XML tal como lo emite el generador (atributos en orden canónico y
+// sin etiquetas autocerradas); si el proveedor tecnológico exige C14N estricto, vuelve a firmar al recibirlo
+type localSigner struct {
+	key         *rsa.PrivateKey
+	certificate *x509.Certificate
+}
+
+// NewLocalSigner carga el certificado y la llave privada RSA (PEM) del facturador
+// Sin rutas configuradas genera un certificado autofirmado temporal, válido solo para pruebas
+func NewLocalSigner(certificatePath, keyPath string) (ports.DocumentSigner, error) {
+	if certificatePath == "" && keyPath == "" {
+		log.Println("⚠️  No signing certificate configured: electronic documents are signed with a temporary self-signed certificate (testing only)")
+		return newSelfSignedSigner()
+	}
+
+	certificatePEM, err := os.ReadFile(certificatePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing certificate: %w", err)
+	}
+	block, _ := pem.Decode(certificatePEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("signing certificate must be a PEM encoded CERTIFICATE")
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing certificate: %w", err)
+	}
+
+	keyPEM, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key: %w", err)
+	}
+	key, err := parsePrivateKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &localSigner{key: key, certificate: certificate}, nil
+}
+
+// parsePrivateKey acepta llaves RSA en PKCS#1 ("RSA PRIVATE KEY") o PKCS#8 ("PRIVATE KEY")
+func parsePrivateKey(keyPEM []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("signing key must be PEM encoded")
+	}
+	if block.Type == "RSA PRIVATE KEY" {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("signing key must be an RSA key")
+	}
+	return key, nil
+}
+
+// newSelfSignedSigner genera una llave y un certificado autofirmado que viven mientras corre el servidor
+func newSelfSignedSigner() (ports.DocumentSigner, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "Fashion Blue - certificado de pruebas", Country: []string{"CO"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(1, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	return &localSigner{key: key, certificate: certificate}, nil
+}
+
+// Estructura de la firma XMLDSig con las propiedades XAdES que pide la DIAN
+type dsSignature struct {
+	XMLName        xml.Name     `xml:"ds:Signature"`
+	ID             string       `xml:"Id,attr"`
+	SignedInfo     dsSignedInfo `xml:"ds:SignedInfo"`
+	SignatureValue string       `xml:"ds:SignatureValue"`
+	KeyInfo        dsKeyInfo    `xml:"ds:KeyInfo"`
+	Object         dsObject     `xml:"ds:Object"`
+}
+
+type dsSignedInfo struct {
+	XMLName                xml.Name      `xml:"ds:SignedInfo"`
+	CanonicalizationMethod dsAlgorithm   `xml:"ds:CanonicalizationMethod"`
+	SignatureMethod        dsAlgorithm   `xml:"ds:SignatureMethod"`
+	References             []dsReference `xml:"ds:Reference"`
+}
+
+type dsAlgorithm struct {
+	Algorithm string `xml:"Algorithm,attr"`
+}
+
+type dsReference struct {
+	ID           string        `xml:"Id,attr,omitempty"`
+	Type         string        `xml:"Type,attr,omitempty"`
+	URI          string        `xml:"URI,attr"`
+	Transforms   *dsTransforms `xml:"ds:Transforms,omitempty"`
+	DigestMethod dsAlgorithm   `xml:"ds:DigestMethod"`
+	DigestValue  string        `xml:"ds:DigestValue"`
+}
+
+type dsTransforms struct {
+	Transform []dsAlgorithm `xml:"ds:Transform"`
+}
+
+type dsKeyInfo struct {
+	X509Certificate string `xml:"ds:X509Data>ds:X509Certificate"`
+}
+
+type dsObject struct {
+	QualifyingProperties xadesQualifyingProperties `xml:"xades:QualifyingProperties"`
+}
+
+type xadesQualifyingProperties struct {
+	Target           string                `xml:"Target,attr"`
+	SignedProperties xadesSignedProperties `xml:"xades:SignedProperties"`
+}
+
+type xadesSignedProperties struct {
+	XMLName      xml.Name    `xml:"xades:SignedProperties"`
+	ID           string      `xml:"Id,attr"`
+	SigningTime  string      `xml:"xades:SignedSignatureProperties>xades:SigningTime"`
+	CertDigest   xadesDigest `xml:"xades:SignedSignatureProperties>xades:SigningCertificate>xades:Cert>xades:CertDigest"`
+	IssuerName   string      `xml:"xades:SignedSignatureProperties>xades:SigningCertificate>xades:Cert>xades:IssuerSerial>ds:X509IssuerName"`
+	SerialNumber string      `xml:"xades:SignedSignatureProperties>xades:SigningCertificate>xades:Cert>xades:IssuerSerial>ds:X509SerialNumber"`
+	PolicyID     string      `xml:"xades:SignedSignatureProperties>xades:SignaturePolicyIdentifier>xades:SignaturePolicyId>xades:SigPolicyId>xades:Identifier"`
+	PolicyHash   xadesDigest `xml:"xades:SignedSignatureProperties>xades:SignaturePolicyIdentifier>xades:SignaturePolicyId>xades:SigPolicyHash"`
+	ClaimedRole  string      `xml:"xades:SignedSignatureProperties>xades:SignerRole>xades:ClaimedRoles>xades:ClaimedRole"`
+}
+
+type xadesDigest struct {
+	DigestMethod dsAlgorithm `xml:"ds:DigestMethod"`
+	DigestValue  string      `xml:"ds:DigestValue"`
+}
+
+// digest calcula el SHA-256 en base64
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// Sign inserta la firma enveloped en la extensión reservada del documento
+func (s *localSigner) Sign(ctx context.Context, document []byte) ([]byte, error) {
+	position := bytes.LastIndex(document, signaturePlaceholder)
+	if position < 0 {
+		return nil, errors.New("document has no empty UBL extension for the signature")
+	}
+
+	randomID := make([]byte, 8)
+	if _, err := rand.Read(randomID); err != nil {
+		return nil, err
+	}
+	signatureID := "xmldsig-" + hex.EncodeToString(randomID)
+
+	// La transformación enveloped excluye la firma: el digest es el del documento con la extensión vacía
+	body := document
+	if bytes.HasPrefix(body, []byte("<?xml")) {
+		body = body[bytes.Index(body, []byte("?>"))+2:]
+	}
+	body = bytes.TrimLeft(body, "\r\n")
+
+	signedProperties := xadesSignedProperties{
+		ID:           signatureID + "-signedprops",
+		SigningTime:  time.Now().Format("2006-01-02T15:04:05.000-07:00"),
+		CertDigest:   xadesDigest{DigestMethod: dsAlgorithm{Algorithm: algorithmSHA256}, DigestValue: digest(s.certificate.Raw)},
+		IssuerName:   s.certificate.Issuer.String(),
+		SerialNumber: s.certificate.SerialNumber.String(),
+		PolicyID:     signaturePolicyURL,
+		PolicyHash:   xadesDigest{DigestMethod: dsAlgorithm{Algorithm: algorithmSHA256}, DigestValue: signaturePolicyHash},
+		ClaimedRole:  "supplier",
+	}
+	signedPropertiesXML, err := xml.Marshal(signedProperties)
+	if err != nil {
+		return nil, err
+	}
+
+	signedInfo := dsSignedInfo{
+		CanonicalizationMethod: dsAlgorithm{Algorithm: algorithmC14N},
+		SignatureMethod:        dsAlgorithm{Algorithm: algorithmRSASHA256},
+		References: []dsReference{
+			{
+				ID:           signatureID + "-ref0",
+				URI:          "",
+				Transforms:   &dsTransforms{Transform: []dsAlgorithm{{Algorithm: algorithmEnveloped}}},
+				DigestMethod: dsAlgorithm{Algorithm: algorithmSHA256},
+				DigestValue:  digest(body),
+			},
+			{
+				Type:         signedPropsType,
+				URI:          "#" + signedProperties.ID,
+				DigestMethod: dsAlgorithm{Algorithm: algorithmSHA256},
+				DigestValue:  digest(signedPropertiesXML),
+			},
+		},
+	}
+	signedInfoXML, err := xml.Marshal(signedInfo)
+	if err != nil {
+		return nil, err
+	}
+	hashed := sha256.Sum256(signedInfoXML)
+	signatureValue, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, hashed[:])
+	if err != nil {
+		return nil, err
+	}
+
+	signature := dsSignature{
+		ID:             signatureID,
+		SignedInfo:     signedInfo,
+		SignatureValue: base64.StdEncoding.EncodeToString(signatureValue),
+		KeyInfo:        dsKeyInfo{X509Certificate: base64.StdEncoding.EncodeToString(s.certificate.Raw)},
+		Object: dsObject{QualifyingProperties: xadesQualifyingProperties{
+			Target:           "#" + signatureID,
+			SignedProperties: signedProperties,
+		}},
+	}
+	signatureXML, err := xml.Marshal(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	signed := make([]byte, 0, len(document)+len(signatureXML))
+	signed = append(signed, document[:position]...)
+	signed = append(signed, "<ext:ExtensionContent>"...)
+	signed = append(signed, signatureXML...)
+	signed = append(signed, "</ext:ExtensionContent>"...)
+	signed = append(signed, document[position+len(signaturePlaceholder):]...)
+	return signed, nil
+}
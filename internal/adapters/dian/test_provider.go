@@ -0,0 +1,63 @@
+package dian
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log"
+	"math"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// testProvider valida los documentos localmente en lugar de enviarlos a la DIAN
+// Útil en desarrollo o mientras no haya un proveedor tecnológico contratado
+type testProvider struct{}
+
+// NewTestProvider crea un ElectronicInvoiceProvider que acepta los documentos bien formados sin enviarlos
+func NewTestProvider() ports.ElectronicInvoiceProvider {
+	return &testProvider{}
+}
+
+func (p *testProvider) Submit(ctx context.Context, document *entities.ElectronicDocument) (*entities.ElectronicSubmission, error) {
+	var problems []string
+
+	decoder := xml.NewDecoder(bytes.NewReader([]byte(document.XML)))
+	for {
+		if _, err := decoder.Token(); err != nil {
+			if err != io.EOF {
+				problems = append(problems, fmt.Sprintf("XML is not well formed: %v", err))
+			}
+			break
+		}
+	}
+	if len(document.CUFE) != 96 {
+		problems = append(problems, "CUFE/CUDE must be a SHA-384 hex digest")
+	}
+	if !bytes.Contains([]byte(document.XML), []byte("<ds:SignatureValue>")) {
+		problems = append(problems, "document is not signed")
+	}
+	if math.Abs(document.Subtotal+document.TaxTotal-document.Total) > 0.01 {
+		problems = append(problems, "payable amount must equal line extension plus taxes")
+	}
+	if len(document.Lines) == 0 {
+		problems = append(problems, "document has no lines")
+	}
+
+	submission := &entities.ElectronicSubmission{
+		Accepted: len(problems) == 0,
+		TrackID:  document.CUFE,
+		Errors:   problems,
+	}
+	if submission.Accepted {
+		submission.Message = "Validated by the test provider (not sent to DIAN)"
+	} else {
+		submission.Message = "Rejected by the test provider"
+	}
+
+	log.Printf("🧾 [E-INVOICE TEST] %s %s total %.2f accepted=%t", document.Type, document.FullNumber(), document.Total, submission.Accepted)
+	return submission, nil
+}
@@ -0,0 +1,76 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// CreateAPIKeyRequest representa la petición para crear una llave de API
+type CreateAPIKeyRequest struct {
+	Name        string     `json:"name" validate:"required"`
+	Permissions []string   `json:"permissions"`
+	OwnerID     uint       `json:"ownerId"` // Usuario responsable; por defecto quien crea la llave
+	ExpiresAt   *time.Time `json:"expiresAt"`
+}
+
+// RotateAPIKeyRequest representa la petición para rotar una llave de API
+type RotateAPIKeyRequest struct {
+	GraceMinutes int        `json:"graceMinutes"` // Minutos que la llave anterior sigue funcionando (0 = la revoca ya)
+	ExpiresAt    *time.Time `json:"expiresAt"`    // Vencimiento de la llave nueva; por defecto el de la anterior
+}
+
+// APIKeyDTO representa una llave de API en la respuesta (nunca incluye el secreto)
+type APIKeyDTO struct {
+	ID            uint       `json:"id"`
+	Name          string     `json:"name"`
+	Prefix        string     `json:"prefix"`
+	Permissions   []string   `json:"permissions"`
+	OwnerID       uint       `json:"ownerId"`
+	CreatedBy     uint       `json:"createdBy"`
+	ExpiresAt     *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt    *time.Time `json:"lastUsedAt,omitempty"`
+	LastUsedIP    string     `json:"lastUsedIp,omitempty"`
+	RevokedAt     *time.Time `json:"revokedAt,omitempty"`
+	RotatedFromID *uint      `json:"rotatedFromId,omitempty"`
+	Active        bool       `json:"active"`
+	CreatedAt     time.Time  `json:"createdAt"`
+}
+
+// IssuedAPIKeyDTO incluye la llave en claro; solo se entrega al crearla o rotarla
+type IssuedAPIKeyDTO struct {
+	APIKeyDTO
+	Key string `json:"key"`
+}
+
+// ToAPIKeyDTO convierte una llave de API a DTO
+func ToAPIKeyDTO(key *entities.APIKey) APIKeyDTO {
+	permissions := make([]string, len(key.Permissions))
+	for i, p := range key.Permissions {
+		permissions[i] = string(p)
+	}
+	return APIKeyDTO{
+		ID:            key.ID,
+		Name:          key.Name,
+		Prefix:        key.Prefix,
+		Permissions:   permissions,
+		OwnerID:       key.UserID,
+		CreatedBy:     key.CreatedBy,
+		ExpiresAt:     key.ExpiresAt,
+		LastUsedAt:    key.LastUsedAt,
+		LastUsedIP:    key.LastUsedIP,
+		RevokedAt:     key.RevokedAt,
+		RotatedFromID: key.RotatedFromID,
+		Active:        key.IsActive(time.Now()),
+		CreatedAt:     key.CreatedAt,
+	}
+}
+
+// ToAPIKeyDTOList convierte una lista de llaves de API a DTOs
+func ToAPIKeyDTOList(keys []entities.APIKey) []APIKeyDTO {
+	dtos := make([]APIKeyDTO, len(keys))
+	for i := range keys {
+		dtos[i] = ToAPIKeyDTO(&keys[i])
+	}
+	return dtos
+}
@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/auth"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// AuthTokensDTO representa el par de tokens de una sesión
+// "token" se conserva como nombre del access token por compatibilidad con el frontend
+type AuthTokensDTO struct {
+	Token            string    `json:"token"`
+	RefreshToken     string    `json:"refreshToken"`
+	ExpiresAt        time.Time `json:"expiresAt"`
+	RefreshExpiresAt time.Time `json:"refreshExpiresAt"`
+}
+
+// ToAuthTokensDTO convierte el par de tokens a DTO
+func ToAuthTokensDTO(tokens *auth.AuthTokens) AuthTokensDTO {
+	return AuthTokensDTO{
+		Token:            tokens.AccessToken,
+		RefreshToken:     tokens.RefreshToken,
+		ExpiresAt:        tokens.ExpiresAt,
+		RefreshExpiresAt: tokens.RefreshExpiresAt,
+	}
+}
+
+// UserSessionDTO representa una sesión activa (dispositivo) del usuario
+type UserSessionDTO struct {
+	ID         uint      `json:"id"`
+	UserAgent  string    `json:"userAgent"`
+	IPAddress  string    `json:"ipAddress"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastUsedAt time.Time `json:"lastUsedAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	Current    bool      `json:"current"` // Sesión desde la que se hace la consulta
+}
+
+// ToUserSessionDTOList convierte sesiones a DTOs marcando la sesión actual
+func ToUserSessionDTOList(sessions []entities.UserSession, currentSessionID uint) []UserSessionDTO {
+	dtos := make([]UserSessionDTO, len(sessions))
+	for i, session := range sessions {
+		dtos[i] = UserSessionDTO{
+			ID:         session.ID,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			ExpiresAt:  session.ExpiresAt,
+			Current:    session.ID == currentSessionID,
+		}
+	}
+	return dtos
+}
@@ -0,0 +1,135 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// RecurringTransactionDTO es la respuesta de una plantilla de transacción recurrente
+type RecurringTransactionDTO struct {
+	ID          uint      `json:"id"`
+	Type        string    `json:"type"`
+	Category    string    `json:"category"`
+	Amount      float64   `json:"amount"`
+	Description string    `json:"description"`
+	Frequency   string    `json:"frequency"` // WEEKLY, BIWEEKLY, MONTHLY
+	DayOfMonth  int       `json:"dayOfMonth,omitempty"`
+	StartDate   string    `json:"startDate"` // YYYY-MM-DD
+	EndDate     *string   `json:"endDate,omitempty"`
+	NextRunDate string    `json:"nextRunDate"`
+	LastRunDate *string   `json:"lastRunDate,omitempty"`
+	IsActive    bool      `json:"isActive"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// ToRecurringTransactionDTO convierte la plantilla a DTO
+func ToRecurringTransactionDTO(recurring *entities.RecurringTransaction) *RecurringTransactionDTO {
+	return &RecurringTransactionDTO{
+		ID:          recurring.ID,
+		Type:        string(recurring.Type),
+		Category:    string(recurring.Category),
+		Amount:      recurring.Amount,
+		Description: recurring.Description,
+		Frequency:   string(recurring.Frequency),
+		DayOfMonth:  recurring.DayOfMonth,
+		StartDate:   recurring.StartDate.Format("2006-01-02"),
+		EndDate:     formatOptionalDate(recurring.EndDate),
+		NextRunDate: recurring.NextRunDate.Format("2006-01-02"),
+		LastRunDate: formatOptionalDate(recurring.LastRunDate),
+		IsActive:    recurring.IsActive,
+		CreatedAt:   recurring.CreatedAt,
+		UpdatedAt:   recurring.UpdatedAt,
+	}
+}
+
+// ToRecurringTransactionDTOList convierte una lista de plantillas a DTOs
+func ToRecurringTransactionDTOList(recurringList []entities.RecurringTransaction) []*RecurringTransactionDTO {
+	dtos := make([]*RecurringTransactionDTO, len(recurringList))
+	for i := range recurringList {
+		dtos[i] = ToRecurringTransactionDTO(&recurringList[i])
+	}
+	return dtos
+}
+
+func formatOptionalDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+	formatted := date.Format("2006-01-02")
+	return &formatted
+}
+
+// BudgetDTO es la respuesta de un presupuesto mensual
+type BudgetDTO struct {
+	ID        uint       `json:"id"`
+	Category  string     `json:"category"`
+	Month     string     `json:"month"` // YYYY-MM
+	Amount    float64    `json:"amount"`
+	AlertedAt *time.Time `json:"alertedAt,omitempty"`
+}
+
+// ToBudgetDTOList convierte una lista de presupuestos a DTOs
+func ToBudgetDTOList(budgets []entities.Budget) []BudgetDTO {
+	dtos := make([]BudgetDTO, len(budgets))
+	for i, budget := range budgets {
+		dtos[i] = BudgetDTO{
+			ID:        budget.ID,
+			Category:  string(budget.Category),
+			Month:     budget.Month.Format("2006-01"),
+			Amount:    budget.Amount,
+			AlertedAt: budget.AlertedAt,
+		}
+	}
+	return dtos
+}
+
+// BudgetLineDTO es el presupuesto contra lo real de una categoría
+type BudgetLineDTO struct {
+	BudgetID    *uint    `json:"budgetId"`
+	Category    string   `json:"category"`
+	Budget      float64  `json:"budget"`
+	Actual      float64  `json:"actual"`
+	Remaining   float64  `json:"remaining"`
+	UsedPercent *float64 `json:"usedPercent"`
+	Overspent   bool     `json:"overspent"`
+}
+
+// BudgetReportDTO es la respuesta del reporte de presupuesto contra lo real
+type BudgetReportDTO struct {
+	Month       string          `json:"month"` // YYYY-MM
+	TotalBudget float64         `json:"totalBudget"`
+	TotalActual float64         `json:"totalActual"`
+	Overspent   []string        `json:"overspent"`
+	Lines       []BudgetLineDTO `json:"lines"`
+}
+
+// ToBudgetReportDTO convierte el reporte a DTO
+func ToBudgetReportDTO(report *entities.BudgetReport) *BudgetReportDTO {
+	lines := make([]BudgetLineDTO, len(report.Lines))
+	for i, line := range report.Lines {
+		lines[i] = BudgetLineDTO{
+			BudgetID:    line.BudgetID,
+			Category:    string(line.Category),
+			Budget:      line.Budget,
+			Actual:      line.Actual,
+			Remaining:   line.Remaining,
+			UsedPercent: line.UsedPercent,
+			Overspent:   line.Overspent,
+		}
+	}
+
+	overspent := make([]string, len(report.Overspent))
+	for i, category := range report.Overspent {
+		overspent[i] = string(category)
+	}
+
+	return &BudgetReportDTO{
+		Month:       report.Month.Format("2006-01"),
+		TotalBudget: report.TotalBudget,
+		TotalActual: report.TotalActual,
+		Overspent:   overspent,
+		Lines:       lines,
+	}
+}
@@ -0,0 +1,113 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// CampaignDTO representa la respuesta de una campaña
+type CampaignDTO struct {
+	ID              uint                      `json:"id"`
+	Name            string                    `json:"name"`
+	Type            string                    `json:"type"`
+	Criteria        entities.CampaignCriteria `json:"criteria"`
+	DiscountType    string                    `json:"discountType"`
+	DiscountValue   float64                   `json:"discountValue"`
+	DiscountLabel   string                    `json:"discountLabel"`
+	MessageTemplate string                    `json:"messageTemplate,omitempty"`
+	ValidFrom       time.Time                 `json:"validFrom"`
+	ValidUntil      time.Time                 `json:"validUntil"`
+	IsActive        bool                      `json:"isActive"`
+	Stats           *entities.CampaignStats   `json:"stats,omitempty"`
+	CreatedAt       time.Time                 `json:"createdAt"`
+	UpdatedAt       time.Time                 `json:"updatedAt"`
+}
+
+// ToCampaignDTO convierte una entidad Campaign a DTO
+func ToCampaignDTO(campaign *entities.Campaign) CampaignDTO {
+	return CampaignDTO{
+		ID:              campaign.ID,
+		Name:            campaign.Name,
+		Type:            string(campaign.Type),
+		Criteria:        campaign.Criteria,
+		DiscountType:    string(campaign.DiscountType),
+		DiscountValue:   campaign.DiscountValue,
+		DiscountLabel:   campaign.DiscountLabel(),
+		MessageTemplate: campaign.MessageTemplate,
+		ValidFrom:       campaign.ValidFrom,
+		ValidUntil:      campaign.ValidUntil,
+		IsActive:        campaign.IsActive,
+		CreatedAt:       campaign.CreatedAt,
+		UpdatedAt:       campaign.UpdatedAt,
+	}
+}
+
+// ToCampaignDTOList convierte una lista de campañas a DTOs
+func ToCampaignDTOList(campaigns []entities.Campaign) []CampaignDTO {
+	dtos := make([]CampaignDTO, len(campaigns))
+	for i, campaign := range campaigns {
+		dtos[i] = ToCampaignDTO(&campaign)
+	}
+	return dtos
+}
+
+// CampaignCouponDTO representa la respuesta de un cupón de campaña
+type CampaignCouponDTO struct {
+	ID              uint       `json:"id"`
+	CampaignID      uint       `json:"campaignId"`
+	CampaignName    string     `json:"campaignName,omitempty"`
+	CustomerID      uint       `json:"customerId"`
+	CustomerName    string     `json:"customerName,omitempty"`
+	CustomerPhone   string     `json:"customerPhone,omitempty"`
+	Code            string     `json:"code"`
+	Message         string     `json:"message"`
+	DiscountType    string     `json:"discountType"`
+	DiscountValue   float64    `json:"discountValue"`
+	DiscountLabel   string     `json:"discountLabel"`
+	ExpiresAt       time.Time  `json:"expiresAt"`
+	Redeemed        bool       `json:"redeemed"`
+	RedeemedAt      *time.Time `json:"redeemedAt,omitempty"`
+	RedeemedOrderID *uint      `json:"redeemedOrderId,omitempty"`
+	DiscountApplied float64    `json:"discountApplied"`
+	CreatedAt       time.Time  `json:"createdAt"`
+}
+
+// ToCampaignCouponDTO convierte una entidad CampaignCoupon a DTO
+func ToCampaignCouponDTO(coupon *entities.CampaignCoupon) CampaignCouponDTO {
+	dto := CampaignCouponDTO{
+		ID:              coupon.ID,
+		CampaignID:      coupon.CampaignID,
+		CustomerID:      coupon.CustomerID,
+		Code:            coupon.Code,
+		Message:         coupon.Message,
+		DiscountType:    string(coupon.DiscountType),
+		DiscountValue:   coupon.DiscountValue,
+		DiscountLabel:   coupon.DiscountLabel(),
+		ExpiresAt:       coupon.ExpiresAt,
+		Redeemed:        coupon.IsRedeemed(),
+		RedeemedAt:      coupon.RedeemedAt,
+		RedeemedOrderID: coupon.RedeemedOrderID,
+		DiscountApplied: coupon.DiscountApplied,
+		CreatedAt:       coupon.CreatedAt,
+	}
+
+	if coupon.Campaign != nil {
+		dto.CampaignName = coupon.Campaign.Name
+	}
+	if coupon.Customer != nil {
+		dto.CustomerName = coupon.Customer.Name
+		dto.CustomerPhone = coupon.Customer.Phone
+	}
+
+	return dto
+}
+
+// ToCampaignCouponDTOList convierte una lista de cupones a DTOs
+func ToCampaignCouponDTOList(coupons []entities.CampaignCoupon) []CampaignCouponDTO {
+	dtos := make([]CampaignCouponDTO, len(coupons))
+	for i, coupon := range coupons {
+		dtos[i] = ToCampaignCouponDTO(&coupon)
+	}
+	return dtos
+}
@@ -0,0 +1,230 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// AccountBalanceDTO es el saldo actual de una cuenta
+type AccountBalanceDTO struct {
+	PaymentMethod  PaymentMethodDTO `json:"paymentMethod"`
+	OpeningBalance float64          `json:"openingBalance"`
+	Inflows        float64          `json:"inflows"`
+	Outflows       float64          `json:"outflows"`
+	Balance        float64          `json:"balance"`
+}
+
+// ToAccountBalanceDTOList convierte los saldos de las cuentas a DTOs
+func ToAccountBalanceDTOList(balances []entities.AccountBalance) []AccountBalanceDTO {
+	dtos := make([]AccountBalanceDTO, len(balances))
+	for i := range balances {
+		dtos[i] = AccountBalanceDTO{
+			PaymentMethod:  ToPaymentMethodDTO(&balances[i].PaymentMethod),
+			OpeningBalance: balances[i].OpeningBalance,
+			Inflows:        balances[i].Inflows,
+			Outflows:       balances[i].Outflows,
+			Balance:        balances[i].Balance,
+		}
+	}
+	return dtos
+}
+
+// AccountMovementDTO es un movimiento de una cuenta
+type AccountMovementDTO struct {
+	Date        time.Time `json:"date"`
+	Source      string    `json:"source"`   // CUSTOMER_TRANSACTION, FINANCIAL_TRANSACTION o ACCOUNT_TRANSFER
+	SourceID    uint      `json:"sourceId"` // ID del registro en su origen
+	Description string    `json:"description"`
+	Amount      float64   `json:"amount"`            // Positivo entra, negativo sale
+	Balance     float64   `json:"balance,omitempty"` // Saldo después del movimiento (solo en el libro de la cuenta)
+	Reconciled  bool      `json:"reconciled"`
+}
+
+// ToAccountMovementDTOList convierte los movimientos a DTOs
+func ToAccountMovementDTOList(movements []entities.AccountMovement) []AccountMovementDTO {
+	dtos := make([]AccountMovementDTO, len(movements))
+	for i, movement := range movements {
+		dtos[i] = AccountMovementDTO{
+			Date:        movement.Date,
+			Source:      string(movement.Source),
+			SourceID:    movement.SourceID,
+			Description: movement.Description,
+			Amount:      movement.Amount,
+			Balance:     movement.Balance,
+			Reconciled:  movement.Reconciled,
+		}
+	}
+	return dtos
+}
+
+// AccountLedgerDTO es la respuesta del libro de una cuenta
+type AccountLedgerDTO struct {
+	PaymentMethod  PaymentMethodDTO     `json:"paymentMethod"`
+	StartDate      string               `json:"startDate"`
+	EndDate        string               `json:"endDate"`
+	OpeningBalance float64              `json:"openingBalance"`
+	Inflows        float64              `json:"inflows"`
+	Outflows       float64              `json:"outflows"`
+	ClosingBalance float64              `json:"closingBalance"`
+	Movements      []AccountMovementDTO `json:"movements"`
+}
+
+// ToAccountLedgerDTO convierte el libro de la cuenta a DTO
+func ToAccountLedgerDTO(ledger *entities.AccountLedger) *AccountLedgerDTO {
+	return &AccountLedgerDTO{
+		PaymentMethod:  ToPaymentMethodDTO(&ledger.PaymentMethod),
+		StartDate:      ledger.StartDate.Format("2006-01-02"),
+		EndDate:        ledger.EndDate.Format("2006-01-02"),
+		OpeningBalance: ledger.OpeningBalance,
+		Inflows:        ledger.Inflows,
+		Outflows:       ledger.Outflows,
+		ClosingBalance: ledger.ClosingBalance,
+		Movements:      ToAccountMovementDTOList(ledger.Movements),
+	}
+}
+
+// AccountTransferDTO es la respuesta de un traslado entre cuentas
+type AccountTransferDTO struct {
+	ID                  uint      `json:"id"`
+	FromPaymentMethodID uint      `json:"fromPaymentMethodId"`
+	ToPaymentMethodID   uint      `json:"toPaymentMethodId"`
+	Amount              float64   `json:"amount"`
+	Description         string    `json:"description"`
+	Date                time.Time `json:"date"`
+	CreatedByID         *uint     `json:"createdById,omitempty"`
+	CreatedAt           time.Time `json:"createdAt"`
+}
+
+// ToAccountTransferDTO convierte un traslado a DTO
+func ToAccountTransferDTO(transfer *entities.AccountTransfer) AccountTransferDTO {
+	return AccountTransferDTO{
+		ID:                  transfer.ID,
+		FromPaymentMethodID: transfer.FromPaymentMethodID,
+		ToPaymentMethodID:   transfer.ToPaymentMethodID,
+		Amount:              transfer.Amount,
+		Description:         transfer.Description,
+		Date:                transfer.Date,
+		CreatedByID:         transfer.CreatedByID,
+		CreatedAt:           transfer.CreatedAt,
+	}
+}
+
+// ToAccountTransferDTOList convierte una lista de traslados a DTOs
+func ToAccountTransferDTOList(transfers []entities.AccountTransfer) []AccountTransferDTO {
+	dtos := make([]AccountTransferDTO, len(transfers))
+	for i := range transfers {
+		dtos[i] = ToAccountTransferDTO(&transfers[i])
+	}
+	return dtos
+}
+
+// BankStatementLineDTO es una línea de un extracto
+type BankStatementLineDTO struct {
+	ID            uint       `json:"id"`
+	LineNumber    int        `json:"lineNumber"`
+	Date          string     `json:"date"` // YYYY-MM-DD
+	Description   string     `json:"description"`
+	Reference     string     `json:"reference,omitempty"`
+	Amount        float64    `json:"amount"`
+	Status        string     `json:"status"` // UNMATCHED, MATCHED o IGNORED
+	MatchedSource *string    `json:"matchedSource,omitempty"`
+	MatchedID     *uint      `json:"matchedId,omitempty"`
+	MatchedAt     *time.Time `json:"matchedAt,omitempty"`
+}
+
+// ToBankStatementLineDTO convierte una línea del extracto a DTO
+func ToBankStatementLineDTO(line *entities.BankStatementLine) BankStatementLineDTO {
+	dto := BankStatementLineDTO{
+		ID:          line.ID,
+		LineNumber:  line.LineNumber,
+		Date:        line.Date.Format("2006-01-02"),
+		Description: line.Description,
+		Reference:   line.Reference,
+		Amount:      line.Amount,
+		Status:      string(line.Status),
+		MatchedID:   line.MatchedID,
+		MatchedAt:   line.MatchedAt,
+	}
+	if line.MatchedSource != nil {
+		source := string(*line.MatchedSource)
+		dto.MatchedSource = &source
+	}
+	return dto
+}
+
+// BankStatementDTO es el resumen de un extracto importado
+type BankStatementDTO struct {
+	ID              uint      `json:"id"`
+	PaymentMethodID uint      `json:"paymentMethodId"`
+	FileName        string    `json:"fileName"`
+	StartDate       string    `json:"startDate"`
+	EndDate         string    `json:"endDate"`
+	ImportedByID    *uint     `json:"importedById,omitempty"`
+	LineCount       int       `json:"lineCount"`
+	MatchedCount    int       `json:"matchedCount"`
+	UnmatchedCount  int       `json:"unmatchedCount"`
+	IgnoredCount    int       `json:"ignoredCount"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+// ToBankStatementDTO convierte el extracto a su resumen
+func ToBankStatementDTO(statement *entities.BankStatement) BankStatementDTO {
+	dto := BankStatementDTO{
+		ID:              statement.ID,
+		PaymentMethodID: statement.PaymentMethodID,
+		FileName:        statement.FileName,
+		StartDate:       statement.StartDate.Format("2006-01-02"),
+		EndDate:         statement.EndDate.Format("2006-01-02"),
+		ImportedByID:    statement.ImportedByID,
+		LineCount:       len(statement.Lines),
+		CreatedAt:       statement.CreatedAt,
+	}
+	for _, line := range statement.Lines {
+		switch line.Status {
+		case entities.StatementLineMatched:
+			dto.MatchedCount++
+		case entities.StatementLineIgnored:
+			dto.IgnoredCount++
+		default:
+			dto.UnmatchedCount++
+		}
+	}
+	return dto
+}
+
+// ToBankStatementDTOList convierte una lista de extractos a DTOs
+func ToBankStatementDTOList(statements []entities.BankStatement) []BankStatementDTO {
+	dtos := make([]BankStatementDTO, len(statements))
+	for i := range statements {
+		dtos[i] = ToBankStatementDTO(&statements[i])
+	}
+	return dtos
+}
+
+// BankReconciliationDTO es la respuesta de la conciliación de un extracto
+type BankReconciliationDTO struct {
+	Statement             BankStatementDTO       `json:"statement"`
+	StatementNet          float64                `json:"statementNet"`
+	UnmatchedNet          float64                `json:"unmatchedNet"`    // En el banco y no en los libros
+	UnreconciledNet       float64                `json:"unreconciledNet"` // En los libros y no en el banco
+	Lines                 []BankStatementLineDTO `json:"lines"`
+	UnreconciledMovements []AccountMovementDTO   `json:"unreconciledMovements"`
+}
+
+// ToBankReconciliationDTO convierte la conciliación a DTO
+func ToBankReconciliationDTO(reconciliation *entities.BankReconciliation) *BankReconciliationDTO {
+	lines := make([]BankStatementLineDTO, len(reconciliation.Statement.Lines))
+	for i := range reconciliation.Statement.Lines {
+		lines[i] = ToBankStatementLineDTO(&reconciliation.Statement.Lines[i])
+	}
+
+	return &BankReconciliationDTO{
+		Statement:             ToBankStatementDTO(&reconciliation.Statement),
+		StatementNet:          reconciliation.StatementNet,
+		UnmatchedNet:          reconciliation.UnmatchedNet,
+		UnreconciledNet:       reconciliation.UnreconciledNet,
+		Lines:                 lines,
+		UnreconciledMovements: ToAccountMovementDTOList(reconciliation.UnreconciledMovements),
+	}
+}
@@ -0,0 +1,80 @@
+package dto
+
+import "github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+
+// CashFlowItemDTO es un movimiento proyectado
+type CashFlowItemDTO struct {
+	Date        string  `json:"date"`   // YYYY-MM-DD
+	Source      string  `json:"source"` // RECEIVABLE, CUSTOM_ORDER, SCHEDULED o RECURRING_EXPENSE
+	ReferenceID *uint   `json:"referenceId,omitempty"`
+	Description string  `json:"description"`
+	Amount      float64 `json:"amount"` // Positivo entra, negativo sale
+}
+
+// CashFlowWeekDTO es una semana de la proyección
+type CashFlowWeekDTO struct {
+	StartDate      string            `json:"startDate"`
+	EndDate        string            `json:"endDate"`
+	OpeningBalance float64           `json:"openingBalance"`
+	Inflows        float64           `json:"inflows"`
+	Outflows       float64           `json:"outflows"`
+	ClosingBalance float64           `json:"closingBalance"`
+	Items          []CashFlowItemDTO `json:"items"`
+}
+
+// CashFlowProjectionDTO es la respuesta de la proyección de caja
+type CashFlowProjectionDTO struct {
+	StartDate              string            `json:"startDate"`
+	EndDate                string            `json:"endDate"`
+	OpeningBalance         float64           `json:"openingBalance"`
+	TotalInflows           float64           `json:"totalInflows"`
+	TotalOutflows          float64           `json:"totalOutflows"`
+	ClosingBalance         float64           `json:"closingBalance"`
+	LowestBalance          float64           `json:"lowestBalance"`
+	LowestWeek             string            `json:"lowestWeek"`
+	UnscheduledReceivables float64           `json:"unscheduledReceivables"`
+	UndatedOrders          float64           `json:"undatedOrders"`
+	ExpenseHistoryMonths   int               `json:"expenseHistoryMonths"`
+	Weeks                  []CashFlowWeekDTO `json:"weeks"`
+}
+
+// ToCashFlowProjectionDTO convierte la proyección a DTO
+func ToCashFlowProjectionDTO(projection *entities.CashFlowProjection) *CashFlowProjectionDTO {
+	weeks := make([]CashFlowWeekDTO, len(projection.Weeks))
+	for i, week := range projection.Weeks {
+		items := make([]CashFlowItemDTO, len(week.Items))
+		for j, item := range week.Items {
+			items[j] = CashFlowItemDTO{
+				Date:        item.Date.Format("2006-01-02"),
+				Source:      string(item.Source),
+				ReferenceID: item.ReferenceID,
+				Description: item.Description,
+				Amount:      item.Amount,
+			}
+		}
+		weeks[i] = CashFlowWeekDTO{
+			StartDate:      week.StartDate.Format("2006-01-02"),
+			EndDate:        week.EndDate.Format("2006-01-02"),
+			OpeningBalance: week.OpeningBalance,
+			Inflows:        week.Inflows,
+			Outflows:       week.Outflows,
+			ClosingBalance: week.ClosingBalance,
+			Items:          items,
+		}
+	}
+
+	return &CashFlowProjectionDTO{
+		StartDate:              projection.StartDate.Format("2006-01-02"),
+		EndDate:                projection.EndDate.Format("2006-01-02"),
+		OpeningBalance:         projection.OpeningBalance,
+		TotalInflows:           projection.TotalInflows,
+		TotalOutflows:          projection.TotalOutflows,
+		ClosingBalance:         projection.ClosingBalance,
+		LowestBalance:          projection.LowestBalance,
+		LowestWeek:             projection.LowestWeek.Format("2006-01-02"),
+		UnscheduledReceivables: projection.UnscheduledReceivables,
+		UndatedOrders:          projection.UndatedOrders,
+		ExpenseHistoryMonths:   projection.ExpenseHistoryMonths,
+		Weeks:                  weeks,
+	}
+}
@@ -0,0 +1,125 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// CashSessionDTO representa una sesión de caja en la API
+type CashSessionDTO struct {
+	ID           uint                  `json:"id"`
+	UserID       uint                  `json:"userId"`
+	UserName     string                `json:"userName,omitempty"`
+	Location     string                `json:"location,omitempty"`
+	Status       string                `json:"status"` // OPEN o CLOSED
+	OpeningFloat float64               `json:"openingFloat"`
+	OpenedAt     time.Time             `json:"openedAt"`
+	ClosedAt     *time.Time            `json:"closedAt,omitempty"`
+	ClosedByID   *uint                 `json:"closedById,omitempty"`
+	Collected    float64               `json:"collected"`    // Total de abonos y ventas de la sesión
+	ExpectedCash float64               `json:"expectedCash"` // Base más lo cobrado en efectivo
+	CountedCash  *float64              `json:"countedCash,omitempty"`
+	Difference   *float64              `json:"difference,omitempty"` // Positivo sobra, negativo falta
+	Notes        string                `json:"notes,omitempty"`
+	Totals       []CashSessionTotalDTO `json:"totals"`
+}
+
+// CashSessionTotalDTO representa lo cobrado con un método de pago en la sesión
+type CashSessionTotalDTO struct {
+	PaymentMethodID   *uint   `json:"paymentMethodId,omitempty"` // Vacío: ventas sin método de pago
+	PaymentMethodName string  `json:"paymentMethodName"`
+	IsCash            bool    `json:"isCash"`
+	PaymentsCount     int     `json:"paymentsCount"`
+	PaymentsAmount    float64 `json:"paymentsAmount"`
+	SalesCount        int     `json:"salesCount"`
+	SalesAmount       float64 `json:"salesAmount"`
+	Total             float64 `json:"total"`
+}
+
+// CashSessionEntryDTO representa un abono o una venta de la sesión
+type CashSessionEntryDTO struct {
+	Date              time.Time `json:"date"`
+	Kind              string    `json:"kind"`     // PAYMENT o SALE
+	SourceID          uint      `json:"sourceId"` // ID de la transacción del cliente o de la orden
+	Description       string    `json:"description"`
+	PaymentMethodID   *uint     `json:"paymentMethodId,omitempty"`
+	PaymentMethodName string    `json:"paymentMethodName,omitempty"`
+	Amount            float64   `json:"amount"`
+}
+
+// CashSessionReportDTO representa la sesión con el detalle de sus movimientos
+type CashSessionReportDTO struct {
+	Session CashSessionDTO        `json:"session"`
+	Entries []CashSessionEntryDTO `json:"entries"`
+}
+
+// ToCashSessionDTO convierte una entidad CashSession a DTO
+func ToCashSessionDTO(session *entities.CashSession) CashSessionDTO {
+	dto := CashSessionDTO{
+		ID:           session.ID,
+		UserID:       session.UserID,
+		Location:     session.Location,
+		Status:       string(session.Status),
+		OpeningFloat: session.OpeningFloat,
+		OpenedAt:     session.OpenedAt,
+		ClosedAt:     session.ClosedAt,
+		ClosedByID:   session.ClosedByID,
+		ExpectedCash: session.ExpectedCash,
+		Notes:        session.Notes,
+		Totals:       make([]CashSessionTotalDTO, len(session.Totals)),
+	}
+	if session.User != nil {
+		dto.UserName = session.User.FullName()
+	}
+	if !session.IsOpen() {
+		countedCash := session.CountedCash
+		difference := session.Difference
+		dto.CountedCash = &countedCash
+		dto.Difference = &difference
+	}
+
+	for i, total := range session.Totals {
+		dto.Totals[i] = CashSessionTotalDTO{
+			PaymentMethodID:   total.PaymentMethodID,
+			PaymentMethodName: total.PaymentMethodName,
+			IsCash:            total.IsCash,
+			PaymentsCount:     total.PaymentsCount,
+			PaymentsAmount:    total.PaymentsAmount,
+			SalesCount:        total.SalesCount,
+			SalesAmount:       total.SalesAmount,
+			Total:             total.Total(),
+		}
+		dto.Collected += total.Total()
+	}
+	return dto
+}
+
+// ToCashSessionDTOList convierte una lista de sesiones a DTOs
+func ToCashSessionDTOList(sessions []entities.CashSession) []CashSessionDTO {
+	dtos := make([]CashSessionDTO, len(sessions))
+	for i := range sessions {
+		dtos[i] = ToCashSessionDTO(&sessions[i])
+	}
+	return dtos
+}
+
+// ToCashSessionReportDTO convierte la sesión y sus movimientos a DTO
+func ToCashSessionReportDTO(report *entities.CashSessionReport) CashSessionReportDTO {
+	dto := CashSessionReportDTO{
+		Session: ToCashSessionDTO(&report.Session),
+		Entries: make([]CashSessionEntryDTO, len(report.Entries)),
+	}
+	for i, entry := range report.Entries {
+		dto.Entries[i] = CashSessionEntryDTO{
+			Date:              entry.Date,
+			Kind:              string(entry.Kind),
+			SourceID:          entry.SourceID,
+			Description:       entry.Description,
+			PaymentMethodID:   entry.PaymentMethodID,
+			PaymentMethodName: entry.PaymentMethodName,
+			Amount:            entry.Amount,
+		}
+	}
+	return dto
+}
@@ -0,0 +1,155 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// CommissionRuleDTO representa la respuesta de una regla de comisión
+type CommissionRuleDTO struct {
+	ID         uint                      `json:"id"`
+	Name       string                    `json:"name"`
+	SellerID   *uint                     `json:"sellerId,omitempty"`
+	CategoryID *uint                     `json:"categoryId,omitempty"`
+	Rate       float64                   `json:"rate"`
+	Tiers      []entities.CommissionTier `json:"tiers"`
+	IsActive   bool                      `json:"isActive"`
+	CreatedAt  time.Time                 `json:"createdAt"`
+	UpdatedAt  time.Time                 `json:"updatedAt"`
+}
+
+// ToCommissionRuleDTO convierte una entidad CommissionRule a DTO
+func ToCommissionRuleDTO(rule *entities.CommissionRule) CommissionRuleDTO {
+	tiers := rule.Tiers
+	if tiers == nil {
+		tiers = []entities.CommissionTier{}
+	}
+	return CommissionRuleDTO{
+		ID:         rule.ID,
+		Name:       rule.Name,
+		SellerID:   rule.SellerID,
+		CategoryID: rule.CategoryID,
+		Rate:       rule.Rate,
+		Tiers:      tiers,
+		IsActive:   rule.IsActive,
+		CreatedAt:  rule.CreatedAt,
+		UpdatedAt:  rule.UpdatedAt,
+	}
+}
+
+// ToCommissionRuleDTOList convierte una lista de reglas a DTOs
+func ToCommissionRuleDTOList(rules []entities.CommissionRule) []CommissionRuleDTO {
+	dtos := make([]CommissionRuleDTO, len(rules))
+	for i, rule := range rules {
+		dtos[i] = ToCommissionRuleDTO(&rule)
+	}
+	return dtos
+}
+
+// CommissionEntryDTO representa un movimiento del libro de comisiones
+type CommissionEntryDTO struct {
+	ID           uint      `json:"id"`
+	OrderID      uint      `json:"orderId"`
+	OrderNumber  string    `json:"orderNumber"`
+	Type         string    `json:"type"`
+	BaseAmount   float64   `json:"baseAmount"`
+	Amount       float64   `json:"amount"`
+	Description  string    `json:"description"`
+	ReversalOfID *uint     `json:"reversalOfId,omitempty"`
+	PayoutID     *uint     `json:"payoutId,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// ToCommissionEntryDTO convierte un movimiento de comisión a DTO
+func ToCommissionEntryDTO(entry *entities.CommissionEntry) CommissionEntryDTO {
+	return CommissionEntryDTO{
+		ID:           entry.ID,
+		OrderID:      entry.OrderID,
+		OrderNumber:  entry.OrderNumber,
+		Type:         string(entry.Type),
+		BaseAmount:   entry.BaseAmount,
+		Amount:       entry.Amount,
+		Description:  entry.Description,
+		ReversalOfID: entry.ReversalOfID,
+		PayoutID:     entry.PayoutID,
+		CreatedAt:    entry.CreatedAt,
+	}
+}
+
+// CommissionPayoutDTO representa una liquidación de comisiones
+type CommissionPayoutDTO struct {
+	ID                     uint      `json:"id"`
+	SellerID               uint      `json:"sellerId"`
+	PeriodStart            string    `json:"periodStart"`
+	PeriodEnd              string    `json:"periodEnd"`
+	Amount                 float64   `json:"amount"`
+	EntryCount             int       `json:"entryCount"`
+	FinancialTransactionID uint      `json:"financialTransactionId"`
+	PaidByID               *uint     `json:"paidById,omitempty"`
+	Notes                  string    `json:"notes,omitempty"`
+	CreatedAt              time.Time `json:"createdAt"`
+}
+
+// ToCommissionPayoutDTO convierte una liquidación a DTO
+func ToCommissionPayoutDTO(payout *entities.CommissionPayout) CommissionPayoutDTO {
+	return CommissionPayoutDTO{
+		ID:                     payout.ID,
+		SellerID:               payout.SellerID,
+		PeriodStart:            payout.PeriodStart.Format("2006-01-02"),
+		PeriodEnd:              payout.PeriodEnd.Format("2006-01-02"),
+		Amount:                 payout.Amount,
+		EntryCount:             payout.EntryCount,
+		FinancialTransactionID: payout.FinancialTransactionID,
+		PaidByID:               payout.PaidByID,
+		Notes:                  payout.Notes,
+		CreatedAt:              payout.CreatedAt,
+	}
+}
+
+// ToCommissionPayoutDTOList convierte una lista de liquidaciones a DTOs
+func ToCommissionPayoutDTOList(payouts []entities.CommissionPayout) []CommissionPayoutDTO {
+	dtos := make([]CommissionPayoutDTO, len(payouts))
+	for i, payout := range payouts {
+		dtos[i] = ToCommissionPayoutDTO(&payout)
+	}
+	return dtos
+}
+
+// CommissionStatementDTO representa el estado de cuenta de comisiones de un vendedor
+type CommissionStatementDTO struct {
+	SellerID       uint                  `json:"sellerId"`
+	SellerName     string                `json:"sellerName"`
+	PeriodStart    string                `json:"periodStart"`
+	PeriodEnd      string                `json:"periodEnd"`
+	Sales          float64               `json:"sales"`
+	Accrued        float64               `json:"accrued"`
+	ClawedBack     float64               `json:"clawedBack"`
+	Net            float64               `json:"net"`
+	Paid           float64               `json:"paid"`
+	PendingBalance float64               `json:"pendingBalance"`
+	Entries        []CommissionEntryDTO  `json:"entries"`
+	Payouts        []CommissionPayoutDTO `json:"payouts"`
+}
+
+// ToCommissionStatementDTO convierte el estado de cuenta de comisiones a DTO
+func ToCommissionStatementDTO(statement *entities.CommissionStatement) CommissionStatementDTO {
+	entries := make([]CommissionEntryDTO, len(statement.Entries))
+	for i, entry := range statement.Entries {
+		entries[i] = ToCommissionEntryDTO(&entry)
+	}
+	return CommissionStatementDTO{
+		SellerID:       statement.SellerID,
+		SellerName:     statement.SellerName,
+		PeriodStart:    statement.PeriodStart.Format("2006-01-02"),
+		PeriodEnd:      statement.PeriodEnd.Format("2006-01-02"),
+		Sales:          statement.Sales,
+		Accrued:        statement.Accrued,
+		ClawedBack:     statement.ClawedBack,
+		Net:            statement.Net,
+		Paid:           statement.Paid,
+		PendingBalance: statement.PendingBalance,
+		Entries:        entries,
+		Payouts:        ToCommissionPayoutDTOList(statement.Payouts),
+	}
+}
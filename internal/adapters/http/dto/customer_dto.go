@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
@@ -23,6 +24,7 @@ type CustomerDTO struct {
 	ShoesSize        *SizeDTO  `json:"shoesSize,omitempty"`
 	PaymentFrequency string    `json:"paymentFrequency,omitempty"`
 	PaymentDays      string    `json:"paymentDays,omitempty"`
+	AssignedSellerID *uint     `json:"assignedSellerId,omitempty"`
 	Balance          *float64  `json:"balance,omitempty"` // Balance del cliente (opcional)
 	CreatedAt        time.Time `json:"createdAt"`
 	UpdatedAt        time.Time `json:"updatedAt"`
@@ -47,6 +49,7 @@ func ToCustomerDTO(customer *entities.Customer) CustomerDTO {
 		ShoesSizeID:      customer.ShoesSizeID,
 		PaymentFrequency: string(customer.PaymentFrequency),
 		PaymentDays:      customer.PaymentDays,
+		AssignedSellerID: customer.AssignedSellerID,
 		BirthDate:        birthDate,
 		Notes:            customer.Notes,
 		CreatedAt:        customer.CreatedAt,
@@ -85,7 +88,12 @@ type CustomerTransactionDTO struct {
 	Description     string            `json:"description"`
 	PaymentMethodID *uint             `json:"paymentMethodId,omitempty"`
 	PaymentMethod   *PaymentMethodDTO `json:"paymentMethod,omitempty"`
+	CashSessionID   *uint             `json:"cashSessionId,omitempty"` // Sesión de caja en la que se cobró
+	OrderID         *uint             `json:"orderId,omitempty"`       // Venta que generó la deuda
 	Date            time.Time         `json:"date"`
+	ReversalOfID    *uint             `json:"reversalOfId,omitempty"`   // Movimiento que este asiento compensa
+	ReversedByID    *uint             `json:"reversedById,omitempty"`   // Reverso que anuló este movimiento
+	ReversalReason  string            `json:"reversalReason,omitempty"` // Motivo del reverso
 	CreatedAt       time.Time         `json:"createdAt"`
 }
 
@@ -98,7 +106,12 @@ func ToCustomerTransactionDTO(transaction *entities.CustomerTransaction) Custome
 		Amount:          transaction.Amount,
 		Description:     transaction.Description,
 		PaymentMethodID: transaction.PaymentMethodID,
+		CashSessionID:   transaction.CashSessionID,
+		OrderID:         transaction.OrderID,
 		Date:            transaction.Date,
+		ReversalOfID:    transaction.ReversalOfID,
+		ReversedByID:    transaction.ReversedByID,
+		ReversalReason:  transaction.ReversalReason,
 		CreatedAt:       transaction.CreatedAt,
 	}
 
@@ -147,3 +160,75 @@ func ToCustomerTransactionDTOListFromSlice(transactions []entities.CustomerTrans
 	}
 	return dtos
 }
+
+// DuplicateCandidateDTO representa un posible par de clientes duplicados
+type DuplicateCandidateDTO struct {
+	Customer       CustomerDTO `json:"customer"`  // Sugerido para conservar (el más antiguo)
+	Duplicate      CustomerDTO `json:"duplicate"` // Sugerido para fusionar
+	NameSimilarity float64     `json:"nameSimilarity"`
+	SamePhone      bool        `json:"samePhone"`
+	Reasons        []string    `json:"reasons"`
+}
+
+// ToDuplicateCandidateDTOList convierte los candidatos a duplicado a DTOs
+func ToDuplicateCandidateDTOList(candidates []entities.DuplicateCandidate) []DuplicateCandidateDTO {
+	dtos := make([]DuplicateCandidateDTO, len(candidates))
+	for i, candidate := range candidates {
+		dtos[i] = DuplicateCandidateDTO{
+			Customer:       ToCustomerDTO(&candidate.Customer),
+			Duplicate:      ToCustomerDTO(&candidate.Duplicate),
+			NameSimilarity: candidate.NameSimilarity,
+			SamePhone:      candidate.SamePhone,
+			Reasons:        candidate.Reasons,
+		}
+	}
+	return dtos
+}
+
+// CustomerMergeDTO representa el registro de una fusión de clientes
+type CustomerMergeDTO struct {
+	ID                  uint            `json:"id"`
+	SurvivorID          uint            `json:"survivorId"`
+	MergedID            uint            `json:"mergedId"`
+	MergedSnapshot      json.RawMessage `json:"mergedSnapshot,omitempty"`
+	TransactionsMoved   int64           `json:"transactionsMoved"`
+	OrdersMoved         int64           `json:"ordersMoved"`
+	CouponsMoved        int64           `json:"couponsMoved"`
+	LoyaltyEntriesMoved int64           `json:"loyaltyEntriesMoved"`
+	SurvivorBalance     float64         `json:"survivorBalance"`
+	MergedBalance       float64         `json:"mergedBalance"`
+	ResultingBalance    float64         `json:"resultingBalance"`
+	MergedBy            uint            `json:"mergedBy"`
+	CreatedAt           time.Time       `json:"createdAt"`
+}
+
+// ToCustomerMergeDTO convierte un registro de fusión a DTO
+func ToCustomerMergeDTO(merge *entities.CustomerMerge) CustomerMergeDTO {
+	dto := CustomerMergeDTO{
+		ID:                  merge.ID,
+		SurvivorID:          merge.SurvivorID,
+		MergedID:            merge.MergedID,
+		TransactionsMoved:   merge.TransactionsMoved,
+		OrdersMoved:         merge.OrdersMoved,
+		CouponsMoved:        merge.CouponsMoved,
+		LoyaltyEntriesMoved: merge.LoyaltyEntriesMoved,
+		SurvivorBalance:     merge.SurvivorBalance,
+		MergedBalance:       merge.MergedBalance,
+		ResultingBalance:    merge.ResultingBalance,
+		MergedBy:            merge.MergedBy,
+		CreatedAt:           merge.CreatedAt,
+	}
+	if merge.MergedSnapshot != "" {
+		dto.MergedSnapshot = json.RawMessage(merge.MergedSnapshot)
+	}
+	return dto
+}
+
+// ToCustomerMergeDTOList convierte una lista de fusiones a DTOs
+func ToCustomerMergeDTOList(merges []entities.CustomerMerge) []CustomerMergeDTO {
+	dtos := make([]CustomerMergeDTO, len(merges))
+	for i, merge := range merges {
+		dtos[i] = ToCustomerMergeDTO(&merge)
+	}
+	return dtos
+}
@@ -0,0 +1,196 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// InvoiceResolutionDTO representa una resolución de numeración en la API
+type InvoiceResolutionDTO struct {
+	ID               uint      `json:"id"`
+	DocumentType     string    `json:"documentType"` // INVOICE o CREDIT_NOTE
+	ResolutionNumber string    `json:"resolutionNumber,omitempty"`
+	Prefix           string    `json:"prefix"`
+	RangeFrom        int64     `json:"rangeFrom"`
+	RangeTo          int64     `json:"rangeTo"`
+	NextNumber       int64     `json:"nextNumber"`
+	Remaining        int64     `json:"remaining"` // Números disponibles
+	ValidFrom        time.Time `json:"validFrom"`
+	ValidTo          time.Time `json:"validTo"`
+	IsActive         bool      `json:"isActive"`
+	IsUsable         bool      `json:"isUsable"` // Activa, vigente hoy y con números disponibles
+}
+
+// ToInvoiceResolutionDTO convierte una resolución a DTO (la clave técnica no se expone)
+func ToInvoiceResolutionDTO(resolution *entities.InvoiceResolution) InvoiceResolutionDTO {
+	return InvoiceResolutionDTO{
+		ID:               resolution.ID,
+		DocumentType:     string(resolution.DocumentType),
+		ResolutionNumber: resolution.ResolutionNumber,
+		Prefix:           resolution.Prefix,
+		RangeFrom:        resolution.RangeFrom,
+		RangeTo:          resolution.RangeTo,
+		NextNumber:       resolution.NextNumber,
+		Remaining:        resolution.Remaining(),
+		ValidFrom:        resolution.ValidFrom,
+		ValidTo:          resolution.ValidTo,
+		IsActive:         resolution.IsActive,
+		IsUsable:         resolution.IsUsableAt(time.Now().In(entities.DianLocation)),
+	}
+}
+
+// ToInvoiceResolutionDTOList convierte una lista de resoluciones a DTOs
+func ToInvoiceResolutionDTOList(resolutions []entities.InvoiceResolution) []InvoiceResolutionDTO {
+	dtos := make([]InvoiceResolutionDTO, len(resolutions))
+	for i := range resolutions {
+		dtos[i] = ToInvoiceResolutionDTO(&resolutions[i])
+	}
+	return dtos
+}
+
+// CreateInvoiceResolutionRequest representa la petición para registrar una resolución
+type CreateInvoiceResolutionRequest struct {
+	DocumentType     string `json:"documentType"`
+	ResolutionNumber string `json:"resolutionNumber"`
+	Prefix           string `json:"prefix"`
+	RangeFrom        int64  `json:"rangeFrom"`
+	RangeTo          int64  `json:"rangeTo"`
+	TechnicalKey     string `json:"technicalKey"`
+	ValidFrom        string `json:"validFrom"` // YYYY-MM-DD
+	ValidTo          string `json:"validTo"`   // YYYY-MM-DD
+}
+
+// UpdateInvoiceResolutionRequest representa la petición para prorrogar o desactivar una resolución
+type UpdateInvoiceResolutionRequest struct {
+	ValidTo  string `json:"validTo"` // YYYY-MM-DD
+	IsActive *bool  `json:"isActive"`
+}
+
+// ElectronicDocumentDTO representa una factura o nota crédito electrónica en la API
+type ElectronicDocumentDTO struct {
+	ID               uint                        `json:"id"`
+	Type             string                      `json:"type"`   // INVOICE o CREDIT_NOTE
+	Status           string                      `json:"status"` // PENDING, ACCEPTED o REJECTED
+	Number           string                      `json:"number"` // Prefijo y consecutivo
+	Environment      string                      `json:"environment"`
+	OrderID          *uint                       `json:"orderId,omitempty"`
+	CustomerID       *uint                       `json:"customerId,omitempty"`
+	ReferenceID      *uint                       `json:"referenceId,omitempty"` // Factura que corrige la nota crédito
+	ReferenceNumber  string                      `json:"referenceNumber,omitempty"`
+	CorrectionCode   string                      `json:"correctionCode,omitempty"`
+	CorrectionReason string                      `json:"correctionReason,omitempty"`
+	BuyerIDType      string                      `json:"buyerIdType"`
+	BuyerIDNumber    string                      `json:"buyerIdNumber"`
+	BuyerName        string                      `json:"buyerName"`
+	BuyerEmail       string                      `json:"buyerEmail,omitempty"`
+	IssueDate        time.Time                   `json:"issueDate"`
+	PaymentMeans     string                      `json:"paymentMeans"`
+	PaymentDueDate   *time.Time                  `json:"paymentDueDate,omitempty"`
+	Notes            string                      `json:"notes,omitempty"`
+	Lines            []ElectronicDocumentLineDTO `json:"lines,omitempty"`
+	Subtotal         float64                     `json:"subtotal"`
+	TaxTotal         float64                     `json:"taxTotal"`
+	Total            float64                     `json:"total"`
+	CUFE             string                      `json:"cufe"`
+	ProviderTrackID  string                      `json:"providerTrackId,omitempty"`
+	ProviderMessage  string                      `json:"providerMessage,omitempty"`
+	SubmittedAt      *time.Time                  `json:"submittedAt,omitempty"`
+	CreatedAt        time.Time                   `json:"createdAt"`
+}
+
+// ElectronicDocumentLineDTO representa un renglón de la factura o nota crédito
+type ElectronicDocumentLineDTO struct {
+	LineNumber  int     `json:"lineNumber"`
+	Code        string  `json:"code,omitempty"`
+	Description string  `json:"description"`
+	Quantity    int     `json:"quantity"`
+	UnitPrice   float64 `json:"unitPrice"` // Sin IVA
+	Subtotal    float64 `json:"subtotal"`
+	TaxPercent  float64 `json:"taxPercent"`
+	TaxAmount   float64 `json:"taxAmount"`
+}
+
+// ToElectronicDocumentDTO convierte un documento electrónico a DTO (el XML se descarga aparte)
+func ToElectronicDocumentDTO(document *entities.ElectronicDocument) ElectronicDocumentDTO {
+	lines := make([]ElectronicDocumentLineDTO, len(document.Lines))
+	for i, line := range document.Lines {
+		lines[i] = ElectronicDocumentLineDTO{
+			LineNumber:  line.LineNumber,
+			Code:        line.Code,
+			Description: line.Description,
+			Quantity:    line.Quantity,
+			UnitPrice:   line.UnitPrice,
+			Subtotal:    line.Subtotal,
+			TaxPercent:  line.TaxPercent,
+			TaxAmount:   line.TaxAmount,
+		}
+	}
+
+	return ElectronicDocumentDTO{
+		ID:               document.ID,
+		Type:             string(document.Type),
+		Status:           string(document.Status),
+		Number:           document.FullNumber(),
+		Environment:      document.Environment,
+		OrderID:          document.OrderID,
+		CustomerID:       document.CustomerID,
+		ReferenceID:      document.ReferenceID,
+		ReferenceNumber:  document.ReferenceNumber,
+		CorrectionCode:   document.CorrectionCode,
+		CorrectionReason: document.CorrectionReason,
+		BuyerIDType:      document.BuyerIDType,
+		BuyerIDNumber:    document.BuyerIDNumber,
+		BuyerName:        document.BuyerName,
+		BuyerEmail:       document.BuyerEmail,
+		IssueDate:        document.IssueDate,
+		PaymentMeans:     document.PaymentMeans,
+		PaymentDueDate:   document.PaymentDueDate,
+		Notes:            document.Notes,
+		Lines:            lines,
+		Subtotal:         document.Subtotal,
+		TaxTotal:         document.TaxTotal,
+		Total:            document.Total,
+		CUFE:             document.CUFE,
+		ProviderTrackID:  document.ProviderTrackID,
+		ProviderMessage:  document.ProviderMessage,
+		SubmittedAt:      document.SubmittedAt,
+		CreatedAt:        document.CreatedAt,
+	}
+}
+
+// ToElectronicDocumentDTOList convierte una lista de documentos electrónicos a DTOs
+func ToElectronicDocumentDTOList(documents []entities.ElectronicDocument) []ElectronicDocumentDTO {
+	dtos := make([]ElectronicDocumentDTO, len(documents))
+	for i := range documents {
+		dtos[i] = ToElectronicDocumentDTO(&documents[i])
+	}
+	return dtos
+}
+
+// IssueInvoiceRequest representa la petición para facturar una orden
+// Sin buyerIdNumber se factura al consumidor final
+type IssueInvoiceRequest struct {
+	BuyerIDType    string `json:"buyerIdType"` // 13 cédula, 31 NIT, 22 cédula de extranjería, 41 pasaporte, 42 documento extranjero
+	BuyerIDNumber  string `json:"buyerIdNumber"`
+	BuyerName      string `json:"buyerName"`
+	BuyerEmail     string `json:"buyerEmail"`
+	BuyerAddress   string `json:"buyerAddress"`
+	BuyerPhone     string `json:"buyerPhone"`
+	PaymentDueDate string `json:"paymentDueDate"` // YYYY-MM-DD, solo ventas a crédito (por defecto 30 días)
+	Notes          string `json:"notes"`
+}
+
+// IssueCreditNoteRequest representa la petición para emitir una nota crédito sobre una factura
+// Sin lines se acredita la factura completa
+type IssueCreditNoteRequest struct {
+	CorrectionCode string                  `json:"correctionCode"` // 1 devolución, 2 anulación, 3 descuento, 4 ajuste de precio, 5 otros
+	Reason         string                  `json:"reason"`
+	Lines          []CreditNoteLineRequest `json:"lines"`
+}
+
+// CreditNoteLineRequest indica el renglón de la factura y la cantidad a acreditar
+type CreditNoteLineRequest struct {
+	LineNumber int `json:"lineNumber"`
+	Quantity   int `json:"quantity"`
+}
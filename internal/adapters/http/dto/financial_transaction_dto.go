@@ -15,6 +15,10 @@ type FinancialTransactionDTO struct {
 	Date        time.Time `json:"date"`        // Fecha de la transacción
 	CreatedAt   time.Time `json:"createdAt"`
 	UpdatedAt   time.Time `json:"updatedAt"`
+
+	RecurringTransactionID *uint `json:"recurringTransactionId,omitempty"` // Plantilla que la generó
+	PaymentMethodID        *uint `json:"paymentMethodId,omitempty"`        // Cuenta por la que entró o salió el dinero
+	OrderID                *uint `json:"orderId,omitempty"`                // Venta que generó el ingreso automático
 }
 
 func ToFinancialTransactionDTO(transaction *entities.FinancialTransaction) *FinancialTransactionDTO {
@@ -27,6 +31,10 @@ func ToFinancialTransactionDTO(transaction *entities.FinancialTransaction) *Fina
 		Date:        transaction.Date,
 		CreatedAt:   transaction.CreatedAt,
 		UpdatedAt:   transaction.UpdatedAt,
+
+		RecurringTransactionID: transaction.RecurringTransactionID,
+		PaymentMethodID:        transaction.PaymentMethodID,
+		OrderID:                transaction.OrderID,
 	}
 }
 
@@ -0,0 +1,339 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// LedgerAccountDTO representa una cuenta del plan de cuentas en la API
+type LedgerAccountDTO struct {
+	ID              uint   `json:"id"`
+	Code            string `json:"code"`
+	Name            string `json:"name"`
+	Type            string `json:"type"`                      // ASSET, LIABILITY, EQUITY, REVENUE, EXPENSE o COST
+	PaymentMethodID *uint  `json:"paymentMethodId,omitempty"` // Cuenta de caja o banco de un método de pago
+	System          bool   `json:"system"`
+	IsActive        bool   `json:"isActive"`
+}
+
+// ToLedgerAccountDTO convierte una cuenta a DTO
+func ToLedgerAccountDTO(account *entities.LedgerAccount) LedgerAccountDTO {
+	return LedgerAccountDTO{
+		ID:              account.ID,
+		Code:            account.Code,
+		Name:            account.Name,
+		Type:            string(account.Type),
+		PaymentMethodID: account.PaymentMethodID,
+		System:          account.System,
+		IsActive:        account.IsActive,
+	}
+}
+
+// ToLedgerAccountDTOList convierte una lista de cuentas a DTOs
+func ToLedgerAccountDTOList(accounts []entities.LedgerAccount) []LedgerAccountDTO {
+	dtos := make([]LedgerAccountDTO, len(accounts))
+	for i := range accounts {
+		dtos[i] = ToLedgerAccountDTO(&accounts[i])
+	}
+	return dtos
+}
+
+// CreateLedgerAccountRequest representa la petición para crear una cuenta
+type CreateLedgerAccountRequest struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// UpdateLedgerAccountRequest representa la petición para renombrar o activar/desactivar una cuenta
+type UpdateLedgerAccountRequest struct {
+	Name     string `json:"name"`
+	IsActive *bool  `json:"isActive"`
+}
+
+// JournalEntryDTO representa un asiento contable en la API
+type JournalEntryDTO struct {
+	ID           uint             `json:"id"`
+	Date         time.Time        `json:"date"`
+	Description  string           `json:"description"`
+	SourceType   string           `json:"sourceType"`         // SALE, CUSTOMER_TRANSACTION, FINANCIAL_TRANSACTION, ACCOUNT_TRANSFER, OPENING_BALANCE o MANUAL
+	SourceID     *uint            `json:"sourceId,omitempty"` // Registro que originó el asiento
+	ReversalOfID *uint            `json:"reversalOfId,omitempty"`
+	ReversedByID *uint            `json:"reversedById,omitempty"`
+	CreatedByID  *uint            `json:"createdById,omitempty"`
+	TotalDebit   float64          `json:"totalDebit"`
+	TotalCredit  float64          `json:"totalCredit"`
+	Lines        []JournalLineDTO `json:"lines"`
+	CreatedAt    time.Time        `json:"createdAt"`
+}
+
+// JournalLineDTO representa un débito o un crédito de un asiento
+type JournalLineDTO struct {
+	AccountID   uint    `json:"accountId"`
+	AccountCode string  `json:"accountCode,omitempty"`
+	AccountName string  `json:"accountName,omitempty"`
+	Debit       float64 `json:"debit"`
+	Credit      float64 `json:"credit"`
+	Description string  `json:"description,omitempty"`
+}
+
+// ToJournalEntryDTO convierte un asiento a DTO
+func ToJournalEntryDTO(entry *entities.JournalEntry) JournalEntryDTO {
+	dto := JournalEntryDTO{
+		ID:           entry.ID,
+		Date:         entry.Date,
+		Description:  entry.Description,
+		SourceType:   string(entry.SourceType),
+		SourceID:     entry.SourceID,
+		ReversalOfID: entry.ReversalOfID,
+		ReversedByID: entry.ReversedByID,
+		CreatedByID:  entry.CreatedByID,
+		TotalDebit:   entry.TotalDebit(),
+		TotalCredit:  entry.TotalCredit(),
+		Lines:        make([]JournalLineDTO, len(entry.Lines)),
+		CreatedAt:    entry.CreatedAt,
+	}
+	for i, line := range entry.Lines {
+		dto.Lines[i] = JournalLineDTO{
+			AccountID:   line.AccountID,
+			Debit:       line.Debit,
+			Credit:      line.Credit,
+			Description: line.Description,
+		}
+		if line.Account != nil {
+			dto.Lines[i].AccountCode = line.Account.Code
+			dto.Lines[i].AccountName = line.Account.Name
+		}
+	}
+	return dto
+}
+
+// ToJournalEntryDTOList convierte una lista de asientos a DTOs
+func ToJournalEntryDTOList(entries []entities.JournalEntry) []JournalEntryDTO {
+	dtos := make([]JournalEntryDTO, len(entries))
+	for i := range entries {
+		dtos[i] = ToJournalEntryDTO(&entries[i])
+	}
+	return dtos
+}
+
+// CreateJournalEntryRequest representa la petición para registrar un asiento manual
+type CreateJournalEntryRequest struct {
+	Date        *time.Time                 `json:"date"` // Opcional, default: ahora
+	Description string                     `json:"description"`
+	Lines       []CreateJournalLineRequest `json:"lines"`
+}
+
+// CreateJournalLineRequest representa una línea del asiento manual: débito o crédito, no ambos
+type CreateJournalLineRequest struct {
+	AccountID   uint    `json:"accountId"`
+	Debit       float64 `json:"debit"`
+	Credit      float64 `json:"credit"`
+	Description string  `json:"description"`
+}
+
+// ToEntity convierte la petición a un asiento
+func (r *CreateJournalEntryRequest) ToEntity() *entities.JournalEntry {
+	entry := &entities.JournalEntry{
+		Description: r.Description,
+		Lines:       make([]entities.JournalLine, len(r.Lines)),
+	}
+	if r.Date != nil {
+		entry.Date = *r.Date
+	}
+	for i, line := range r.Lines {
+		entry.Lines[i] = entities.JournalLine{
+			AccountID:   line.AccountID,
+			Debit:       line.Debit,
+			Credit:      line.Credit,
+			Description: line.Description,
+		}
+	}
+	return entry
+}
+
+// ReverseJournalEntryRequest representa la petición para anular un asiento manual
+type ReverseJournalEntryRequest struct {
+	Reason string `json:"reason"`
+}
+
+// TrialBalanceDTO representa el balance de prueba
+type TrialBalanceDTO struct {
+	StartDate   time.Time             `json:"startDate"`
+	EndDate     time.Time             `json:"endDate"`
+	Lines       []TrialBalanceLineDTO `json:"lines"`
+	TotalDebit  float64               `json:"totalDebit"`
+	TotalCredit float64               `json:"totalCredit"`
+	IsBalanced  bool                  `json:"isBalanced"`
+}
+
+// TrialBalanceLineDTO representa el movimiento de una cuenta en el periodo
+type TrialBalanceLineDTO struct {
+	Account        LedgerAccountDTO `json:"account"`
+	OpeningBalance float64          `json:"openingBalance"`
+	Debit          float64          `json:"debit"`
+	Credit         float64          `json:"credit"`
+	ClosingBalance float64          `json:"closingBalance"`
+}
+
+// ToTrialBalanceDTO convierte el balance de prueba a DTO
+func ToTrialBalanceDTO(trial *entities.TrialBalance) TrialBalanceDTO {
+	dto := TrialBalanceDTO{
+		StartDate:   trial.StartDate,
+		EndDate:     trial.EndDate,
+		Lines:       make([]TrialBalanceLineDTO, len(trial.Lines)),
+		TotalDebit:  trial.TotalDebit,
+		TotalCredit: trial.TotalCredit,
+		IsBalanced:  trial.IsBalanced(),
+	}
+	for i := range trial.Lines {
+		line := &trial.Lines[i]
+		dto.Lines[i] = TrialBalanceLineDTO{
+			Account:        ToLedgerAccountDTO(&line.Account),
+			OpeningBalance: line.OpeningBalance,
+			Debit:          line.Debit,
+			Credit:         line.Credit,
+			ClosingBalance: line.ClosingBalance,
+		}
+	}
+	return dto
+}
+
+// StatementLineDTO representa el saldo de una cuenta en un estado financiero
+type StatementLineDTO struct {
+	Code   string  `json:"code"`
+	Name   string  `json:"name"`
+	Amount float64 `json:"amount"`
+}
+
+func toStatementLineDTOList(lines []entities.StatementLine) []StatementLineDTO {
+	dtos := make([]StatementLineDTO, len(lines))
+	for i, line := range lines {
+		dtos[i] = StatementLineDTO{Code: line.Account.Code, Name: line.Account.Name, Amount: line.Amount}
+	}
+	return dtos
+}
+
+// IncomeStatementDTO representa el estado de resultados (P&G)
+type IncomeStatementDTO struct {
+	StartDate     time.Time          `json:"startDate"`
+	EndDate       time.Time          `json:"endDate"`
+	Revenue       []StatementLineDTO `json:"revenue"`
+	CostOfSales   []StatementLineDTO `json:"costOfSales"`
+	Expenses      []StatementLineDTO `json:"expenses"`
+	TotalRevenue  float64            `json:"totalRevenue"`
+	TotalCost     float64            `json:"totalCost"`
+	GrossProfit   float64            `json:"grossProfit"`
+	TotalExpenses float64            `json:"totalExpenses"`
+	NetIncome     float64            `json:"netIncome"`
+}
+
+// ToIncomeStatementDTO convierte el estado de resultados a DTO
+func ToIncomeStatementDTO(statement *entities.IncomeStatement) IncomeStatementDTO {
+	return IncomeStatementDTO{
+		StartDate:     statement.StartDate,
+		EndDate:       statement.EndDate,
+		Revenue:       toStatementLineDTOList(statement.Revenue),
+		CostOfSales:   toStatementLineDTOList(statement.CostOfSales),
+		Expenses:      toStatementLineDTOList(statement.Expenses),
+		TotalRevenue:  statement.TotalRevenue,
+		TotalCost:     statement.TotalCost,
+		GrossProfit:   statement.GrossProfit,
+		TotalExpenses: statement.TotalExpenses,
+		NetIncome:     statement.NetIncome,
+	}
+}
+
+// BalanceSheetDTO representa el balance general
+type BalanceSheetDTO struct {
+	Date             time.Time          `json:"date"`
+	Assets           []StatementLineDTO `json:"assets"`
+	Liabilities      []StatementLineDTO `json:"liabilities"`
+	Equity           []StatementLineDTO `json:"equity"`
+	NetIncome        float64            `json:"netIncome"` // Resultado acumulado, incluido en el patrimonio
+	TotalAssets      float64            `json:"totalAssets"`
+	TotalLiabilities float64            `json:"totalLiabilities"`
+	TotalEquity      float64            `json:"totalEquity"`
+	IsBalanced       bool               `json:"isBalanced"`
+}
+
+// ToBalanceSheetDTO convierte el balance general a DTO
+func ToBalanceSheetDTO(sheet *entities.BalanceSheet) BalanceSheetDTO {
+	return BalanceSheetDTO{
+		Date:             sheet.Date,
+		Assets:           toStatementLineDTOList(sheet.Assets),
+		Liabilities:      toStatementLineDTOList(sheet.Liabilities),
+		Equity:           toStatementLineDTOList(sheet.Equity),
+		NetIncome:        sheet.NetIncome,
+		TotalAssets:      sheet.TotalAssets,
+		TotalLiabilities: sheet.TotalLiabilities,
+		TotalEquity:      sheet.TotalEquity,
+		IsBalanced:       sheet.IsBalanced(),
+	}
+}
+
+// LedgerCrossCheckDTO representa el cruce del libro mayor con los demás módulos
+type LedgerCrossCheckDTO struct {
+	IsConsistent  bool             `json:"isConsistent"`
+	TrialBalanced bool             `json:"trialBalanced"`
+	TotalDebit    float64          `json:"totalDebit"`
+	TotalCredit   float64          `json:"totalCredit"`
+	Checks        []LedgerCheckDTO `json:"checks"`
+}
+
+// LedgerCheckDTO representa la comparación de una cuenta de control con su auxiliar
+type LedgerCheckDTO struct {
+	Name          string  `json:"name"`
+	AccountCode   string  `json:"accountCode"`
+	LedgerBalance float64 `json:"ledgerBalance"`
+	SourceBalance float64 `json:"sourceBalance"`
+	Difference    float64 `json:"difference"`
+	Matches       bool    `json:"matches"`
+}
+
+// ToLedgerCrossCheckDTO convierte el cruce a DTO
+func ToLedgerCrossCheckDTO(crossCheck *entities.LedgerCrossCheck) LedgerCrossCheckDTO {
+	dto := LedgerCrossCheckDTO{
+		IsConsistent:  crossCheck.IsConsistent(),
+		TrialBalanced: crossCheck.TrialBalanced,
+		TotalDebit:    crossCheck.TotalDebit,
+		TotalCredit:   crossCheck.TotalCredit,
+		Checks:        make([]LedgerCheckDTO, len(crossCheck.Checks)),
+	}
+	for i, check := range crossCheck.Checks {
+		dto.Checks[i] = LedgerCheckDTO{
+			Name:          check.Name,
+			AccountCode:   check.Account.Code,
+			LedgerBalance: check.LedgerBalance,
+			SourceBalance: check.SourceBalance,
+			Difference:    check.Difference,
+			Matches:       check.Matches(),
+		}
+	}
+	return dto
+}
+
+// LedgerSyncResultDTO representa los asientos creados por la sincronización
+type LedgerSyncResultDTO struct {
+	Sales                 int `json:"sales"`
+	CustomerTransactions  int `json:"customerTransactions"`
+	FinancialTransactions int `json:"financialTransactions"`
+	Transfers             int `json:"transfers"`
+	OpeningBalances       int `json:"openingBalances"`
+	Total                 int `json:"total"`
+	Failed                int `json:"failed"` // Registros que no se pudieron contabilizar (ver el log)
+}
+
+// ToLedgerSyncResultDTO convierte el resultado de la sincronización a DTO
+func ToLedgerSyncResultDTO(result entities.LedgerSyncResult) LedgerSyncResultDTO {
+	return LedgerSyncResultDTO{
+		Sales:                 result.Sales,
+		CustomerTransactions:  result.CustomerTransactions,
+		FinancialTransactions: result.FinancialTransactions,
+		Transfers:             result.Transfers,
+		OpeningBalances:       result.OpeningBalances,
+		Total:                 result.Total(),
+		Failed:                result.Failed,
+	}
+}
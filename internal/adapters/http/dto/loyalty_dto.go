@@ -0,0 +1,96 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// LoyaltyRuleDTO representa la respuesta de una regla de puntos
+type LoyaltyRuleDTO struct {
+	ID         uint      `json:"id"`
+	Name       string    `json:"name"`
+	Type       string    `json:"type"`
+	CategoryID *uint     `json:"categoryId,omitempty"`
+	AmountStep float64   `json:"amountStep,omitempty"`
+	Points     int       `json:"points,omitempty"`
+	Multiplier float64   `json:"multiplier,omitempty"`
+	IsActive   bool      `json:"isActive"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// ToLoyaltyRuleDTO convierte una entidad LoyaltyRule a DTO
+func ToLoyaltyRuleDTO(rule *entities.LoyaltyRule) LoyaltyRuleDTO {
+	return LoyaltyRuleDTO{
+		ID:         rule.ID,
+		Name:       rule.Name,
+		Type:       string(rule.Type),
+		CategoryID: rule.CategoryID,
+		AmountStep: rule.AmountStep,
+		Points:     rule.Points,
+		Multiplier: rule.Multiplier,
+		IsActive:   rule.IsActive,
+		CreatedAt:  rule.CreatedAt,
+		UpdatedAt:  rule.UpdatedAt,
+	}
+}
+
+// ToLoyaltyRuleDTOList convierte una lista de reglas a DTOs
+func ToLoyaltyRuleDTOList(rules []entities.LoyaltyRule) []LoyaltyRuleDTO {
+	dtos := make([]LoyaltyRuleDTO, len(rules))
+	for i, rule := range rules {
+		dtos[i] = ToLoyaltyRuleDTO(&rule)
+	}
+	return dtos
+}
+
+// LoyaltyPointsEntryDTO representa un movimiento del libro de puntos
+type LoyaltyPointsEntryDTO struct {
+	ID          uint       `json:"id"`
+	OrderID     *uint      `json:"orderId,omitempty"`
+	Type        string     `json:"type"`
+	Points      int        `json:"points"`
+	Remaining   int        `json:"remaining,omitempty"`
+	Description string     `json:"description"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+// LoyaltyStatementDTO representa el estado de cuenta de puntos de un cliente
+type LoyaltyStatementDTO struct {
+	CustomerID   uint                    `json:"customerId"`
+	CustomerName string                  `json:"customerName"`
+	Balance      int                     `json:"balance"`
+	PointValue   float64                 `json:"pointValue"`
+	BalanceValue float64                 `json:"balanceValue"`
+	ExpiringSoon int                     `json:"expiringSoon"`
+	Entries      []LoyaltyPointsEntryDTO `json:"entries"`
+}
+
+// ToLoyaltyStatementDTO convierte el estado de cuenta de puntos a DTO
+func ToLoyaltyStatementDTO(statement *entities.LoyaltyStatement) LoyaltyStatementDTO {
+	entries := make([]LoyaltyPointsEntryDTO, len(statement.Entries))
+	for i, entry := range statement.Entries {
+		entries[i] = LoyaltyPointsEntryDTO{
+			ID:          entry.ID,
+			OrderID:     entry.OrderID,
+			Type:        string(entry.Type),
+			Points:      entry.Points,
+			Remaining:   entry.Remaining,
+			Description: entry.Description,
+			ExpiresAt:   entry.ExpiresAt,
+			CreatedAt:   entry.CreatedAt,
+		}
+	}
+
+	return LoyaltyStatementDTO{
+		CustomerID:   statement.CustomerID,
+		CustomerName: statement.CustomerName,
+		Balance:      statement.Balance,
+		PointValue:   statement.PointValue,
+		BalanceValue: statement.BalanceValue,
+		ExpiringSoon: statement.ExpiringSoon,
+		Entries:      entries,
+	}
+}
@@ -18,7 +18,11 @@ type OrderDTO struct {
 	Status                string          `json:"status"`
 	TotalAmount           float64         `json:"totalAmount"`
 	Discount              float64         `json:"discount"`
+	CouponCode            string          `json:"couponCode,omitempty"`
+	LoyaltyPointsRedeemed int             `json:"loyaltyPointsRedeemed,omitempty"`
 	Notes                 string          `json:"notes,omitempty"`
+	PaymentMethodID       *uint           `json:"paymentMethodId,omitempty"` // Método de pago de una venta de contado
+	CashSessionID         *uint           `json:"cashSessionId,omitempty"`
 	OrderDate             time.Time       `json:"orderDate"`
 	EstimatedDeliveryDate *time.Time      `json:"estimatedDeliveryDate,omitempty"`
 	ActualDeliveryDate    *time.Time      `json:"actualDeliveryDate,omitempty"`
@@ -67,7 +71,11 @@ func ToOrderDTO(order *entities.Order) *OrderDTO {
 		Status:                string(order.Status),
 		TotalAmount:           order.TotalAmount,
 		Discount:              order.Discount,
+		CouponCode:            order.CouponCode,
+		LoyaltyPointsRedeemed: order.LoyaltyPointsRedeemed,
 		Notes:                 order.Notes,
+		PaymentMethodID:       order.PaymentMethodID,
+		CashSessionID:         order.CashSessionID,
 		OrderDate:             order.OrderDate,
 		EstimatedDeliveryDate: order.EstimatedDeliveryDate,
 		ActualDeliveryDate:    order.ActualDeliveryDate,
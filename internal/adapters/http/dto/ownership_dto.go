@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// ShareRecordRequest representa la petición para compartir una orden o cliente con un usuario
+type ShareRecordRequest struct {
+	UserID uint `json:"userId" validate:"required"`
+}
+
+// ReassignOrderRequest representa la petición para cambiar el vendedor de una orden
+type ReassignOrderRequest struct {
+	SellerID uint `json:"sellerId" validate:"required"`
+}
+
+// ReassignCustomerRequest representa la petición para cambiar el vendedor responsable de un cliente
+// (sellerId null deja el cliente sin asignar)
+type ReassignCustomerRequest struct {
+	SellerID *uint `json:"sellerId"`
+}
+
+// RecordShareDTO representa un acceso compartido en la respuesta
+type RecordShareDTO struct {
+	ID           uint      `json:"id"`
+	ResourceType string    `json:"resourceType"`
+	ResourceID   uint      `json:"resourceId"`
+	UserID       uint      `json:"userId"`
+	SharedBy     uint      `json:"sharedBy"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// ToRecordShareDTO convierte un acceso compartido a DTO
+func ToRecordShareDTO(share *entities.RecordShare) RecordShareDTO {
+	return RecordShareDTO{
+		ID:           share.ID,
+		ResourceType: string(share.ResourceType),
+		ResourceID:   share.ResourceID,
+		UserID:       share.UserID,
+		SharedBy:     share.SharedBy,
+		CreatedAt:    share.CreatedAt,
+	}
+}
+
+// ToRecordShareDTOList convierte una lista de accesos compartidos a DTOs
+func ToRecordShareDTOList(shares []entities.RecordShare) []RecordShareDTO {
+	dtos := make([]RecordShareDTO, len(shares))
+	for i := range shares {
+		dtos[i] = ToRecordShareDTO(&shares[i])
+	}
+	return dtos
+}
@@ -8,21 +8,25 @@ import (
 
 // PaymentMethodDTO representa la respuesta de un método de pago
 type PaymentMethodDTO struct {
-	ID        uint      `json:"id"`
-	Name      string    `json:"name"`
-	IsActive  bool      `json:"isActive"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ID             uint      `json:"id"`
+	Name           string    `json:"name"`
+	IsActive       bool      `json:"isActive"`
+	OpeningBalance float64   `json:"openingBalance"`
+	IsCash         bool      `json:"isCash"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
 }
 
 // ToPaymentMethodDTO convierte una entidad PaymentMethodOption a DTO
 func ToPaymentMethodDTO(paymentMethod *entities.PaymentMethodOption) PaymentMethodDTO {
 	return PaymentMethodDTO{
-		ID:        paymentMethod.ID,
-		Name:      paymentMethod.Name,
-		IsActive:  paymentMethod.IsActive,
-		CreatedAt: paymentMethod.CreatedAt,
-		UpdatedAt: paymentMethod.UpdatedAt,
+		ID:             paymentMethod.ID,
+		Name:           paymentMethod.Name,
+		IsActive:       paymentMethod.IsActive,
+		OpeningBalance: paymentMethod.OpeningBalance,
+		IsCash:         paymentMethod.IsCash,
+		CreatedAt:      paymentMethod.CreatedAt,
+		UpdatedAt:      paymentMethod.UpdatedAt,
 	}
 }
 
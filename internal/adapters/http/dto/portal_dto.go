@@ -0,0 +1,94 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// PortalCustomerDTO representa los datos que el cliente ve de sí mismo en el portal
+// (sin notas internas ni nivel de riesgo)
+type PortalCustomerDTO struct {
+	ID      uint    `json:"id"`
+	Name    string  `json:"name"`
+	Phone   string  `json:"phone"`
+	Address string  `json:"address,omitempty"`
+	Balance float64 `json:"balance"`
+}
+
+// ToPortalCustomerDTO convierte un cliente a DTO del portal
+func ToPortalCustomerDTO(customer *entities.Customer, balance float64) PortalCustomerDTO {
+	return PortalCustomerDTO{
+		ID:      customer.ID,
+		Name:    customer.Name,
+		Phone:   customer.Phone,
+		Address: customer.Address,
+		Balance: balance,
+	}
+}
+
+// PortalOrderDTO representa el seguimiento de una orden en el portal
+type PortalOrderDTO struct {
+	ID                    uint                 `json:"id"`
+	OrderNumber           string               `json:"orderNumber"`
+	Type                  string               `json:"type"`
+	Status                string               `json:"status"`
+	TotalAmount           float64              `json:"totalAmount"`
+	Discount              float64              `json:"discount"`
+	OrderDate             time.Time            `json:"orderDate"`
+	EstimatedDeliveryDate *time.Time           `json:"estimatedDeliveryDate,omitempty"`
+	ActualDeliveryDate    *time.Time           `json:"actualDeliveryDate,omitempty"`
+	Items                 []PortalOrderItemDTO `json:"items,omitempty"`
+}
+
+// PortalOrderItemDTO representa un item de orden en el portal
+type PortalOrderItemDTO struct {
+	ProductName string  `json:"productName"`
+	Color       string  `json:"color,omitempty"`
+	SizeName    string  `json:"sizeName,omitempty"`
+	Quantity    int     `json:"quantity"`
+	UnitPrice   float64 `json:"unitPrice"`
+	Subtotal    float64 `json:"subtotal"`
+}
+
+// ToPortalOrderDTO convierte una orden a DTO del portal
+func ToPortalOrderDTO(order *entities.Order) PortalOrderDTO {
+	dto := PortalOrderDTO{
+		ID:                    order.ID,
+		OrderNumber:           order.OrderNumber,
+		Type:                  string(order.Type),
+		Status:                string(order.Status),
+		TotalAmount:           order.TotalAmount,
+		Discount:              order.Discount,
+		OrderDate:             order.OrderDate,
+		EstimatedDeliveryDate: order.EstimatedDeliveryDate,
+		ActualDeliveryDate:    order.ActualDeliveryDate,
+	}
+
+	if len(order.Items) > 0 {
+		dto.Items = make([]PortalOrderItemDTO, len(order.Items))
+		for i, item := range order.Items {
+			dto.Items[i] = PortalOrderItemDTO{
+				ProductName: item.ProductName,
+				Color:       item.Color,
+				Quantity:    item.Quantity,
+				UnitPrice:   item.UnitPrice,
+				Subtotal:    item.Subtotal,
+			}
+			if item.Size != nil {
+				dto.Items[i].SizeName = item.Size.Value
+			}
+		}
+	}
+
+	return dto
+}
+
+// ToPortalOrderDTOList convierte una lista de órdenes a DTOs del portal
+func ToPortalOrderDTOList(orders []entities.Order) []PortalOrderDTO {
+	dtos := make([]PortalOrderDTO, len(orders))
+	for i, order := range orders {
+		dtos[i] = ToPortalOrderDTO(&order)
+	}
+	return dtos
+}
@@ -0,0 +1,81 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// CreateRoleRequest representa la petición para crear un rol
+type CreateRoleRequest struct {
+	Name        string   `json:"name" validate:"required"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// UpdateRoleRequest representa la petición para cambiar la descripción y los permisos de un rol
+type UpdateRoleRequest struct {
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// RoleDTO representa un rol en la respuesta
+type RoleDTO struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Permissions []string  `json:"permissions"`
+	IsSystem    bool      `json:"isSystem"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// PermissionDTO describe un permiso del catálogo
+type PermissionDTO struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+// ToPermissionList convierte los códigos recibidos a permisos del dominio
+func ToPermissionList(codes []string) []entities.Permission {
+	permissions := make([]entities.Permission, len(codes))
+	for i, code := range codes {
+		permissions[i] = entities.Permission(code)
+	}
+	return permissions
+}
+
+// ToRoleDTO convierte un rol a DTO
+func ToRoleDTO(role *entities.Role) RoleDTO {
+	permissions := make([]string, len(role.Permissions))
+	for i, p := range role.Permissions {
+		permissions[i] = string(p)
+	}
+	return RoleDTO{
+		ID:          role.ID,
+		Name:        string(role.Name),
+		Description: role.Description,
+		Permissions: permissions,
+		IsSystem:    role.IsSystem,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
+
+// ToRoleDTOList convierte una lista de roles a DTOs
+func ToRoleDTOList(roles []entities.Role) []RoleDTO {
+	dtos := make([]RoleDTO, len(roles))
+	for i := range roles {
+		dtos[i] = ToRoleDTO(&roles[i])
+	}
+	return dtos
+}
+
+// ToPermissionCatalogDTO convierte el catálogo de permisos a DTOs
+func ToPermissionCatalogDTO(catalog []entities.PermissionDefinition) []PermissionDTO {
+	dtos := make([]PermissionDTO, len(catalog))
+	for i, def := range catalog {
+		dtos[i] = PermissionDTO{Code: string(def.Code), Description: def.Description}
+	}
+	return dtos
+}
@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/auth"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// TwoFactorChallengeDTO es la respuesta del login cuando falta el segundo paso
+type TwoFactorChallengeDTO struct {
+	TwoFactorRequired  bool      `json:"twoFactorRequired"`
+	EnrollmentRequired bool      `json:"enrollmentRequired"` // El rol exige 2FA y el usuario aún no se enroló
+	ChallengeToken     string    `json:"challengeToken"`
+	ExpiresAt          time.Time `json:"expiresAt"`
+}
+
+// ToTwoFactorChallengeDTO convierte el desafío de segundo factor a DTO
+func ToTwoFactorChallengeDTO(challenge *entities.TwoFactorRequiredError) TwoFactorChallengeDTO {
+	return TwoFactorChallengeDTO{
+		TwoFactorRequired:  true,
+		EnrollmentRequired: challenge.EnrollmentRequired,
+		ChallengeToken:     challenge.ChallengeToken,
+		ExpiresAt:          challenge.ExpiresAt,
+	}
+}
+
+// TwoFactorSetupDTO contiene el secreto TOTP; otpauthUrl es el contenido del código QR
+type TwoFactorSetupDTO struct {
+	Secret     string `json:"secret"`
+	OTPAuthURL string `json:"otpauthUrl"`
+}
+
+// ToTwoFactorSetupDTO convierte los datos de enrolamiento a DTO
+func ToTwoFactorSetupDTO(setup *auth.TwoFactorSetup) TwoFactorSetupDTO {
+	return TwoFactorSetupDTO{
+		Secret:     setup.Secret,
+		OTPAuthURL: setup.OTPAuthURL,
+	}
+}
+
+// TwoFactorStatusDTO representa el estado del segundo factor del usuario
+type TwoFactorStatusDTO struct {
+	Enabled           bool       `json:"enabled"`
+	EnabledAt         *time.Time `json:"enabledAt"`
+	Required          bool       `json:"required"`
+	RecoveryCodesLeft int        `json:"recoveryCodesLeft"`
+}
+
+// ToTwoFactorStatusDTO convierte el estado del segundo factor a DTO
+func ToTwoFactorStatusDTO(status *auth.TwoFactorStatus) TwoFactorStatusDTO {
+	return TwoFactorStatusDTO{
+		Enabled:           status.Enabled,
+		EnabledAt:         status.EnabledAt,
+		Required:          status.Required,
+		RecoveryCodesLeft: status.RecoveryCodesLeft,
+	}
+}
+
+// TwoFactorChallengeRequest es el token del segundo paso del login
+type TwoFactorChallengeRequest struct {
+	ChallengeToken string `json:"challengeToken" validate:"required"`
+}
+
+// TwoFactorVerifyRequest completa el login con un código TOTP o de recuperación
+type TwoFactorVerifyRequest struct {
+	ChallengeToken string `json:"challengeToken" validate:"required"`
+	Code           string `json:"code" validate:"required"`
+}
+
+// TwoFactorCodeRequest contiene un código TOTP vigente
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" validate:"required"`
+}
+
+// TwoFactorDisableRequest pide la contraseña y un código para desactivar el segundo factor
+type TwoFactorDisableRequest struct {
+	Password string `json:"password" validate:"required"`
+	Code     string `json:"code" validate:"required"`
+}
@@ -0,0 +1,97 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// CreateUserInvitationRequest representa la petición para invitar a un usuario
+// Los permisos usan el mismo formato que el endpoint de permisos por categoría
+type CreateUserInvitationRequest struct {
+	Email       string            `json:"email" validate:"required,email"`
+	FirstName   string            `json:"firstName" validate:"required"`
+	LastName    string            `json:"lastName" validate:"required"`
+	Role        entities.UserRole `json:"role" validate:"required"`
+	Permissions []PermissionItem  `json:"permissions"`
+}
+
+// AcceptUserInvitationRequest representa la petición del invitado para crear su cuenta
+type AcceptUserInvitationRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=6"`
+}
+
+// UserInvitationDTO representa una invitación en la respuesta
+type UserInvitationDTO struct {
+	ID             uint             `json:"id"`
+	Email          string           `json:"email"`
+	FirstName      string           `json:"firstName"`
+	LastName       string           `json:"lastName"`
+	Role           string           `json:"role"`
+	Permissions    []PermissionItem `json:"permissions"`
+	Status         string           `json:"status"` // PENDING, ACCEPTED, REVOKED, EXPIRED
+	ExpiresAt      time.Time        `json:"expiresAt"`
+	AcceptedAt     *time.Time       `json:"acceptedAt,omitempty"`
+	AcceptedUserID *uint            `json:"acceptedUserId,omitempty"`
+	InvitedBy      uint             `json:"invitedBy"`
+	CreatedAt      time.Time        `json:"createdAt"`
+}
+
+// ToUserInvitationDTO convierte una invitación a DTO
+func ToUserInvitationDTO(invitation *entities.UserInvitation) UserInvitationDTO {
+	permissions := make([]PermissionItem, len(invitation.Permissions))
+	for i, perm := range invitation.Permissions {
+		permissions[i] = PermissionItem{
+			CategoryID: perm.CategoryID,
+			CanView:    perm.CanView,
+			CanCreate:  perm.CanCreate,
+			CanEdit:    perm.CanEdit,
+			CanDelete:  perm.CanDelete,
+		}
+	}
+
+	return UserInvitationDTO{
+		ID:             invitation.ID,
+		Email:          invitation.Email,
+		FirstName:      invitation.FirstName,
+		LastName:       invitation.LastName,
+		Role:           string(invitation.Role),
+		Permissions:    permissions,
+		Status:         invitation.Status(time.Now()),
+		ExpiresAt:      invitation.ExpiresAt,
+		AcceptedAt:     invitation.AcceptedAt,
+		AcceptedUserID: invitation.AcceptedUserID,
+		InvitedBy:      invitation.InvitedBy,
+		CreatedAt:      invitation.CreatedAt,
+	}
+}
+
+// ToUserInvitationDTOList convierte una lista de invitaciones a DTOs
+func ToUserInvitationDTOList(invitations []entities.UserInvitation) []UserInvitationDTO {
+	dtos := make([]UserInvitationDTO, len(invitations))
+	for i := range invitations {
+		dtos[i] = ToUserInvitationDTO(&invitations[i])
+	}
+	return dtos
+}
+
+// UserInvitationPreviewDTO muestra al invitado los datos de la cuenta que va a crear
+type UserInvitationPreviewDTO struct {
+	Email     string    `json:"email"`
+	FirstName string    `json:"firstName"`
+	LastName  string    `json:"lastName"`
+	Role      string    `json:"role"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// ToUserInvitationPreviewDTO convierte una invitación a la vista del invitado
+func ToUserInvitationPreviewDTO(invitation *entities.UserInvitation) UserInvitationPreviewDTO {
+	return UserInvitationPreviewDTO{
+		Email:     invitation.Email,
+		FirstName: invitation.FirstName,
+		LastName:  invitation.LastName,
+		Role:      string(invitation.Role),
+		ExpiresAt: invitation.ExpiresAt,
+	}
+}
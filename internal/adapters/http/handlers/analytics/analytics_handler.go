@@ -1,47 +1,104 @@
 package analytics
 
 import (
-	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/event_handlers"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	analyticsUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/analytics"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
 	"github.com/labstack/echo/v4"
 )
 
 // AnalyticsHTTPHandler maneja las peticiones HTTP de analytics
 type AnalyticsHTTPHandler struct {
-	analyticsHandler *event_handlers.AnalyticsHandler
+	getOrderAnalyticsUC *analyticsUseCases.GetOrderAnalyticsUseCase
 }
 
 // NewAnalyticsHTTPHandler crea un nuevo handler HTTP de analytics
-func NewAnalyticsHTTPHandler(analyticsHandler *event_handlers.AnalyticsHandler) *AnalyticsHTTPHandler {
+func NewAnalyticsHTTPHandler(getOrderAnalyticsUC *analyticsUseCases.GetOrderAnalyticsUseCase) *AnalyticsHTTPHandler {
 	return &AnalyticsHTTPHandler{
-		analyticsHandler: analyticsHandler,
+		getOrderAnalyticsUC: getOrderAnalyticsUC,
 	}
 }
 
-// GetMetrics retorna las métricas actuales del sistema
-// GET /api/analytics/metrics
+// parseFilters lee los filtros opcionales: startDate, endDate (YYYY-MM-DD), sellerId, categoryId y type
+func parseFilters(c echo.Context) (entities.AnalyticsFilters, error) {
+	var filters entities.AnalyticsFilters
+
+	if value := c.QueryParam("startDate"); value != "" {
+		date, err := time.ParseInLocation("2006-01-02", value, time.Local)
+		if err != nil {
+			return filters, errors.New("startDate must have format YYYY-MM-DD")
+		}
+		filters.StartDate = &date
+	}
+	if value := c.QueryParam("endDate"); value != "" {
+		date, err := time.ParseInLocation("2006-01-02", value, time.Local)
+		if err != nil {
+			return filters, errors.New("endDate must have format YYYY-MM-DD")
+		}
+		filters.EndDate = &date
+	}
+	if filters.StartDate != nil && filters.EndDate != nil && filters.EndDate.Before(*filters.StartDate) {
+		return filters, errors.New("endDate cannot be before startDate")
+	}
+
+	if value := c.QueryParam("sellerId"); value != "" {
+		id, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return filters, errors.New("invalid sellerId")
+		}
+		sellerID := uint(id)
+		filters.SellerID = &sellerID
+	}
+	if value := c.QueryParam("categoryId"); value != "" {
+		id, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return filters, errors.New("invalid categoryId")
+		}
+		categoryID := uint(id)
+		filters.CategoryID = &categoryID
+	}
+
+	if value := c.QueryParam("type"); value != "" {
+		orderType := entities.OrderType(strings.ToUpper(value))
+		switch orderType {
+		case entities.OrderTypeCustom, entities.OrderTypeInventory, entities.OrderTypeSale:
+			filters.OrderType = orderType
+		default:
+			return filters, errors.New("type must be CUSTOM, INVENTORY or SALE")
+		}
+	}
+
+	return filters, nil
+}
+
+// GetMetrics retorna las métricas de órdenes y ventas
+// GET /api/analytics/metrics?startDate=2026-01-01&endDate=2026-01-31&sellerId=3&categoryId=2&type=SALE
 func (h *AnalyticsHTTPHandler) GetMetrics(c echo.Context) error {
-	metrics := h.analyticsHandler.GetMetrics()
+	filters, err := parseFilters(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error(), err)
+	}
+
+	metrics, err := h.getOrderAnalyticsUC.Execute(c.Request().Context(), filters)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to calculate analytics", err)
+	}
 
 	// Convertir a un formato amigable para el frontend
-	response := map[string]interface{}{
-		"ordersByStatus": map[string]int{
-			"total":         metrics.TotalOrders,
-			"quote":         metrics.QuoteOrders,
-			"approved":      metrics.ApprovedOrders,
-			"manufacturing": metrics.ManufacturingOrders,
-			"finished":      metrics.FinishedOrders,
-			"delivered":     metrics.DeliveredOrders,
-			"cancelled":     metrics.CancelledOrders,
-		},
-		"ordersByType": map[string]int{
-			"custom":    metrics.CustomOrders,
-			"inventory": metrics.InventoryOrders,
-			"sale":      metrics.SaleOrders,
-		},
+	data := map[string]interface{}{
+		"ordersByStatus": ordersByStatus(metrics, true),
+		"ordersByType":   ordersByType(metrics),
 		"revenue": map[string]interface{}{
 			"total":             metrics.TotalRevenue,
 			"averageOrderValue": metrics.AverageOrderValue,
+			"paymentsCollected": metrics.PaymentsCollected,
+			"byCategory":        revenueByCategory(metrics),
+			"bySeller":          revenueBySeller(metrics),
 		},
 		"conversionRates": map[string]float64{
 			"approvalRate":     metrics.ApprovalRate,
@@ -51,19 +108,26 @@ func (h *AnalyticsHTTPHandler) GetMetrics(c echo.Context) error {
 		"manufacturing": map[string]interface{}{
 			"averageTime":        metrics.AverageManufacturingTime.String(),
 			"averageTimeSeconds": metrics.AverageManufacturingTime.Seconds(),
+			"ordersMeasured":     metrics.ManufacturedOrders,
 		},
+		"filters": filtersSummary(filters),
 	}
 
-	return c.JSON(200, map[string]interface{}{
-		"success": true,
-		"data":    response,
-	})
+	return response.OK(c, "Analytics retrieved successfully", data)
 }
 
 // GetDashboardSummary retorna un resumen optimizado para el dashboard
-// GET /api/analytics/dashboard
+// GET /api/analytics/dashboard (acepta los mismos filtros que /metrics)
 func (h *AnalyticsHTTPHandler) GetDashboardSummary(c echo.Context) error {
-	metrics := h.analyticsHandler.GetMetrics()
+	filters, err := parseFilters(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error(), err)
+	}
+
+	metrics, err := h.getOrderAnalyticsUC.Execute(c.Request().Context(), filters)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to calculate analytics", err)
+	}
 
 	// KPIs principales para el dashboard
 	summary := map[string]interface{}{
@@ -76,7 +140,7 @@ func (h *AnalyticsHTTPHandler) GetDashboardSummary(c echo.Context) error {
 			},
 			{
 				"label":  "Orders Delivered",
-				"value":  metrics.DeliveredOrders,
+				"value":  metrics.CountByStatus(entities.OrderStatusDelivered),
 				"format": "number",
 				"icon":   "check-circle",
 			},
@@ -93,27 +157,86 @@ func (h *AnalyticsHTTPHandler) GetDashboardSummary(c echo.Context) error {
 				"icon":   "percent",
 			},
 		},
-		"ordersByStatus": map[string]int{
-			"quote":         metrics.QuoteOrders,
-			"approved":      metrics.ApprovedOrders,
-			"manufacturing": metrics.ManufacturingOrders,
-			"finished":      metrics.FinishedOrders,
-			"delivered":     metrics.DeliveredOrders,
-			"cancelled":     metrics.CancelledOrders,
-		},
-		"ordersByType": map[string]int{
-			"custom":    metrics.CustomOrders,
-			"inventory": metrics.InventoryOrders,
-			"sale":      metrics.SaleOrders,
-		},
-		"alerts": h.generateAlerts(metrics),
+		"ordersByStatus": ordersByStatus(metrics, false),
+		"ordersByType":   ordersByType(metrics),
+		"alerts":         h.generateAlerts(metrics),
 	}
 
 	return response.Success(c, 200, "Dashboard summary retrieved successfully", summary)
 }
 
+func ordersByStatus(metrics *entities.OrderAnalytics, withTotal bool) map[string]int {
+	counts := map[string]int{
+		"quote":         metrics.CountByStatus(entities.OrderStatusQuote),
+		"approved":      metrics.CountByStatus(entities.OrderStatusApproved),
+		"manufacturing": metrics.CountByStatus(entities.OrderStatusManufacturing),
+		"finished":      metrics.CountByStatus(entities.OrderStatusFinished),
+		"delivered":     metrics.CountByStatus(entities.OrderStatusDelivered),
+		"cancelled":     metrics.CountByStatus(entities.OrderStatusCancelled),
+	}
+	if withTotal {
+		counts["total"] = metrics.TotalOrders
+	}
+	return counts
+}
+
+func ordersByType(metrics *entities.OrderAnalytics) map[string]int {
+	return map[string]int{
+		"custom":    metrics.OrdersByType[entities.OrderTypeCustom],
+		"inventory": metrics.OrdersByType[entities.OrderTypeInventory],
+		"sale":      metrics.OrdersByType[entities.OrderTypeSale],
+	}
+}
+
+func revenueByCategory(metrics *entities.OrderAnalytics) []map[string]interface{} {
+	rows := make([]map[string]interface{}, 0, len(metrics.RevenueByCategory))
+	for _, sales := range metrics.RevenueByCategory {
+		rows = append(rows, map[string]interface{}{
+			"categoryId":   sales.CategoryID,
+			"categoryName": sales.CategoryName,
+			"quantity":     sales.Quantity,
+			"revenue":      sales.Revenue,
+		})
+	}
+	return rows
+}
+
+func revenueBySeller(metrics *entities.OrderAnalytics) []map[string]interface{} {
+	rows := make([]map[string]interface{}, 0, len(metrics.RevenueBySeller))
+	for _, sales := range metrics.RevenueBySeller {
+		rows = append(rows, map[string]interface{}{
+			"sellerId":   sales.SellerID,
+			"sellerName": sales.SellerName,
+			"orders":     sales.Orders,
+			"revenue":    sales.Revenue,
+		})
+	}
+	return rows
+}
+
+// filtersSummary devuelve los filtros aplicados para que el frontend los muestre
+func filtersSummary(filters entities.AnalyticsFilters) map[string]interface{} {
+	summary := map[string]interface{}{}
+	if filters.StartDate != nil {
+		summary["startDate"] = filters.StartDate.Format("2006-01-02")
+	}
+	if filters.EndDate != nil {
+		summary["endDate"] = filters.EndDate.Format("2006-01-02")
+	}
+	if filters.SellerID != nil {
+		summary["sellerId"] = *filters.SellerID
+	}
+	if filters.CategoryID != nil {
+		summary["categoryId"] = *filters.CategoryID
+	}
+	if filters.OrderType != "" {
+		summary["type"] = filters.OrderType
+	}
+	return summary
+}
+
 // generateAlerts genera alertas basadas en las métricas
-func (h *AnalyticsHTTPHandler) generateAlerts(metrics event_handlers.OrderMetricsSnapshot) []map[string]interface{} {
+func (h *AnalyticsHTTPHandler) generateAlerts(metrics *entities.OrderAnalytics) []map[string]interface{} {
 	alerts := []map[string]interface{}{}
 
 	// Alerta de alta tasa de cancelación
@@ -137,11 +260,11 @@ func (h *AnalyticsHTTPHandler) generateAlerts(metrics event_handlers.OrderMetric
 	}
 
 	// Alerta de órdenes en manufactura
-	if metrics.ManufacturingOrders > 10 {
+	if manufacturing := metrics.CountByStatus(entities.OrderStatusManufacturing); manufacturing > 10 {
 		alerts = append(alerts, map[string]interface{}{
 			"type":    "info",
 			"message": "High number of orders in manufacturing",
-			"value":   metrics.ManufacturingOrders,
+			"value":   manufacturing,
 			"action":  "Monitor production capacity",
 		})
 	}
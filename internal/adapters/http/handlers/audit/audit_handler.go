@@ -2,6 +2,7 @@ package audit
 
 import (
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
@@ -27,9 +28,19 @@ func NewAuditHTTPHandler(repository ports.AuditLogRepository) *AuditHTTPHandler
 func (h *AuditHTTPHandler) GetAuditLogs(c echo.Context) error {
 	// Parsear filtros
 	filters := entities.AuditLogFilters{
-		EventType: c.QueryParam("eventType"),
-		Limit:     50, // Default
-		Offset:    0,
+		EventType:  c.QueryParam("eventType"),
+		EntityType: strings.ToUpper(c.QueryParam("entityType")),
+		Action:     strings.ToUpper(c.QueryParam("action")),
+		Limit:      50, // Default
+		Offset:     0,
+	}
+
+	// EntityID
+	if entityIDStr := c.QueryParam("entityId"); entityIDStr != "" {
+		if entityID, err := strconv.ParseUint(entityIDStr, 10, 32); err == nil {
+			entityIDUint := uint(entityID)
+			filters.EntityID = &entityIDUint
+		}
 	}
 
 	// OrderID
@@ -48,6 +59,14 @@ func (h *AuditHTTPHandler) GetAuditLogs(c echo.Context) error {
 		}
 	}
 
+	// APIKeyID
+	if apiKeyIDStr := c.QueryParam("apiKeyId"); apiKeyIDStr != "" {
+		if apiKeyID, err := strconv.ParseUint(apiKeyIDStr, 10, 32); err == nil {
+			apiKeyIDUint := uint(apiKeyID)
+			filters.APIKeyID = &apiKeyIDUint
+		}
+	}
+
 	// StartDate
 	if startDateStr := c.QueryParam("startDate"); startDateStr != "" {
 		if startDate, err := time.Parse("2006-01-02", startDateStr); err == nil {
@@ -132,6 +151,28 @@ func (h *AuditHTTPHandler) GetAuditLogsByOrder(c echo.Context) error {
 	})
 }
 
+// GetAuditLogsByEntity obtiene el historial de cambios de una entidad
+// GET /api/v1/audit/entities/:type/:id
+func (h *AuditHTTPHandler) GetAuditLogsByEntity(c echo.Context) error {
+	entityType := strings.ToUpper(c.Param("type"))
+	entityID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid entity ID", err)
+	}
+
+	logs, err := h.repository.GetByEntity(c.Request().Context(), entityType, uint(entityID))
+	if err != nil {
+		return response.InternalServerError(c, "Failed to retrieve audit logs", err)
+	}
+
+	return response.Success(c, 200, "Entity audit logs retrieved successfully", map[string]interface{}{
+		"entityType": entityType,
+		"entityId":   entityID,
+		"logs":       logs,
+		"total":      len(logs),
+	})
+}
+
 // GetAuditLogsByUser obtiene todos los logs de un usuario
 // GET /api/v1/audit/users/:userId/logs
 func (h *AuditHTTPHandler) GetAuditLogsByUser(c echo.Context) error {
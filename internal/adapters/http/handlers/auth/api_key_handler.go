@@ -0,0 +1,147 @@
+package auth
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/middleware"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/auth"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// APIKeyHandler maneja la administración de llaves de API para integraciones
+type APIKeyHandler struct {
+	createUC *auth.CreateAPIKeyUseCase
+	listUC   *auth.ListAPIKeysUseCase
+	rotateUC *auth.RotateAPIKeyUseCase
+	revokeUC *auth.RevokeAPIKeyUseCase
+}
+
+// NewAPIKeyHandler crea una nueva instancia del handler
+func NewAPIKeyHandler(
+	createUC *auth.CreateAPIKeyUseCase,
+	listUC *auth.ListAPIKeysUseCase,
+	rotateUC *auth.RotateAPIKeyUseCase,
+	revokeUC *auth.RevokeAPIKeyUseCase,
+) *APIKeyHandler {
+	return &APIKeyHandler{
+		createUC: createUC,
+		listUC:   listUC,
+		rotateUC: rotateUC,
+		revokeUC: revokeUC,
+	}
+}
+
+// apiKeyError traduce los errores de llaves de API a respuestas HTTP
+func apiKeyError(c echo.Context, message string, err error) error {
+	switch {
+	case errors.Is(err, entities.ErrAPIKeyNotFound):
+		return response.NotFound(c, err.Error())
+	case errors.Is(err, entities.ErrAPIKeyPermissionDenied), errors.Is(err, entities.ErrRoleEscalation):
+		return response.Forbidden(c, err.Error())
+	default:
+		return response.BadRequest(c, message, err)
+	}
+}
+
+// List lista las llaves de API (sin su secreto)
+// GET /api/v1/api-keys
+func (h *APIKeyHandler) List(c echo.Context) error {
+	keys, err := h.listUC.Execute(c.Request().Context())
+	if err != nil {
+		return response.InternalServerError(c, "Failed to list API keys", err)
+	}
+	return response.OK(c, "API keys retrieved successfully", dto.ToAPIKeyDTOList(keys))
+}
+
+// Create emite una llave de API; la llave en claro solo se muestra en esta respuesta
+// POST /api/v1/api-keys
+func (h *APIKeyHandler) Create(c echo.Context) error {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	var req dto.CreateAPIKeyRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	key, plain, err := h.createUC.Execute(c.Request().Context(), auth.CreateAPIKeyRequest{
+		Name:        req.Name,
+		Permissions: dto.ToPermissionList(req.Permissions),
+		OwnerID:     req.OwnerID,
+		ExpiresAt:   req.ExpiresAt,
+		CreatedBy:   user,
+		Client:      clientInfo(c),
+	})
+	if err != nil {
+		return apiKeyError(c, "Failed to create API key", err)
+	}
+
+	return response.Created(c, "API key created successfully, store it now: it will not be shown again", dto.IssuedAPIKeyDTO{
+		APIKeyDTO: dto.ToAPIKeyDTO(key),
+		Key:       plain,
+	})
+}
+
+// Rotate emite una llave nueva con los mismos permisos y vence la anterior tras el periodo de gracia
+// POST /api/v1/api-keys/:id/rotate
+func (h *APIKeyHandler) Rotate(c echo.Context) error {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid API key ID", err)
+	}
+
+	var req dto.RotateAPIKeyRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+	if req.GraceMinutes < 0 {
+		return response.BadRequest(c, "graceMinutes cannot be negative", nil)
+	}
+
+	key, plain, err := h.rotateUC.Execute(c.Request().Context(), auth.RotateAPIKeyRequest{
+		ID:        uint(id),
+		Grace:     time.Duration(req.GraceMinutes) * time.Minute,
+		ExpiresAt: req.ExpiresAt,
+		Actor:     user,
+		Client:    clientInfo(c),
+	})
+	if err != nil {
+		return apiKeyError(c, "Failed to rotate API key", err)
+	}
+
+	return response.Created(c, "API key rotated successfully, store it now: it will not be shown again", dto.IssuedAPIKeyDTO{
+		APIKeyDTO: dto.ToAPIKeyDTO(key),
+		Key:       plain,
+	})
+}
+
+// Revoke desactiva una llave de API de forma permanente
+// DELETE /api/v1/api-keys/:id
+func (h *APIKeyHandler) Revoke(c echo.Context) error {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid API key ID", err)
+	}
+
+	if err := h.revokeUC.Execute(c.Request().Context(), uint(id), user, clientInfo(c)); err != nil {
+		return apiKeyError(c, "Failed to revoke API key", err)
+	}
+	return response.OK(c, "API key revoked successfully", nil)
+}
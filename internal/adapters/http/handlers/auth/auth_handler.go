@@ -1,8 +1,14 @@
 package auth
 
 import (
+	"errors"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/middleware"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/auth"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
@@ -10,28 +16,50 @@ import (
 )
 
 type AuthHandler struct {
-	loginUC    *auth.LoginUseCase
-	registerUC *auth.RegisterUseCase
+	loginUC         *auth.LoginUseCase
+	registerUC      *auth.RegisterUseCase
+	refreshTokenUC  *auth.RefreshTokenUseCase
+	logoutUC        *auth.LogoutUseCase
+	listSessionsUC  *auth.ListSessionsUseCase
+	revokeSessionUC *auth.RevokeSessionUseCase
 }
 
-func NewAuthHandler(loginUC *auth.LoginUseCase, registerUC *auth.RegisterUseCase) *AuthHandler {
+func NewAuthHandler(
+	loginUC *auth.LoginUseCase,
+	registerUC *auth.RegisterUseCase,
+	refreshTokenUC *auth.RefreshTokenUseCase,
+	logoutUC *auth.LogoutUseCase,
+	listSessionsUC *auth.ListSessionsUseCase,
+	revokeSessionUC *auth.RevokeSessionUseCase,
+) *AuthHandler {
 	return &AuthHandler{
-		loginUC:    loginUC,
-		registerUC: registerUC,
+		loginUC:         loginUC,
+		registerUC:      registerUC,
+		refreshTokenUC:  refreshTokenUC,
+		logoutUC:        logoutUC,
+		listSessionsUC:  listSessionsUC,
+		revokeSessionUC: revokeSessionUC,
 	}
 }
 
 type LoginRequest struct {
 	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required"`
+	Password string `json:"password" validate:"required,min=6"`
 }
 
 type RegisterRequest struct {
-	Email     string            `json:"email" validate:"required,email"`
-	Password  string            `json:"password" validate:"required,min=6"`
-	FirstName string            `json:"first_name" validate:"required"`
-	LastName  string            `json:"last_name" validate:"required"`
-	Role      entities.UserRole `json:"role" validate:"required"`
+	Email     string `json:"email" validate:"required,email"`
+	Password  string `json:"password" validate:"required,min=6"`
+	FirstName string `json:"first_name" validate:"required"`
+	LastName  string `json:"last_name" validate:"required"`
+}
+
+// clientInfo extrae el dispositivo desde el que se hace la petición
+func clientInfo(c echo.Context) auth.ClientInfo {
+	return auth.ClientInfo{
+		UserAgent: c.Request().UserAgent(),
+		IPAddress: c.RealIP(),
+	}
 }
 
 func (h *AuthHandler) Login(c echo.Context) error {
@@ -40,15 +68,42 @@ func (h *AuthHandler) Login(c echo.Context) error {
 		return response.BadRequest(c, "Invalid request body", err)
 	}
 
-	token, user, err := h.loginUC.Execute(c.Request().Context(), req.Email, req.Password)
+	tokens, user, err := h.loginUC.Execute(c.Request().Context(), req.Email, req.Password, clientInfo(c))
+	var lockedErr *entities.LoginLockedError
+	if errors.As(err, &lockedErr) {
+		return lockedResponse(c, lockedErr)
+	}
+	var twoFactorErr *entities.TwoFactorRequiredError
+	if errors.As(err, &twoFactorErr) {
+		return response.OK(c, "Two-factor authentication required", dto.ToTwoFactorChallengeDTO(twoFactorErr))
+	}
 	if err != nil {
 		return response.Unauthorized(c, err.Error())
 	}
 
-	return response.OK(c, "Login successful", map[string]interface{}{
-		"token": token,
-		"user":  user,
-	})
+	return response.OK(c, "Login successful", loginData(tokens, user))
+}
+
+// loginData arma la respuesta de un login completado (con o sin segundo factor)
+func loginData(tokens *auth.AuthTokens, user *entities.User) map[string]interface{} {
+	tokensDTO := dto.ToAuthTokensDTO(tokens)
+	return map[string]interface{}{
+		"token":            tokensDTO.Token,
+		"refreshToken":     tokensDTO.RefreshToken,
+		"expiresAt":        tokensDTO.ExpiresAt,
+		"refreshExpiresAt": tokensDTO.RefreshExpiresAt,
+		"user":             user,
+	}
+}
+
+// lockedResponse responde 429 con Retry-After cuando la cuenta o IP está bloqueada
+func lockedResponse(c echo.Context, lockedErr *entities.LoginLockedError) error {
+	retryAfter := int(math.Ceil(time.Until(lockedErr.Until).Seconds()))
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+	c.Response().Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	return response.Error(c, http.StatusTooManyRequests, "Too many failed login attempts", lockedErr)
 }
 
 func (h *AuthHandler) Register(c echo.Context) error {
@@ -61,21 +116,117 @@ func (h *AuthHandler) Register(c echo.Context) error {
 		Email:     req.Email,
 		FirstName: req.FirstName,
 		LastName:  req.LastName,
-		Role:      req.Role,
-		IsActive:  true,
 	}
 
-	token, err := h.registerUC.Execute(c.Request().Context(), user, req.Password)
+	tokens, err := h.registerUC.Execute(c.Request().Context(), user, req.Password, clientInfo(c))
+	if errors.Is(err, entities.ErrRegistrationDisabled) {
+		return response.Forbidden(c, err.Error())
+	}
+	var twoFactorErr *entities.TwoFactorRequiredError
+	if errors.As(err, &twoFactorErr) {
+		return response.Created(c, "Two-factor authentication required", dto.ToTwoFactorChallengeDTO(twoFactorErr))
+	}
 	if err != nil {
 		return response.BadRequest(c, "Failed to register user", err)
 	}
 
+	tokensDTO := dto.ToAuthTokensDTO(tokens)
 	return c.JSON(http.StatusCreated, map[string]interface{}{
 		"success": true,
 		"message": "User registered successfully",
 		"data": map[string]interface{}{
-			"token": token,
-			"user":  user,
+			"token":            tokensDTO.Token,
+			"refreshToken":     tokensDTO.RefreshToken,
+			"expiresAt":        tokensDTO.ExpiresAt,
+			"refreshExpiresAt": tokensDTO.RefreshExpiresAt,
+			"user":             user,
 		},
 	})
 }
+
+// RefreshRequest representa la petición para renovar el access token
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// Refresh canjea el refresh token por un nuevo par de tokens (el anterior deja de servir)
+// POST /api/v1/auth/refresh
+func (h *AuthHandler) Refresh(c echo.Context) error {
+	var req RefreshRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	tokens, err := h.refreshTokenUC.Execute(c.Request().Context(), req.RefreshToken)
+	if err != nil {
+		return response.Unauthorized(c, "Invalid or expired refresh token")
+	}
+
+	return response.OK(c, "Token refreshed successfully", dto.ToAuthTokensDTO(tokens))
+}
+
+// LogoutRequest representa la petición de cierre de sesión
+type LogoutRequest struct {
+	AllDevices bool `json:"allDevices"` // Cierra también las sesiones de los demás dispositivos
+}
+
+// Logout revoca la sesión actual (o todas con allDevices)
+// POST /api/v1/auth/logout
+func (h *AuthHandler) Logout(c echo.Context) error {
+	var req LogoutRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+	sessionID, err := middleware.GetSessionIDFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "Session not found")
+	}
+
+	if err := h.logoutUC.Execute(c.Request().Context(), user.ID, sessionID, req.AllDevices); err != nil {
+		return response.InternalServerError(c, "Failed to logout", err)
+	}
+
+	return response.OK(c, "Logout successful", nil)
+}
+
+// ListSessions lista los dispositivos con sesión activa del usuario autenticado
+// GET /api/v1/auth/sessions
+func (h *AuthHandler) ListSessions(c echo.Context) error {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+	sessionID, _ := middleware.GetSessionIDFromContext(c)
+
+	sessions, err := h.listSessionsUC.Execute(c.Request().Context(), user.ID)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to list sessions", err)
+	}
+
+	return response.OK(c, "Sessions retrieved successfully", dto.ToUserSessionDTOList(sessions, sessionID))
+}
+
+// RevokeSession cierra una sesión del usuario autenticado (ej: un dispositivo perdido)
+// DELETE /api/v1/auth/sessions/:id
+func (h *AuthHandler) RevokeSession(c echo.Context) error {
+	sessionID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid session ID", err)
+	}
+
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	if err := h.revokeSessionUC.Execute(c.Request().Context(), user.ID, uint(sessionID)); err != nil {
+		return response.NotFound(c, "Session not found")
+	}
+
+	return response.OK(c, "Session revoked successfully", nil)
+}
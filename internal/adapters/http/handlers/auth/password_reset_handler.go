@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"errors"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/auth"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// PasswordResetHandler maneja la recuperación de contraseña olvidada
+type PasswordResetHandler struct {
+	requestResetUC *auth.RequestPasswordResetUseCase
+	confirmResetUC *auth.ConfirmPasswordResetUseCase
+}
+
+// NewPasswordResetHandler crea una nueva instancia del handler
+func NewPasswordResetHandler(
+	requestResetUC *auth.RequestPasswordResetUseCase,
+	confirmResetUC *auth.ConfirmPasswordResetUseCase,
+) *PasswordResetHandler {
+	return &PasswordResetHandler{
+		requestResetUC: requestResetUC,
+		confirmResetUC: confirmResetUC,
+	}
+}
+
+// ForgotPasswordRequest representa la petición de un enlace de recuperación
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// ResetPasswordRequest representa la petición para fijar la nueva contraseña
+type ResetPasswordRequest struct {
+	Token       string `json:"token" validate:"required"`
+	NewPassword string `json:"newPassword" validate:"required"`
+}
+
+// Forgot envía el enlace de recuperación al email, si corresponde a un usuario activo
+// Siempre responde igual para no revelar qué emails están registrados
+// POST /api/v1/auth/password/forgot
+func (h *PasswordResetHandler) Forgot(c echo.Context) error {
+	var req ForgotPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+	if req.Email == "" {
+		return response.BadRequest(c, "email is required", nil)
+	}
+
+	if err := h.requestResetUC.Execute(c.Request().Context(), req.Email, clientInfo(c)); err != nil {
+		return response.InternalServerError(c, "Failed to send password reset link", err)
+	}
+
+	return response.OK(c, "If the email belongs to an active account, a reset link has been sent", nil)
+}
+
+// Reset fija la nueva contraseña usando el token del enlace y cierra todas las sesiones
+// POST /api/v1/auth/password/reset
+func (h *PasswordResetHandler) Reset(c echo.Context) error {
+	var req ResetPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	err := h.confirmResetUC.Execute(c.Request().Context(), req.Token, req.NewPassword, clientInfo(c))
+	if errors.Is(err, entities.ErrInvalidResetToken) {
+		return response.BadRequest(c, "Invalid or expired reset link", err)
+	}
+	if err != nil {
+		return response.BadRequest(c, "Failed to reset password", err)
+	}
+
+	return response.OK(c, "Password reset successfully, you can now log in", nil)
+}
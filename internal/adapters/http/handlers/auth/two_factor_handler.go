@@ -0,0 +1,230 @@
+package auth
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/middleware"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/auth"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// TwoFactorHandler maneja el segundo factor (TOTP): enrolamiento, segundo paso del login y recuperación
+type TwoFactorHandler struct {
+	verifyUC     *auth.VerifyTwoFactorUseCase
+	setupUC      *auth.SetupTwoFactorUseCase
+	enableUC     *auth.EnableTwoFactorUseCase
+	disableUC    *auth.DisableTwoFactorUseCase
+	regenerateUC *auth.RegenerateRecoveryCodesUseCase
+	getStatusUC  *auth.GetTwoFactorStatusUseCase
+}
+
+// NewTwoFactorHandler crea una nueva instancia del handler
+func NewTwoFactorHandler(
+	verifyUC *auth.VerifyTwoFactorUseCase,
+	setupUC *auth.SetupTwoFactorUseCase,
+	enableUC *auth.EnableTwoFactorUseCase,
+	disableUC *auth.DisableTwoFactorUseCase,
+	regenerateUC *auth.RegenerateRecoveryCodesUseCase,
+	getStatusUC *auth.GetTwoFactorStatusUseCase,
+) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		verifyUC:     verifyUC,
+		setupUC:      setupUC,
+		enableUC:     enableUC,
+		disableUC:    disableUC,
+		regenerateUC: regenerateUC,
+		getStatusUC:  getStatusUC,
+	}
+}
+
+// twoFactorError traduce los errores del segundo factor a respuestas HTTP
+func twoFactorError(c echo.Context, message string, err error) error {
+	var lockedErr *entities.LoginLockedError
+	switch {
+	case errors.As(err, &lockedErr):
+		return lockedResponse(c, lockedErr)
+	case errors.Is(err, entities.ErrInvalidTwoFactorSession):
+		return response.Unauthorized(c, "Invalid or expired two-factor challenge, log in again")
+	case errors.Is(err, entities.ErrInvalidTwoFactorCode):
+		return response.Unauthorized(c, "Invalid two-factor code")
+	case errors.Is(err, entities.ErrTwoFactorRequiredForRole):
+		return response.Forbidden(c, err.Error())
+	default:
+		return response.BadRequest(c, message, err)
+	}
+}
+
+// Verify completa el login con el código de la app o un código de recuperación
+// POST /api/v1/auth/2fa/verify
+func (h *TwoFactorHandler) Verify(c echo.Context) error {
+	var req dto.TwoFactorVerifyRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	tokens, user, err := h.verifyUC.Execute(c.Request().Context(), req.ChallengeToken, req.Code, clientInfo(c))
+	if err != nil {
+		return twoFactorError(c, "Failed to verify two-factor code", err)
+	}
+
+	return response.OK(c, "Login successful", loginData(tokens, user))
+}
+
+// EnrollSetup genera el secreto para un usuario obligado a enrolarse durante el login
+// POST /api/v1/auth/2fa/enroll/setup
+func (h *TwoFactorHandler) EnrollSetup(c echo.Context) error {
+	var req dto.TwoFactorChallengeRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	setup, err := h.verifyUC.Setup(c.Request().Context(), req.ChallengeToken, clientInfo(c))
+	if err != nil {
+		return twoFactorError(c, "Failed to set up two-factor authentication", err)
+	}
+
+	return response.OK(c, "Scan the QR code with your authenticator app", dto.ToTwoFactorSetupDTO(setup))
+}
+
+// EnrollConfirm activa el segundo factor durante el login y abre la sesión
+// POST /api/v1/auth/2fa/enroll/confirm
+func (h *TwoFactorHandler) EnrollConfirm(c echo.Context) error {
+	var req dto.TwoFactorVerifyRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	tokens, user, codes, err := h.verifyUC.Enroll(c.Request().Context(), req.ChallengeToken, req.Code, clientInfo(c))
+	if err != nil {
+		return twoFactorError(c, "Failed to enable two-factor authentication", err)
+	}
+
+	data := loginData(tokens, user)
+	data["recoveryCodes"] = codes
+	return response.OK(c, "Two-factor authentication enabled, store the recovery codes in a safe place", data)
+}
+
+// Status retorna el estado del segundo factor del usuario autenticado
+// GET /api/v1/auth/2fa
+func (h *TwoFactorHandler) Status(c echo.Context) error {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	status, err := h.getStatusUC.Execute(c.Request().Context(), user.ID)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get two-factor status", err)
+	}
+
+	return response.OK(c, "Two-factor status retrieved successfully", dto.ToTwoFactorStatusDTO(status))
+}
+
+// Setup genera el secreto para enrolarse voluntariamente
+// POST /api/v1/auth/2fa/setup
+func (h *TwoFactorHandler) Setup(c echo.Context) error {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	setup, err := h.setupUC.Execute(c.Request().Context(), user.ID)
+	if err != nil {
+		return twoFactorError(c, "Failed to set up two-factor authentication", err)
+	}
+
+	return response.OK(c, "Scan the QR code with your authenticator app", dto.ToTwoFactorSetupDTO(setup))
+}
+
+// Enable confirma el enrolamiento con un código y retorna los códigos de recuperación
+// POST /api/v1/auth/2fa/enable
+func (h *TwoFactorHandler) Enable(c echo.Context) error {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	var req dto.TwoFactorCodeRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	codes, err := h.enableUC.Execute(c.Request().Context(), user.ID, req.Code, clientInfo(c))
+	if err != nil {
+		return twoFactorError(c, "Failed to enable two-factor authentication", err)
+	}
+
+	return response.OK(c, "Two-factor authentication enabled, store the recovery codes in a safe place", map[string]interface{}{
+		"recoveryCodes": codes,
+	})
+}
+
+// Disable desactiva el segundo factor propio (no permitido si el rol lo exige)
+// POST /api/v1/auth/2fa/disable
+func (h *TwoFactorHandler) Disable(c echo.Context) error {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	var req dto.TwoFactorDisableRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	if err := h.disableUC.Execute(c.Request().Context(), user.ID, req.Password, req.Code, clientInfo(c)); err != nil {
+		return twoFactorError(c, "Failed to disable two-factor authentication", err)
+	}
+
+	return response.OK(c, "Two-factor authentication disabled", nil)
+}
+
+// RegenerateRecoveryCodes reemplaza los códigos de recuperación
+// POST /api/v1/auth/2fa/recovery-codes
+func (h *TwoFactorHandler) RegenerateRecoveryCodes(c echo.Context) error {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	var req dto.TwoFactorCodeRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	codes, err := h.regenerateUC.Execute(c.Request().Context(), user.ID, req.Code, clientInfo(c))
+	if err != nil {
+		return twoFactorError(c, "Failed to regenerate recovery codes", err)
+	}
+
+	return response.OK(c, "Recovery codes regenerated, the previous ones no longer work", map[string]interface{}{
+		"recoveryCodes": codes,
+	})
+}
+
+// AdminReset elimina el segundo factor de otro usuario con un rol que el actor puede otorgar
+// DELETE /api/v1/users/:id/2fa
+func (h *TwoFactorHandler) AdminReset(c echo.Context) error {
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid user ID", err)
+	}
+
+	actor, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	if err := h.disableUC.AdminReset(c.Request().Context(), actor, uint(userID), clientInfo(c)); err != nil {
+		if errors.Is(err, entities.ErrRoleEscalation) {
+			return response.Forbidden(c, err.Error())
+		}
+		return response.NotFound(c, "User not found")
+	}
+
+	return response.OK(c, "Two-factor authentication reset", nil)
+}
@@ -0,0 +1,253 @@
+package campaign
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/middleware"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/campaign"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// CampaignHandler maneja las peticiones HTTP de campañas y cupones
+type CampaignHandler struct {
+	createCampaignUC      *campaign.CreateCampaignUseCase
+	getCampaignUC         *campaign.GetCampaignUseCase
+	listCampaignsUC       *campaign.ListCampaignsUseCase
+	previewAudienceUC     *campaign.PreviewAudienceUseCase
+	generateCouponsUC     *campaign.GenerateCouponsUseCase
+	validateCouponUC      *campaign.ValidateCouponUseCase
+	listCustomerCouponsUC *campaign.ListCustomerCouponsUseCase
+}
+
+// NewCampaignHandler crea una nueva instancia del handler
+func NewCampaignHandler(
+	createCampaignUC *campaign.CreateCampaignUseCase,
+	getCampaignUC *campaign.GetCampaignUseCase,
+	listCampaignsUC *campaign.ListCampaignsUseCase,
+	previewAudienceUC *campaign.PreviewAudienceUseCase,
+	generateCouponsUC *campaign.GenerateCouponsUseCase,
+	validateCouponUC *campaign.ValidateCouponUseCase,
+	listCustomerCouponsUC *campaign.ListCustomerCouponsUseCase,
+) *CampaignHandler {
+	return &CampaignHandler{
+		createCampaignUC:      createCampaignUC,
+		getCampaignUC:         getCampaignUC,
+		listCampaignsUC:       listCampaignsUC,
+		previewAudienceUC:     previewAudienceUC,
+		generateCouponsUC:     generateCouponsUC,
+		validateCouponUC:      validateCouponUC,
+		listCustomerCouponsUC: listCustomerCouponsUC,
+	}
+}
+
+// CreateCampaignRequest representa la petición para crear una campaña
+type CreateCampaignRequest struct {
+	Name            string                    `json:"name"`
+	Type            entities.CampaignType     `json:"type"`
+	Criteria        entities.CampaignCriteria `json:"criteria"`
+	DiscountType    entities.DiscountType     `json:"discountType"`
+	DiscountValue   float64                   `json:"discountValue"`
+	MessageTemplate string                    `json:"messageTemplate"` // {nombre}, {codigo}, {descuento}, {vence}
+	ValidFrom       string                    `json:"validFrom"`       // YYYY-MM-DD (opcional, default: hoy)
+	ValidUntil      string                    `json:"validUntil"`      // YYYY-MM-DD
+}
+
+// Create crea una nueva campaña
+// POST /api/v1/campaigns
+func (h *CampaignHandler) Create(c echo.Context) error {
+	var req CreateCampaignRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	validFrom := time.Now()
+	if req.ValidFrom != "" {
+		t, err := time.Parse("2006-01-02", req.ValidFrom)
+		if err != nil {
+			return response.BadRequest(c, "Invalid validFrom format. Use YYYY-MM-DD", err)
+		}
+		validFrom = t
+	}
+
+	validUntil, err := time.Parse("2006-01-02", req.ValidUntil)
+	if err != nil {
+		return response.BadRequest(c, "Invalid validUntil format. Use YYYY-MM-DD", err)
+	}
+	// Incluir todo el último día de vigencia
+	validUntil = validUntil.Add(24*time.Hour - time.Second)
+
+	campaignEntity := &entities.Campaign{
+		Name:            req.Name,
+		Type:            req.Type,
+		Criteria:        req.Criteria,
+		DiscountType:    req.DiscountType,
+		DiscountValue:   req.DiscountValue,
+		MessageTemplate: req.MessageTemplate,
+		ValidFrom:       validFrom,
+		ValidUntil:      validUntil,
+	}
+
+	if user, err := middleware.GetUserFromContext(c); err == nil {
+		campaignEntity.CreatedBy = user.ID
+	}
+
+	if err := h.createCampaignUC.Execute(c.Request().Context(), campaignEntity); err != nil {
+		return response.BadRequest(c, "Failed to create campaign", err)
+	}
+
+	return response.Created(c, "Campaign created successfully", dto.ToCampaignDTO(campaignEntity))
+}
+
+// List lista las campañas
+// GET /api/v1/campaigns
+func (h *CampaignHandler) List(c echo.Context) error {
+	filters := make(map[string]interface{})
+
+	if campaignType := c.QueryParam("type"); campaignType != "" {
+		filters["type"] = campaignType
+	}
+	if isActive := c.QueryParam("is_active"); isActive != "" {
+		filters["is_active"] = isActive == "true"
+	}
+
+	campaigns, err := h.listCampaignsUC.Execute(c.Request().Context(), filters)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to list campaigns", err)
+	}
+
+	return response.OK(c, "Campaigns retrieved successfully", dto.ToCampaignDTOList(campaigns))
+}
+
+// GetByID obtiene una campaña con sus estadísticas de redención
+// GET /api/v1/campaigns/:id
+func (h *CampaignHandler) GetByID(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid campaign ID", err)
+	}
+
+	campaignEntity, stats, err := h.getCampaignUC.Execute(c.Request().Context(), uint(id))
+	if err != nil {
+		return response.NotFound(c, "Campaign not found")
+	}
+
+	campaignDTO := dto.ToCampaignDTO(campaignEntity)
+	campaignDTO.Stats = stats
+
+	return response.OK(c, "Campaign retrieved successfully", campaignDTO)
+}
+
+// PreviewAudience muestra los clientes que recibirían la campaña
+// GET /api/v1/campaigns/:id/audience
+func (h *CampaignHandler) PreviewAudience(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid campaign ID", err)
+	}
+
+	customers, err := h.previewAudienceUC.Execute(c.Request().Context(), uint(id))
+	if err != nil {
+		return response.BadRequest(c, "Failed to preview campaign audience", err)
+	}
+
+	return response.OK(c, "Campaign audience retrieved successfully", map[string]interface{}{
+		"total":     len(customers),
+		"customers": dto.ToCustomerDTOList(customers),
+	})
+}
+
+// GenerateCoupons emite los cupones y mensajes personalizados de la campaña
+// POST /api/v1/campaigns/:id/coupons
+func (h *CampaignHandler) GenerateCoupons(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid campaign ID", err)
+	}
+
+	result, err := h.generateCouponsUC.Execute(c.Request().Context(), uint(id))
+	if err != nil {
+		return response.BadRequest(c, "Failed to generate coupons", err)
+	}
+
+	return response.Created(c, "Coupons generated successfully", map[string]interface{}{
+		"created": len(result.Created),
+		"skipped": result.Skipped,
+		"coupons": dto.ToCampaignCouponDTOList(result.Created),
+	})
+}
+
+// ListCoupons lista los cupones emitidos por la campaña
+// GET /api/v1/campaigns/:id/coupons
+func (h *CampaignHandler) ListCoupons(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid campaign ID", err)
+	}
+
+	coupons, err := h.getCampaignUC.ListCoupons(c.Request().Context(), uint(id))
+	if err != nil {
+		return response.NotFound(c, "Campaign not found")
+	}
+
+	return response.OK(c, "Coupons retrieved successfully", dto.ToCampaignCouponDTOList(coupons))
+}
+
+// ValidateCoupon verifica un código para un cliente y calcula el descuento
+// GET /api/v1/coupons/:code?customer_id=1&subtotal=100000
+func (h *CampaignHandler) ValidateCoupon(c echo.Context) error {
+	var customerID *uint
+	if customerIDStr := c.QueryParam("customer_id"); customerIDStr != "" {
+		id, err := strconv.ParseUint(customerIDStr, 10, 32)
+		if err != nil {
+			return response.BadRequest(c, "Invalid customer ID", err)
+		}
+		idUint := uint(id)
+		customerID = &idUint
+	}
+
+	subtotal := 0.0
+	if subtotalStr := c.QueryParam("subtotal"); subtotalStr != "" {
+		value, err := strconv.ParseFloat(subtotalStr, 64)
+		if err != nil {
+			return response.BadRequest(c, "Invalid subtotal", err)
+		}
+		subtotal = value
+	}
+
+	validation, err := h.validateCouponUC.Execute(c.Request().Context(), c.Param("code"), customerID, subtotal)
+	if err != nil {
+		if errors.Is(err, entities.ErrCouponNotFound) {
+			return response.NotFound(c, "Coupon not found")
+		}
+		return response.BadRequest(c, "Coupon cannot be redeemed", err)
+	}
+
+	return response.OK(c, "Coupon is valid", map[string]interface{}{
+		"coupon":   dto.ToCampaignCouponDTO(validation.Coupon),
+		"discount": validation.Discount,
+	})
+}
+
+// ListCustomerCoupons lista los cupones de un cliente
+// GET /api/v1/customers/:id/coupons
+func (h *CampaignHandler) ListCustomerCoupons(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid customer ID", err)
+	}
+
+	coupons, err := h.listCustomerCouponsUC.Execute(c.Request().Context(), uint(id))
+	if err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
+		return response.InternalServerError(c, "Failed to retrieve customer coupons", err)
+	}
+
+	return response.OK(c, "Customer coupons retrieved successfully", dto.ToCampaignCouponDTOList(coupons))
+}
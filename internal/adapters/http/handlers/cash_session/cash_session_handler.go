@@ -0,0 +1,250 @@
+package cash_session
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/middleware"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/cash_session"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// CashSessionHandler maneja la apertura y el cierre de caja
+type CashSessionHandler struct {
+	openUC        *cash_session.OpenCashSessionUseCase
+	getUC         *cash_session.GetCashSessionUseCase
+	closeUC       *cash_session.CloseCashSessionUseCase
+	listUC        *cash_session.ListCashSessionsUseCase
+	generatePDFUC *cash_session.GenerateClosingPDFUseCase
+}
+
+// NewCashSessionHandler crea una nueva instancia del handler
+func NewCashSessionHandler(
+	openUC *cash_session.OpenCashSessionUseCase,
+	getUC *cash_session.GetCashSessionUseCase,
+	closeUC *cash_session.CloseCashSessionUseCase,
+	listUC *cash_session.ListCashSessionsUseCase,
+	generatePDFUC *cash_session.GenerateClosingPDFUseCase,
+) *CashSessionHandler {
+	return &CashSessionHandler{
+		openUC:        openUC,
+		getUC:         getUC,
+		closeUC:       closeUC,
+		listUC:        listUC,
+		generatePDFUC: generatePDFUC,
+	}
+}
+
+// OpenCashSessionRequest representa la petición para abrir la caja
+type OpenCashSessionRequest struct {
+	Location     string  `json:"location"`     // Punto de venta (opcional)
+	OpeningFloat float64 `json:"openingFloat"` // Base de efectivo
+}
+
+// CloseCashSessionRequest representa el arqueo al cerrar la caja
+type CloseCashSessionRequest struct {
+	CountedCash *float64 `json:"countedCash"` // Efectivo contado (requerido)
+	Notes       string   `json:"notes"`
+}
+
+// Open abre la caja del usuario autenticado
+// POST /api/v1/cash-sessions
+func (h *CashSessionHandler) Open(c echo.Context) error {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	var req OpenCashSessionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	session, err := h.openUC.Execute(c.Request().Context(), user.ID, req.Location, req.OpeningFloat)
+	if err != nil {
+		return response.BadRequest(c, "Failed to open cash session", err)
+	}
+
+	return response.Created(c, "Cash session opened successfully", dto.ToCashSessionDTO(session))
+}
+
+// GetCurrent obtiene la caja abierta del usuario autenticado con lo cobrado hasta ahora
+// GET /api/v1/cash-sessions/current
+func (h *CashSessionHandler) GetCurrent(c echo.Context) error {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	report, err := h.getUC.ExecuteCurrent(c.Request().Context(), user.ID)
+	if err != nil {
+		if errors.Is(err, entities.ErrNoOpenCashSession) {
+			return response.NotFound(c, "No open cash session")
+		}
+		return response.InternalServerError(c, "Failed to get cash session", err)
+	}
+
+	return response.OK(c, "Cash session retrieved successfully", dto.ToCashSessionReportDTO(report))
+}
+
+// CloseCurrent cierra la caja abierta del usuario autenticado
+// POST /api/v1/cash-sessions/current/close
+func (h *CashSessionHandler) CloseCurrent(c echo.Context) error {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	report, err := h.getUC.ExecuteCurrent(c.Request().Context(), user.ID)
+	if err != nil {
+		if errors.Is(err, entities.ErrNoOpenCashSession) {
+			return response.NotFound(c, "No open cash session")
+		}
+		return response.InternalServerError(c, "Failed to get cash session", err)
+	}
+
+	return h.close(c, report.Session.ID, user.ID)
+}
+
+// Close cierra la caja de otro usuario (supervisor)
+// POST /api/v1/cash-sessions/:id/close
+func (h *CashSessionHandler) Close(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid cash session ID", err)
+	}
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	return h.close(c, uint(id), user.ID)
+}
+
+func (h *CashSessionHandler) close(c echo.Context, sessionID, closedByID uint) error {
+	var req CloseCashSessionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+	if req.CountedCash == nil {
+		return response.BadRequest(c, "countedCash is required", nil)
+	}
+
+	report, err := h.closeUC.Execute(c.Request().Context(), cash_session.CloseCashSessionInput{
+		SessionID:   sessionID,
+		CountedCash: *req.CountedCash,
+		Notes:       req.Notes,
+		ClosedByID:  &closedByID,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NotFound(c, "Cash session not found")
+		}
+		return response.BadRequest(c, "Failed to close cash session", err)
+	}
+
+	return response.OK(c, "Cash session closed successfully", dto.ToCashSessionReportDTO(report))
+}
+
+// List lista las sesiones de caja; sin finance:read solo las propias
+// GET /api/v1/cash-sessions?status=CLOSED&user_id=3&location=tienda&start_date=2026-10-01&end_date=2026-10-31
+func (h *CashSessionHandler) List(c echo.Context) error {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	filters := map[string]interface{}{
+		"status":     c.QueryParam("status"),
+		"location":   c.QueryParam("location"),
+		"start_date": c.QueryParam("start_date"),
+		"end_date":   c.QueryParam("end_date"),
+	}
+	if !middleware.HasPermission(c, entities.PermissionFinanceRead) {
+		filters["user_id"] = user.ID
+	} else if value := c.QueryParam("user_id"); value != "" {
+		userID, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return response.BadRequest(c, "Invalid user ID", err)
+		}
+		filters["user_id"] = uint(userID)
+	}
+
+	sessions, err := h.listUC.Execute(c.Request().Context(), filters)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to list cash sessions", err)
+	}
+
+	return response.OK(c, "Cash sessions retrieved successfully", dto.ToCashSessionDTOList(sessions))
+}
+
+// Get obtiene una sesión de caja con sus totales y movimientos
+// GET /api/v1/cash-sessions/:id
+func (h *CashSessionHandler) Get(c echo.Context) error {
+	report, err := h.load(c)
+	if err != nil {
+		return sessionError(c, err)
+	}
+
+	return response.OK(c, "Cash session retrieved successfully", dto.ToCashSessionReportDTO(report))
+}
+
+// GeneratePDF genera el comprobante de cierre de caja
+// GET /api/v1/cash-sessions/:id/pdf
+func (h *CashSessionHandler) GeneratePDF(c echo.Context) error {
+	report, err := h.load(c)
+	if err != nil {
+		return sessionError(c, err)
+	}
+
+	pdfBytes, err := h.generatePDFUC.Execute(c.Request().Context(), report.Session.ID)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to generate PDF", err)
+	}
+
+	c.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=cierre_caja_%d.pdf", report.Session.ID))
+	return c.Blob(200, "application/pdf", pdfBytes)
+}
+
+// load obtiene la sesión del parámetro :id; sin finance:read solo se pueden ver las propias
+func (h *CashSessionHandler) load(c echo.Context) (*entities.CashSessionReport, error) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return nil, errInvalidSessionID
+	}
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := h.getUC.Execute(c.Request().Context(), uint(id))
+	if err != nil {
+		return nil, err
+	}
+	if report.Session.UserID != user.ID && !middleware.HasPermission(c, entities.PermissionFinanceRead) {
+		return nil, entities.ErrRecordAccessDenied
+	}
+	return report, nil
+}
+
+// errInvalidSessionID indica que el parámetro :id no es un número
+var errInvalidSessionID = errors.New("invalid cash session ID")
+
+// sessionError traduce los errores al consultar una sesión a respuestas HTTP
+func sessionError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, errInvalidSessionID):
+		return response.BadRequest(c, err.Error(), err)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return response.NotFound(c, "Cash session not found")
+	case errors.Is(err, entities.ErrRecordAccessDenied):
+		return response.Forbidden(c, "Cannot view another user's cash session")
+	default:
+		return response.InternalServerError(c, "Failed to get cash session", err)
+	}
+}
@@ -0,0 +1,307 @@
+package commission
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/middleware"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/commission"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// CommissionHandler maneja las peticiones HTTP de comisiones de vendedores
+type CommissionHandler struct {
+	createRuleUC   *commission.CreateRuleUseCase
+	listRulesUC    *commission.ListRulesUseCase
+	updateRuleUC   *commission.UpdateRuleUseCase
+	deleteRuleUC   *commission.DeleteRuleUseCase
+	getStatementUC *commission.GetStatementUseCase
+	payUC          *commission.PayCommissionsUseCase
+	listPayoutsUC  *commission.ListPayoutsUseCase
+	clawbackUC     *commission.ClawbackCommissionUseCase
+}
+
+// NewCommissionHandler crea una nueva instancia del handler
+func NewCommissionHandler(
+	createRuleUC *commission.CreateRuleUseCase,
+	listRulesUC *commission.ListRulesUseCase,
+	updateRuleUC *commission.UpdateRuleUseCase,
+	deleteRuleUC *commission.DeleteRuleUseCase,
+	getStatementUC *commission.GetStatementUseCase,
+	payUC *commission.PayCommissionsUseCase,
+	listPayoutsUC *commission.ListPayoutsUseCase,
+	clawbackUC *commission.ClawbackCommissionUseCase,
+) *CommissionHandler {
+	return &CommissionHandler{
+		createRuleUC:   createRuleUC,
+		listRulesUC:    listRulesUC,
+		updateRuleUC:   updateRuleUC,
+		deleteRuleUC:   deleteRuleUC,
+		getStatementUC: getStatementUC,
+		payUC:          payUC,
+		listPayoutsUC:  listPayoutsUC,
+		clawbackUC:     clawbackUC,
+	}
+}
+
+// CommissionRuleRequest representa la petición para crear o actualizar una regla
+type CommissionRuleRequest struct {
+	Name       string                    `json:"name"`
+	SellerID   *uint                     `json:"sellerId"`   // Sin vendedor aplica a todos
+	CategoryID *uint                     `json:"categoryId"` // Sin categoría aplica a todas
+	Rate       float64                   `json:"rate"`       // Porcentaje sobre la venta neta
+	Tiers      []entities.CommissionTier `json:"tiers"`      // Escalones por venta neta mensual
+	IsActive   *bool                     `json:"isActive"`
+}
+
+// toEntity convierte la petición a entidad
+func (req *CommissionRuleRequest) toEntity() *entities.CommissionRule {
+	rule := &entities.CommissionRule{
+		Name:       req.Name,
+		SellerID:   req.SellerID,
+		CategoryID: req.CategoryID,
+		Rate:       req.Rate,
+		Tiers:      req.Tiers,
+		IsActive:   true,
+	}
+	if req.IsActive != nil {
+		rule.IsActive = *req.IsActive
+	}
+	return rule
+}
+
+// parsePeriod lee startDate y endDate (YYYY-MM-DD); por defecto el mes en curso
+func parsePeriod(c echo.Context) (time.Time, time.Time, error) {
+	now := time.Now()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
+	end := start.AddDate(0, 1, -1)
+
+	if value := c.QueryParam("startDate"); value != "" {
+		date, err := time.ParseInLocation("2006-01-02", value, time.Local)
+		if err != nil {
+			return start, end, errors.New("startDate must have format YYYY-MM-DD")
+		}
+		start = date
+	}
+	if value := c.QueryParam("endDate"); value != "" {
+		date, err := time.ParseInLocation("2006-01-02", value, time.Local)
+		if err != nil {
+			return start, end, errors.New("endDate must have format YYYY-MM-DD")
+		}
+		end = date
+	}
+	return start, end, nil
+}
+
+// CreateRule crea una regla de comisión
+// POST /api/v1/commissions/rules
+func (h *CommissionHandler) CreateRule(c echo.Context) error {
+	var req CommissionRuleRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	rule := req.toEntity()
+	if err := h.createRuleUC.Execute(c.Request().Context(), rule); err != nil {
+		return response.BadRequest(c, "Failed to create commission rule", err)
+	}
+
+	return response.Created(c, "Commission rule created successfully", dto.ToCommissionRuleDTO(rule))
+}
+
+// ListRules lista las reglas de comisión
+// GET /api/v1/commissions/rules?active=true
+func (h *CommissionHandler) ListRules(c echo.Context) error {
+	activeOnly := c.QueryParam("active") == "true"
+
+	rules, err := h.listRulesUC.Execute(c.Request().Context(), activeOnly)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to list commission rules", err)
+	}
+
+	return response.OK(c, "Commission rules retrieved successfully", dto.ToCommissionRuleDTOList(rules))
+}
+
+// UpdateRule actualiza una regla de comisión
+// PUT /api/v1/commissions/rules/:id
+func (h *CommissionHandler) UpdateRule(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid rule ID", err)
+	}
+
+	var req CommissionRuleRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	rule := req.toEntity()
+	rule.ID = uint(id)
+	if err := h.updateRuleUC.Execute(c.Request().Context(), rule); err != nil {
+		return response.BadRequest(c, "Failed to update commission rule", err)
+	}
+
+	return response.OK(c, "Commission rule updated successfully", dto.ToCommissionRuleDTO(rule))
+}
+
+// DeleteRule elimina una regla de comisión
+// DELETE /api/v1/commissions/rules/:id
+func (h *CommissionHandler) DeleteRule(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid rule ID", err)
+	}
+
+	if err := h.deleteRuleUC.Execute(c.Request().Context(), uint(id)); err != nil {
+		return response.NotFound(c, "Commission rule not found")
+	}
+
+	return response.OK(c, "Commission rule deleted successfully", nil)
+}
+
+// GetMyStatement obtiene el estado de cuenta de comisiones del usuario autenticado
+// GET /api/v1/commissions/me?startDate=2026-10-01&endDate=2026-10-31
+func (h *CommissionHandler) GetMyStatement(c echo.Context) error {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+	return h.statement(c, user.ID)
+}
+
+// GetSellerStatement obtiene el estado de cuenta de comisiones de un vendedor
+// GET /api/v1/commissions/sellers/:sellerId?startDate=2026-10-01&endDate=2026-10-31
+func (h *CommissionHandler) GetSellerStatement(c echo.Context) error {
+	sellerID, err := strconv.ParseUint(c.Param("sellerId"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid seller ID", err)
+	}
+	return h.statement(c, uint(sellerID))
+}
+
+func (h *CommissionHandler) statement(c echo.Context, sellerID uint) error {
+	start, end, err := parsePeriod(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error(), err)
+	}
+
+	statement, err := h.getStatementUC.Execute(c.Request().Context(), sellerID, start, end)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidReportPeriod):
+			return response.BadRequest(c, err.Error(), err)
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return response.NotFound(c, "Seller not found")
+		default:
+			return response.InternalServerError(c, "Failed to get commission statement", err)
+		}
+	}
+
+	return response.OK(c, "Commission statement retrieved successfully", dto.ToCommissionStatementDTO(statement))
+}
+
+// PayCommissions liquida las comisiones pendientes del vendedor hasta el fin del periodo
+// y registra el pago como gasto PERSONNEL
+// POST /api/v1/commissions/sellers/:sellerId/payouts
+func (h *CommissionHandler) PayCommissions(c echo.Context) error {
+	sellerID, err := strconv.ParseUint(c.Param("sellerId"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid seller ID", err)
+	}
+
+	var req struct {
+		StartDate string `json:"startDate"` // YYYY-MM-DD
+		EndDate   string `json:"endDate"`   // YYYY-MM-DD
+		Notes     string `json:"notes"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	start, err := time.ParseInLocation("2006-01-02", req.StartDate, time.Local)
+	if err != nil {
+		return response.BadRequest(c, "startDate must have format YYYY-MM-DD", err)
+	}
+	end, err := time.ParseInLocation("2006-01-02", req.EndDate, time.Local)
+	if err != nil {
+		return response.BadRequest(c, "endDate must have format YYYY-MM-DD", err)
+	}
+
+	payout, err := h.payUC.Execute(c.Request().Context(), uint(sellerID), start, end, req.Notes)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidReportPeriod),
+			errors.Is(err, entities.ErrNothingToPay),
+			errors.Is(err, entities.ErrCommissionsAlreadyPaid):
+			return response.BadRequest(c, err.Error(), err)
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return response.NotFound(c, "Seller not found")
+		default:
+			return response.InternalServerError(c, "Failed to pay commissions", err)
+		}
+	}
+
+	return response.Created(c, "Commissions paid successfully", dto.ToCommissionPayoutDTO(payout))
+}
+
+// ListPayouts lista las liquidaciones del periodo
+// GET /api/v1/commissions/payouts?sellerId=3&startDate=2026-10-01&endDate=2026-10-31
+func (h *CommissionHandler) ListPayouts(c echo.Context) error {
+	start, end, err := parsePeriod(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error(), err)
+	}
+
+	var sellerID *uint
+	if value := c.QueryParam("sellerId"); value != "" {
+		id, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return response.BadRequest(c, "Invalid seller ID", err)
+		}
+		parsed := uint(id)
+		sellerID = &parsed
+	}
+
+	payouts, err := h.listPayoutsUC.Execute(c.Request().Context(), sellerID, start, end)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidReportPeriod) {
+			return response.BadRequest(c, err.Error(), err)
+		}
+		return response.InternalServerError(c, "Failed to list commission payouts", err)
+	}
+
+	return response.OK(c, "Commission payouts retrieved successfully", dto.ToCommissionPayoutDTOList(payouts))
+}
+
+// ClawbackOrder reversa la comisión de una orden (ej: devolución)
+// POST /api/v1/commissions/orders/:orderId/clawback
+func (h *CommissionHandler) ClawbackOrder(c echo.Context) error {
+	orderID, err := strconv.ParseUint(c.Param("orderId"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid order ID", err)
+	}
+
+	var req struct {
+		Reason string `json:"reason"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	entry, err := h.clawbackUC.Execute(c.Request().Context(), uint(orderID), req.Reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrCommissionNotFound):
+			return response.NotFound(c, err.Error())
+		default:
+			return response.BadRequest(c, "Failed to claw back commission", err)
+		}
+	}
+
+	return response.Created(c, "Commission clawed back successfully", dto.ToCommissionEntryDTO(entry))
+}
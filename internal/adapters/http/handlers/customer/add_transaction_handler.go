@@ -1,10 +1,12 @@
 package customer
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/customer"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/labstack/echo/v4"
 )
 
@@ -29,6 +31,12 @@ func (h *CustomerHandler) AddTransaction(c echo.Context) error {
 
 	transactions, err := h.addTransactionUC.Execute(c.Request().Context(), req)
 	if err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return c.JSON(http.StatusForbidden, map[string]interface{}{
+				"success": false,
+				"message": err.Error(),
+			})
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"success": false,
 			"message": "Failed to add transactions",
@@ -1,6 +1,7 @@
 package customer
 
 import (
+	"errors"
 	"strconv"
 	"strings"
 	"time"
@@ -23,6 +24,7 @@ type CustomerHandler struct {
 	getUpcomingPaymentsUC *customer.GetUpcomingPaymentsUseCase
 	getBalanceUC          *customer.GetCustomerBalanceUseCase
 	addTransactionUC      *customer.AddTransactionUseCase
+	reverseTransactionUC  *customer.ReverseTransactionUseCase
 }
 
 func NewCustomerHandler(
@@ -36,6 +38,7 @@ func NewCustomerHandler(
 	getUpcomingPaymentsUC *customer.GetUpcomingPaymentsUseCase,
 	getBalanceUC *customer.GetCustomerBalanceUseCase,
 	addTransactionUC *customer.AddTransactionUseCase,
+	reverseTransactionUC *customer.ReverseTransactionUseCase,
 ) *CustomerHandler {
 	return &CustomerHandler{
 		createCustomerUC:      createCustomerUC,
@@ -48,6 +51,7 @@ func NewCustomerHandler(
 		getUpcomingPaymentsUC: getUpcomingPaymentsUC,
 		getBalanceUC:          getBalanceUC,
 		addTransactionUC:      addTransactionUC,
+		reverseTransactionUC:  reverseTransactionUC,
 	}
 }
 
@@ -112,6 +116,9 @@ func (h *CustomerHandler) Create(c echo.Context) error {
 	}
 
 	if err := h.createCustomerUC.Execute(c.Request().Context(), cust); err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
 		return response.BadRequest(c, "Failed to create customer", err)
 	}
 
@@ -129,6 +136,9 @@ func (h *CustomerHandler) GetByID(c echo.Context) error {
 
 	cust, err := h.getCustomerUC.Execute(c.Request().Context(), uint(id))
 	if err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
 		return response.NotFound(c, "Customer not found")
 	}
 
@@ -186,6 +196,9 @@ func (h *CustomerHandler) Update(c echo.Context) error {
 	// Obtener cliente existente
 	existingCustomer, err := h.getCustomerUC.Execute(c.Request().Context(), uint(id))
 	if err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
 		return response.NotFound(c, "Customer not found")
 	}
 
@@ -242,6 +255,9 @@ func (h *CustomerHandler) Update(c echo.Context) error {
 
 	existingCustomer.ID = uint(id)
 	if err := h.updateCustomerUC.Execute(c.Request().Context(), existingCustomer); err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
 		return response.BadRequest(c, "Failed to update customer", err)
 	}
 
@@ -255,6 +271,9 @@ func (h *CustomerHandler) Delete(c echo.Context) error {
 	}
 
 	if err := h.deleteCustomerUC.Execute(c.Request().Context(), uint(id)); err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
 		return response.BadRequest(c, "Failed to delete customer", err)
 	}
 
@@ -269,12 +288,18 @@ func (h *CustomerHandler) GetHistory(c echo.Context) error {
 
 	history, err := h.getHistoryUC.Execute(c.Request().Context(), uint(id))
 	if err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
 		return response.InternalServerError(c, "Failed to get customer history", err)
 	}
 
 	// Obtener balance del cliente
 	balance, err := h.getBalanceUC.Execute(c.Request().Context(), uint(id))
 	if err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
 		return response.InternalServerError(c, "Failed to get customer balance", err)
 	}
 
@@ -325,6 +350,9 @@ func (h *CustomerHandler) CreatePayment(c echo.Context) error {
 
 	transaction, err := h.createPaymentUC.Execute(c.Request().Context(), input)
 	if err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
 		return response.InternalServerError(c, "Failed to create payment", err)
 	}
 
@@ -366,6 +394,9 @@ func (h *CustomerHandler) GetBalance(c echo.Context) error {
 
 	balance, err := h.getBalanceUC.Execute(c.Request().Context(), uint(id))
 	if err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
 		return response.InternalServerError(c, "Failed to get customer balance", err)
 	}
 
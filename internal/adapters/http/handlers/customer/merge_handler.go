@@ -0,0 +1,97 @@
+package customer
+
+import (
+	"strconv"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/middleware"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/customer"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// MergeHandler maneja la detección y fusión de clientes duplicados
+type MergeHandler struct {
+	findDuplicatesUC *customer.FindDuplicatesUseCase
+	mergeCustomersUC *customer.MergeCustomersUseCase
+	listMergesUC     *customer.ListCustomerMergesUseCase
+}
+
+func NewMergeHandler(
+	findDuplicatesUC *customer.FindDuplicatesUseCase,
+	mergeCustomersUC *customer.MergeCustomersUseCase,
+	listMergesUC *customer.ListCustomerMergesUseCase,
+) *MergeHandler {
+	return &MergeHandler{
+		findDuplicatesUC: findDuplicatesUC,
+		mergeCustomersUC: mergeCustomersUC,
+		listMergesUC:     listMergesUC,
+	}
+}
+
+// FindDuplicates lista posibles clientes duplicados
+// GET /api/v1/customers/duplicates?similarity=0.85
+func (h *MergeHandler) FindDuplicates(c echo.Context) error {
+	similarity := customer.DefaultNameSimilarity
+	if similarityStr := c.QueryParam("similarity"); similarityStr != "" {
+		value, err := strconv.ParseFloat(similarityStr, 64)
+		if err != nil || value <= 0 || value > 1 {
+			return response.BadRequest(c, "Parameter 'similarity' must be between 0 and 1", err)
+		}
+		similarity = value
+	}
+
+	candidates, err := h.findDuplicatesUC.Execute(c.Request().Context(), similarity)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to find duplicate customers", err)
+	}
+
+	return response.OK(c, "Duplicate candidates retrieved successfully", dto.ToDuplicateCandidateDTOList(candidates))
+}
+
+// MergeRequest representa la petición de fusión
+type MergeRequest struct {
+	DuplicateID uint `json:"duplicateId"` // Cliente que se fusiona y se desactiva
+}
+
+// Merge fusiona un cliente duplicado en el cliente de la ruta
+// POST /api/v1/customers/:id/merge
+func (h *MergeHandler) Merge(c echo.Context) error {
+	survivorID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid customer ID", err)
+	}
+
+	var req MergeRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	record, err := h.mergeCustomersUC.Execute(c.Request().Context(), uint(survivorID), req.DuplicateID, user.ID)
+	if err != nil {
+		return response.BadRequest(c, "Failed to merge customers", err)
+	}
+
+	return response.OK(c, "Customers merged successfully", dto.ToCustomerMergeDTO(record))
+}
+
+// ListMerges lista el historial de fusiones de un cliente
+// GET /api/v1/customers/:id/merges
+func (h *MergeHandler) ListMerges(c echo.Context) error {
+	customerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid customer ID", err)
+	}
+
+	merges, err := h.listMergesUC.Execute(c.Request().Context(), uint(customerID))
+	if err != nil {
+		return response.InternalServerError(c, "Failed to list customer merges", err)
+	}
+
+	return response.OK(c, "Customer merges retrieved successfully", dto.ToCustomerMergeDTOList(merges))
+}
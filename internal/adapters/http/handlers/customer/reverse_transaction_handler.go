@@ -0,0 +1,51 @@
+package customer
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/middleware"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/customer"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// ReverseTransactionRequest representa la petición de reverso
+type ReverseTransactionRequest struct {
+	Reason string `json:"reason"`
+}
+
+// ReverseTransaction anula un movimiento creando un asiento compensatorio
+// POST /api/v1/customers/transactions/:transactionId/reverse
+func (h *CustomerHandler) ReverseTransaction(c echo.Context) error {
+	transactionID, err := strconv.ParseUint(c.Param("transactionId"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid transaction ID", err)
+	}
+
+	var req ReverseTransactionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	reversal, err := h.reverseTransactionUC.Execute(c.Request().Context(), customer.ReverseTransactionRequest{
+		TransactionID: uint(transactionID),
+		Reason:        req.Reason,
+		User:          user,
+	})
+	if err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
+		return response.BadRequest(c, "Failed to reverse transaction", err)
+	}
+
+	return response.Created(c, "Transaction reversed successfully", dto.ToCustomerTransactionDTO(reversal))
+}
@@ -1,10 +1,12 @@
 package customer
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/labstack/echo/v4"
 )
 
@@ -59,6 +61,9 @@ func (h *StatementHandler) DownloadStatement(c echo.Context) error {
 	// Generar PDF
 	response, err := h.generateStatementUC.Execute(c.Request().Context(), req)
 	if err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return c.JSON(http.StatusForbidden, map[string]interface{}{"error": err.Error()})
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": err.Error()})
 	}
 
@@ -0,0 +1,307 @@
+package electronic_invoice
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/electronic_invoice"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// ElectronicInvoiceHandler maneja las resoluciones de numeración, las facturas y las notas crédito electrónicas
+type ElectronicInvoiceHandler struct {
+	listResolutionsUC  *electronic_invoice.ListInvoiceResolutionsUseCase
+	createResolutionUC *electronic_invoice.CreateInvoiceResolutionUseCase
+	updateResolutionUC *electronic_invoice.UpdateInvoiceResolutionUseCase
+	issueInvoiceUC     *electronic_invoice.IssueInvoiceUseCase
+	issueCreditNoteUC  *electronic_invoice.IssueCreditNoteUseCase
+	listDocumentsUC    *electronic_invoice.ListElectronicDocumentsUseCase
+	getDocumentUC      *electronic_invoice.GetElectronicDocumentUseCase
+	submitDocumentUC   *electronic_invoice.SubmitElectronicDocumentUseCase
+}
+
+// NewElectronicInvoiceHandler crea una nueva instancia del handler
+func NewElectronicInvoiceHandler(
+	listResolutionsUC *electronic_invoice.ListInvoiceResolutionsUseCase,
+	createResolutionUC *electronic_invoice.CreateInvoiceResolutionUseCase,
+	updateResolutionUC *electronic_invoice.UpdateInvoiceResolutionUseCase,
+	issueInvoiceUC *electronic_invoice.IssueInvoiceUseCase,
+	issueCreditNoteUC *electronic_invoice.IssueCreditNoteUseCase,
+	listDocumentsUC *electronic_invoice.ListElectronicDocumentsUseCase,
+	getDocumentUC *electronic_invoice.GetElectronicDocumentUseCase,
+	submitDocumentUC *electronic_invoice.SubmitElectronicDocumentUseCase,
+) *ElectronicInvoiceHandler {
+	return &ElectronicInvoiceHandler{
+		listResolutionsUC:  listResolutionsUC,
+		createResolutionUC: createResolutionUC,
+		updateResolutionUC: updateResolutionUC,
+		issueInvoiceUC:     issueInvoiceUC,
+		issueCreditNoteUC:  issueCreditNoteUC,
+		listDocumentsUC:    listDocumentsUC,
+		getDocumentUC:      getDocumentUC,
+		submitDocumentUC:   submitDocumentUC,
+	}
+}
+
+// parseDate lee una fecha YYYY-MM-DD en la hora legal de Colombia
+func parseDate(value string) (time.Time, error) {
+	return time.ParseInLocation("2006-01-02", value, entities.DianLocation)
+}
+
+// electronicInvoiceError traduce los errores de facturación electrónica a respuestas HTTP
+func electronicInvoiceError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return response.NotFound(c, "Order, resolution or electronic document not found")
+	case errors.Is(err, entities.ErrRecordAccessDenied):
+		return response.Forbidden(c, err.Error())
+	case errors.Is(err, entities.ErrInvalidInvoiceResolution),
+		errors.Is(err, entities.ErrNoActiveInvoiceResolution),
+		errors.Is(err, entities.ErrInvoiceResolutionOverlap),
+		errors.Is(err, entities.ErrOrderNotInvoiceable),
+		errors.Is(err, entities.ErrOrderAlreadyInvoiced),
+		errors.Is(err, entities.ErrInvalidBuyer),
+		errors.Is(err, entities.ErrInvalidCreditNote),
+		errors.Is(err, entities.ErrCreditNoteRequiresInvoice),
+		errors.Is(err, entities.ErrCreditExceedsInvoice),
+		errors.Is(err, entities.ErrElectronicDocumentNotPending):
+		return response.BadRequest(c, err.Error(), err)
+	default:
+		return response.InternalServerError(c, message, err)
+	}
+}
+
+// ListResolutions lista las resoluciones de numeración
+// GET /api/v1/electronic-invoices/resolutions?documentType=INVOICE
+func (h *ElectronicInvoiceHandler) ListResolutions(c echo.Context) error {
+	documentType := entities.ElectronicDocumentType(c.QueryParam("documentType"))
+
+	resolutions, err := h.listResolutionsUC.Execute(c.Request().Context(), documentType)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get invoice resolutions", err)
+	}
+
+	return response.OK(c, "Invoice resolutions retrieved successfully", dto.ToInvoiceResolutionDTOList(resolutions))
+}
+
+// CreateResolution registra una resolución de numeración autorizada por la DIAN
+// POST /api/v1/electronic-invoices/resolutions
+func (h *ElectronicInvoiceHandler) CreateResolution(c echo.Context) error {
+	var req dto.CreateInvoiceResolutionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	validFrom, err := parseDate(req.ValidFrom)
+	if err != nil {
+		return response.BadRequest(c, "validFrom must have format YYYY-MM-DD", err)
+	}
+	validTo, err := parseDate(req.ValidTo)
+	if err != nil {
+		return response.BadRequest(c, "validTo must have format YYYY-MM-DD", err)
+	}
+
+	resolution := &entities.InvoiceResolution{
+		DocumentType:     entities.ElectronicDocumentType(req.DocumentType),
+		ResolutionNumber: req.ResolutionNumber,
+		Prefix:           req.Prefix,
+		RangeFrom:        req.RangeFrom,
+		RangeTo:          req.RangeTo,
+		TechnicalKey:     req.TechnicalKey,
+		ValidFrom:        validFrom,
+		ValidTo:          validTo,
+	}
+	if err := h.createResolutionUC.Execute(c.Request().Context(), resolution); err != nil {
+		return electronicInvoiceError(c, err, "Failed to create invoice resolution")
+	}
+
+	return response.Created(c, "Invoice resolution created successfully", dto.ToInvoiceResolutionDTO(resolution))
+}
+
+// UpdateResolution prorroga o desactiva una resolución
+// PUT /api/v1/electronic-invoices/resolutions/:id
+func (h *ElectronicInvoiceHandler) UpdateResolution(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid resolution ID", err)
+	}
+
+	var req dto.UpdateInvoiceResolutionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	var validTo *time.Time
+	if req.ValidTo != "" {
+		date, err := parseDate(req.ValidTo)
+		if err != nil {
+			return response.BadRequest(c, "validTo must have format YYYY-MM-DD", err)
+		}
+		validTo = &date
+	}
+
+	resolution, err := h.updateResolutionUC.Execute(c.Request().Context(), uint(id), validTo, req.IsActive)
+	if err != nil {
+		return electronicInvoiceError(c, err, "Failed to update invoice resolution")
+	}
+
+	return response.OK(c, "Invoice resolution updated successfully", dto.ToInvoiceResolutionDTO(resolution))
+}
+
+// IssueInvoice emite la factura electrónica de una orden
+// POST /api/v1/electronic-invoices/orders/:orderId
+func (h *ElectronicInvoiceHandler) IssueInvoice(c echo.Context) error {
+	orderID, err := strconv.ParseUint(c.Param("orderId"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid order ID", err)
+	}
+
+	var req dto.IssueInvoiceRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	document := &entities.ElectronicDocument{
+		BuyerIDType:   req.BuyerIDType,
+		BuyerIDNumber: req.BuyerIDNumber,
+		BuyerName:     req.BuyerName,
+		BuyerEmail:    req.BuyerEmail,
+		BuyerAddress:  req.BuyerAddress,
+		BuyerPhone:    req.BuyerPhone,
+		Notes:         req.Notes,
+	}
+	if req.PaymentDueDate != "" {
+		dueDate, err := parseDate(req.PaymentDueDate)
+		if err != nil {
+			return response.BadRequest(c, "paymentDueDate must have format YYYY-MM-DD", err)
+		}
+		document.PaymentDueDate = &dueDate
+	}
+
+	if err := h.issueInvoiceUC.Execute(c.Request().Context(), uint(orderID), document); err != nil {
+		return electronicInvoiceError(c, err, "Failed to issue electronic invoice")
+	}
+
+	return response.Created(c, "Electronic invoice issued successfully", dto.ToElectronicDocumentDTO(document))
+}
+
+// IssueCreditNote emite una nota crédito que anula o corrige una factura
+// POST /api/v1/electronic-invoices/:id/credit-notes
+func (h *ElectronicInvoiceHandler) IssueCreditNote(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid electronic document ID", err)
+	}
+
+	var req dto.IssueCreditNoteRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	lines := make([]electronic_invoice.CreditNoteLine, len(req.Lines))
+	for i, line := range req.Lines {
+		lines[i] = electronic_invoice.CreditNoteLine{LineNumber: line.LineNumber, Quantity: line.Quantity}
+	}
+
+	note, err := h.issueCreditNoteUC.Execute(c.Request().Context(), uint(id), req.CorrectionCode, req.Reason, lines)
+	if err != nil {
+		return electronicInvoiceError(c, err, "Failed to issue credit note")
+	}
+
+	return response.Created(c, "Credit note issued successfully", dto.ToElectronicDocumentDTO(note))
+}
+
+// ListDocuments lista las facturas y notas crédito electrónicas
+// GET /api/v1/electronic-invoices?type=INVOICE&status=ACCEPTED&orderId=15&customerId=3&startDate=2026-10-01&endDate=2026-10-31
+func (h *ElectronicInvoiceHandler) ListDocuments(c echo.Context) error {
+	filters := make(map[string]interface{})
+	if documentType := c.QueryParam("type"); documentType != "" {
+		filters["type"] = documentType
+	}
+	if status := c.QueryParam("status"); status != "" {
+		filters["status"] = status
+	}
+	if value := c.QueryParam("orderId"); value != "" {
+		orderID, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return response.BadRequest(c, "Invalid order ID", err)
+		}
+		filters["order_id"] = uint(orderID)
+	}
+	if value := c.QueryParam("customerId"); value != "" {
+		customerID, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return response.BadRequest(c, "Invalid customer ID", err)
+		}
+		filters["customer_id"] = uint(customerID)
+	}
+	if startDate := c.QueryParam("startDate"); startDate != "" {
+		filters["start_date"] = startDate
+	}
+	if endDate := c.QueryParam("endDate"); endDate != "" {
+		filters["end_date"] = endDate
+	}
+
+	documents, err := h.listDocumentsUC.Execute(c.Request().Context(), filters)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get electronic documents", err)
+	}
+
+	return response.OK(c, "Electronic documents retrieved successfully", dto.ToElectronicDocumentDTOList(documents))
+}
+
+// GetDocument obtiene una factura o nota crédito con sus líneas
+// GET /api/v1/electronic-invoices/:id
+func (h *ElectronicInvoiceHandler) GetDocument(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid electronic document ID", err)
+	}
+
+	document, err := h.getDocumentUC.Execute(c.Request().Context(), uint(id))
+	if err != nil {
+		return electronicInvoiceError(c, err, "Failed to get electronic document")
+	}
+
+	return response.OK(c, "Electronic document retrieved successfully", dto.ToElectronicDocumentDTO(document))
+}
+
+// DownloadXML descarga el XML UBL 2.1 firmado
+// GET /api/v1/electronic-invoices/:id/xml
+// @Response: XML file
+func (h *ElectronicInvoiceHandler) DownloadXML(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid electronic document ID", err)
+	}
+
+	document, err := h.getDocumentUC.Execute(c.Request().Context(), uint(id))
+	if err != nil {
+		return electronicInvoiceError(c, err, "Failed to get electronic document")
+	}
+
+	c.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.xml", document.FullNumber()))
+	return c.Blob(200, "application/xml", []byte(document.XML))
+}
+
+// SubmitDocument reenvía a la DIAN un documento que quedó pendiente
+// POST /api/v1/electronic-invoices/:id/submit
+func (h *ElectronicInvoiceHandler) SubmitDocument(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid electronic document ID", err)
+	}
+
+	document, err := h.submitDocumentUC.Execute(c.Request().Context(), uint(id))
+	if err != nil {
+		return electronicInvoiceError(c, err, "Failed to submit electronic document")
+	}
+
+	return response.OK(c, "Electronic document submitted successfully", dto.ToElectronicDocumentDTO(document))
+}
@@ -0,0 +1,104 @@
+package financial_transaction
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/financial_transaction"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// BudgetHandler maneja los presupuestos mensuales por categoría
+type BudgetHandler struct {
+	setBudgetUC    *financial_transaction.SetBudgetUseCase
+	deleteBudgetUC *financial_transaction.DeleteBudgetUseCase
+	getReportUC    *financial_transaction.GetBudgetReportUseCase
+}
+
+// NewBudgetHandler crea una nueva instancia del handler
+func NewBudgetHandler(
+	setBudgetUC *financial_transaction.SetBudgetUseCase,
+	deleteBudgetUC *financial_transaction.DeleteBudgetUseCase,
+	getReportUC *financial_transaction.GetBudgetReportUseCase,
+) *BudgetHandler {
+	return &BudgetHandler{
+		setBudgetUC:    setBudgetUC,
+		deleteBudgetUC: deleteBudgetUC,
+		getReportUC:    getReportUC,
+	}
+}
+
+// SetBudgetRequest representa la petición para fijar el presupuesto de una categoría
+type SetBudgetRequest struct {
+	Category string  `json:"category"` // Categoría de gasto
+	Month    string  `json:"month"`    // YYYY-MM
+	Amount   float64 `json:"amount"`
+	Months   int     `json:"months"` // Meses consecutivos con el mismo presupuesto (por defecto 1)
+}
+
+// SetBudget crea o reemplaza el presupuesto de una categoría
+// PUT /api/v1/budgets
+func (h *BudgetHandler) SetBudget(c echo.Context) error {
+	var req SetBudgetRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	month, err := time.ParseInLocation("2006-01", req.Month, time.Local)
+	if err != nil {
+		return response.BadRequest(c, "month must have format YYYY-MM", err)
+	}
+	if req.Months == 0 {
+		req.Months = 1
+	}
+
+	budget := entities.Budget{
+		Category: entities.FinancialTransactionCategory(req.Category),
+		Month:    month,
+		Amount:   req.Amount,
+	}
+	budgets, err := h.setBudgetUC.Execute(c.Request().Context(), budget, req.Months)
+	if err != nil {
+		return response.BadRequest(c, "Failed to set budget", err)
+	}
+
+	return response.OK(c, "Budget saved successfully", dto.ToBudgetDTOList(budgets))
+}
+
+// DeleteBudget elimina un presupuesto
+// DELETE /api/v1/budgets/:id
+func (h *BudgetHandler) DeleteBudget(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid budget ID", err)
+	}
+
+	if err := h.deleteBudgetUC.Execute(c.Request().Context(), uint(id)); err != nil {
+		return response.NotFound(c, "Budget not found")
+	}
+
+	return response.OK(c, "Budget deleted successfully", nil)
+}
+
+// GetReport compara el presupuesto con el gasto real del mes (por defecto el mes en curso)
+// GET /api/v1/budgets/report?month=2026-10
+func (h *BudgetHandler) GetReport(c echo.Context) error {
+	month := time.Now()
+	if value := c.QueryParam("month"); value != "" {
+		parsed, err := time.ParseInLocation("2006-01", value, time.Local)
+		if err != nil {
+			return response.BadRequest(c, "month must have format YYYY-MM", err)
+		}
+		month = parsed
+	}
+
+	report, err := h.getReportUC.Execute(c.Request().Context(), month)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get budget report", err)
+	}
+
+	return response.OK(c, "Budget report retrieved successfully", dto.ToBudgetReportDTO(report))
+}
@@ -1,6 +1,7 @@
 package financial_transaction
 
 import (
+	"errors"
 	"strconv"
 
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
@@ -17,6 +18,7 @@ type FinancialTransactionHandler struct {
 	listTransactionsUC  *financial_transaction.ListTransactionsUseCase
 	getBalanceUC        *financial_transaction.GetBalanceUseCase
 	generatePDFUC       *financial_transaction.GeneratePDFUseCase
+	getCashFlowUC       *financial_transaction.GetCashFlowProjectionUseCase
 }
 
 func NewFinancialTransactionHandler(
@@ -26,6 +28,7 @@ func NewFinancialTransactionHandler(
 	listTransactionsUC *financial_transaction.ListTransactionsUseCase,
 	getBalanceUC *financial_transaction.GetBalanceUseCase,
 	generatePDFUC *financial_transaction.GeneratePDFUseCase,
+	getCashFlowUC *financial_transaction.GetCashFlowProjectionUseCase,
 ) *FinancialTransactionHandler {
 	return &FinancialTransactionHandler{
 		createTransactionUC: createTransactionUC,
@@ -34,6 +37,7 @@ func NewFinancialTransactionHandler(
 		listTransactionsUC:  listTransactionsUC,
 		getBalanceUC:        getBalanceUC,
 		generatePDFUC:       generatePDFUC,
+		getCashFlowUC:       getCashFlowUC,
 	}
 }
 
@@ -80,6 +84,9 @@ func (h *FinancialTransactionHandler) List(c echo.Context) error {
 	if endDate := c.QueryParam("end_date"); endDate != "" {
 		filters["end_date"] = endDate
 	}
+	if paymentMethodID := c.QueryParam("payment_method_id"); paymentMethodID != "" {
+		filters["payment_method_id"] = paymentMethodID
+	}
 
 	transactions, err := h.listTransactionsUC.Execute(c.Request().Context(), filters)
 	if err != nil {
@@ -118,6 +125,29 @@ func (h *FinancialTransactionHandler) GetBalance(c echo.Context) error {
 	return response.OK(c, "Balance retrieved successfully", balance)
 }
 
+// GetCashFlowProjection proyecta la caja semana a semana (por defecto los próximos 90 días)
+// GET /api/v1/financial-transactions/cash-flow?weeks=13
+func (h *FinancialTransactionHandler) GetCashFlowProjection(c echo.Context) error {
+	weeks := entities.DefaultCashFlowWeeks
+	if value := c.QueryParam("weeks"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return response.BadRequest(c, "Invalid weeks", err)
+		}
+		weeks = parsed
+	}
+
+	projection, err := h.getCashFlowUC.Execute(c.Request().Context(), weeks)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidCashFlowWeeks) {
+			return response.BadRequest(c, err.Error(), err)
+		}
+		return response.InternalServerError(c, "Failed to project cash flow", err)
+	}
+
+	return response.OK(c, "Cash flow projection retrieved successfully", dto.ToCashFlowProjectionDTO(projection))
+}
+
 func (h *FinancialTransactionHandler) GeneratePDF(c echo.Context) error {
 	filters := make(map[string]interface{})
 
@@ -134,6 +164,9 @@ func (h *FinancialTransactionHandler) GeneratePDF(c echo.Context) error {
 	if endDate := c.QueryParam("end_date"); endDate != "" {
 		filters["end_date"] = endDate
 	}
+	if paymentMethodID := c.QueryParam("payment_method_id"); paymentMethodID != "" {
+		filters["payment_method_id"] = paymentMethodID
+	}
 
 	pdfBytes, err := h.generatePDFUC.Execute(c.Request().Context(), filters)
 	if err != nil {
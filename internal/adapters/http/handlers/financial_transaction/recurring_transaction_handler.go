@@ -0,0 +1,167 @@
+package financial_transaction
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/financial_transaction"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// RecurringTransactionHandler maneja las plantillas de ingresos y gastos recurrentes
+type RecurringTransactionHandler struct {
+	createUC   *financial_transaction.CreateRecurringTransactionUseCase
+	listUC     *financial_transaction.ListRecurringTransactionsUseCase
+	updateUC   *financial_transaction.UpdateRecurringTransactionUseCase
+	deleteUC   *financial_transaction.DeleteRecurringTransactionUseCase
+	generateUC *financial_transaction.GenerateRecurringTransactionsUseCase
+}
+
+// NewRecurringTransactionHandler crea una nueva instancia del handler
+func NewRecurringTransactionHandler(
+	createUC *financial_transaction.CreateRecurringTransactionUseCase,
+	listUC *financial_transaction.ListRecurringTransactionsUseCase,
+	updateUC *financial_transaction.UpdateRecurringTransactionUseCase,
+	deleteUC *financial_transaction.DeleteRecurringTransactionUseCase,
+	generateUC *financial_transaction.GenerateRecurringTransactionsUseCase,
+) *RecurringTransactionHandler {
+	return &RecurringTransactionHandler{
+		createUC:   createUC,
+		listUC:     listUC,
+		updateUC:   updateUC,
+		deleteUC:   deleteUC,
+		generateUC: generateUC,
+	}
+}
+
+// RecurringTransactionRequest representa la petición para crear o actualizar una plantilla
+type RecurringTransactionRequest struct {
+	Type        string  `json:"type"`     // INCOME o EXPENSE
+	Category    string  `json:"category"` // Categoría de la transacción
+	Amount      float64 `json:"amount"`
+	Description string  `json:"description"`
+	Frequency   string  `json:"frequency"`  // WEEKLY, BIWEEKLY, MONTHLY
+	DayOfMonth  int     `json:"dayOfMonth"` // MONTHLY: 1-31, BIWEEKLY: 1-15 (se repite 15 días después)
+	StartDate   string  `json:"startDate"`  // YYYY-MM-DD
+	EndDate     string  `json:"endDate"`    // YYYY-MM-DD, opcional
+	IsActive    *bool   `json:"isActive"`
+}
+
+// toEntity convierte la petición a entidad
+func (req *RecurringTransactionRequest) toEntity() (*entities.RecurringTransaction, error) {
+	startDate, err := time.ParseInLocation("2006-01-02", req.StartDate, time.Local)
+	if err != nil {
+		return nil, errors.New("startDate must have format YYYY-MM-DD")
+	}
+
+	recurring := &entities.RecurringTransaction{
+		Type:        entities.FinancialTransactionType(req.Type),
+		Category:    entities.FinancialTransactionCategory(req.Category),
+		Amount:      req.Amount,
+		Description: req.Description,
+		Frequency:   entities.RecurrenceFrequency(req.Frequency),
+		DayOfMonth:  req.DayOfMonth,
+		StartDate:   startDate,
+		IsActive:    true,
+	}
+	if req.EndDate != "" {
+		endDate, err := time.ParseInLocation("2006-01-02", req.EndDate, time.Local)
+		if err != nil {
+			return nil, errors.New("endDate must have format YYYY-MM-DD")
+		}
+		recurring.EndDate = &endDate
+	}
+	if req.IsActive != nil {
+		recurring.IsActive = *req.IsActive
+	}
+	return recurring, nil
+}
+
+// Create crea una plantilla recurrente
+// POST /api/v1/recurring-transactions
+func (h *RecurringTransactionHandler) Create(c echo.Context) error {
+	var req RecurringTransactionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	recurring, err := req.toEntity()
+	if err != nil {
+		return response.BadRequest(c, err.Error(), err)
+	}
+
+	if err := h.createUC.Execute(c.Request().Context(), recurring); err != nil {
+		return response.BadRequest(c, "Failed to create recurring transaction", err)
+	}
+
+	return response.Created(c, "Recurring transaction created successfully", dto.ToRecurringTransactionDTO(recurring))
+}
+
+// List lista las plantillas recurrentes
+// GET /api/v1/recurring-transactions?active=true
+func (h *RecurringTransactionHandler) List(c echo.Context) error {
+	activeOnly := c.QueryParam("active") == "true"
+
+	recurringList, err := h.listUC.Execute(c.Request().Context(), activeOnly)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to list recurring transactions", err)
+	}
+
+	return response.OK(c, "Recurring transactions retrieved successfully", dto.ToRecurringTransactionDTOList(recurringList))
+}
+
+// Update actualiza una plantilla recurrente
+// PUT /api/v1/recurring-transactions/:id
+func (h *RecurringTransactionHandler) Update(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid recurring transaction ID", err)
+	}
+
+	var req RecurringTransactionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	recurring, err := req.toEntity()
+	if err != nil {
+		return response.BadRequest(c, err.Error(), err)
+	}
+	recurring.ID = uint(id)
+
+	if err := h.updateUC.Execute(c.Request().Context(), recurring); err != nil {
+		return response.BadRequest(c, "Failed to update recurring transaction", err)
+	}
+
+	return response.OK(c, "Recurring transaction updated successfully", dto.ToRecurringTransactionDTO(recurring))
+}
+
+// Delete elimina una plantilla recurrente (las transacciones generadas se conservan)
+// DELETE /api/v1/recurring-transactions/:id
+func (h *RecurringTransactionHandler) Delete(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid recurring transaction ID", err)
+	}
+
+	if err := h.deleteUC.Execute(c.Request().Context(), uint(id)); err != nil {
+		return response.NotFound(c, "Recurring transaction not found")
+	}
+
+	return response.OK(c, "Recurring transaction deleted successfully", nil)
+}
+
+// Generate genera ya las ocurrencias vencidas (el proceso automático corre cada hora)
+// POST /api/v1/recurring-transactions/generate
+func (h *RecurringTransactionHandler) Generate(c echo.Context) error {
+	generated, err := h.generateUC.Execute(c.Request().Context())
+	if err != nil {
+		return response.InternalServerError(c, "Failed to generate recurring transactions", err)
+	}
+
+	return response.OK(c, "Recurring transactions generated successfully", map[string]int{"generated": generated})
+}
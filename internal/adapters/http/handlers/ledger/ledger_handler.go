@@ -0,0 +1,324 @@
+package ledger
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/ledger"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// LedgerHandler maneja el plan de cuentas, el libro diario y los estados financieros
+type LedgerHandler struct {
+	listAccountsUC       *ledger.ListLedgerAccountsUseCase
+	createAccountUC      *ledger.CreateLedgerAccountUseCase
+	updateAccountUC      *ledger.UpdateLedgerAccountUseCase
+	listEntriesUC        *ledger.ListJournalEntriesUseCase
+	getEntryUC           *ledger.GetJournalEntryUseCase
+	createEntryUC        *ledger.CreateManualEntryUseCase
+	reverseEntryUC       *ledger.ReverseManualEntryUseCase
+	getTrialBalanceUC    *ledger.GetTrialBalanceUseCase
+	getIncomeStatementUC *ledger.GetIncomeStatementUseCase
+	getBalanceSheetUC    *ledger.GetBalanceSheetUseCase
+	getCrossCheckUC      *ledger.GetLedgerCrossCheckUseCase
+	syncUC               *ledger.SyncLedgerUseCase
+}
+
+// NewLedgerHandler crea una nueva instancia del handler
+func NewLedgerHandler(
+	listAccountsUC *ledger.ListLedgerAccountsUseCase,
+	createAccountUC *ledger.CreateLedgerAccountUseCase,
+	updateAccountUC *ledger.UpdateLedgerAccountUseCase,
+	listEntriesUC *ledger.ListJournalEntriesUseCase,
+	getEntryUC *ledger.GetJournalEntryUseCase,
+	createEntryUC *ledger.CreateManualEntryUseCase,
+	reverseEntryUC *ledger.ReverseManualEntryUseCase,
+	getTrialBalanceUC *ledger.GetTrialBalanceUseCase,
+	getIncomeStatementUC *ledger.GetIncomeStatementUseCase,
+	getBalanceSheetUC *ledger.GetBalanceSheetUseCase,
+	getCrossCheckUC *ledger.GetLedgerCrossCheckUseCase,
+	syncUC *ledger.SyncLedgerUseCase,
+) *LedgerHandler {
+	return &LedgerHandler{
+		listAccountsUC:       listAccountsUC,
+		createAccountUC:      createAccountUC,
+		updateAccountUC:      updateAccountUC,
+		listEntriesUC:        listEntriesUC,
+		getEntryUC:           getEntryUC,
+		createEntryUC:        createEntryUC,
+		reverseEntryUC:       reverseEntryUC,
+		getTrialBalanceUC:    getTrialBalanceUC,
+		getIncomeStatementUC: getIncomeStatementUC,
+		getBalanceSheetUC:    getBalanceSheetUC,
+		getCrossCheckUC:      getCrossCheckUC,
+		syncUC:               syncUC,
+	}
+}
+
+// parsePeriod lee startDate y endDate (YYYY-MM-DD); por defecto el mes en curso
+func parsePeriod(c echo.Context) (time.Time, time.Time, error) {
+	now := time.Now()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
+	end := start.AddDate(0, 1, -1)
+
+	if value := c.QueryParam("startDate"); value != "" {
+		date, err := time.ParseInLocation("2006-01-02", value, time.Local)
+		if err != nil {
+			return start, end, errors.New("startDate must have format YYYY-MM-DD")
+		}
+		start = date
+	}
+	if value := c.QueryParam("endDate"); value != "" {
+		date, err := time.ParseInLocation("2006-01-02", value, time.Local)
+		if err != nil {
+			return start, end, errors.New("endDate must have format YYYY-MM-DD")
+		}
+		end = date
+	}
+	return start, end, nil
+}
+
+// ledgerError traduce los errores del libro mayor a respuestas HTTP
+func ledgerError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return response.NotFound(c, "Ledger account or journal entry not found")
+	case errors.Is(err, entities.ErrUnbalancedJournalEntry),
+		errors.Is(err, entities.ErrJournalDescriptionRequired),
+		errors.Is(err, entities.ErrInvalidJournalLine),
+		errors.Is(err, entities.ErrJournalEntryAlreadyReversed),
+		errors.Is(err, entities.ErrOnlyManualEntriesReversible),
+		errors.Is(err, entities.ErrCannotReverseReversal),
+		errors.Is(err, entities.ErrReversalReasonRequired),
+		errors.Is(err, entities.ErrInvalidLedgerAccount),
+		errors.Is(err, entities.ErrLedgerAccountInactive),
+		errors.Is(err, entities.ErrLedgerAccountAlreadyExists),
+		errors.Is(err, entities.ErrInvalidReportPeriod):
+		return response.BadRequest(c, err.Error(), err)
+	default:
+		return response.InternalServerError(c, message, err)
+	}
+}
+
+// ListAccounts lista el plan de cuentas
+// GET /api/v1/ledger/accounts?active=true
+func (h *LedgerHandler) ListAccounts(c echo.Context) error {
+	activeOnly := c.QueryParam("active") == "true"
+
+	accounts, err := h.listAccountsUC.Execute(c.Request().Context(), activeOnly)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get ledger accounts", err)
+	}
+
+	return response.OK(c, "Ledger accounts retrieved successfully", dto.ToLedgerAccountDTOList(accounts))
+}
+
+// CreateAccount agrega una cuenta al plan de cuentas
+// POST /api/v1/ledger/accounts
+func (h *LedgerHandler) CreateAccount(c echo.Context) error {
+	var req dto.CreateLedgerAccountRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	account := &entities.LedgerAccount{
+		Code: req.Code,
+		Name: req.Name,
+		Type: entities.LedgerAccountType(req.Type),
+	}
+	if err := h.createAccountUC.Execute(c.Request().Context(), account); err != nil {
+		return ledgerError(c, err, "Failed to create ledger account")
+	}
+
+	return response.Created(c, "Ledger account created successfully", dto.ToLedgerAccountDTO(account))
+}
+
+// UpdateAccount cambia el nombre o el estado de una cuenta
+// PUT /api/v1/ledger/accounts/:id
+func (h *LedgerHandler) UpdateAccount(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid account ID", err)
+	}
+
+	var req dto.UpdateLedgerAccountRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	account, err := h.updateAccountUC.Execute(c.Request().Context(), uint(id), req.Name, req.IsActive)
+	if err != nil {
+		return ledgerError(c, err, "Failed to update ledger account")
+	}
+
+	return response.OK(c, "Ledger account updated successfully", dto.ToLedgerAccountDTO(account))
+}
+
+// ListEntries lista los asientos del libro diario
+// GET /api/v1/ledger/entries?startDate=2026-10-01&endDate=2026-10-31&sourceType=SALE&sourceId=15&accountId=3
+func (h *LedgerHandler) ListEntries(c echo.Context) error {
+	filters := make(map[string]interface{})
+	if startDate := c.QueryParam("startDate"); startDate != "" {
+		filters["start_date"] = startDate
+	}
+	if endDate := c.QueryParam("endDate"); endDate != "" {
+		filters["end_date"] = endDate
+	}
+	if sourceType := c.QueryParam("sourceType"); sourceType != "" {
+		filters["source_type"] = sourceType
+	}
+	if value := c.QueryParam("sourceId"); value != "" {
+		sourceID, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return response.BadRequest(c, "Invalid source ID", err)
+		}
+		filters["source_id"] = uint(sourceID)
+	}
+	if value := c.QueryParam("accountId"); value != "" {
+		accountID, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return response.BadRequest(c, "Invalid account ID", err)
+		}
+		filters["account_id"] = uint(accountID)
+	}
+
+	entries, err := h.listEntriesUC.Execute(c.Request().Context(), filters)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get journal entries", err)
+	}
+
+	return response.OK(c, "Journal entries retrieved successfully", dto.ToJournalEntryDTOList(entries))
+}
+
+// GetEntry obtiene un asiento con sus líneas
+// GET /api/v1/ledger/entries/:id
+func (h *LedgerHandler) GetEntry(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid journal entry ID", err)
+	}
+
+	entry, err := h.getEntryUC.Execute(c.Request().Context(), uint(id))
+	if err != nil {
+		return ledgerError(c, err, "Failed to get journal entry")
+	}
+
+	return response.OK(c, "Journal entry retrieved successfully", dto.ToJournalEntryDTO(entry))
+}
+
+// CreateEntry registra un asiento manual
+// POST /api/v1/ledger/entries
+func (h *LedgerHandler) CreateEntry(c echo.Context) error {
+	var req dto.CreateJournalEntryRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	entry := req.ToEntity()
+	if err := h.createEntryUC.Execute(c.Request().Context(), entry); err != nil {
+		return ledgerError(c, err, "Failed to create journal entry")
+	}
+
+	return response.Created(c, "Journal entry created successfully", dto.ToJournalEntryDTO(entry))
+}
+
+// ReverseEntry anula un asiento manual con su reverso
+// POST /api/v1/ledger/entries/:id/reverse
+func (h *LedgerHandler) ReverseEntry(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid journal entry ID", err)
+	}
+
+	var req dto.ReverseJournalEntryRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	reversal, err := h.reverseEntryUC.Execute(c.Request().Context(), uint(id), req.Reason)
+	if err != nil {
+		return ledgerError(c, err, "Failed to reverse journal entry")
+	}
+
+	return response.Created(c, "Journal entry reversed successfully", dto.ToJournalEntryDTO(reversal))
+}
+
+// GetTrialBalance obtiene el balance de prueba del periodo
+// GET /api/v1/ledger/trial-balance?startDate=2026-10-01&endDate=2026-10-31
+func (h *LedgerHandler) GetTrialBalance(c echo.Context) error {
+	start, end, err := parsePeriod(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error(), err)
+	}
+
+	trial, err := h.getTrialBalanceUC.Execute(c.Request().Context(), start, end)
+	if err != nil {
+		return ledgerError(c, err, "Failed to get trial balance")
+	}
+
+	return response.OK(c, "Trial balance retrieved successfully", dto.ToTrialBalanceDTO(trial))
+}
+
+// GetIncomeStatement obtiene el estado de resultados (P&G) del periodo
+// GET /api/v1/ledger/income-statement?startDate=2026-10-01&endDate=2026-10-31
+func (h *LedgerHandler) GetIncomeStatement(c echo.Context) error {
+	start, end, err := parsePeriod(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error(), err)
+	}
+
+	statement, err := h.getIncomeStatementUC.Execute(c.Request().Context(), start, end)
+	if err != nil {
+		return ledgerError(c, err, "Failed to get income statement")
+	}
+
+	return response.OK(c, "Income statement retrieved successfully", dto.ToIncomeStatementDTO(statement))
+}
+
+// GetBalanceSheet obtiene el balance general al final del día indicado (por defecto hoy)
+// GET /api/v1/ledger/balance-sheet?date=2026-10-31
+func (h *LedgerHandler) GetBalanceSheet(c echo.Context) error {
+	now := time.Now()
+	date := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	if value := c.QueryParam("date"); value != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", value, time.Local)
+		if err != nil {
+			return response.BadRequest(c, "date must have format YYYY-MM-DD", err)
+		}
+		date = parsed
+	}
+
+	sheet, err := h.getBalanceSheetUC.Execute(c.Request().Context(), date)
+	if err != nil {
+		return ledgerError(c, err, "Failed to get balance sheet")
+	}
+
+	return response.OK(c, "Balance sheet retrieved successfully", dto.ToBalanceSheetDTO(sheet))
+}
+
+// GetCrossCheck cruza el libro mayor con la cartera de clientes y los saldos de las cuentas
+// GET /api/v1/ledger/cross-check
+func (h *LedgerHandler) GetCrossCheck(c echo.Context) error {
+	crossCheck, err := h.getCrossCheckUC.Execute(c.Request().Context())
+	if err != nil {
+		return ledgerError(c, err, "Failed to cross-check ledger")
+	}
+
+	return response.OK(c, "Ledger cross-check completed successfully", dto.ToLedgerCrossCheckDTO(crossCheck))
+}
+
+// Sync contabiliza los registros que todavía no tienen asiento
+// POST /api/v1/ledger/sync
+func (h *LedgerHandler) Sync(c echo.Context) error {
+	result, err := h.syncUC.Execute(c.Request().Context())
+	if err != nil {
+		return response.InternalServerError(c, "Failed to sync ledger", err)
+	}
+
+	return response.OK(c, "Ledger synchronized successfully", dto.ToLedgerSyncResultDTO(result))
+}
@@ -0,0 +1,167 @@
+package loyalty
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/loyalty"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// LoyaltyHandler maneja las peticiones HTTP del programa de puntos
+type LoyaltyHandler struct {
+	createRuleUC         *loyalty.CreateRuleUseCase
+	listRulesUC          *loyalty.ListRulesUseCase
+	updateRuleUC         *loyalty.UpdateRuleUseCase
+	deleteRuleUC         *loyalty.DeleteRuleUseCase
+	getPointsStatementUC *loyalty.GetPointsStatementUseCase
+	expirePointsUC       *loyalty.ExpirePointsUseCase
+}
+
+// NewLoyaltyHandler crea una nueva instancia del handler
+func NewLoyaltyHandler(
+	createRuleUC *loyalty.CreateRuleUseCase,
+	listRulesUC *loyalty.ListRulesUseCase,
+	updateRuleUC *loyalty.UpdateRuleUseCase,
+	deleteRuleUC *loyalty.DeleteRuleUseCase,
+	getPointsStatementUC *loyalty.GetPointsStatementUseCase,
+	expirePointsUC *loyalty.ExpirePointsUseCase,
+) *LoyaltyHandler {
+	return &LoyaltyHandler{
+		createRuleUC:         createRuleUC,
+		listRulesUC:          listRulesUC,
+		updateRuleUC:         updateRuleUC,
+		deleteRuleUC:         deleteRuleUC,
+		getPointsStatementUC: getPointsStatementUC,
+		expirePointsUC:       expirePointsUC,
+	}
+}
+
+// LoyaltyRuleRequest representa la petición para crear o actualizar una regla
+type LoyaltyRuleRequest struct {
+	Name       string                   `json:"name"`
+	Type       entities.LoyaltyRuleType `json:"type"`
+	CategoryID *uint                    `json:"categoryId"`
+	AmountStep float64                  `json:"amountStep"` // Cada cuánto gastado se otorgan los puntos
+	Points     int                      `json:"points"`
+	Multiplier float64                  `json:"multiplier"` // Solo BIRTHDAY_MULTIPLIER
+	IsActive   *bool                    `json:"isActive"`
+}
+
+// toEntity convierte la petición a entidad
+func (req *LoyaltyRuleRequest) toEntity() *entities.LoyaltyRule {
+	rule := &entities.LoyaltyRule{
+		Name:       req.Name,
+		Type:       req.Type,
+		CategoryID: req.CategoryID,
+		AmountStep: req.AmountStep,
+		Points:     req.Points,
+		Multiplier: req.Multiplier,
+		IsActive:   true,
+	}
+	if req.IsActive != nil {
+		rule.IsActive = *req.IsActive
+	}
+	return rule
+}
+
+// CreateRule crea una regla de puntos
+// POST /api/v1/loyalty/rules
+func (h *LoyaltyHandler) CreateRule(c echo.Context) error {
+	var req LoyaltyRuleRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	rule := req.toEntity()
+	if err := h.createRuleUC.Execute(c.Request().Context(), rule); err != nil {
+		return response.BadRequest(c, "Failed to create loyalty rule", err)
+	}
+
+	return response.Created(c, "Loyalty rule created successfully", dto.ToLoyaltyRuleDTO(rule))
+}
+
+// ListRules lista las reglas de puntos
+// GET /api/v1/loyalty/rules?active=true
+func (h *LoyaltyHandler) ListRules(c echo.Context) error {
+	activeOnly := c.QueryParam("active") == "true"
+
+	rules, err := h.listRulesUC.Execute(c.Request().Context(), activeOnly)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to list loyalty rules", err)
+	}
+
+	return response.OK(c, "Loyalty rules retrieved successfully", dto.ToLoyaltyRuleDTOList(rules))
+}
+
+// UpdateRule actualiza una regla de puntos
+// PUT /api/v1/loyalty/rules/:id
+func (h *LoyaltyHandler) UpdateRule(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid rule ID", err)
+	}
+
+	var req LoyaltyRuleRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	rule := req.toEntity()
+	rule.ID = uint(id)
+	if err := h.updateRuleUC.Execute(c.Request().Context(), rule); err != nil {
+		return response.BadRequest(c, "Failed to update loyalty rule", err)
+	}
+
+	return response.OK(c, "Loyalty rule updated successfully", dto.ToLoyaltyRuleDTO(rule))
+}
+
+// DeleteRule elimina una regla de puntos
+// DELETE /api/v1/loyalty/rules/:id
+func (h *LoyaltyHandler) DeleteRule(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid rule ID", err)
+	}
+
+	if err := h.deleteRuleUC.Execute(c.Request().Context(), uint(id)); err != nil {
+		return response.NotFound(c, "Loyalty rule not found")
+	}
+
+	return response.OK(c, "Loyalty rule deleted successfully", nil)
+}
+
+// GetStatement obtiene el saldo y los movimientos de puntos de un cliente
+// GET /api/v1/customers/:id/loyalty
+func (h *LoyaltyHandler) GetStatement(c echo.Context) error {
+	customerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid customer ID", err)
+	}
+
+	statement, err := h.getPointsStatementUC.Execute(c.Request().Context(), uint(customerID))
+	if err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
+		return response.NotFound(c, "Customer not found")
+	}
+
+	return response.OK(c, "Loyalty statement retrieved successfully", dto.ToLoyaltyStatementDTO(statement))
+}
+
+// ExpirePoints vence los puntos cuya vigencia terminó
+// POST /api/v1/loyalty/expire
+func (h *LoyaltyHandler) ExpirePoints(c echo.Context) error {
+	expired, err := h.expirePointsUC.Execute(c.Request().Context())
+	if err != nil {
+		return response.InternalServerError(c, "Failed to expire loyalty points", err)
+	}
+
+	return response.OK(c, "Loyalty points expired successfully", map[string]interface{}{
+		"expiredPoints": expired,
+	})
+}
@@ -1,6 +1,7 @@
 package order
 
 import (
+	"errors"
 	"strconv"
 	"time"
 
@@ -58,7 +59,10 @@ func (h *OrderHandler) CreateOrder(c echo.Context) error {
 		SellerID              uint               `json:"sellerId"`
 		Type                  entities.OrderType `json:"type"`
 		Discount              float64            `json:"discount"`
+		CouponCode            string             `json:"couponCode"`    // Código de campaña (opcional)
+		LoyaltyPoints         int                `json:"loyaltyPoints"` // Puntos a redimir como descuento (opcional)
 		Notes                 string             `json:"notes"`
+		PaymentMethodID       *uint              `json:"paymentMethodId"` // Método de pago de una venta de contado (opcional)
 		EstimatedDeliveryDate *time.Time         `json:"estimatedDeliveryDate"`
 		Items                 []struct {
 			ProductID   uint    `json:"productId"`   // Opcional para CUSTOM/INVENTORY
@@ -82,7 +86,10 @@ func (h *OrderHandler) CreateOrder(c echo.Context) error {
 		SellerID:              req.SellerID,
 		Type:                  req.Type,
 		Discount:              req.Discount,
+		CouponCode:            req.CouponCode,
+		LoyaltyPointsRedeemed: req.LoyaltyPoints,
 		Notes:                 req.Notes,
+		PaymentMethodID:       req.PaymentMethodID,
 		EstimatedDeliveryDate: req.EstimatedDeliveryDate,
 		OrderDate:             time.Now(),
 	}
@@ -103,6 +110,9 @@ func (h *OrderHandler) CreateOrder(c echo.Context) error {
 	}
 
 	if err := h.createOrderUC.Execute(c.Request().Context(), orderEntity); err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
 		return response.BadRequest(c, "Failed to create order", err)
 	}
 
@@ -194,6 +204,9 @@ func (h *OrderHandler) AddOrderItem(c echo.Context) error {
 	}
 
 	if err := h.addOrderItemUC.Execute(c.Request().Context(), item); err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
 		return response.BadRequest(c, "Failed to add order item", err)
 	}
 
@@ -232,6 +245,9 @@ func (h *OrderHandler) UpdateOrderItem(c echo.Context) error {
 	}
 
 	if err := h.updateOrderItemUC.Execute(c.Request().Context(), item); err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
 		return response.BadRequest(c, "Failed to update order item", err)
 	}
 
@@ -247,6 +263,9 @@ func (h *OrderHandler) RemoveOrderItem(c echo.Context) error {
 	}
 
 	if err := h.removeOrderItemUC.Execute(c.Request().Context(), uint(itemID)); err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
 		return response.BadRequest(c, "Failed to remove order item", err)
 	}
 
@@ -277,15 +296,16 @@ func (h *OrderHandler) ChangeOrderStatus(c echo.Context) error {
 		return response.BadRequest(c, "Status is required", nil)
 	}
 
-	newStatus := entities.OrderStatus(req.Status)
-
 	result, err := h.changeOrderStatusUC.Execute(
 		c.Request().Context(),
 		uint(orderID),
-		newStatus,
+		entities.OrderStatus(req.Status),
 		req.ProducedQuantities,
 	)
 	if err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) || errors.Is(err, entities.ErrManufacturingOnly) {
+			return response.Forbidden(c, err.Error())
+		}
 		return response.BadRequest(c, "Failed to change order status", err)
 	}
 
@@ -310,6 +330,9 @@ func (h *OrderHandler) GetAllowedNextStatuses(c echo.Context) error {
 
 	allowedStatuses, err := h.changeOrderStatusUC.GetAllowedNextStatuses(c.Request().Context(), uint(orderID))
 	if err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
 		return response.InternalServerError(c, "Failed to get allowed statuses", err)
 	}
 
@@ -328,6 +351,9 @@ func (h *OrderHandler) GetAccountStatementDraft(c echo.Context) error {
 
 	data, err := h.generateAccountStatementUC.GetDraft(c.Request().Context(), uint(orderID))
 	if err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
 		return response.InternalServerError(c, "Failed to get account statement draft", err)
 	}
 
@@ -371,6 +397,9 @@ func (h *OrderHandler) ConfirmAccountStatement(c echo.Context) error {
 	// Primero obtener el draft para tener todos los datos base
 	draftData, err := h.generateAccountStatementUC.GetDraft(c.Request().Context(), uint(orderID))
 	if err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
 		return response.InternalServerError(c, "Failed to get account statement data", err)
 	}
 
@@ -0,0 +1,191 @@
+package ownership
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/middleware"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/ownership"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// OwnershipHandler maneja el compartir y reasignar órdenes y clientes entre vendedores
+type OwnershipHandler struct {
+	shareRecordUC      *ownership.ShareRecordUseCase
+	unshareRecordUC    *ownership.UnshareRecordUseCase
+	listRecordSharesUC *ownership.ListRecordSharesUseCase
+	reassignOrderUC    *ownership.ReassignOrderUseCase
+	reassignCustomerUC *ownership.ReassignCustomerUseCase
+}
+
+// NewOwnershipHandler crea una nueva instancia del handler
+func NewOwnershipHandler(
+	shareRecordUC *ownership.ShareRecordUseCase,
+	unshareRecordUC *ownership.UnshareRecordUseCase,
+	listRecordSharesUC *ownership.ListRecordSharesUseCase,
+	reassignOrderUC *ownership.ReassignOrderUseCase,
+	reassignCustomerUC *ownership.ReassignCustomerUseCase,
+) *OwnershipHandler {
+	return &OwnershipHandler{
+		shareRecordUC:      shareRecordUC,
+		unshareRecordUC:    unshareRecordUC,
+		listRecordSharesUC: listRecordSharesUC,
+		reassignOrderUC:    reassignOrderUC,
+		reassignCustomerUC: reassignCustomerUC,
+	}
+}
+
+// ownershipError traduce los errores de propiedad a respuestas HTTP
+func ownershipError(c echo.Context, message string, err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return response.NotFound(c, "Record not found")
+	case errors.Is(err, entities.ErrRecordShareNotFound):
+		return response.NotFound(c, err.Error())
+	default:
+		return response.BadRequest(c, message, err)
+	}
+}
+
+// ListOrderShares lista los usuarios con quienes se compartió la orden
+// GET /api/v1/orders/:id/shares
+func (h *OwnershipHandler) ListOrderShares(c echo.Context) error {
+	return h.listShares(c, entities.ShareResourceOrder)
+}
+
+// ShareOrder comparte la orden con otro usuario
+// POST /api/v1/orders/:id/shares
+func (h *OwnershipHandler) ShareOrder(c echo.Context) error {
+	return h.share(c, entities.ShareResourceOrder)
+}
+
+// UnshareOrder retira el acceso compartido a la orden
+// DELETE /api/v1/orders/:id/shares/:userId
+func (h *OwnershipHandler) UnshareOrder(c echo.Context) error {
+	return h.unshare(c, entities.ShareResourceOrder)
+}
+
+// ListCustomerShares lista los usuarios con quienes se compartió el cliente
+// GET /api/v1/customers/:id/shares
+func (h *OwnershipHandler) ListCustomerShares(c echo.Context) error {
+	return h.listShares(c, entities.ShareResourceCustomer)
+}
+
+// ShareCustomer comparte el cliente con otro usuario
+// POST /api/v1/customers/:id/shares
+func (h *OwnershipHandler) ShareCustomer(c echo.Context) error {
+	return h.share(c, entities.ShareResourceCustomer)
+}
+
+// UnshareCustomer retira el acceso compartido al cliente
+// DELETE /api/v1/customers/:id/shares/:userId
+func (h *OwnershipHandler) UnshareCustomer(c echo.Context) error {
+	return h.unshare(c, entities.ShareResourceCustomer)
+}
+
+// ReassignOrder cambia el vendedor dueño de la orden
+// PUT /api/v1/orders/:id/seller
+func (h *OwnershipHandler) ReassignOrder(c echo.Context) error {
+	orderID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid order ID", err)
+	}
+
+	var req dto.ReassignOrderRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+	if req.SellerID == 0 {
+		return response.BadRequest(c, "sellerId is required", nil)
+	}
+
+	order, err := h.reassignOrderUC.Execute(c.Request().Context(), uint(orderID), req.SellerID)
+	if err != nil {
+		return ownershipError(c, "Failed to reassign order", err)
+	}
+
+	return response.OK(c, "Order reassigned successfully", dto.ToOrderDTO(order))
+}
+
+// ReassignCustomer cambia el vendedor responsable del cliente
+// PUT /api/v1/customers/:id/seller
+func (h *OwnershipHandler) ReassignCustomer(c echo.Context) error {
+	customerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid customer ID", err)
+	}
+
+	var req dto.ReassignCustomerRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	customer, err := h.reassignCustomerUC.Execute(c.Request().Context(), uint(customerID), req.SellerID)
+	if err != nil {
+		return ownershipError(c, "Failed to reassign customer", err)
+	}
+
+	return response.OK(c, "Customer reassigned successfully", dto.ToCustomerDTO(customer))
+}
+
+func (h *OwnershipHandler) listShares(c echo.Context, resourceType entities.ShareResourceType) error {
+	resourceID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid ID", err)
+	}
+
+	shares, err := h.listRecordSharesUC.Execute(c.Request().Context(), resourceType, uint(resourceID))
+	if err != nil {
+		return response.InternalServerError(c, "Failed to list shares", err)
+	}
+
+	return response.OK(c, "Shares retrieved successfully", dto.ToRecordShareDTOList(shares))
+}
+
+func (h *OwnershipHandler) share(c echo.Context, resourceType entities.ShareResourceType) error {
+	resourceID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid ID", err)
+	}
+
+	var req dto.ShareRecordRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+	if req.UserID == 0 {
+		return response.BadRequest(c, "userId is required", nil)
+	}
+
+	admin, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	share, err := h.shareRecordUC.Execute(c.Request().Context(), resourceType, uint(resourceID), req.UserID, admin.ID)
+	if err != nil {
+		return ownershipError(c, "Failed to share record", err)
+	}
+
+	return response.Created(c, "Record shared successfully", dto.ToRecordShareDTO(share))
+}
+
+func (h *OwnershipHandler) unshare(c echo.Context, resourceType entities.ShareResourceType) error {
+	resourceID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid ID", err)
+	}
+	userID, err := strconv.ParseUint(c.Param("userId"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid user ID", err)
+	}
+
+	if err := h.unshareRecordUC.Execute(c.Request().Context(), resourceType, uint(resourceID), uint(userID)); err != nil {
+		return ownershipError(c, "Failed to unshare record", err)
+	}
+
+	return response.OK(c, "Share removed successfully", nil)
+}
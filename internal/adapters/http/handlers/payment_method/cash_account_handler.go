@@ -0,0 +1,423 @@
+package payment_method
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/middleware"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/payment_method"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// maxStatementSize limita el tamaño del extracto CSV que se puede importar
+const maxStatementSize = 5 << 20
+
+// CashAccountHandler maneja las cuentas de caja y banco (métodos de pago), sus traslados y la conciliación
+type CashAccountHandler struct {
+	createUC            *payment_method.CreatePaymentMethodUseCase
+	updateUC            *payment_method.UpdatePaymentMethodUseCase
+	listBalancesUC      *payment_method.ListAccountBalancesUseCase
+	getLedgerUC         *payment_method.GetAccountLedgerUseCase
+	createTransferUC    *payment_method.CreateAccountTransferUseCase
+	listTransfersUC     *payment_method.ListAccountTransfersUseCase
+	importStatementUC   *payment_method.ImportBankStatementUseCase
+	listStatementsUC    *payment_method.ListBankStatementsUseCase
+	getReconciliationUC *payment_method.GetBankReconciliationUseCase
+	matchLineUC         *payment_method.MatchStatementLineUseCase
+	ignoreLineUC        *payment_method.IgnoreStatementLineUseCase
+	resetLineUC         *payment_method.ResetStatementLineUseCase
+}
+
+// NewCashAccountHandler crea una nueva instancia del handler
+func NewCashAccountHandler(
+	createUC *payment_method.CreatePaymentMethodUseCase,
+	updateUC *payment_method.UpdatePaymentMethodUseCase,
+	listBalancesUC *payment_method.ListAccountBalancesUseCase,
+	getLedgerUC *payment_method.GetAccountLedgerUseCase,
+	createTransferUC *payment_method.CreateAccountTransferUseCase,
+	listTransfersUC *payment_method.ListAccountTransfersUseCase,
+	importStatementUC *payment_method.ImportBankStatementUseCase,
+	listStatementsUC *payment_method.ListBankStatementsUseCase,
+	getReconciliationUC *payment_method.GetBankReconciliationUseCase,
+	matchLineUC *payment_method.MatchStatementLineUseCase,
+	ignoreLineUC *payment_method.IgnoreStatementLineUseCase,
+	resetLineUC *payment_method.ResetStatementLineUseCase,
+) *CashAccountHandler {
+	return &CashAccountHandler{
+		createUC:            createUC,
+		updateUC:            updateUC,
+		listBalancesUC:      listBalancesUC,
+		getLedgerUC:         getLedgerUC,
+		createTransferUC:    createTransferUC,
+		listTransfersUC:     listTransfersUC,
+		importStatementUC:   importStatementUC,
+		listStatementsUC:    listStatementsUC,
+		getReconciliationUC: getReconciliationUC,
+		matchLineUC:         matchLineUC,
+		ignoreLineUC:        ignoreLineUC,
+		resetLineUC:         resetLineUC,
+	}
+}
+
+// CashAccountRequest representa la petición para crear o actualizar una cuenta
+type CashAccountRequest struct {
+	Name           string  `json:"name"`
+	OpeningBalance float64 `json:"openingBalance"` // Saldo antes del primer movimiento registrado
+	IsCash         bool    `json:"isCash"`         // Efectivo: se cuenta al cerrar la caja
+	IsActive       *bool   `json:"isActive"`
+}
+
+// parsePeriod lee startDate y endDate (YYYY-MM-DD); por defecto el mes en curso
+func parsePeriod(c echo.Context) (time.Time, time.Time, error) {
+	now := time.Now()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
+	end := start.AddDate(0, 1, -1)
+
+	if value := c.QueryParam("startDate"); value != "" {
+		date, err := time.ParseInLocation("2006-01-02", value, time.Local)
+		if err != nil {
+			return start, end, errors.New("startDate must have format YYYY-MM-DD")
+		}
+		start = date
+	}
+	if value := c.QueryParam("endDate"); value != "" {
+		date, err := time.ParseInLocation("2006-01-02", value, time.Local)
+		if err != nil {
+			return start, end, errors.New("endDate must have format YYYY-MM-DD")
+		}
+		end = date
+	}
+	return start, end, nil
+}
+
+// currentUserID retorna el ID del usuario autenticado, si lo hay
+func currentUserID(c echo.Context) *uint {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return nil
+	}
+	return &user.ID
+}
+
+// reconciliationError traduce los errores de la conciliación a respuestas HTTP
+func reconciliationError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound), errors.Is(err, entities.ErrStatementLineNotFound):
+		return response.NotFound(c, "Statement, line or account movement not found")
+	case errors.Is(err, entities.ErrInvalidStatementFile),
+		errors.Is(err, entities.ErrStatementLineNotUnmatched),
+		errors.Is(err, entities.ErrMovementAlreadyReconciled),
+		errors.Is(err, entities.ErrStatementAmountMismatch):
+		return response.BadRequest(c, err.Error(), err)
+	default:
+		return response.InternalServerError(c, message, err)
+	}
+}
+
+// ListBalances lista las cuentas con su saldo actual
+// GET /api/v1/cash-accounts?active=true
+func (h *CashAccountHandler) ListBalances(c echo.Context) error {
+	activeOnly := c.QueryParam("active") == "true"
+
+	balances, err := h.listBalancesUC.Execute(c.Request().Context(), activeOnly)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get account balances", err)
+	}
+
+	return response.OK(c, "Account balances retrieved successfully", dto.ToAccountBalanceDTOList(balances))
+}
+
+// Create crea una cuenta (método de pago)
+// POST /api/v1/cash-accounts
+func (h *CashAccountHandler) Create(c echo.Context) error {
+	var req CashAccountRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	paymentMethod := &entities.PaymentMethodOption{
+		Name:           req.Name,
+		OpeningBalance: req.OpeningBalance,
+		IsCash:         req.IsCash,
+	}
+	if err := h.createUC.Execute(c.Request().Context(), paymentMethod); err != nil {
+		return response.BadRequest(c, "Failed to create account", err)
+	}
+
+	return response.Created(c, "Account created successfully", dto.ToPaymentMethodDTO(paymentMethod))
+}
+
+// Update actualiza el nombre, el saldo inicial o el estado de una cuenta
+// PUT /api/v1/cash-accounts/:id
+func (h *CashAccountHandler) Update(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid account ID", err)
+	}
+
+	var req CashAccountRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	paymentMethod := &entities.PaymentMethodOption{
+		ID:             uint(id),
+		Name:           req.Name,
+		OpeningBalance: req.OpeningBalance,
+		IsCash:         req.IsCash,
+		IsActive:       true,
+	}
+	if req.IsActive != nil {
+		paymentMethod.IsActive = *req.IsActive
+	}
+
+	if err := h.updateUC.Execute(c.Request().Context(), paymentMethod); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NotFound(c, "Account not found")
+		}
+		return response.BadRequest(c, "Failed to update account", err)
+	}
+
+	return response.OK(c, "Account updated successfully", dto.ToPaymentMethodDTO(paymentMethod))
+}
+
+// GetLedger obtiene los movimientos de la cuenta con su saldo corrido
+// GET /api/v1/cash-accounts/:id/ledger?startDate=2026-10-01&endDate=2026-10-31
+func (h *CashAccountHandler) GetLedger(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid account ID", err)
+	}
+
+	start, end, err := parsePeriod(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error(), err)
+	}
+
+	ledger, err := h.getLedgerUC.Execute(c.Request().Context(), uint(id), start, end)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidReportPeriod):
+			return response.BadRequest(c, err.Error(), err)
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return response.NotFound(c, "Account not found")
+		default:
+			return response.InternalServerError(c, "Failed to get account ledger", err)
+		}
+	}
+
+	return response.OK(c, "Account ledger retrieved successfully", dto.ToAccountLedgerDTO(ledger))
+}
+
+// CreateTransfer registra un traslado entre cuentas
+// POST /api/v1/cash-accounts/transfers
+func (h *CashAccountHandler) CreateTransfer(c echo.Context) error {
+	var req struct {
+		FromPaymentMethodID uint    `json:"fromPaymentMethodId"`
+		ToPaymentMethodID   uint    `json:"toPaymentMethodId"`
+		Amount              float64 `json:"amount"`
+		Description         string  `json:"description"`
+		Date                string  `json:"date"` // YYYY-MM-DD, por defecto hoy
+	}
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	transfer := &entities.AccountTransfer{
+		FromPaymentMethodID: req.FromPaymentMethodID,
+		ToPaymentMethodID:   req.ToPaymentMethodID,
+		Amount:              req.Amount,
+		Description:         req.Description,
+		CreatedByID:         currentUserID(c),
+	}
+	if req.Date != "" {
+		date, err := time.ParseInLocation("2006-01-02", req.Date, time.Local)
+		if err != nil {
+			return response.BadRequest(c, "date must have format YYYY-MM-DD", err)
+		}
+		transfer.Date = date
+	}
+
+	if err := h.createTransferUC.Execute(c.Request().Context(), transfer); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NotFound(c, "Account not found")
+		}
+		return response.BadRequest(c, "Failed to create transfer", err)
+	}
+
+	return response.Created(c, "Transfer created successfully", dto.ToAccountTransferDTO(transfer))
+}
+
+// ListTransfers lista los traslados del periodo
+// GET /api/v1/cash-accounts/transfers?accountId=2&startDate=2026-10-01&endDate=2026-10-31
+func (h *CashAccountHandler) ListTransfers(c echo.Context) error {
+	start, end, err := parsePeriod(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error(), err)
+	}
+
+	var accountID *uint
+	if value := c.QueryParam("accountId"); value != "" {
+		id, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return response.BadRequest(c, "Invalid account ID", err)
+		}
+		parsed := uint(id)
+		accountID = &parsed
+	}
+
+	transfers, err := h.listTransfersUC.Execute(c.Request().Context(), accountID, start, end)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidReportPeriod) {
+			return response.BadRequest(c, err.Error(), err)
+		}
+		return response.InternalServerError(c, "Failed to list transfers", err)
+	}
+
+	return response.OK(c, "Transfers retrieved successfully", dto.ToAccountTransferDTOList(transfers))
+}
+
+// ImportStatement importa el extracto CSV de la cuenta (campo "file") y lo cruza con sus movimientos
+// POST /api/v1/cash-accounts/:id/statements
+func (h *CashAccountHandler) ImportStatement(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid account ID", err)
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		return response.BadRequest(c, "A CSV file is required in the \"file\" field", err)
+	}
+	if file.Size > maxStatementSize {
+		return response.BadRequest(c, "Statement file is too large (max 5 MB)", nil)
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return response.InternalServerError(c, "Failed to open file", err)
+	}
+	data, err := io.ReadAll(src)
+	src.Close()
+	if err != nil {
+		return response.InternalServerError(c, "Failed to read file", err)
+	}
+
+	reconciliation, err := h.importStatementUC.Execute(c.Request().Context(), uint(id), file.Filename, data, currentUserID(c))
+	if err != nil {
+		return reconciliationError(c, err, "Failed to import statement")
+	}
+
+	return response.Created(c, "Statement imported successfully", dto.ToBankReconciliationDTO(reconciliation))
+}
+
+// ListStatements lista los extractos importados de la cuenta
+// GET /api/v1/cash-accounts/:id/statements
+func (h *CashAccountHandler) ListStatements(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid account ID", err)
+	}
+
+	statements, err := h.listStatementsUC.Execute(c.Request().Context(), uint(id))
+	if err != nil {
+		return response.InternalServerError(c, "Failed to list statements", err)
+	}
+
+	return response.OK(c, "Statements retrieved successfully", dto.ToBankStatementDTOList(statements))
+}
+
+// GetReconciliation obtiene las líneas del extracto y los movimientos de la cuenta sin conciliar
+// GET /api/v1/cash-accounts/statements/:statementId
+func (h *CashAccountHandler) GetReconciliation(c echo.Context) error {
+	statementID, err := strconv.ParseUint(c.Param("statementId"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid statement ID", err)
+	}
+
+	reconciliation, err := h.getReconciliationUC.Execute(c.Request().Context(), uint(statementID))
+	if err != nil {
+		return reconciliationError(c, err, "Failed to get reconciliation")
+	}
+
+	return response.OK(c, "Reconciliation retrieved successfully", dto.ToBankReconciliationDTO(reconciliation))
+}
+
+// parseLineParams lee el extracto y la línea de la ruta
+func parseLineParams(c echo.Context) (uint, uint, error) {
+	statementID, err := strconv.ParseUint(c.Param("statementId"), 10, 32)
+	if err != nil {
+		return 0, 0, errors.New("invalid statement ID")
+	}
+	lineID, err := strconv.ParseUint(c.Param("lineId"), 10, 32)
+	if err != nil {
+		return 0, 0, errors.New("invalid line ID")
+	}
+	return uint(statementID), uint(lineID), nil
+}
+
+// MatchLine cruza a mano una línea del extracto con un movimiento de la cuenta
+// POST /api/v1/cash-accounts/statements/:statementId/lines/:lineId/match
+func (h *CashAccountHandler) MatchLine(c echo.Context) error {
+	statementID, lineID, err := parseLineParams(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error(), err)
+	}
+
+	var req struct {
+		Source   string `json:"source"` // CUSTOMER_TRANSACTION, FINANCIAL_TRANSACTION o ACCOUNT_TRANSFER
+		SourceID uint   `json:"sourceId"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+	source := entities.AccountMovementSource(req.Source)
+	if !source.IsValid() {
+		return response.BadRequest(c, "source must be CUSTOMER_TRANSACTION, FINANCIAL_TRANSACTION or ACCOUNT_TRANSFER", nil)
+	}
+
+	line, err := h.matchLineUC.Execute(c.Request().Context(), statementID, lineID, source, req.SourceID)
+	if err != nil {
+		return reconciliationError(c, err, "Failed to match statement line")
+	}
+
+	return response.OK(c, "Statement line matched successfully", dto.ToBankStatementLineDTO(line))
+}
+
+// IgnoreLine descarta una línea del extracto
+// POST /api/v1/cash-accounts/statements/:statementId/lines/:lineId/ignore
+func (h *CashAccountHandler) IgnoreLine(c echo.Context) error {
+	statementID, lineID, err := parseLineParams(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error(), err)
+	}
+
+	line, err := h.ignoreLineUC.Execute(c.Request().Context(), statementID, lineID)
+	if err != nil {
+		return reconciliationError(c, err, "Failed to ignore statement line")
+	}
+
+	return response.OK(c, "Statement line ignored successfully", dto.ToBankStatementLineDTO(line))
+}
+
+// ResetLine deshace el cruce o el descarte de una línea del extracto
+// DELETE /api/v1/cash-accounts/statements/:statementId/lines/:lineId/match
+func (h *CashAccountHandler) ResetLine(c echo.Context) error {
+	statementID, lineID, err := parseLineParams(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error(), err)
+	}
+
+	line, err := h.resetLineUC.Execute(c.Request().Context(), statementID, lineID)
+	if err != nil {
+		return reconciliationError(c, err, "Failed to reset statement line")
+	}
+
+	return response.OK(c, "Statement line reset successfully", dto.ToBankStatementLineDTO(line))
+}
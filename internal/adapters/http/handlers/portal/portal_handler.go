@@ -0,0 +1,239 @@
+package portal
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/middleware"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/customer"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/portal"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// PortalHandler maneja las peticiones del portal de clientes
+// Todas las consultas usan el cliente del token, nunca un ID recibido en la petición
+type PortalHandler struct {
+	requestAccessLinkUC *portal.RequestAccessLinkUseCase
+	createAccessLinkUC  *portal.CreateAccessLinkUseCase
+	verifyAccessLinkUC  *portal.VerifyAccessLinkUseCase
+	listOrdersUC        *portal.ListOrdersUseCase
+	getOrderUC          *portal.GetOrderUseCase
+	getHistoryUC        *customer.GetCustomerHistoryUseCase
+	getBalanceUC        *customer.GetCustomerBalanceUseCase
+	generateStatementUC *usecases.GenerateCustomerStatementUseCase
+}
+
+// NewPortalHandler crea una nueva instancia del handler
+func NewPortalHandler(
+	requestAccessLinkUC *portal.RequestAccessLinkUseCase,
+	createAccessLinkUC *portal.CreateAccessLinkUseCase,
+	verifyAccessLinkUC *portal.VerifyAccessLinkUseCase,
+	listOrdersUC *portal.ListOrdersUseCase,
+	getOrderUC *portal.GetOrderUseCase,
+	getHistoryUC *customer.GetCustomerHistoryUseCase,
+	getBalanceUC *customer.GetCustomerBalanceUseCase,
+	generateStatementUC *usecases.GenerateCustomerStatementUseCase,
+) *PortalHandler {
+	return &PortalHandler{
+		requestAccessLinkUC: requestAccessLinkUC,
+		createAccessLinkUC:  createAccessLinkUC,
+		verifyAccessLinkUC:  verifyAccessLinkUC,
+		listOrdersUC:        listOrdersUC,
+		getOrderUC:          getOrderUC,
+		getHistoryUC:        getHistoryUC,
+		getBalanceUC:        getBalanceUC,
+		generateStatementUC: generateStatementUC,
+	}
+}
+
+// RequestLinkRequest representa la petición de un enlace de acceso
+type RequestLinkRequest struct {
+	Phone string `json:"phone"`
+}
+
+// RequestLink envía un enlace mágico al teléfono registrado
+// Siempre responde igual para no revelar qué teléfonos son clientes
+// POST /api/v1/portal/auth/request-link
+func (h *PortalHandler) RequestLink(c echo.Context) error {
+	var req RequestLinkRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	if err := h.requestAccessLinkUC.Execute(c.Request().Context(), req.Phone, c.RealIP()); err != nil {
+		if errors.Is(err, entities.ErrInvalidPortalPhone) {
+			return response.BadRequest(c, "Invalid phone number", err)
+		}
+		return response.InternalServerError(c, "Failed to send access link", err)
+	}
+
+	// Misma respuesta exista o no el teléfono
+	return response.OK(c, "If the phone is registered, an access link has been sent", nil)
+}
+
+// VerifyLinkRequest representa la petición para canjear un enlace de acceso
+type VerifyLinkRequest struct {
+	Token string `json:"token"`
+}
+
+// VerifyLink canjea el enlace mágico por un token de sesión
+// POST /api/v1/portal/auth/verify
+func (h *PortalHandler) VerifyLink(c echo.Context) error {
+	var req VerifyLinkRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	token, customerEntity, err := h.verifyAccessLinkUC.Execute(c.Request().Context(), req.Token)
+	if err != nil {
+		return response.Unauthorized(c, "Invalid or expired access link")
+	}
+
+	// La ruta es pública: el alcance del cliente se agrega después de validar el enlace
+	ctx := access.WithScope(c.Request().Context(), access.Customer(customerEntity.ID))
+	balance, err := h.getBalanceUC.Execute(ctx, customerEntity.ID)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get balance", err)
+	}
+
+	return response.OK(c, "Login successful", map[string]interface{}{
+		"token":    token,
+		"customer": dto.ToPortalCustomerDTO(customerEntity, balance),
+	})
+}
+
+// CreateLink genera un enlace de acceso para compartir con el cliente (uso interno)
+// POST /api/v1/customers/:id/portal-link
+func (h *PortalHandler) CreateLink(c echo.Context) error {
+	customerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid customer ID", err)
+	}
+
+	linkURL, expiresAt, err := h.createAccessLinkUC.Execute(c.Request().Context(), uint(customerID))
+	if err != nil {
+		if errors.Is(err, entities.ErrRecordAccessDenied) {
+			return response.Forbidden(c, err.Error())
+		}
+		return response.BadRequest(c, "Failed to create access link", err)
+	}
+
+	return response.Created(c, "Access link created successfully", map[string]interface{}{
+		"url":       linkURL,
+		"expiresAt": expiresAt,
+	})
+}
+
+// Me retorna los datos y el saldo del cliente autenticado
+// GET /api/v1/portal/me
+func (h *PortalHandler) Me(c echo.Context) error {
+	customerEntity, err := middleware.GetCustomerFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "Customer not authenticated")
+	}
+
+	balance, err := h.getBalanceUC.Execute(c.Request().Context(), customerEntity.ID)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get balance", err)
+	}
+
+	return response.OK(c, "Customer retrieved successfully", dto.ToPortalCustomerDTO(customerEntity, balance))
+}
+
+// GetTransactions retorna el historial de movimientos del cliente autenticado
+// GET /api/v1/portal/transactions
+func (h *PortalHandler) GetTransactions(c echo.Context) error {
+	customerEntity, err := middleware.GetCustomerFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "Customer not authenticated")
+	}
+
+	history, err := h.getHistoryUC.Execute(c.Request().Context(), customerEntity.ID)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get transactions", err)
+	}
+
+	balance, err := h.getBalanceUC.Execute(c.Request().Context(), customerEntity.ID)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get balance", err)
+	}
+
+	return response.OK(c, "Transactions retrieved successfully", map[string]interface{}{
+		"balance":      balance,
+		"transactions": dto.ToCustomerTransactionDTOListFromSlice(history),
+	})
+}
+
+// DownloadStatement descarga el estado de cuenta en PDF del cliente autenticado
+// GET /api/v1/portal/statement?days=30
+func (h *PortalHandler) DownloadStatement(c echo.Context) error {
+	customerEntity, err := middleware.GetCustomerFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "Customer not authenticated")
+	}
+
+	var days *int
+	if daysStr := c.QueryParam("days"); daysStr != "" {
+		daysInt, err := strconv.Atoi(daysStr)
+		if err != nil || daysInt <= 0 {
+			return response.BadRequest(c, "Parameter 'days' must be a positive number", err)
+		}
+		days = &daysInt
+	}
+
+	statement, err := h.generateStatementUC.Execute(c.Request().Context(), usecases.StatementRequest{
+		CustomerID: customerEntity.ID,
+		Days:       days,
+	})
+	if err != nil {
+		return response.InternalServerError(c, "Failed to generate statement", err)
+	}
+
+	c.Response().Header().Set("Content-Disposition", "attachment; filename="+statement.Filename)
+	c.Response().Header().Set("Content-Length", strconv.Itoa(len(statement.PDFBytes)))
+
+	return c.Blob(http.StatusOK, "application/pdf", statement.PDFBytes)
+}
+
+// ListOrders lista las órdenes del cliente autenticado
+// GET /api/v1/portal/orders
+func (h *PortalHandler) ListOrders(c echo.Context) error {
+	customerEntity, err := middleware.GetCustomerFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "Customer not authenticated")
+	}
+
+	orders, err := h.listOrdersUC.Execute(c.Request().Context(), customerEntity.ID)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to list orders", err)
+	}
+
+	return response.OK(c, "Orders retrieved successfully", dto.ToPortalOrderDTOList(orders))
+}
+
+// GetOrder obtiene el estado de una orden del cliente autenticado
+// GET /api/v1/portal/orders/:id
+func (h *PortalHandler) GetOrder(c echo.Context) error {
+	customerEntity, err := middleware.GetCustomerFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "Customer not authenticated")
+	}
+
+	orderID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid order ID", err)
+	}
+
+	order, err := h.getOrderUC.Execute(c.Request().Context(), customerEntity.ID, uint(orderID))
+	if err != nil {
+		return response.NotFound(c, "Order not found")
+	}
+
+	return response.OK(c, "Order retrieved successfully", dto.ToPortalOrderDTO(order))
+}
@@ -0,0 +1,125 @@
+package report
+
+import (
+	"net/http"
+	"strings"
+
+	reportUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/report"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// MarginReportHandler maneja los reportes de márgenes y rentabilidad
+type MarginReportHandler struct {
+	getMarginReportUC    *reportUseCases.GetMarginReportUseCase
+	exportMarginReportUC *reportUseCases.ExportMarginReportUseCase
+}
+
+// NewMarginReportHandler crea una nueva instancia del handler
+func NewMarginReportHandler(
+	getMarginReportUC *reportUseCases.GetMarginReportUseCase,
+	exportMarginReportUC *reportUseCases.ExportMarginReportUseCase,
+) *MarginReportHandler {
+	return &MarginReportHandler{
+		getMarginReportUC:    getMarginReportUC,
+		exportMarginReportUC: exportMarginReportUC,
+	}
+}
+
+// parseMarginFilters lee groupBy (por defecto product), interval (por defecto month) y los filtros comunes
+func parseMarginFilters(c echo.Context) (entities.MarginReportFilters, error) {
+	query, err := parseReportQuery(c)
+	if err != nil {
+		return entities.MarginReportFilters{}, err
+	}
+
+	filters := entities.MarginReportFilters{
+		GroupBy:    entities.MarginByProduct,
+		Interval:   entities.MarginIntervalMonth,
+		StartDate:  query.startDate,
+		EndDate:    query.endDate,
+		SellerID:   query.sellerID,
+		CategoryID: query.categoryID,
+		OrderType:  query.orderType,
+	}
+	if value := c.QueryParam("groupBy"); value != "" {
+		filters.GroupBy = entities.MarginGrouping(strings.ToUpper(value))
+	}
+	if value := c.QueryParam("interval"); value != "" {
+		filters.Interval = entities.MarginInterval(strings.ToUpper(value))
+	}
+	return filters, nil
+}
+
+func marginLineResponse(line entities.MarginReportLine) map[string]interface{} {
+	return map[string]interface{}{
+		"key":              line.Key,
+		"label":            line.Label,
+		"quantity":         line.Quantity,
+		"orders":           line.Orders,
+		"revenue":          line.Revenue,
+		"discount":         line.Discount,
+		"netRevenue":       line.NetRevenue,
+		"cost":             line.Cost,
+		"grossProfit":      line.GrossProfit,
+		"marginPercent":    line.MarginPercent,
+		"uncostedQuantity": line.UncostedQuantity,
+	}
+}
+
+// GetMarginReport retorna venta neta, costo, utilidad bruta y margen agrupados por orden, producto o periodo
+// GET /api/v1/reports/margins?groupBy=period&interval=week&startDate=2026-10-01&endDate=2026-10-31
+func (h *MarginReportHandler) GetMarginReport(c echo.Context) error {
+	filters, err := parseMarginFilters(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error(), err)
+	}
+
+	report, err := h.getMarginReportUC.Execute(c.Request().Context(), filters)
+	if err != nil {
+		return reportError(c, "Failed to generate margin report", err)
+	}
+
+	lines := make([]map[string]interface{}, 0, len(report.Lines))
+	for _, line := range report.Lines {
+		lines = append(lines, marginLineResponse(line))
+	}
+
+	data := map[string]interface{}{
+		"groupBy": report.GroupBy,
+		"period": map[string]string{
+			"startDate": report.StartDate.Format("2006-01-02"),
+			"endDate":   report.EndDate.Format("2006-01-02"),
+		},
+		"lines":  lines,
+		"totals": marginLineResponse(report.Totals),
+	}
+	if report.Interval != "" {
+		data["interval"] = report.Interval
+	}
+
+	return response.OK(c, "Margin report generated successfully", data)
+}
+
+// ExportMarginReport descarga el reporte de márgenes como CSV, XLSX o PDF
+// GET /api/v1/reports/margins/export?format=xlsx&groupBy=order
+func (h *MarginReportHandler) ExportMarginReport(c echo.Context) error {
+	filters, err := parseMarginFilters(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error(), err)
+	}
+
+	format := c.QueryParam("format")
+	if format == "" {
+		format = reportUseCases.FormatCSV
+	}
+
+	file, err := h.exportMarginReportUC.Execute(c.Request().Context(), filters, format)
+	if err != nil {
+		return reportError(c, "Failed to export margin report", err)
+	}
+
+	c.Response().Header().Set("Content-Disposition", "attachment; filename="+file.Filename)
+	return c.Blob(http.StatusOK, file.ContentType, file.Content)
+}
@@ -0,0 +1,196 @@
+package report
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	reportUseCases "github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/report"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// SalesReportHandler maneja los reportes de ventas
+type SalesReportHandler struct {
+	getSalesReportUC    *reportUseCases.GetSalesReportUseCase
+	exportSalesReportUC *reportUseCases.ExportSalesReportUseCase
+}
+
+// NewSalesReportHandler crea una nueva instancia del handler
+func NewSalesReportHandler(
+	getSalesReportUC *reportUseCases.GetSalesReportUseCase,
+	exportSalesReportUC *reportUseCases.ExportSalesReportUseCase,
+) *SalesReportHandler {
+	return &SalesReportHandler{
+		getSalesReportUC:    getSalesReportUC,
+		exportSalesReportUC: exportSalesReportUC,
+	}
+}
+
+// reportQuery son los filtros comunes de los reportes
+type reportQuery struct {
+	startDate  time.Time
+	endDate    time.Time
+	sellerID   *uint
+	categoryID *uint
+	orderType  entities.OrderType
+}
+
+// parseReportQuery lee startDate y endDate (YYYY-MM-DD, por defecto el mes en curso),
+// sellerId, categoryId y type
+func parseReportQuery(c echo.Context) (reportQuery, error) {
+	now := time.Now()
+	query := reportQuery{startDate: time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)}
+	query.endDate = query.startDate.AddDate(0, 1, -1)
+
+	if value := c.QueryParam("startDate"); value != "" {
+		date, err := time.ParseInLocation("2006-01-02", value, time.Local)
+		if err != nil {
+			return query, errors.New("startDate must have format YYYY-MM-DD")
+		}
+		query.startDate = date
+	}
+	if value := c.QueryParam("endDate"); value != "" {
+		date, err := time.ParseInLocation("2006-01-02", value, time.Local)
+		if err != nil {
+			return query, errors.New("endDate must have format YYYY-MM-DD")
+		}
+		query.endDate = date
+	}
+
+	if value := c.QueryParam("sellerId"); value != "" {
+		id, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return query, errors.New("invalid sellerId")
+		}
+		sellerID := uint(id)
+		query.sellerID = &sellerID
+	}
+	if value := c.QueryParam("categoryId"); value != "" {
+		id, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return query, errors.New("invalid categoryId")
+		}
+		categoryID := uint(id)
+		query.categoryID = &categoryID
+	}
+	if value := c.QueryParam("type"); value != "" {
+		orderType := entities.OrderType(strings.ToUpper(value))
+		switch orderType {
+		case entities.OrderTypeCustom, entities.OrderTypeInventory, entities.OrderTypeSale:
+			query.orderType = orderType
+		default:
+			return query, errors.New("type must be CUSTOM, INVENTORY or SALE")
+		}
+	}
+
+	return query, nil
+}
+
+// parseSalesFilters lee dimension (por defecto product) y los filtros comunes
+func parseSalesFilters(c echo.Context) (entities.SalesReportFilters, error) {
+	query, err := parseReportQuery(c)
+	if err != nil {
+		return entities.SalesReportFilters{}, err
+	}
+
+	filters := entities.SalesReportFilters{
+		Dimension:  entities.SalesByProduct,
+		StartDate:  query.startDate,
+		EndDate:    query.endDate,
+		SellerID:   query.sellerID,
+		CategoryID: query.categoryID,
+		OrderType:  query.orderType,
+	}
+	if value := c.QueryParam("dimension"); value != "" {
+		filters.Dimension = entities.SalesDimension(strings.ToUpper(value))
+	}
+	return filters, nil
+}
+
+// reportError traduce los errores de validación de los reportes a 400
+func reportError(c echo.Context, message string, err error) error {
+	switch {
+	case errors.Is(err, entities.ErrInvalidSalesDimension),
+		errors.Is(err, entities.ErrInvalidMarginGrouping),
+		errors.Is(err, entities.ErrInvalidMarginInterval),
+		errors.Is(err, entities.ErrInvalidReportPeriod),
+		errors.Is(err, entities.ErrInvalidExportFormat):
+		return response.BadRequest(c, err.Error(), err)
+	default:
+		return response.InternalServerError(c, message, err)
+	}
+}
+
+// GetSalesReport retorna las ventas agrupadas por la dimensión, comparadas con el periodo anterior
+// GET /api/v1/reports/sales?dimension=color&startDate=2026-10-01&endDate=2026-10-31
+func (h *SalesReportHandler) GetSalesReport(c echo.Context) error {
+	filters, err := parseSalesFilters(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error(), err)
+	}
+
+	report, err := h.getSalesReportUC.Execute(c.Request().Context(), filters)
+	if err != nil {
+		return reportError(c, "Failed to generate sales report", err)
+	}
+
+	lines := make([]map[string]interface{}, 0, len(report.Lines))
+	for _, line := range report.Lines {
+		lines = append(lines, map[string]interface{}{
+			"key":              line.Key,
+			"label":            line.Label,
+			"quantity":         line.Quantity,
+			"orders":           line.Orders,
+			"revenue":          line.Revenue,
+			"share":            line.Share,
+			"previousQuantity": line.PreviousQuantity,
+			"previousRevenue":  line.PreviousRevenue,
+			"revenueChange":    line.RevenueChange,
+		})
+	}
+
+	return response.OK(c, "Sales report generated successfully", map[string]interface{}{
+		"dimension": report.Dimension,
+		"period": map[string]string{
+			"startDate": report.StartDate.Format("2006-01-02"),
+			"endDate":   report.EndDate.Format("2006-01-02"),
+		},
+		"previousPeriod": map[string]string{
+			"startDate": report.PreviousStart.Format("2006-01-02"),
+			"endDate":   report.PreviousEnd.Format("2006-01-02"),
+		},
+		"lines": lines,
+		"totals": map[string]interface{}{
+			"quantity":        report.TotalQuantity,
+			"revenue":         report.TotalRevenue,
+			"previousRevenue": report.PreviousRevenue,
+			"revenueChange":   report.RevenueChange,
+		},
+	})
+}
+
+// ExportSalesReport descarga el reporte de ventas como CSV, XLSX o PDF
+// GET /api/v1/reports/sales/export?format=xlsx&dimension=seller
+func (h *SalesReportHandler) ExportSalesReport(c echo.Context) error {
+	filters, err := parseSalesFilters(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error(), err)
+	}
+
+	format := c.QueryParam("format")
+	if format == "" {
+		format = reportUseCases.FormatCSV
+	}
+
+	file, err := h.exportSalesReportUC.Execute(c.Request().Context(), filters, format)
+	if err != nil {
+		return reportError(c, "Failed to export sales report", err)
+	}
+
+	c.Response().Header().Set("Content-Disposition", "attachment; filename="+file.Filename)
+	return c.Blob(http.StatusOK, file.ContentType, file.Content)
+}
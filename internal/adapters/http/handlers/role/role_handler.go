@@ -0,0 +1,126 @@
+package role
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/middleware"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/role"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// RoleHandler maneja la administración de roles y el catálogo de permisos
+type RoleHandler struct {
+	createRoleUC *role.CreateRoleUseCase
+	listRolesUC  *role.ListRolesUseCase
+	updateRoleUC *role.UpdateRoleUseCase
+	deleteRoleUC *role.DeleteRoleUseCase
+}
+
+// NewRoleHandler crea una nueva instancia del handler
+func NewRoleHandler(
+	createRoleUC *role.CreateRoleUseCase,
+	listRolesUC *role.ListRolesUseCase,
+	updateRoleUC *role.UpdateRoleUseCase,
+	deleteRoleUC *role.DeleteRoleUseCase,
+) *RoleHandler {
+	return &RoleHandler{
+		createRoleUC: createRoleUC,
+		listRolesUC:  listRolesUC,
+		updateRoleUC: updateRoleUC,
+		deleteRoleUC: deleteRoleUC,
+	}
+}
+
+// roleError traduce los errores de roles a respuestas HTTP
+func roleError(c echo.Context, message string, err error) error {
+	switch {
+	case errors.Is(err, entities.ErrRoleNotFound):
+		return response.NotFound(c, err.Error())
+	case errors.Is(err, entities.ErrRoleAlreadyExists), errors.Is(err, entities.ErrRoleInUse):
+		return response.Error(c, http.StatusConflict, message, err)
+	case errors.Is(err, entities.ErrSystemRole), errors.Is(err, entities.ErrSuperAdminLocked), errors.Is(err, entities.ErrRoleEscalation):
+		return response.Forbidden(c, err.Error())
+	default:
+		return response.BadRequest(c, message, err)
+	}
+}
+
+// List lista los roles con sus permisos
+// GET /api/v1/roles
+func (h *RoleHandler) List(c echo.Context) error {
+	roles, err := h.listRolesUC.Execute(c.Request().Context())
+	if err != nil {
+		return response.InternalServerError(c, "Failed to list roles", err)
+	}
+	return response.OK(c, "Roles retrieved successfully", dto.ToRoleDTOList(roles))
+}
+
+// Permissions lista el catálogo de permisos asignables
+// GET /api/v1/roles/permissions
+func (h *RoleHandler) Permissions(c echo.Context) error {
+	return response.OK(c, "Permissions retrieved successfully", dto.ToPermissionCatalogDTO(entities.PermissionCatalog))
+}
+
+// Create crea un rol personalizado
+// POST /api/v1/roles
+func (h *RoleHandler) Create(c echo.Context) error {
+	var req dto.CreateRoleRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	newRole := &entities.Role{
+		Name:        entities.UserRole(req.Name),
+		Description: req.Description,
+		Permissions: dto.ToPermissionList(req.Permissions),
+	}
+	if err := h.createRoleUC.Execute(c.Request().Context(), newRole); err != nil {
+		return roleError(c, "Failed to create role", err)
+	}
+
+	return response.Created(c, "Role created successfully", dto.ToRoleDTO(newRole))
+}
+
+// Update cambia la descripción y los permisos de un rol
+// PUT /api/v1/roles/:name
+func (h *RoleHandler) Update(c echo.Context) error {
+	var req dto.UpdateRoleRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	updated, err := h.updateRoleUC.Execute(
+		c.Request().Context(),
+		entities.UserRole(c.Param("name")),
+		req.Description,
+		dto.ToPermissionList(req.Permissions),
+	)
+	if err != nil {
+		return roleError(c, "Failed to update role", err)
+	}
+
+	return response.OK(c, "Role updated successfully", dto.ToRoleDTO(updated))
+}
+
+// Delete elimina un rol personalizado sin usuarios asignados
+// DELETE /api/v1/roles/:name
+func (h *RoleHandler) Delete(c echo.Context) error {
+	if err := h.deleteRoleUC.Execute(c.Request().Context(), entities.UserRole(c.Param("name"))); err != nil {
+		return roleError(c, "Failed to delete role", err)
+	}
+	return response.OK(c, "Role deleted successfully", nil)
+}
+
+// MyPermissions retorna el rol y los permisos del usuario autenticado (para que el frontend oculte opciones)
+// GET /api/v1/auth/permissions
+func (h *RoleHandler) MyPermissions(c echo.Context) error {
+	current, err := middleware.GetRoleFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+	return response.OK(c, "Permissions retrieved successfully", dto.ToRoleDTO(current))
+}
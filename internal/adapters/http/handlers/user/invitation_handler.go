@@ -0,0 +1,151 @@
+package user
+
+import (
+	"strconv"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/dto"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/middleware"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/user"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// InvitationHandler maneja las invitaciones de usuario (reemplaza el registro público)
+type InvitationHandler struct {
+	createInvitationUC *user.CreateInvitationUseCase
+	listInvitationsUC  *user.ListInvitationsUseCase
+	revokeInvitationUC *user.RevokeInvitationUseCase
+	acceptInvitationUC *user.AcceptInvitationUseCase
+}
+
+// NewInvitationHandler crea una nueva instancia del handler
+func NewInvitationHandler(
+	createInvitationUC *user.CreateInvitationUseCase,
+	listInvitationsUC *user.ListInvitationsUseCase,
+	revokeInvitationUC *user.RevokeInvitationUseCase,
+	acceptInvitationUC *user.AcceptInvitationUseCase,
+) *InvitationHandler {
+	return &InvitationHandler{
+		createInvitationUC: createInvitationUC,
+		listInvitationsUC:  listInvitationsUC,
+		revokeInvitationUC: revokeInvitationUC,
+		acceptInvitationUC: acceptInvitationUC,
+	}
+}
+
+// Create crea una invitación y retorna el enlace de un solo uso para compartir
+// POST /api/v1/users/invitations
+func (h *InvitationHandler) Create(c echo.Context) error {
+	var req dto.CreateUserInvitationRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	admin, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		return response.Unauthorized(c, "User not authenticated")
+	}
+
+	permissions := make([]entities.UserCategoryPermission, len(req.Permissions))
+	for i, perm := range req.Permissions {
+		permissions[i] = entities.UserCategoryPermission{
+			CategoryID: perm.CategoryID,
+			CanView:    perm.CanView,
+			CanCreate:  perm.CanCreate,
+			CanEdit:    perm.CanEdit,
+			CanDelete:  perm.CanDelete,
+		}
+	}
+
+	invitation, inviteURL, err := h.createInvitationUC.Execute(c.Request().Context(), user.CreateInvitationRequest{
+		Email:       req.Email,
+		FirstName:   req.FirstName,
+		LastName:    req.LastName,
+		Role:        req.Role,
+		Permissions: permissions,
+		InvitedBy:   admin.ID,
+	})
+	if err != nil {
+		return roleAssignmentError(c, "Failed to create invitation", err)
+	}
+
+	return response.Created(c, "Invitation created successfully", map[string]interface{}{
+		"invitation": dto.ToUserInvitationDTO(invitation),
+		"url":        inviteURL,
+	})
+}
+
+// List lista las invitaciones
+// GET /api/v1/users/invitations?status=PENDING
+func (h *InvitationHandler) List(c echo.Context) error {
+	filters := make(map[string]interface{})
+	if status := c.QueryParam("status"); status != "" {
+		filters["status"] = status
+	}
+
+	invitations, err := h.listInvitationsUC.Execute(c.Request().Context(), filters)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to list invitations", err)
+	}
+
+	return response.OK(c, "Invitations retrieved successfully", dto.ToUserInvitationDTOList(invitations))
+}
+
+// Revoke anula una invitación pendiente
+// DELETE /api/v1/users/invitations/:id
+func (h *InvitationHandler) Revoke(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return response.BadRequest(c, "Invalid invitation ID", err)
+	}
+
+	if err := h.revokeInvitationUC.Execute(c.Request().Context(), uint(id)); err != nil {
+		return response.BadRequest(c, "Failed to revoke invitation", err)
+	}
+
+	return response.OK(c, "Invitation revoked successfully", nil)
+}
+
+// PreviewRequest representa la petición para consultar una invitación
+type PreviewRequest struct {
+	Token string `json:"token"`
+}
+
+// Preview muestra al invitado los datos de la cuenta antes de elegir contraseña
+// POST /api/v1/auth/invitations/preview
+func (h *InvitationHandler) Preview(c echo.Context) error {
+	var req PreviewRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	invitation, err := h.acceptInvitationUC.Preview(c.Request().Context(), req.Token)
+	if err != nil {
+		return response.NotFound(c, "Invalid or expired invitation")
+	}
+
+	return response.OK(c, "Invitation retrieved successfully", dto.ToUserInvitationPreviewDTO(invitation))
+}
+
+// Accept crea la cuenta del invitado con la contraseña elegida
+// POST /api/v1/auth/invitations/accept
+func (h *InvitationHandler) Accept(c echo.Context) error {
+	var req dto.AcceptUserInvitationRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "Invalid request body", err)
+	}
+
+	createdUser, err := h.acceptInvitationUC.Execute(c.Request().Context(), req.Token, req.Password)
+	if err != nil {
+		return response.BadRequest(c, "Failed to accept invitation", err)
+	}
+
+	return response.Created(c, "Account created successfully, you can now log in", map[string]interface{}{
+		"id":        createdUser.ID,
+		"email":     createdUser.Email,
+		"firstName": createdUser.FirstName,
+		"lastName":  createdUser.LastName,
+		"role":      createdUser.Role,
+	})
+}
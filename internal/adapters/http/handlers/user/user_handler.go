@@ -1,6 +1,7 @@
 package user
 
 import (
+	"errors"
 	"strconv"
 
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/user"
@@ -63,7 +64,7 @@ func (h *UserHandler) Create(c echo.Context) error {
 	}
 
 	if err := h.createUserUC.Execute(c.Request().Context(), user, req.Password); err != nil {
-		return response.BadRequest(c, "Failed to create user", err)
+		return roleAssignmentError(c, "Failed to create user", err)
 	}
 
 	return response.Created(c, "User created successfully", user)
@@ -114,7 +115,7 @@ func (h *UserHandler) Update(c echo.Context) error {
 
 	user.ID = uint(id)
 	if err := h.updateUserUC.Execute(c.Request().Context(), &user); err != nil {
-		return response.BadRequest(c, "Failed to update user", err)
+		return roleAssignmentError(c, "Failed to update user", err)
 	}
 
 	return response.OK(c, "User updated successfully", user)
@@ -128,7 +129,7 @@ func (h *UserHandler) Delete(c echo.Context) error {
 	}
 
 	if err := h.deleteUserUC.Execute(c.Request().Context(), uint(id)); err != nil {
-		return response.BadRequest(c, "Failed to delete user", err)
+		return roleAssignmentError(c, "Failed to delete user", err)
 	}
 
 	return response.OK(c, "User deleted successfully", nil)
@@ -152,9 +153,18 @@ func (h *UserHandler) ChangePassword(c echo.Context) error {
 		return response.BadRequest(c, "Invalid request body", err)
 	}
 
-	if err := h.changePasswordUC.Execute(c.Request().Context(), uint(id), req.OldPassword, req.NewPassword); err != nil {
+	if err := h.changePasswordUC.Execute(c.Request().Context(), uint(id), req.OldPassword, req.NewPassword, c.RealIP(), c.Request().UserAgent()); err != nil {
 		return response.BadRequest(c, "Failed to change password", err)
 	}
 
 	return response.OK(c, "Password changed successfully", nil)
 }
+
+// roleAssignmentError responde 403 cuando el actor intenta otorgar un rol por encima del suyo, cambiar el propio
+// o gestionar a un usuario con un rol por encima del suyo
+func roleAssignmentError(c echo.Context, message string, err error) error {
+	if errors.Is(err, entities.ErrRoleEscalation) || errors.Is(err, entities.ErrSelfRoleChange) {
+		return response.Forbidden(c, err.Error())
+	}
+	return response.BadRequest(c, message, err)
+}
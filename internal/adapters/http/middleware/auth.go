@@ -3,34 +3,94 @@ package middleware
 import (
 	"strings"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/auth"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
 	"github.com/labstack/echo/v4"
 )
 
+// APIKeyHeader es el header con el que las integraciones envían su llave de API
+const APIKeyHeader = "X-API-Key"
+
 // AuthMiddleware middleware de autenticación
-func AuthMiddleware(validateTokenUC *auth.ValidateTokenUseCase) echo.MiddlewareFunc {
+// Acepta un access token (Authorization: Bearer) o una llave de API (X-API-Key)
+func AuthMiddleware(validateTokenUC *auth.ValidateTokenUseCase, validateAPIKeyUC *auth.ValidateAPIKeyUseCase) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
+			var principal *auth.Principal
 			authHeader := c.Request().Header.Get("Authorization")
-			if authHeader == "" {
+			apiKey := c.Request().Header.Get(APIKeyHeader)
+
+			switch {
+			case authHeader != "":
+				parts := strings.Split(authHeader, " ")
+				if len(parts) != 2 || parts[0] != "Bearer" {
+					return response.Unauthorized(c, "Invalid authorization header format")
+				}
+
+				token := parts[1]
+				validated, err := validateTokenUC.Execute(c.Request().Context(), token)
+				if err != nil {
+					return response.Unauthorized(c, "Invalid or expired token")
+				}
+				principal = validated
+			case apiKey != "":
+				validated, err := validateAPIKeyUC.Execute(c.Request().Context(), apiKey, c.RealIP())
+				if err != nil {
+					return response.Unauthorized(c, "Invalid, expired or revoked API key")
+				}
+				principal = validated
+			default:
 				return response.Unauthorized(c, "Missing authorization header")
 			}
 
-			parts := strings.Split(authHeader, " ")
-			if len(parts) != 2 || parts[0] != "Bearer" {
-				return response.Unauthorized(c, "Invalid authorization header format")
+			// Guardar usuario, rol y sesión (o llave de API) en el contexto
+			c.Set("user", principal.User)
+			c.Set("role", principal.Role)
+			if principal.APIKey != nil {
+				c.Set("api_key", principal.APIKey)
+			} else {
+				c.Set("session_id", principal.SessionID)
 			}
 
-			token := parts[1]
-			user, err := validateTokenUC.Execute(c.Request().Context(), token)
-			if err != nil {
-				return response.Unauthorized(c, "Invalid or expired token")
+			// Alcance de propiedad que aplican los casos de uso de órdenes y clientes
+			scope := access.Scope{
+				UserID:       principal.User.ID,
+				AllOrders:    principal.Role.HasPermission(entities.PermissionOrdersAll),
+				AllCustomers: principal.Role.HasPermission(entities.PermissionCustomersAll),
 			}
+			ctx := access.WithScope(c.Request().Context(), scope)
+			ctx = access.WithActor(ctx, access.Actor{
+				User:      principal.User,
+				Role:      principal.Role,
+				APIKey:    principal.APIKey,
+				IPAddress: c.RealIP(),
+				UserAgent: c.Request().UserAgent(),
+				RequestID: requestID(c),
+			})
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
 
-			// Guardar usuario en el contexto
-			c.Set("user", user)
+// requestID obtiene el X-Request-ID de la petición (lo genera el middleware RequestID si el cliente no lo envía)
+func requestID(c echo.Context) string {
+	if id := c.Response().Header().Get(echo.HeaderXRequestID); id != "" {
+		return id
+	}
+	return c.Request().Header.Get(echo.HeaderXRequestID)
+}
+
+// RequireSession rechaza las llaves de API en rutas que solo tienen sentido para una persona
+// (sesiones, 2FA, contraseña, administración de llaves)
+func RequireSession() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if _, ok := c.Get("api_key").(*entities.APIKey); ok {
+				return response.Forbidden(c, entities.ErrAPIKeySessionRequired.Error())
+			}
 			return next(c)
 		}
 	}
@@ -56,6 +116,31 @@ func RequireRole(roles ...entities.UserRole) echo.MiddlewareFunc {
 	}
 }
 
+// RequirePermission middleware que requiere que el rol del usuario otorgue alguno de los permisos
+func RequirePermission(permissions ...entities.Permission) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if _, ok := c.Get("user").(*entities.User); !ok {
+				return response.Unauthorized(c, "User not found in context")
+			}
+
+			for _, permission := range permissions {
+				if HasPermission(c, permission) {
+					return next(c)
+				}
+			}
+
+			return response.Forbidden(c, "Insufficient permissions")
+		}
+	}
+}
+
+// HasPermission verifica si el rol del usuario autenticado otorga el permiso
+func HasPermission(c echo.Context, permission entities.Permission) bool {
+	role, ok := c.Get("role").(*entities.Role)
+	return ok && role.HasPermission(permission)
+}
+
 // GetUserFromContext obtiene el usuario del contexto
 func GetUserFromContext(c echo.Context) (*entities.User, error) {
 	user, ok := c.Get("user").(*entities.User)
@@ -64,3 +149,27 @@ func GetUserFromContext(c echo.Context) (*entities.User, error) {
 	}
 	return user, nil
 }
+
+// GetSessionIDFromContext obtiene el ID de la sesión del token actual
+func GetSessionIDFromContext(c echo.Context) (uint, error) {
+	sessionID, ok := c.Get("session_id").(uint)
+	if !ok {
+		return 0, echo.NewHTTPError(401, "Session not found in context")
+	}
+	return sessionID, nil
+}
+
+// GetAPIKeyFromContext obtiene la llave de API de la petición (nil si usa un token de sesión)
+func GetAPIKeyFromContext(c echo.Context) *entities.APIKey {
+	key, _ := c.Get("api_key").(*entities.APIKey)
+	return key
+}
+
+// GetRoleFromContext obtiene el rol (con sus permisos) del usuario autenticado
+func GetRoleFromContext(c echo.Context) (*entities.Role, error) {
+	role, ok := c.Get("role").(*entities.Role)
+	if !ok {
+		return nil, echo.NewHTTPError(401, "Role not found in context")
+	}
+	return role, nil
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/portal"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/response"
+	"github.com/labstack/echo/v4"
+)
+
+// CustomerAuthMiddleware middleware de autenticación del portal de clientes
+func CustomerAuthMiddleware(validatePortalTokenUC *portal.ValidatePortalTokenUseCase) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			authHeader := c.Request().Header.Get("Authorization")
+			if authHeader == "" {
+				return response.Unauthorized(c, "Missing authorization header")
+			}
+
+			parts := strings.Split(authHeader, " ")
+			if len(parts) != 2 || parts[0] != "Bearer" {
+				return response.Unauthorized(c, "Invalid authorization header format")
+			}
+
+			customer, err := validatePortalTokenUC.Execute(c.Request().Context(), parts[1])
+			if err != nil {
+				return response.Unauthorized(c, "Invalid or expired token")
+			}
+
+			// Guardar cliente en el contexto; los casos de uso solo le dan acceso a sus propios datos
+			c.Set("customer", customer)
+			ctx := access.WithScope(c.Request().Context(), access.Customer(customer.ID))
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
+
+// GetCustomerFromContext obtiene el cliente autenticado del contexto
+func GetCustomerFromContext(c echo.Context) (*entities.Customer, error) {
+	customer, ok := c.Get("customer").(*entities.Customer)
+	if !ok {
+		return nil, echo.NewHTTPError(401, "Customer not found in context")
+	}
+	return customer, nil
+}
@@ -4,12 +4,22 @@ import (
 	analyticsHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/analytics"
 	auditHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/audit"
 	authHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/auth"
+	campaignHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/campaign"
+	cashSessionHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/cash_session"
 	categoryHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/category"
+	commissionHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/commission"
 	customerHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/customer"
+	electronicInvoiceHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/electronic_invoice"
 	financialTransactionHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/financial_transaction"
+	ledgerHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/ledger"
+	loyaltyHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/loyalty"
 	orderHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/order"
+	ownershipHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/ownership"
 	paymentMethodHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/payment_method"
+	portalHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/portal"
 	productHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/product"
+	reportHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/report"
+	roleHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/role"
 	sizeHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/size"
 	supplierHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/supplier"
 	swaggerHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/swagger"
@@ -17,6 +27,7 @@ import (
 	userPermissionHandler "github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/handlers/user_permission"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/http/middleware"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/auth"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/usecases/portal"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/labstack/echo/v4"
 )
@@ -24,24 +35,43 @@ import (
 // Handlers contiene todos los handlers HTTP
 type Handlers struct {
 	Analytics            *analyticsHandler.AnalyticsHTTPHandler
+	SalesReport          *reportHandler.SalesReportHandler
+	MarginReport         *reportHandler.MarginReportHandler
 	Audit                *auditHandler.AuditHTTPHandler
 	Auth                 *authHandler.AuthHandler
+	PasswordReset        *authHandler.PasswordResetHandler
+	APIKey               *authHandler.APIKeyHandler
+	TwoFactor            *authHandler.TwoFactorHandler
+	Campaign             *campaignHandler.CampaignHandler
+	Commission           *commissionHandler.CommissionHandler
+	Loyalty              *loyaltyHandler.LoyaltyHandler
+	Portal               *portalHandler.PortalHandler
 	User                 *userHandler.UserHandler
+	UserInvitation       *userHandler.InvitationHandler
+	Role                 *roleHandler.RoleHandler
 	Product              *productHandler.ProductHandler
 	Category             *categoryHandler.CategoryHandler
 	Size                 *sizeHandler.SizeHandler
 	PaymentMethod        *paymentMethodHandler.PaymentMethodHandler
+	CashAccount          *paymentMethodHandler.CashAccountHandler
+	CashSession          *cashSessionHandler.CashSessionHandler
 	Customer             *customerHandler.CustomerHandler
 	CustomerStatement    *customerHandler.StatementHandler
+	CustomerMerge        *customerHandler.MergeHandler
 	Order                *orderHandler.OrderHandler
+	Ownership            *ownershipHandler.OwnershipHandler
 	Supplier             *supplierHandler.SupplierHandler
 	FinancialTransaction *financialTransactionHandler.FinancialTransactionHandler
+	RecurringTransaction *financialTransactionHandler.RecurringTransactionHandler
+	Budget               *financialTransactionHandler.BudgetHandler
+	Ledger               *ledgerHandler.LedgerHandler
+	ElectronicInvoice    *electronicInvoiceHandler.ElectronicInvoiceHandler
 	Swagger              *swaggerHandler.SwaggerHandler
 	UserPermission       *userPermissionHandler.UserPermissionHandler
 }
 
 // SetupRoutes configura todas las rutas de la aplicación
-func SetupRoutes(e *echo.Echo, handlers Handlers, validateTokenUC *auth.ValidateTokenUseCase) {
+func SetupRoutes(e *echo.Echo, handlers Handlers, validateTokenUC *auth.ValidateTokenUseCase, validateAPIKeyUC *auth.ValidateAPIKeyUseCase, validatePortalTokenUC *portal.ValidatePortalTokenUseCase) {
 	// Health check endpoint (para Railway, Docker, K8s, etc.)
 	e.GET("/health", func(c echo.Context) error {
 		return c.JSON(200, map[string]interface{}{
@@ -58,41 +88,65 @@ func SetupRoutes(e *echo.Echo, handlers Handlers, validateTokenUC *auth.Validate
 	authGroup := api.Group("/auth")
 	{
 		authGroup.POST("/login", handlers.Auth.Login)
-		authGroup.POST("/register", handlers.Auth.Register)
+		authGroup.POST("/register", handlers.Auth.Register) // Deshabilitado salvo AUTH_ALLOW_PUBLIC_REGISTRATION=true
+		authGroup.POST("/refresh", handlers.Auth.Refresh)
+		authGroup.POST("/invitations/preview", handlers.UserInvitation.Preview)
+		authGroup.POST("/invitations/accept", handlers.UserInvitation.Accept)
+		authGroup.POST("/password/forgot", handlers.PasswordReset.Forgot)
+		authGroup.POST("/password/reset", handlers.PasswordReset.Reset)
+
+		// Segundo paso del login (con el challengeToken que retorna /login)
+		authGroup.POST("/2fa/verify", handlers.TwoFactor.Verify)
+		authGroup.POST("/2fa/enroll/setup", handlers.TwoFactor.EnrollSetup)
+		authGroup.POST("/2fa/enroll/confirm", handlers.TwoFactor.EnrollConfirm)
 	}
 
-	// Middleware de autenticación para rutas protegidas
-	authMiddleware := middleware.AuthMiddleware(validateTokenUC)
+	// Middleware de autenticación para rutas protegidas (token de sesión o llave de API)
+	authMiddleware := middleware.AuthMiddleware(validateTokenUC, validateAPIKeyUC)
+
+	// Rutas protegidas - Sesiones del usuario autenticado (no aceptan llaves de API)
+	sessions := api.Group("/auth", authMiddleware, middleware.RequireSession())
+	{
+		sessions.POST("/logout", handlers.Auth.Logout)
+		sessions.GET("/permissions", handlers.Role.MyPermissions)
+		sessions.GET("/sessions", handlers.Auth.ListSessions)
+		sessions.DELETE("/sessions/:id", handlers.Auth.RevokeSession)
+		sessions.GET("/2fa", handlers.TwoFactor.Status)
+		sessions.POST("/2fa/setup", handlers.TwoFactor.Setup)
+		sessions.POST("/2fa/enable", handlers.TwoFactor.Enable)
+		sessions.POST("/2fa/disable", handlers.TwoFactor.Disable)
+		sessions.POST("/2fa/recovery-codes", handlers.TwoFactor.RegenerateRecoveryCodes)
+	}
 
 	// Rutas protegidas - Categorías
 	categories := api.Group("/categories", authMiddleware)
 	{
-		categories.POST("", handlers.Category.Create, middleware.RequireRole(entities.RoleSuperAdmin))
-		categories.GET("", handlers.Category.List)
-		categories.GET("/:id", handlers.Category.GetByID)
-		categories.PUT("/:id", handlers.Category.Update, middleware.RequireRole(entities.RoleSuperAdmin))
-		categories.DELETE("/:id", handlers.Category.Delete, middleware.RequireRole(entities.RoleSuperAdmin))
+		categories.POST("", handlers.Category.Create, middleware.RequirePermission(entities.PermissionCatalogWrite))
+		categories.GET("", handlers.Category.List, middleware.RequirePermission(entities.PermissionCatalogRead))
+		categories.GET("/:id", handlers.Category.GetByID, middleware.RequirePermission(entities.PermissionCatalogRead))
+		categories.PUT("/:id", handlers.Category.Update, middleware.RequirePermission(entities.PermissionCatalogWrite))
+		categories.DELETE("/:id", handlers.Category.Delete, middleware.RequirePermission(entities.PermissionCatalogWrite))
 	}
 
 	// Rutas protegidas - Productos
 	products := api.Group("/products", authMiddleware)
 	{
-		products.POST("", handlers.Product.Create, middleware.RequireRole(entities.RoleSuperAdmin))
-		products.GET("", handlers.Product.List)
-		products.GET("/:id", handlers.Product.GetByID)
-		products.GET("/low-stock", handlers.Product.GetLowStock)
-		products.PUT("/:id", handlers.Product.Update, middleware.RequireRole(entities.RoleSuperAdmin))
-		products.DELETE("/:id", handlers.Product.Delete, middleware.RequireRole(entities.RoleSuperAdmin))
+		products.POST("", handlers.Product.Create, middleware.RequirePermission(entities.PermissionCatalogWrite))
+		products.GET("", handlers.Product.List, middleware.RequirePermission(entities.PermissionCatalogRead))
+		products.GET("/:id", handlers.Product.GetByID, middleware.RequirePermission(entities.PermissionCatalogRead))
+		products.GET("/low-stock", handlers.Product.GetLowStock, middleware.RequirePermission(entities.PermissionCatalogRead))
+		products.PUT("/:id", handlers.Product.Update, middleware.RequirePermission(entities.PermissionCatalogWrite))
+		products.DELETE("/:id", handlers.Product.Delete, middleware.RequirePermission(entities.PermissionCatalogWrite))
 
 		// Rutas de fotos de productos
-		products.POST("/:id/photos", handlers.Product.UploadPhotos)
-		products.GET("/:id/photos", handlers.Product.GetPhotos)
-		products.DELETE("/:id/photos/:photoId", handlers.Product.DeletePhoto)
-		products.PUT("/:id/photos/:photoId/primary", handlers.Product.SetPrimaryPhoto)
+		products.POST("/:id/photos", handlers.Product.UploadPhotos, middleware.RequirePermission(entities.PermissionProductPhotos))
+		products.GET("/:id/photos", handlers.Product.GetPhotos, middleware.RequirePermission(entities.PermissionCatalogRead))
+		products.DELETE("/:id/photos/:photoId", handlers.Product.DeletePhoto, middleware.RequirePermission(entities.PermissionProductPhotos))
+		products.PUT("/:id/photos/:photoId/primary", handlers.Product.SetPrimaryPhoto, middleware.RequirePermission(entities.PermissionProductPhotos))
 	}
 
 	// Rutas protegidas - Tallas
-	sizes := api.Group("/sizes", authMiddleware)
+	sizes := api.Group("/sizes", authMiddleware, middleware.RequirePermission(entities.PermissionCatalogRead))
 	{
 		sizes.GET("", handlers.Size.List)
 		sizes.GET("/:id", handlers.Size.GetByID)
@@ -100,105 +154,293 @@ func SetupRoutes(e *echo.Echo, handlers Handlers, validateTokenUC *auth.Validate
 	}
 
 	// Rutas protegidas - Métodos de Pago
-	paymentMethods := api.Group("/payment-methods", authMiddleware)
+	paymentMethods := api.Group("/payment-methods", authMiddleware, middleware.RequirePermission(entities.PermissionCatalogRead, entities.PermissionPaymentsCreate))
 	{
 		paymentMethods.GET("", handlers.PaymentMethod.List)
 	}
 
+	// Rutas protegidas - Cuentas de caja y banco (cada método de pago es una cuenta) y conciliación
+	cashAccounts := api.Group("/cash-accounts", authMiddleware)
+	{
+		cashAccounts.GET("", handlers.CashAccount.ListBalances, middleware.RequirePermission(entities.PermissionFinanceRead)) // Saldo actual de cada cuenta
+		cashAccounts.POST("", handlers.CashAccount.Create, middleware.RequirePermission(entities.PermissionFinanceWrite))
+		cashAccounts.PUT("/:id", handlers.CashAccount.Update, middleware.RequirePermission(entities.PermissionFinanceWrite))
+		cashAccounts.GET("/:id/ledger", handlers.CashAccount.GetLedger, middleware.RequirePermission(entities.PermissionFinanceRead)) // Movimientos con saldo corrido
+		cashAccounts.POST("/transfers", handlers.CashAccount.CreateTransfer, middleware.RequirePermission(entities.PermissionFinanceWrite))
+		cashAccounts.GET("/transfers", handlers.CashAccount.ListTransfers, middleware.RequirePermission(entities.PermissionFinanceRead))
+		cashAccounts.POST("/:id/statements", handlers.CashAccount.ImportStatement, middleware.RequirePermission(entities.PermissionFinanceWrite)) // Importar extracto CSV y cruzar automáticamente
+		cashAccounts.GET("/:id/statements", handlers.CashAccount.ListStatements, middleware.RequirePermission(entities.PermissionFinanceRead))
+		cashAccounts.GET("/statements/:statementId", handlers.CashAccount.GetReconciliation, middleware.RequirePermission(entities.PermissionFinanceRead))
+		cashAccounts.POST("/statements/:statementId/lines/:lineId/match", handlers.CashAccount.MatchLine, middleware.RequirePermission(entities.PermissionFinanceWrite))
+		cashAccounts.DELETE("/statements/:statementId/lines/:lineId/match", handlers.CashAccount.ResetLine, middleware.RequirePermission(entities.PermissionFinanceWrite)) // Deshacer cruce o descarte
+		cashAccounts.POST("/statements/:statementId/lines/:lineId/ignore", handlers.CashAccount.IgnoreLine, middleware.RequirePermission(entities.PermissionFinanceWrite))
+	}
+
+	// Rutas protegidas - Apertura y cierre de caja (arqueo)
+	cashSessions := api.Group("/cash-sessions", authMiddleware)
+	{
+		cashSessions.POST("", handlers.CashSession.Open, middleware.RequirePermission(entities.PermissionPaymentsCreate))
+		cashSessions.GET("/current", handlers.CashSession.GetCurrent, middleware.RequirePermission(entities.PermissionPaymentsCreate))          // Caja abierta con lo cobrado hasta ahora
+		cashSessions.POST("/current/close", handlers.CashSession.CloseCurrent, middleware.RequirePermission(entities.PermissionPaymentsCreate)) // Arqueo y cierre de la caja propia
+		cashSessions.GET("", handlers.CashSession.List, middleware.RequirePermission(entities.PermissionPaymentsCreate, entities.PermissionFinanceRead))
+		cashSessions.GET("/:id", handlers.CashSession.Get, middleware.RequirePermission(entities.PermissionPaymentsCreate, entities.PermissionFinanceRead))
+		cashSessions.GET("/:id/pdf", handlers.CashSession.GeneratePDF, middleware.RequirePermission(entities.PermissionPaymentsCreate, entities.PermissionFinanceRead)) // Comprobante de cierre
+		cashSessions.POST("/:id/close", handlers.CashSession.Close, middleware.RequirePermission(entities.PermissionFinanceWrite))                                      // Cierre por un supervisor
+	}
+
+	// Rutas protegidas - Contabilidad (libro mayor por partida doble)
+	ledger := api.Group("/ledger", authMiddleware)
+	{
+		ledger.GET("/accounts", handlers.Ledger.ListAccounts, middleware.RequirePermission(entities.PermissionFinanceRead)) // Plan de cuentas
+		ledger.POST("/accounts", handlers.Ledger.CreateAccount, middleware.RequirePermission(entities.PermissionFinanceWrite))
+		ledger.PUT("/accounts/:id", handlers.Ledger.UpdateAccount, middleware.RequirePermission(entities.PermissionFinanceWrite))
+		ledger.GET("/entries", handlers.Ledger.ListEntries, middleware.RequirePermission(entities.PermissionFinanceRead)) // Libro diario
+		ledger.GET("/entries/:id", handlers.Ledger.GetEntry, middleware.RequirePermission(entities.PermissionFinanceRead))
+		ledger.POST("/entries", handlers.Ledger.CreateEntry, middleware.RequirePermission(entities.PermissionFinanceWrite))              // Asiento manual
+		ledger.POST("/entries/:id/reverse", handlers.Ledger.ReverseEntry, middleware.RequirePermission(entities.PermissionFinanceWrite)) // Solo asientos manuales
+		ledger.GET("/trial-balance", handlers.Ledger.GetTrialBalance, middleware.RequirePermission(entities.PermissionFinanceRead))
+		ledger.GET("/income-statement", handlers.Ledger.GetIncomeStatement, middleware.RequirePermission(entities.PermissionFinanceRead)) // P&G
+		ledger.GET("/balance-sheet", handlers.Ledger.GetBalanceSheet, middleware.RequirePermission(entities.PermissionFinanceRead))
+		ledger.GET("/cross-check", handlers.Ledger.GetCrossCheck, middleware.RequirePermission(entities.PermissionFinanceRead)) // Cruce con cartera y cuentas
+		ledger.POST("/sync", handlers.Ledger.Sync, middleware.RequirePermission(entities.PermissionFinanceWrite))               // Contabilizar registros pendientes
+	}
+
+	// Rutas protegidas - Facturación electrónica (DIAN UBL 2.1)
+	electronicInvoices := api.Group("/electronic-invoices", authMiddleware)
+	{
+		electronicInvoices.GET("/resolutions", handlers.ElectronicInvoice.ListResolutions, middleware.RequirePermission(entities.PermissionFinanceRead)) // Resoluciones de numeración
+		electronicInvoices.POST("/resolutions", handlers.ElectronicInvoice.CreateResolution, middleware.RequirePermission(entities.PermissionFinanceWrite))
+		electronicInvoices.PUT("/resolutions/:id", handlers.ElectronicInvoice.UpdateResolution, middleware.RequirePermission(entities.PermissionFinanceWrite))
+		electronicInvoices.POST("/orders/:orderId", handlers.ElectronicInvoice.IssueInvoice, middleware.RequirePermission(entities.PermissionFinanceWrite)) // Facturar una orden
+		electronicInvoices.GET("", handlers.ElectronicInvoice.ListDocuments, middleware.RequirePermission(entities.PermissionFinanceRead))
+		electronicInvoices.GET("/:id", handlers.ElectronicInvoice.GetDocument, middleware.RequirePermission(entities.PermissionFinanceRead))
+		electronicInvoices.GET("/:id/xml", handlers.ElectronicInvoice.DownloadXML, middleware.RequirePermission(entities.PermissionFinanceRead))                // XML firmado
+		electronicInvoices.POST("/:id/credit-notes", handlers.ElectronicInvoice.IssueCreditNote, middleware.RequirePermission(entities.PermissionFinanceWrite)) // Anular o corregir una factura
+		electronicInvoices.POST("/:id/submit", handlers.ElectronicInvoice.SubmitDocument, middleware.RequirePermission(entities.PermissionFinanceWrite))        // Reenviar pendientes
+	}
+
 	// Rutas protegidas - Clientes
 	customers := api.Group("/customers", authMiddleware)
 	{
-		customers.POST("", handlers.Customer.Create)
-		customers.GET("", handlers.Customer.List)
-		customers.POST("/transactions", handlers.Customer.AddTransaction)          // Nuevo endpoint para movimientos manuales
-		customers.GET("/upcoming-payments", handlers.Customer.GetUpcomingPayments) // Debe ir antes de /:id
-		customers.GET("/:id", handlers.Customer.GetByID)
-		customers.GET("/:id/balance", handlers.Customer.GetBalance)
-		customers.GET("/:id/history", handlers.Customer.GetHistory)
-		customers.GET("/:id/statement", handlers.CustomerStatement.DownloadStatement) // PDF estado de cuenta (days opcional)
-		customers.POST("/:id/payments", handlers.Customer.CreatePayment)
-		customers.PUT("/:id", handlers.Customer.Update)
-		customers.DELETE("/:id", handlers.Customer.Delete, middleware.RequireRole(entities.RoleSuperAdmin))
+		customers.POST("", handlers.Customer.Create, middleware.RequirePermission(entities.PermissionCustomersWrite))
+		customers.GET("", handlers.Customer.List, middleware.RequirePermission(entities.PermissionCustomersRead))
+		customers.POST("/transactions", handlers.Customer.AddTransaction, middleware.RequirePermission(entities.PermissionPaymentsCreate)) // Nuevo endpoint para movimientos manuales
+		// Reverso con asiento compensatorio
+		customers.POST("/transactions/:transactionId/reverse", handlers.Customer.ReverseTransaction, middleware.RequirePermission(entities.PermissionTransactionsReverse))
+		customers.GET("/upcoming-payments", handlers.Customer.GetUpcomingPayments, middleware.RequirePermission(entities.PermissionCustomersRead)) // Debe ir antes de /:id
+		customers.GET("/duplicates", handlers.CustomerMerge.FindDuplicates, middleware.RequirePermission(entities.PermissionCustomersMerge))       // Posibles duplicados (similarity opcional)
+		customers.GET("/:id", handlers.Customer.GetByID, middleware.RequirePermission(entities.PermissionCustomersRead))
+		customers.GET("/:id/balance", handlers.Customer.GetBalance, middleware.RequirePermission(entities.PermissionCustomersRead))
+		customers.GET("/:id/history", handlers.Customer.GetHistory, middleware.RequirePermission(entities.PermissionCustomersRead))
+		customers.GET("/:id/statement", handlers.CustomerStatement.DownloadStatement, middleware.RequirePermission(entities.PermissionCustomersRead)) // PDF estado de cuenta (days opcional)
+		customers.GET("/:id/coupons", handlers.Campaign.ListCustomerCoupons, middleware.RequirePermission(entities.PermissionCustomersRead))          // Cupones de campañas del cliente
+		customers.GET("/:id/loyalty", handlers.Loyalty.GetStatement, middleware.RequirePermission(entities.PermissionCustomersRead))                  // Saldo y movimientos de puntos
+		customers.POST("/:id/portal-link", handlers.Portal.CreateLink, middleware.RequirePermission(entities.PermissionCustomersWrite))               // Enlace de acceso al portal para compartir
+		customers.POST("/:id/merge", handlers.CustomerMerge.Merge, middleware.RequirePermission(entities.PermissionCustomersMerge))
+		customers.GET("/:id/merges", handlers.CustomerMerge.ListMerges, middleware.RequirePermission(entities.PermissionCustomersMerge))
+		customers.POST("/:id/payments", handlers.Customer.CreatePayment, middleware.RequirePermission(entities.PermissionPaymentsCreate))
+		customers.PUT("/:id", handlers.Customer.Update, middleware.RequirePermission(entities.PermissionCustomersWrite))
+		customers.DELETE("/:id", handlers.Customer.Delete, middleware.RequirePermission(entities.PermissionCustomersDelete))
+		customers.GET("/:id/shares", handlers.Ownership.ListCustomerShares, middleware.RequirePermission(entities.PermissionOwnershipManage))
+		customers.POST("/:id/shares", handlers.Ownership.ShareCustomer, middleware.RequirePermission(entities.PermissionOwnershipManage)) // Compartir cliente con otro usuario
+		customers.DELETE("/:id/shares/:userId", handlers.Ownership.UnshareCustomer, middleware.RequirePermission(entities.PermissionOwnershipManage))
+		customers.PUT("/:id/seller", handlers.Ownership.ReassignCustomer, middleware.RequirePermission(entities.PermissionOwnershipManage)) // Reasignar vendedor del cliente
+	}
+
+	// Rutas protegidas - Campañas de cumpleaños y fidelización
+	campaigns := api.Group("/campaigns", authMiddleware, middleware.RequirePermission(entities.PermissionCampaignsManage))
+	{
+		campaigns.POST("", handlers.Campaign.Create)
+		campaigns.GET("", handlers.Campaign.List)
+		campaigns.GET("/:id", handlers.Campaign.GetByID)                  // Incluye estadísticas de redención
+		campaigns.GET("/:id/audience", handlers.Campaign.PreviewAudience) // Clientes seleccionados (sin emitir)
+		campaigns.POST("/:id/coupons", handlers.Campaign.GenerateCoupons) // Emitir cupones y mensajes
+		campaigns.GET("/:id/coupons", handlers.Campaign.ListCoupons)
+	}
+
+	// Rutas protegidas - Cupones (validación antes de crear la orden)
+	coupons := api.Group("/coupons", authMiddleware, middleware.RequirePermission(entities.PermissionOrdersWrite))
+	{
+		coupons.GET("/:code", handlers.Campaign.ValidateCoupon)
+	}
+
+	// Rutas protegidas - Programa de puntos
+	loyalty := api.Group("/loyalty", authMiddleware, middleware.RequirePermission(entities.PermissionLoyaltyManage))
+	{
+		loyalty.POST("/rules", handlers.Loyalty.CreateRule)
+		loyalty.GET("/rules", handlers.Loyalty.ListRules)
+		loyalty.PUT("/rules/:id", handlers.Loyalty.UpdateRule)
+		loyalty.DELETE("/rules/:id", handlers.Loyalty.DeleteRule)
+		loyalty.POST("/expire", handlers.Loyalty.ExpirePoints) // Vencer puntos manualmente
+	}
+
+	// Rutas protegidas - Comisiones de vendedores
+	commissions := api.Group("/commissions", authMiddleware)
+	{
+		commissions.GET("/me", handlers.Commission.GetMyStatement) // Estado de cuenta del vendedor autenticado
+		commissions.GET("/rules", handlers.Commission.ListRules, middleware.RequirePermission(entities.PermissionFinanceRead))
+		commissions.POST("/rules", handlers.Commission.CreateRule, middleware.RequirePermission(entities.PermissionFinanceWrite))
+		commissions.PUT("/rules/:id", handlers.Commission.UpdateRule, middleware.RequirePermission(entities.PermissionFinanceWrite))
+		commissions.DELETE("/rules/:id", handlers.Commission.DeleteRule, middleware.RequirePermission(entities.PermissionFinanceWrite))
+		commissions.GET("/sellers/:sellerId", handlers.Commission.GetSellerStatement, middleware.RequirePermission(entities.PermissionFinanceRead))
+		commissions.POST("/sellers/:sellerId/payouts", handlers.Commission.PayCommissions, middleware.RequirePermission(entities.PermissionFinanceWrite)) // Liquidar y registrar gasto PERSONNEL
+		commissions.GET("/payouts", handlers.Commission.ListPayouts, middleware.RequirePermission(entities.PermissionFinanceRead))
+		commissions.POST("/orders/:orderId/clawback", handlers.Commission.ClawbackOrder, middleware.RequirePermission(entities.PermissionFinanceWrite)) // Reverso por devolución
+	}
+
+	// Portal de clientes - autenticación por enlace mágico (público)
+	portalAuth := api.Group("/portal/auth")
+	{
+		portalAuth.POST("/request-link", handlers.Portal.RequestLink)
+		portalAuth.POST("/verify", handlers.Portal.VerifyLink)
+	}
+
+	// Portal de clientes - solo datos del cliente del token
+	portalGroup := api.Group("/portal", middleware.CustomerAuthMiddleware(validatePortalTokenUC))
+	{
+		portalGroup.GET("/me", handlers.Portal.Me)
+		portalGroup.GET("/transactions", handlers.Portal.GetTransactions)
+		portalGroup.GET("/statement", handlers.Portal.DownloadStatement) // PDF (days opcional)
+		portalGroup.GET("/orders", handlers.Portal.ListOrders)
+		portalGroup.GET("/orders/:id", handlers.Portal.GetOrder)
 	}
 
 	// Rutas protegidas - Proveedores
 	suppliers := api.Group("/suppliers", authMiddleware)
 	{
-		suppliers.POST("", handlers.Supplier.Create, middleware.RequireRole(entities.RoleSuperAdmin))
-		suppliers.GET("", handlers.Supplier.List)
-		suppliers.GET("/:id", handlers.Supplier.GetByID)
-		suppliers.PUT("/:id", handlers.Supplier.Update, middleware.RequireRole(entities.RoleSuperAdmin))
-		suppliers.DELETE("/:id", handlers.Supplier.Delete, middleware.RequireRole(entities.RoleSuperAdmin))
+		suppliers.POST("", handlers.Supplier.Create, middleware.RequirePermission(entities.PermissionCatalogWrite))
+		suppliers.GET("", handlers.Supplier.List, middleware.RequirePermission(entities.PermissionCatalogRead))
+		suppliers.GET("/:id", handlers.Supplier.GetByID, middleware.RequirePermission(entities.PermissionCatalogRead))
+		suppliers.PUT("/:id", handlers.Supplier.Update, middleware.RequirePermission(entities.PermissionCatalogWrite))
+		suppliers.DELETE("/:id", handlers.Supplier.Delete, middleware.RequirePermission(entities.PermissionCatalogWrite))
+	}
+
+	// Rutas protegidas - Transacciones Financieras
+	financialTransactions := api.Group("/financial-transactions", authMiddleware)
+	{
+		financialTransactions.POST("", handlers.FinancialTransaction.Create, middleware.RequirePermission(entities.PermissionFinanceWrite))                        // Crear ingreso o gasto
+		financialTransactions.GET("", handlers.FinancialTransaction.List, middleware.RequirePermission(entities.PermissionFinanceRead))                            // Listar con filtros
+		financialTransactions.GET("/balance", handlers.FinancialTransaction.GetBalance, middleware.RequirePermission(entities.PermissionFinanceRead))              // Obtener balance
+		financialTransactions.GET("/cash-flow", handlers.FinancialTransaction.GetCashFlowProjection, middleware.RequirePermission(entities.PermissionFinanceRead)) // Proyección semanal de caja
+		financialTransactions.GET("/pdf", handlers.FinancialTransaction.GeneratePDF, middleware.RequirePermission(entities.PermissionFinanceRead))                 // Generar PDF con filtros
+		financialTransactions.GET("/:id", handlers.FinancialTransaction.GetByID, middleware.RequirePermission(entities.PermissionFinanceRead))                     // Obtener por ID
+		financialTransactions.PUT("/:id", handlers.FinancialTransaction.Update, middleware.RequirePermission(entities.PermissionFinanceWrite))                     // Actualizar transacción
 	}
 
-	// Rutas protegidas - Transacciones Financieras (Solo Super Admin)
-	financialTransactions := api.Group("/financial-transactions", authMiddleware, middleware.RequireRole(entities.RoleSuperAdmin))
+	// Rutas protegidas - Transacciones Recurrentes (arriendo, nómina, servicios...)
+	recurringTransactions := api.Group("/recurring-transactions", authMiddleware)
 	{
-		financialTransactions.POST("", handlers.FinancialTransaction.Create)            // Crear ingreso o gasto
-		financialTransactions.GET("", handlers.FinancialTransaction.List)               // Listar con filtros
-		financialTransactions.GET("/balance", handlers.FinancialTransaction.GetBalance) // Obtener balance
-		financialTransactions.GET("/pdf", handlers.FinancialTransaction.GeneratePDF)    // Generar PDF con filtros
-		financialTransactions.GET("/:id", handlers.FinancialTransaction.GetByID)        // Obtener por ID
-		financialTransactions.PUT("/:id", handlers.FinancialTransaction.Update)         // Actualizar transacción
+		recurringTransactions.POST("", handlers.RecurringTransaction.Create, middleware.RequirePermission(entities.PermissionFinanceWrite))
+		recurringTransactions.GET("", handlers.RecurringTransaction.List, middleware.RequirePermission(entities.PermissionFinanceRead))
+		recurringTransactions.POST("/generate", handlers.RecurringTransaction.Generate, middleware.RequirePermission(entities.PermissionFinanceWrite)) // Generar ya las ocurrencias vencidas
+		recurringTransactions.PUT("/:id", handlers.RecurringTransaction.Update, middleware.RequirePermission(entities.PermissionFinanceWrite))
+		recurringTransactions.DELETE("/:id", handlers.RecurringTransaction.Delete, middleware.RequirePermission(entities.PermissionFinanceWrite))
+	}
+
+	// Rutas protegidas - Presupuestos mensuales por categoría
+	budgets := api.Group("/budgets", authMiddleware)
+	{
+		budgets.PUT("", handlers.Budget.SetBudget, middleware.RequirePermission(entities.PermissionFinanceWrite))       // Fijar presupuesto de una categoría
+		budgets.GET("/report", handlers.Budget.GetReport, middleware.RequirePermission(entities.PermissionFinanceRead)) // Presupuesto vs real del mes
+		budgets.DELETE("/:id", handlers.Budget.DeleteBudget, middleware.RequirePermission(entities.PermissionFinanceWrite))
 	}
 
 	// Rutas protegidas - Órdenes
 	orders := api.Group("/orders", authMiddleware)
 	{
-		orders.POST("", handlers.Order.CreateOrder)
-		orders.GET("", handlers.Order.ListOrders)
-		orders.GET("/:id", handlers.Order.GetOrder)
-		orders.GET("/:id/allowed-statuses", handlers.Order.GetAllowedNextStatuses)          // Obtener estados permitidos
-		orders.GET("/:id/account-statement/draft", handlers.Order.GetAccountStatementDraft) // Obtener borrador de cuenta de cobro
-		orders.POST("/:id/account-statement", handlers.Order.ConfirmAccountStatement)       // Confirmar y generar PDF de cuenta de cobro
-		orders.POST("/:id/change-status", handlers.Order.ChangeOrderStatus)                 // Cambiar estado
-		orders.POST("/:id/items", handlers.Order.AddOrderItem)
-		orders.PUT("/:id/items/:itemId", handlers.Order.UpdateOrderItem)
-		orders.DELETE("/:id/items/:itemId", handlers.Order.RemoveOrderItem)
+		orders.POST("", handlers.Order.CreateOrder, middleware.RequirePermission(entities.PermissionOrdersWrite))
+		orders.GET("", handlers.Order.ListOrders, middleware.RequirePermission(entities.PermissionOrdersRead))
+		orders.GET("/:id", handlers.Order.GetOrder, middleware.RequirePermission(entities.PermissionOrdersRead))
+		orders.GET("/:id/allowed-statuses", handlers.Order.GetAllowedNextStatuses, middleware.RequirePermission(entities.PermissionOrdersRead))                                          // Obtener estados permitidos
+		orders.GET("/:id/account-statement/draft", handlers.Order.GetAccountStatementDraft, middleware.RequirePermission(entities.PermissionOrdersRead))                                 // Obtener borrador de cuenta de cobro
+		orders.POST("/:id/account-statement", handlers.Order.ConfirmAccountStatement, middleware.RequirePermission(entities.PermissionOrdersWrite))                                      // Confirmar y generar PDF de cuenta de cobro
+		orders.POST("/:id/change-status", handlers.Order.ChangeOrderStatus, middleware.RequirePermission(entities.PermissionOrdersChangeStatus, entities.PermissionOrdersManufacturing)) // Cambiar estado
+		orders.POST("/:id/items", handlers.Order.AddOrderItem, middleware.RequirePermission(entities.PermissionOrdersWrite))
+		orders.PUT("/:id/items/:itemId", handlers.Order.UpdateOrderItem, middleware.RequirePermission(entities.PermissionOrdersWrite))
+		orders.DELETE("/:id/items/:itemId", handlers.Order.RemoveOrderItem, middleware.RequirePermission(entities.PermissionOrdersWrite))
+		orders.GET("/:id/shares", handlers.Ownership.ListOrderShares, middleware.RequirePermission(entities.PermissionOwnershipManage))
+		orders.POST("/:id/shares", handlers.Ownership.ShareOrder, middleware.RequirePermission(entities.PermissionOwnershipManage)) // Compartir orden con otro usuario
+		orders.DELETE("/:id/shares/:userId", handlers.Ownership.UnshareOrder, middleware.RequirePermission(entities.PermissionOwnershipManage))
+		orders.PUT("/:id/seller", handlers.Ownership.ReassignOrder, middleware.RequirePermission(entities.PermissionOwnershipManage)) // Reasignar vendedor de la orden
 	}
 
-	// Rutas protegidas - Usuarios (Solo Super Admin)
-	users := api.Group("/users", authMiddleware, middleware.RequireRole(entities.RoleSuperAdmin))
+	// Rutas protegidas - Usuarios
+	users := api.Group("/users", authMiddleware, middleware.RequireSession(), middleware.RequirePermission(entities.PermissionUsersManage))
 	{
 		users.POST("", handlers.User.Create)
 		users.GET("", handlers.User.List)
+		users.POST("/invitations", handlers.UserInvitation.Create) // Debe ir antes de /:id
+		users.GET("/invitations", handlers.UserInvitation.List)
+		users.DELETE("/invitations/:id", handlers.UserInvitation.Revoke)
 		users.GET("/:id", handlers.User.GetByID)
 		users.PUT("/:id", handlers.User.Update)
 		users.DELETE("/:id", handlers.User.Delete)
 		users.PUT("/:id/password", handlers.User.ChangePassword)
+		users.DELETE("/:id/2fa", handlers.TwoFactor.AdminReset)
+	}
+
+	// Rutas protegidas - Roles y permisos
+	roles := api.Group("/roles", authMiddleware, middleware.RequireSession(), middleware.RequirePermission(entities.PermissionRolesManage))
+	{
+		roles.GET("", handlers.Role.List)
+		roles.GET("/permissions", handlers.Role.Permissions)
+		roles.POST("", handlers.Role.Create)
+		roles.PUT("/:name", handlers.Role.Update)
+		roles.DELETE("/:name", handlers.Role.Delete)
 	}
 
-	// Rutas de Analytics (protegidas - solo admin)
+	// Rutas protegidas - Llaves de API para integraciones
+	apiKeys := api.Group("/api-keys", authMiddleware, middleware.RequireSession(), middleware.RequirePermission(entities.PermissionAPIKeysManage))
+	{
+		apiKeys.GET("", handlers.APIKey.List)
+		apiKeys.POST("", handlers.APIKey.Create)
+		apiKeys.POST("/:id/rotate", handlers.APIKey.Rotate)
+		apiKeys.DELETE("/:id", handlers.APIKey.Revoke)
+	}
+
+	// Rutas de Analytics (protegidas)
 	analytics := api.Group("/analytics")
 	analytics.Use(authMiddleware)
-	analytics.Use(middleware.RequireRole(entities.RoleSuperAdmin))
+	analytics.Use(middleware.RequirePermission(entities.PermissionAnalyticsRead))
 	{
 		analytics.GET("/metrics", handlers.Analytics.GetMetrics)
 		analytics.GET("/dashboard", handlers.Analytics.GetDashboardSummary)
 	}
 
-	// Rutas de Auditoría (protegidas - solo admin)
+	// Rutas de Reportes de ventas (protegidas)
+	reports := api.Group("/reports")
+	reports.Use(authMiddleware)
+	reports.Use(middleware.RequirePermission(entities.PermissionAnalyticsRead))
+	{
+		reports.GET("/sales", handlers.SalesReport.GetSalesReport)               // ?dimension=product|variant|size|color|category|seller
+		reports.GET("/sales/export", handlers.SalesReport.ExportSalesReport)     // ?format=csv|xlsx|pdf
+		reports.GET("/margins", handlers.MarginReport.GetMarginReport)           // ?groupBy=order|product|period&interval=day|week|month
+		reports.GET("/margins/export", handlers.MarginReport.ExportMarginReport) // ?format=csv|xlsx|pdf
+	}
+
+	// Rutas de Auditoría (protegidas)
 	audit := api.Group("/audit")
 	audit.Use(authMiddleware)
-	audit.Use(middleware.RequireRole(entities.RoleSuperAdmin))
+	audit.Use(middleware.RequirePermission(entities.PermissionAuditRead))
 	{
 		audit.GET("/logs", handlers.Audit.GetAuditLogs)
 		audit.GET("/logs/:orderId", handlers.Audit.GetAuditLogsByOrder) // Busca por Order ID
 		audit.GET("/users/:userId/logs", handlers.Audit.GetAuditLogsByUser)
+		audit.GET("/entities/:type/:id", handlers.Audit.GetAuditLogsByEntity) // Historial de cambios (ej: /entities/product/12)
 		audit.GET("/stats", handlers.Audit.GetAuditStats)
 	}
 
 	// Rutas protegidas - User Permissions (solo autenticados)
 	permissions := api.Group("/permissions")
-	permissions.Use(middleware.AuthMiddleware(validateTokenUC))
+	permissions.Use(authMiddleware)
 	{
 		// Cualquier usuario puede consultar sus propios permisos
 		permissions.GET("/users/:id/allowed-categories", handlers.UserPermission.GetAllowedCategories)
 		permissions.GET("/users/:id/check/:categoryId", handlers.UserPermission.CheckPermission)
 
-		// Solo admin puede gestionar permisos
+		// Gestión de permisos por categoría
 		adminPermissions := permissions.Group("")
-		adminPermissions.Use(middleware.RequireRole(entities.RoleSuperAdmin))
+		adminPermissions.Use(middleware.RequirePermission(entities.PermissionCategoryAccessManage))
 		{
 			adminPermissions.GET("/users/:id", handlers.UserPermission.GetUserPermissions)
 			adminPermissions.POST("/users/:id", handlers.UserPermission.SetUserPermissions)
@@ -0,0 +1,22 @@
+package notification
+
+import (
+	"context"
+	"log"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// logNotifier escribe las notificaciones en el log en lugar de enviarlas
+// Útil en desarrollo o mientras no haya un servidor de correo configurado
+type logNotifier struct{}
+
+// NewLogNotifier crea un Notifier que solo registra las notificaciones
+func NewLogNotifier() ports.Notifier {
+	return &logNotifier{}
+}
+
+func (n *logNotifier) Notify(ctx context.Context, notification ports.Notification) error {
+	log.Printf("📨 [NOTIFICATION] To: %s | %s | %s", notification.To, notification.Subject, notification.Body)
+	return nil
+}
@@ -0,0 +1,22 @@
+package notification
+
+import (
+	"context"
+	"log"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// logMessageSender escribe los mensajes en el log en lugar de enviarlos
+// Útil en desarrollo o mientras no haya un proveedor de mensajería configurado
+type logMessageSender struct{}
+
+// NewLogMessageSender crea un MessageSender que solo registra los mensajes
+func NewLogMessageSender() ports.MessageSender {
+	return &logMessageSender{}
+}
+
+func (s *logMessageSender) Send(ctx context.Context, to, message string) error {
+	log.Printf("📨 [MESSAGE] To: %s | %s", to, message)
+	return nil
+}
@@ -0,0 +1,26 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// messageSenderNotifier entrega las notificaciones por un MessageSender (WhatsApp, SMS...)
+// El asunto va como primera línea del mensaje, ya que estos canales no lo soportan
+type messageSenderNotifier struct {
+	sender ports.MessageSender
+}
+
+// NewMessageSenderNotifier adapta un MessageSender al puerto Notifier
+func NewMessageSenderNotifier(sender ports.MessageSender) ports.Notifier {
+	return &messageSenderNotifier{sender: sender}
+}
+
+func (n *messageSenderNotifier) Notify(ctx context.Context, notification ports.Notification) error {
+	message := notification.Body
+	if notification.Subject != "" {
+		message = notification.Subject + "\n" + message
+	}
+	return n.sender.Send(ctx, notification.To, message)
+}
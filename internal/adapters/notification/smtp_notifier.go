@@ -0,0 +1,57 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// SMTPConfig contiene los datos de conexión al servidor de correo
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+// smtpNotifier envía las notificaciones por correo electrónico
+type smtpNotifier struct {
+	config SMTPConfig
+}
+
+// NewSMTPNotifier crea un Notifier que envía correos por SMTP
+func NewSMTPNotifier(config SMTPConfig) ports.Notifier {
+	return &smtpNotifier{config: config}
+}
+
+func (n *smtpNotifier) Notify(ctx context.Context, notification ports.Notification) error {
+	if strings.ContainsAny(notification.To, "\r\n") {
+		return fmt.Errorf("invalid recipient: %q", notification.To)
+	}
+
+	var auth smtp.Auth
+	if n.config.Username != "" {
+		auth = smtp.PlainAuth("", n.config.Username, n.config.Password, n.config.Host)
+	}
+
+	var message strings.Builder
+	message.WriteString("From: " + n.config.From + "\r\n")
+	message.WriteString("To: " + notification.To + "\r\n")
+	message.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", notification.Subject) + "\r\n")
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	message.WriteString("\r\n")
+	message.WriteString(strings.ReplaceAll(notification.Body, "\n", "\r\n"))
+
+	addr := net.JoinHostPort(n.config.Host, n.config.Port)
+	if err := smtp.SendMail(addr, auth, n.config.From, []string{notification.To}, []byte(message.String())); err != nil {
+		return fmt.Errorf("error sending email to %s: %w", notification.To, err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// APIKeyModel representa el modelo de persistencia para llaves de API
+type APIKeyModel struct {
+	ID            uint   `gorm:"primaryKey"`
+	Name          string `gorm:"type:varchar(100);not null"`
+	Prefix        string `gorm:"type:varchar(20);uniqueIndex;not null"`
+	KeyHash       string `gorm:"type:varchar(64);not null"`
+	Permissions   string `gorm:"type:jsonb;not null;default:'[]'"` // Lista de permisos (ej: ["catalog:read"])
+	UserID        uint   `gorm:"not null;index"`
+	CreatedBy     uint   `gorm:"not null"`
+	ExpiresAt     *time.Time
+	LastUsedAt    *time.Time
+	LastUsedIP    string     `gorm:"type:varchar(45)"`
+	RevokedAt     *time.Time `gorm:"index"`
+	RotatedFromID *uint
+	CreatedAt     time.Time
+
+	// Relaciones
+	User *UserModel `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (APIKeyModel) TableName() string {
+	return "api_keys"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *APIKeyModel) ToEntity() *entities.APIKey {
+	key := &entities.APIKey{
+		ID:            m.ID,
+		Name:          m.Name,
+		Prefix:        m.Prefix,
+		KeyHash:       m.KeyHash,
+		UserID:        m.UserID,
+		CreatedBy:     m.CreatedBy,
+		ExpiresAt:     m.ExpiresAt,
+		LastUsedAt:    m.LastUsedAt,
+		LastUsedIP:    m.LastUsedIP,
+		RevokedAt:     m.RevokedAt,
+		RotatedFromID: m.RotatedFromID,
+		CreatedAt:     m.CreatedAt,
+	}
+
+	var stored []string
+	if err := json.Unmarshal([]byte(m.Permissions), &stored); err == nil {
+		for _, p := range stored {
+			key.Permissions = append(key.Permissions, entities.Permission(p))
+		}
+	}
+
+	return key
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *APIKeyModel) FromEntity(key *entities.APIKey) {
+	m.ID = key.ID
+	m.Name = key.Name
+	m.Prefix = key.Prefix
+	m.KeyHash = key.KeyHash
+	m.UserID = key.UserID
+	m.CreatedBy = key.CreatedBy
+	m.ExpiresAt = key.ExpiresAt
+	m.LastUsedAt = key.LastUsedAt
+	m.LastUsedIP = key.LastUsedIP
+	m.RevokedAt = key.RevokedAt
+	m.RotatedFromID = key.RotatedFromID
+	m.CreatedAt = key.CreatedAt
+
+	stored := make([]string, len(key.Permissions))
+	for i, p := range key.Permissions {
+		stored[i] = string(p)
+	}
+	permissions, _ := json.Marshal(stored)
+	m.Permissions = string(permissions)
+}
@@ -0,0 +1,159 @@
+package models
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// CampaignModel representa el modelo de persistencia para campañas
+type CampaignModel struct {
+	ID                 uint   `gorm:"primaryKey"`
+	Name               string `gorm:"not null"`
+	Type               string `gorm:"type:varchar(30);not null;index"` // BIRTHDAY, SIZE_PROFILE, PURCHASE_HISTORY
+	BirthdayMonth      *int
+	ShirtSizeID        *uint
+	PantsSizeID        *uint
+	ShoesSizeID        *uint
+	MinOrders          *int
+	MinSpent           *float64
+	PurchasedSinceDays *int
+	InactiveSinceDays  *int
+	DiscountType       string    `gorm:"type:varchar(20);not null"` // PERCENTAGE, FIXED
+	DiscountValue      float64   `gorm:"not null"`
+	MessageTemplate    string    `gorm:"type:text"`
+	ValidFrom          time.Time `gorm:"not null"`
+	ValidUntil         time.Time `gorm:"not null"`
+	IsActive           bool      `gorm:"default:true"`
+	CreatedBy          uint      `gorm:"index"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (CampaignModel) TableName() string {
+	return "campaigns"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *CampaignModel) ToEntity() *entities.Campaign {
+	return &entities.Campaign{
+		ID:   m.ID,
+		Name: m.Name,
+		Type: entities.CampaignType(m.Type),
+		Criteria: entities.CampaignCriteria{
+			BirthdayMonth:      m.BirthdayMonth,
+			ShirtSizeID:        m.ShirtSizeID,
+			PantsSizeID:        m.PantsSizeID,
+			ShoesSizeID:        m.ShoesSizeID,
+			MinOrders:          m.MinOrders,
+			MinSpent:           m.MinSpent,
+			PurchasedSinceDays: m.PurchasedSinceDays,
+			InactiveSinceDays:  m.InactiveSinceDays,
+		},
+		DiscountType:    entities.DiscountType(m.DiscountType),
+		DiscountValue:   m.DiscountValue,
+		MessageTemplate: m.MessageTemplate,
+		ValidFrom:       m.ValidFrom,
+		ValidUntil:      m.ValidUntil,
+		IsActive:        m.IsActive,
+		CreatedBy:       m.CreatedBy,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *CampaignModel) FromEntity(campaign *entities.Campaign) {
+	m.ID = campaign.ID
+	m.Name = campaign.Name
+	m.Type = string(campaign.Type)
+	m.BirthdayMonth = campaign.Criteria.BirthdayMonth
+	m.ShirtSizeID = campaign.Criteria.ShirtSizeID
+	m.PantsSizeID = campaign.Criteria.PantsSizeID
+	m.ShoesSizeID = campaign.Criteria.ShoesSizeID
+	m.MinOrders = campaign.Criteria.MinOrders
+	m.MinSpent = campaign.Criteria.MinSpent
+	m.PurchasedSinceDays = campaign.Criteria.PurchasedSinceDays
+	m.InactiveSinceDays = campaign.Criteria.InactiveSinceDays
+	m.DiscountType = string(campaign.DiscountType)
+	m.DiscountValue = campaign.DiscountValue
+	m.MessageTemplate = campaign.MessageTemplate
+	m.ValidFrom = campaign.ValidFrom
+	m.ValidUntil = campaign.ValidUntil
+	m.IsActive = campaign.IsActive
+	m.CreatedBy = campaign.CreatedBy
+	m.CreatedAt = campaign.CreatedAt
+	m.UpdatedAt = campaign.UpdatedAt
+}
+
+// CampaignCouponModel representa el modelo de persistencia para cupones de campaña
+type CampaignCouponModel struct {
+	ID              uint      `gorm:"primaryKey"`
+	CampaignID      uint      `gorm:"not null;index;uniqueIndex:idx_campaign_customer"`
+	CustomerID      uint      `gorm:"not null;index;uniqueIndex:idx_campaign_customer"`
+	Code            string    `gorm:"type:varchar(30);uniqueIndex;not null"`
+	Message         string    `gorm:"type:text"`
+	DiscountType    string    `gorm:"type:varchar(20);not null"`
+	DiscountValue   float64   `gorm:"not null"`
+	ExpiresAt       time.Time `gorm:"not null"`
+	RedeemedAt      *time.Time
+	RedeemedOrderID *uint   `gorm:"index"`
+	DiscountApplied float64 `gorm:"not null;default:0"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+
+	// Relaciones
+	Campaign *CampaignModel `gorm:"foreignKey:CampaignID;constraint:OnDelete:CASCADE"`
+	Customer *CustomerModel `gorm:"foreignKey:CustomerID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (CampaignCouponModel) TableName() string {
+	return "campaign_coupons"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *CampaignCouponModel) ToEntity() *entities.CampaignCoupon {
+	coupon := &entities.CampaignCoupon{
+		ID:              m.ID,
+		CampaignID:      m.CampaignID,
+		CustomerID:      m.CustomerID,
+		Code:            m.Code,
+		Message:         m.Message,
+		DiscountType:    entities.DiscountType(m.DiscountType),
+		DiscountValue:   m.DiscountValue,
+		ExpiresAt:       m.ExpiresAt,
+		RedeemedAt:      m.RedeemedAt,
+		RedeemedOrderID: m.RedeemedOrderID,
+		DiscountApplied: m.DiscountApplied,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+
+	if m.Campaign != nil {
+		coupon.Campaign = m.Campaign.ToEntity()
+	}
+	if m.Customer != nil {
+		coupon.Customer = m.Customer.ToEntity()
+	}
+
+	return coupon
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *CampaignCouponModel) FromEntity(coupon *entities.CampaignCoupon) {
+	m.ID = coupon.ID
+	m.CampaignID = coupon.CampaignID
+	m.CustomerID = coupon.CustomerID
+	m.Code = coupon.Code
+	m.Message = coupon.Message
+	m.DiscountType = string(coupon.DiscountType)
+	m.DiscountValue = coupon.DiscountValue
+	m.ExpiresAt = coupon.ExpiresAt
+	m.RedeemedAt = coupon.RedeemedAt
+	m.RedeemedOrderID = coupon.RedeemedOrderID
+	m.DiscountApplied = coupon.DiscountApplied
+	m.CreatedAt = coupon.CreatedAt
+	m.UpdatedAt = coupon.UpdatedAt
+}
@@ -0,0 +1,164 @@
+package models
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// AccountTransferModel representa el modelo de persistencia para traslados entre cuentas
+type AccountTransferModel struct {
+	ID                  uint      `gorm:"primaryKey"`
+	FromPaymentMethodID uint      `gorm:"not null;index"`
+	ToPaymentMethodID   uint      `gorm:"not null;index"`
+	Amount              float64   `gorm:"not null"`
+	Description         string    `gorm:"type:text;not null"`
+	Date                time.Time `gorm:"not null;index"`
+	CreatedByID         *uint
+	CreatedAt           time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (AccountTransferModel) TableName() string {
+	return "account_transfers"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *AccountTransferModel) ToEntity() *entities.AccountTransfer {
+	return &entities.AccountTransfer{
+		ID:                  m.ID,
+		FromPaymentMethodID: m.FromPaymentMethodID,
+		ToPaymentMethodID:   m.ToPaymentMethodID,
+		Amount:              m.Amount,
+		Description:         m.Description,
+		Date:                m.Date,
+		CreatedByID:         m.CreatedByID,
+		CreatedAt:           m.CreatedAt,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *AccountTransferModel) FromEntity(transfer *entities.AccountTransfer) {
+	m.ID = transfer.ID
+	m.FromPaymentMethodID = transfer.FromPaymentMethodID
+	m.ToPaymentMethodID = transfer.ToPaymentMethodID
+	m.Amount = transfer.Amount
+	m.Description = transfer.Description
+	m.Date = transfer.Date
+	m.CreatedByID = transfer.CreatedByID
+	m.CreatedAt = transfer.CreatedAt
+}
+
+// BankStatementModel representa el modelo de persistencia para extractos importados
+type BankStatementModel struct {
+	ID              uint      `gorm:"primaryKey"`
+	PaymentMethodID uint      `gorm:"not null;index"`
+	FileName        string    `gorm:"type:varchar(255)"`
+	StartDate       time.Time `gorm:"not null"`
+	EndDate         time.Time `gorm:"not null"`
+	ImportedByID    *uint
+	Lines           []BankStatementLineModel `gorm:"foreignKey:StatementID"`
+	CreatedAt       time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (BankStatementModel) TableName() string {
+	return "bank_statements"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *BankStatementModel) ToEntity() *entities.BankStatement {
+	statement := &entities.BankStatement{
+		ID:              m.ID,
+		PaymentMethodID: m.PaymentMethodID,
+		FileName:        m.FileName,
+		StartDate:       m.StartDate,
+		EndDate:         m.EndDate,
+		ImportedByID:    m.ImportedByID,
+		Lines:           make([]entities.BankStatementLine, len(m.Lines)),
+		CreatedAt:       m.CreatedAt,
+	}
+	for i := range m.Lines {
+		statement.Lines[i] = *m.Lines[i].ToEntity()
+	}
+	return statement
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *BankStatementModel) FromEntity(statement *entities.BankStatement) {
+	m.ID = statement.ID
+	m.PaymentMethodID = statement.PaymentMethodID
+	m.FileName = statement.FileName
+	m.StartDate = statement.StartDate
+	m.EndDate = statement.EndDate
+	m.ImportedByID = statement.ImportedByID
+	m.CreatedAt = statement.CreatedAt
+	m.Lines = make([]BankStatementLineModel, len(statement.Lines))
+	for i := range statement.Lines {
+		m.Lines[i].FromEntity(&statement.Lines[i])
+	}
+}
+
+// BankStatementLineModel representa el modelo de persistencia para las líneas de un extracto
+// El índice único impide cruzar el mismo movimiento de una cuenta con dos líneas
+type BankStatementLineModel struct {
+	ID              uint      `gorm:"primaryKey"`
+	StatementID     uint      `gorm:"not null;index"`
+	PaymentMethodID uint      `gorm:"not null;uniqueIndex:idx_statement_lines_match"`
+	LineNumber      int       `gorm:"not null"`
+	Date            time.Time `gorm:"not null"`
+	Description     string    `gorm:"type:text"`
+	Reference       string    `gorm:"type:varchar(100)"`
+	Amount          float64   `gorm:"not null"`
+	Status          string    `gorm:"type:varchar(20);not null;index"` // UNMATCHED, MATCHED, IGNORED
+	MatchedSource   *string   `gorm:"type:varchar(30);uniqueIndex:idx_statement_lines_match"`
+	MatchedID       *uint     `gorm:"uniqueIndex:idx_statement_lines_match"`
+	MatchedAt       *time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (BankStatementLineModel) TableName() string {
+	return "bank_statement_lines"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *BankStatementLineModel) ToEntity() *entities.BankStatementLine {
+	line := &entities.BankStatementLine{
+		ID:              m.ID,
+		StatementID:     m.StatementID,
+		PaymentMethodID: m.PaymentMethodID,
+		LineNumber:      m.LineNumber,
+		Date:            m.Date,
+		Description:     m.Description,
+		Reference:       m.Reference,
+		Amount:          m.Amount,
+		Status:          entities.StatementLineStatus(m.Status),
+		MatchedID:       m.MatchedID,
+		MatchedAt:       m.MatchedAt,
+	}
+	if m.MatchedSource != nil {
+		source := entities.AccountMovementSource(*m.MatchedSource)
+		line.MatchedSource = &source
+	}
+	return line
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *BankStatementLineModel) FromEntity(line *entities.BankStatementLine) {
+	m.ID = line.ID
+	m.StatementID = line.StatementID
+	m.PaymentMethodID = line.PaymentMethodID
+	m.LineNumber = line.LineNumber
+	m.Date = line.Date
+	m.Description = line.Description
+	m.Reference = line.Reference
+	m.Amount = line.Amount
+	m.Status = string(line.Status)
+	m.MatchedSource = nil
+	if line.MatchedSource != nil {
+		source := string(*line.MatchedSource)
+		m.MatchedSource = &source
+	}
+	m.MatchedID = line.MatchedID
+	m.MatchedAt = line.MatchedAt
+}
@@ -0,0 +1,145 @@
+package models
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CashSessionModel representa el modelo de persistencia para sesiones de caja
+// El índice parcial impide que un usuario tenga dos cajas abiertas
+type CashSessionModel struct {
+	ID           uint       `gorm:"primaryKey"`
+	UserID       uint       `gorm:"not null;index;uniqueIndex:idx_cash_sessions_open_user,where:status = 'OPEN'"`
+	User         *UserModel `gorm:"foreignKey:UserID"`
+	Location     string     `gorm:"type:varchar(100)"`
+	Status       string     `gorm:"type:varchar(10);not null;index"` // OPEN o CLOSED
+	OpeningFloat float64    `gorm:"not null;default:0"`
+	OpenedAt     time.Time  `gorm:"not null;index"`
+	ClosedAt     *time.Time
+	ClosedByID   *uint
+	ExpectedCash float64                 `gorm:"not null;default:0"`
+	CountedCash  float64                 `gorm:"not null;default:0"`
+	Difference   float64                 `gorm:"not null;default:0"` // Contado menos esperado
+	Notes        string                  `gorm:"type:text"`
+	Totals       []CashSessionTotalModel `gorm:"foreignKey:SessionID"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (CashSessionModel) TableName() string {
+	return "cash_sessions"
+}
+
+// lockOpenCashSession bloquea la sesión (FOR SHARE) en la transacción del registro que se le asocia
+// y verifica que siga abierta. El cierre la bloquea FOR UPDATE, así nada entra después del arqueo
+func lockOpenCashSession(tx *gorm.DB, sessionID *uint) error {
+	if sessionID == nil {
+		return nil
+	}
+
+	var session CashSessionModel
+	err := tx.Session(&gorm.Session{NewDB: true}).
+		Clauses(clause.Locking{Strength: "SHARE"}).
+		Select("id", "status").
+		First(&session, *sessionID).Error
+	if err != nil {
+		return err
+	}
+	if session.Status != string(entities.CashSessionOpen) {
+		return entities.ErrCashSessionNotOpen
+	}
+	return nil
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *CashSessionModel) ToEntity() *entities.CashSession {
+	session := &entities.CashSession{
+		ID:           m.ID,
+		UserID:       m.UserID,
+		Location:     m.Location,
+		Status:       entities.CashSessionStatus(m.Status),
+		OpeningFloat: m.OpeningFloat,
+		OpenedAt:     m.OpenedAt,
+		ClosedAt:     m.ClosedAt,
+		ClosedByID:   m.ClosedByID,
+		ExpectedCash: m.ExpectedCash,
+		CountedCash:  m.CountedCash,
+		Difference:   m.Difference,
+		Notes:        m.Notes,
+		Totals:       make([]entities.CashSessionTotal, len(m.Totals)),
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+	if m.User != nil {
+		session.User = m.User.ToEntity()
+	}
+	for i := range m.Totals {
+		session.Totals[i] = m.Totals[i].ToEntity()
+	}
+	return session
+}
+
+// FromEntity convierte una entidad de dominio a modelo (sin los totales, que se guardan al cerrar)
+func (m *CashSessionModel) FromEntity(session *entities.CashSession) {
+	m.ID = session.ID
+	m.UserID = session.UserID
+	m.Location = session.Location
+	m.Status = string(session.Status)
+	m.OpeningFloat = session.OpeningFloat
+	m.OpenedAt = session.OpenedAt
+	m.ClosedAt = session.ClosedAt
+	m.ClosedByID = session.ClosedByID
+	m.ExpectedCash = session.ExpectedCash
+	m.CountedCash = session.CountedCash
+	m.Difference = session.Difference
+	m.Notes = session.Notes
+	m.CreatedAt = session.CreatedAt
+	m.UpdatedAt = session.UpdatedAt
+}
+
+// CashSessionTotalModel representa lo cobrado con un método de pago en una sesión cerrada
+type CashSessionTotalModel struct {
+	ID                uint    `gorm:"primaryKey"`
+	SessionID         uint    `gorm:"not null;index"`
+	PaymentMethodID   *uint   // nil: ventas sin método de pago
+	PaymentMethodName string  `gorm:"type:varchar(100)"`
+	IsCash            bool    `gorm:"not null;default:false"`
+	PaymentsCount     int     `gorm:"not null;default:0"`
+	PaymentsAmount    float64 `gorm:"not null;default:0"`
+	SalesCount        int     `gorm:"not null;default:0"`
+	SalesAmount       float64 `gorm:"not null;default:0"`
+}
+
+// TableName especifica el nombre de la tabla
+func (CashSessionTotalModel) TableName() string {
+	return "cash_session_totals"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *CashSessionTotalModel) ToEntity() entities.CashSessionTotal {
+	return entities.CashSessionTotal{
+		PaymentMethodID:   m.PaymentMethodID,
+		PaymentMethodName: m.PaymentMethodName,
+		IsCash:            m.IsCash,
+		PaymentsCount:     m.PaymentsCount,
+		PaymentsAmount:    m.PaymentsAmount,
+		SalesCount:        m.SalesCount,
+		SalesAmount:       m.SalesAmount,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *CashSessionTotalModel) FromEntity(sessionID uint, total *entities.CashSessionTotal) {
+	m.SessionID = sessionID
+	m.PaymentMethodID = total.PaymentMethodID
+	m.PaymentMethodName = total.PaymentMethodName
+	m.IsCash = total.IsCash
+	m.PaymentsCount = total.PaymentsCount
+	m.PaymentsAmount = total.PaymentsAmount
+	m.SalesCount = total.SalesCount
+	m.SalesAmount = total.SalesAmount
+}
@@ -0,0 +1,169 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// CommissionRuleModel representa el modelo de persistencia para reglas de comisión
+type CommissionRuleModel struct {
+	ID         uint    `gorm:"primaryKey"`
+	Name       string  `gorm:"not null"`
+	SellerID   *uint   `gorm:"index"`
+	CategoryID *uint   `gorm:"index"`
+	Rate       float64 `gorm:"not null;default:0"`
+	Tiers      string  `gorm:"type:jsonb;not null;default:'[]'"` // Escalones [{"minSales":..,"rate":..}]
+	IsActive   bool    `gorm:"default:true"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (CommissionRuleModel) TableName() string {
+	return "commission_rules"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *CommissionRuleModel) ToEntity() *entities.CommissionRule {
+	rule := &entities.CommissionRule{
+		ID:         m.ID,
+		Name:       m.Name,
+		SellerID:   m.SellerID,
+		CategoryID: m.CategoryID,
+		Rate:       m.Rate,
+		IsActive:   m.IsActive,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+	_ = json.Unmarshal([]byte(m.Tiers), &rule.Tiers)
+	return rule
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *CommissionRuleModel) FromEntity(rule *entities.CommissionRule) {
+	m.ID = rule.ID
+	m.Name = rule.Name
+	m.SellerID = rule.SellerID
+	m.CategoryID = rule.CategoryID
+	m.Rate = rule.Rate
+	m.IsActive = rule.IsActive
+	m.CreatedAt = rule.CreatedAt
+	m.UpdatedAt = rule.UpdatedAt
+
+	tiers := rule.Tiers
+	if tiers == nil {
+		tiers = []entities.CommissionTier{}
+	}
+	stored, _ := json.Marshal(tiers)
+	m.Tiers = string(stored)
+}
+
+// CommissionEntryModel representa el modelo de persistencia del libro de comisiones
+type CommissionEntryModel struct {
+	ID           uint      `gorm:"primaryKey"`
+	SellerID     uint      `gorm:"not null;index"`
+	OrderID      uint      `gorm:"not null;uniqueIndex:idx_commission_entries_order_type"` // Una causación y un reverso por orden
+	OrderNumber  string    `gorm:"type:varchar(50)"`
+	Type         string    `gorm:"type:varchar(20);not null;uniqueIndex:idx_commission_entries_order_type"` // ACCRUAL, CLAWBACK
+	BaseAmount   float64   `gorm:"not null"`
+	Amount       float64   `gorm:"not null"`
+	Description  string    `gorm:"type:text"`
+	ReversalOfID *uint     `gorm:"index"`
+	PayoutID     *uint     `gorm:"index"`
+	CreatedAt    time.Time `gorm:"index"`
+
+	// Relaciones
+	Seller *UserModel `gorm:"foreignKey:SellerID"`
+}
+
+// TableName especifica el nombre de la tabla
+func (CommissionEntryModel) TableName() string {
+	return "commission_entries"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *CommissionEntryModel) ToEntity() *entities.CommissionEntry {
+	return &entities.CommissionEntry{
+		ID:           m.ID,
+		SellerID:     m.SellerID,
+		OrderID:      m.OrderID,
+		OrderNumber:  m.OrderNumber,
+		Type:         entities.CommissionEntryType(m.Type),
+		BaseAmount:   m.BaseAmount,
+		Amount:       m.Amount,
+		Description:  m.Description,
+		ReversalOfID: m.ReversalOfID,
+		PayoutID:     m.PayoutID,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *CommissionEntryModel) FromEntity(entry *entities.CommissionEntry) {
+	m.ID = entry.ID
+	m.SellerID = entry.SellerID
+	m.OrderID = entry.OrderID
+	m.OrderNumber = entry.OrderNumber
+	m.Type = string(entry.Type)
+	m.BaseAmount = entry.BaseAmount
+	m.Amount = entry.Amount
+	m.Description = entry.Description
+	m.ReversalOfID = entry.ReversalOfID
+	m.PayoutID = entry.PayoutID
+	m.CreatedAt = entry.CreatedAt
+}
+
+// CommissionPayoutModel representa el modelo de persistencia de las liquidaciones de comisiones
+type CommissionPayoutModel struct {
+	ID                     uint      `gorm:"primaryKey"`
+	SellerID               uint      `gorm:"not null;index"`
+	PeriodStart            time.Time `gorm:"not null"`
+	PeriodEnd              time.Time `gorm:"not null"`
+	Amount                 float64   `gorm:"not null"`
+	EntryCount             int       `gorm:"not null"`
+	FinancialTransactionID uint      `gorm:"not null;index"`
+	PaidByID               *uint
+	Notes                  string    `gorm:"type:text"`
+	CreatedAt              time.Time `gorm:"index"`
+
+	// Relaciones
+	Seller               *UserModel                 `gorm:"foreignKey:SellerID"`
+	FinancialTransaction *FinancialTransactionModel `gorm:"foreignKey:FinancialTransactionID"`
+}
+
+// TableName especifica el nombre de la tabla
+func (CommissionPayoutModel) TableName() string {
+	return "commission_payouts"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *CommissionPayoutModel) ToEntity() *entities.CommissionPayout {
+	return &entities.CommissionPayout{
+		ID:                     m.ID,
+		SellerID:               m.SellerID,
+		PeriodStart:            m.PeriodStart,
+		PeriodEnd:              m.PeriodEnd,
+		Amount:                 m.Amount,
+		EntryCount:             m.EntryCount,
+		FinancialTransactionID: m.FinancialTransactionID,
+		PaidByID:               m.PaidByID,
+		Notes:                  m.Notes,
+		CreatedAt:              m.CreatedAt,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *CommissionPayoutModel) FromEntity(payout *entities.CommissionPayout) {
+	m.ID = payout.ID
+	m.SellerID = payout.SellerID
+	m.PeriodStart = payout.PeriodStart
+	m.PeriodEnd = payout.PeriodEnd
+	m.Amount = payout.Amount
+	m.EntryCount = payout.EntryCount
+	m.FinancialTransactionID = payout.FinancialTransactionID
+	m.PaidByID = payout.PaidByID
+	m.Notes = payout.Notes
+	m.CreatedAt = payout.CreatedAt
+}
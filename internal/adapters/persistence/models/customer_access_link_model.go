@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// CustomerAccessLinkModel representa el modelo de persistencia para enlaces de acceso al portal
+type CustomerAccessLinkModel struct {
+	ID         uint      `gorm:"primaryKey"`
+	CustomerID uint      `gorm:"not null;index"`
+	TokenHash  string    `gorm:"type:varchar(64);uniqueIndex;not null"`
+	ExpiresAt  time.Time `gorm:"not null"`
+	UsedAt     *time.Time
+	CreatedAt  time.Time
+
+	// Relaciones
+	Customer *CustomerModel `gorm:"foreignKey:CustomerID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (CustomerAccessLinkModel) TableName() string {
+	return "customer_access_links"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *CustomerAccessLinkModel) ToEntity() *entities.CustomerAccessLink {
+	return &entities.CustomerAccessLink{
+		ID:         m.ID,
+		CustomerID: m.CustomerID,
+		TokenHash:  m.TokenHash,
+		ExpiresAt:  m.ExpiresAt,
+		UsedAt:     m.UsedAt,
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *CustomerAccessLinkModel) FromEntity(link *entities.CustomerAccessLink) {
+	m.ID = link.ID
+	m.CustomerID = link.CustomerID
+	m.TokenHash = link.TokenHash
+	m.ExpiresAt = link.ExpiresAt
+	m.UsedAt = link.UsedAt
+	m.CreatedAt = link.CreatedAt
+}
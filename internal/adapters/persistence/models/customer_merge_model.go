@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// CustomerMergeModel representa el modelo de persistencia para el registro de fusiones de clientes
+type CustomerMergeModel struct {
+	ID                  uint   `gorm:"primaryKey"`
+	SurvivorID          uint   `gorm:"not null;index"`
+	MergedID            uint   `gorm:"not null;index"`
+	MergedSnapshot      string `gorm:"type:jsonb"`
+	TransactionsMoved   int64
+	OrdersMoved         int64
+	CouponsMoved        int64
+	LoyaltyEntriesMoved int64
+	SurvivorBalance     float64 `gorm:"type:decimal(15,2)"`
+	MergedBalance       float64 `gorm:"type:decimal(15,2)"`
+	ResultingBalance    float64 `gorm:"type:decimal(15,2)"`
+	MergedBy            uint    `gorm:"index"`
+	CreatedAt           time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (CustomerMergeModel) TableName() string {
+	return "customer_merges"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *CustomerMergeModel) ToEntity() *entities.CustomerMerge {
+	return &entities.CustomerMerge{
+		ID:                  m.ID,
+		SurvivorID:          m.SurvivorID,
+		MergedID:            m.MergedID,
+		MergedSnapshot:      m.MergedSnapshot,
+		TransactionsMoved:   m.TransactionsMoved,
+		OrdersMoved:         m.OrdersMoved,
+		CouponsMoved:        m.CouponsMoved,
+		LoyaltyEntriesMoved: m.LoyaltyEntriesMoved,
+		SurvivorBalance:     m.SurvivorBalance,
+		MergedBalance:       m.MergedBalance,
+		ResultingBalance:    m.ResultingBalance,
+		MergedBy:            m.MergedBy,
+		CreatedAt:           m.CreatedAt,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *CustomerMergeModel) FromEntity(merge *entities.CustomerMerge) {
+	m.ID = merge.ID
+	m.SurvivorID = merge.SurvivorID
+	m.MergedID = merge.MergedID
+	m.MergedSnapshot = merge.MergedSnapshot
+	m.TransactionsMoved = merge.TransactionsMoved
+	m.OrdersMoved = merge.OrdersMoved
+	m.CouponsMoved = merge.CouponsMoved
+	m.LoyaltyEntriesMoved = merge.LoyaltyEntriesMoved
+	m.SurvivorBalance = merge.SurvivorBalance
+	m.MergedBalance = merge.MergedBalance
+	m.ResultingBalance = merge.ResultingBalance
+	m.MergedBy = merge.MergedBy
+	m.CreatedAt = merge.CreatedAt
+}
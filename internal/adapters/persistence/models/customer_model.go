@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"gorm.io/gorm"
 )
 
 // CustomerModel representa el modelo de persistencia para clientes
@@ -21,6 +22,7 @@ type CustomerModel struct {
 	IsActive         bool      `gorm:"default:true"`
 	PaymentFrequency string    `gorm:"type:varchar(20);default:'NONE'"` // NONE, WEEKLY, BIWEEKLY, MONTHLY
 	PaymentDays      string    `gorm:"type:varchar(50)"`                // Días de pago separados por coma (ej: "2,17")
+	AssignedSellerID *uint     `gorm:"index"`                           // Vendedor responsable del cliente
 	CreatedAt        time.Time `gorm:"autoCreateTime"`
 	UpdatedAt        time.Time `gorm:"autoUpdateTime"`
 
@@ -55,6 +57,7 @@ func (m *CustomerModel) ToEntity() *entities.Customer {
 		IsActive:         m.IsActive,
 		PaymentFrequency: entities.PaymentFrequency(m.PaymentFrequency),
 		PaymentDays:      m.PaymentDays,
+		AssignedSellerID: m.AssignedSellerID,
 		CreatedAt:        m.CreatedAt,
 		UpdatedAt:        m.UpdatedAt,
 	}
@@ -92,6 +95,7 @@ func (m *CustomerModel) FromEntity(customer *entities.Customer) {
 	m.IsActive = customer.IsActive
 	m.PaymentFrequency = string(customer.PaymentFrequency)
 	m.PaymentDays = customer.PaymentDays
+	m.AssignedSellerID = customer.AssignedSellerID
 	m.CreatedAt = customer.CreatedAt
 	m.UpdatedAt = customer.UpdatedAt
 }
@@ -106,7 +110,12 @@ type CustomerTransactionModel struct {
 	Description     string              `gorm:"type:text"`                 // Descripción del movimiento
 	PaymentMethodID *uint               `gorm:"index"`                     // ID del método de pago (solo para ABONO)
 	PaymentMethod   *PaymentMethodModel `gorm:"foreignKey:PaymentMethodID"`
+	CashSessionID   *uint               `gorm:"index"` // Sesión de caja en la que se cobró
+	OrderID         *uint               `gorm:"index"` // Venta que generó la deuda
 	Date            time.Time           `gorm:"not null"`
+	ReversalOfID    *uint               `gorm:"uniqueIndex"` // Movimiento compensado (solo en reversos)
+	ReversedByID    *uint               `gorm:"index"`       // Reverso que anuló este movimiento
+	ReversalReason  string              `gorm:"type:text"`
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 }
@@ -116,6 +125,12 @@ func (CustomerTransactionModel) TableName() string {
 	return "customer_transactions"
 }
 
+// BeforeCreate es un hook de GORM que se ejecuta dentro de la transacción del insert
+// Un abono solo se asocia a una caja que siga abierta
+func (m *CustomerTransactionModel) BeforeCreate(tx *gorm.DB) error {
+	return lockOpenCashSession(tx, m.CashSessionID)
+}
+
 // ToEntity convierte el modelo a entidad de dominio
 func (m *CustomerTransactionModel) ToEntity() *entities.CustomerTransaction {
 	transaction := &entities.CustomerTransaction{
@@ -125,7 +140,12 @@ func (m *CustomerTransactionModel) ToEntity() *entities.CustomerTransaction {
 		Amount:          m.Amount,
 		Description:     m.Description,
 		PaymentMethodID: m.PaymentMethodID,
+		CashSessionID:   m.CashSessionID,
+		OrderID:         m.OrderID,
 		Date:            m.Date,
+		ReversalOfID:    m.ReversalOfID,
+		ReversedByID:    m.ReversedByID,
+		ReversalReason:  m.ReversalReason,
 		CreatedAt:       m.CreatedAt,
 		UpdatedAt:       m.UpdatedAt,
 	}
@@ -146,7 +166,12 @@ func (m *CustomerTransactionModel) FromEntity(transaction *entities.CustomerTran
 	m.Amount = transaction.Amount
 	m.Description = transaction.Description
 	m.PaymentMethodID = transaction.PaymentMethodID
+	m.CashSessionID = transaction.CashSessionID
+	m.OrderID = transaction.OrderID
 	m.Date = transaction.Date
+	m.ReversalOfID = transaction.ReversalOfID
+	m.ReversedByID = transaction.ReversedByID
+	m.ReversalReason = transaction.ReversalReason
 	m.CreatedAt = transaction.CreatedAt
 	m.UpdatedAt = transaction.UpdatedAt
 }
@@ -0,0 +1,258 @@
+package models
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// InvoiceResolutionModel representa el modelo de persistencia de una resolución de numeración de la DIAN
+type InvoiceResolutionModel struct {
+	ID               uint      `gorm:"primaryKey"`
+	DocumentType     string    `gorm:"type:varchar(20);not null;index"` // INVOICE o CREDIT_NOTE
+	ResolutionNumber string    `gorm:"type:varchar(50)"`
+	Prefix           string    `gorm:"type:varchar(4);not null;index"`
+	RangeFrom        int64     `gorm:"not null"`
+	RangeTo          int64     `gorm:"not null"`
+	NextNumber       int64     `gorm:"not null"`  // Siguiente consecutivo a asignar
+	TechnicalKey     string    `gorm:"type:text"` // Clave técnica para el CUFE
+	ValidFrom        time.Time `gorm:"type:date;not null"`
+	ValidTo          time.Time `gorm:"type:date;not null"`
+	IsActive         bool      `gorm:"not null;default:true"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (InvoiceResolutionModel) TableName() string {
+	return "invoice_resolutions"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *InvoiceResolutionModel) ToEntity() *entities.InvoiceResolution {
+	return &entities.InvoiceResolution{
+		ID:               m.ID,
+		DocumentType:     entities.ElectronicDocumentType(m.DocumentType),
+		ResolutionNumber: m.ResolutionNumber,
+		Prefix:           m.Prefix,
+		RangeFrom:        m.RangeFrom,
+		RangeTo:          m.RangeTo,
+		NextNumber:       m.NextNumber,
+		TechnicalKey:     m.TechnicalKey,
+		ValidFrom:        m.ValidFrom,
+		ValidTo:          m.ValidTo,
+		IsActive:         m.IsActive,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *InvoiceResolutionModel) FromEntity(resolution *entities.InvoiceResolution) {
+	m.ID = resolution.ID
+	m.DocumentType = string(resolution.DocumentType)
+	m.ResolutionNumber = resolution.ResolutionNumber
+	m.Prefix = resolution.Prefix
+	m.RangeFrom = resolution.RangeFrom
+	m.RangeTo = resolution.RangeTo
+	m.NextNumber = resolution.NextNumber
+	m.TechnicalKey = resolution.TechnicalKey
+	m.ValidFrom = resolution.ValidFrom
+	m.ValidTo = resolution.ValidTo
+	m.IsActive = resolution.IsActive
+	m.CreatedAt = resolution.CreatedAt
+	m.UpdatedAt = resolution.UpdatedAt
+}
+
+// ElectronicDocumentModel representa el modelo de persistencia de una factura o nota crédito electrónica
+// El índice único de prefijo y número impide asignar dos veces el mismo consecutivo
+type ElectronicDocumentModel struct {
+	ID                 uint   `gorm:"primaryKey"`
+	Type               string `gorm:"type:varchar(20);not null;index"`
+	Status             string `gorm:"type:varchar(20);not null;index"` // PENDING, ACCEPTED o REJECTED
+	ResolutionID       uint   `gorm:"not null;index"`
+	Prefix             string `gorm:"type:varchar(4);not null;uniqueIndex:idx_electronic_documents_number"`
+	Number             int64  `gorm:"not null;uniqueIndex:idx_electronic_documents_number"`
+	Environment        string `gorm:"type:varchar(1);not null"`
+	OrderID            *uint  `gorm:"index"`
+	CustomerID         *uint  `gorm:"index"`
+	ReferenceID        *uint  `gorm:"index"` // Factura que corrige la nota crédito
+	ReferenceNumber    string `gorm:"type:varchar(30)"`
+	ReferenceCUFE      string `gorm:"type:varchar(96)"`
+	ReferenceIssueDate *time.Time
+	CorrectionCode     string                        `gorm:"type:varchar(2)"`
+	CorrectionReason   string                        `gorm:"type:text"`
+	BuyerIDType        string                        `gorm:"type:varchar(2);not null"`
+	BuyerIDNumber      string                        `gorm:"type:varchar(30);not null;index"`
+	BuyerName          string                        `gorm:"type:varchar(200);not null"`
+	BuyerEmail         string                        `gorm:"type:varchar(150)"`
+	BuyerAddress       string                        `gorm:"type:text"`
+	BuyerPhone         string                        `gorm:"type:varchar(30)"`
+	IssueDate          time.Time                     `gorm:"not null;index"`
+	PaymentMeans       string                        `gorm:"type:varchar(3);not null"`
+	PaymentDueDate     *time.Time                    `gorm:"type:date"` // Nil en ventas de contado
+	Notes              string                        `gorm:"type:text"`
+	TaxResponsible     bool                          `gorm:"not null;default:false"`
+	Lines              []ElectronicDocumentLineModel `gorm:"foreignKey:DocumentID"`
+	Subtotal           float64                       `gorm:"not null"`
+	TaxTotal           float64                       `gorm:"not null;default:0"`
+	Total              float64                       `gorm:"not null"`
+	CUFE               string                        `gorm:"column:cufe;type:varchar(96);not null;uniqueIndex"`
+	XML                string                        `gorm:"column:xml;type:text;not null"` // UBL 2.1 firmado
+	ProviderTrackID    string                        `gorm:"type:varchar(100)"`
+	ProviderMessage    string                        `gorm:"type:text"`
+	SubmittedAt        *time.Time
+	CreatedByID        *uint `gorm:"index"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (ElectronicDocumentModel) TableName() string {
+	return "electronic_documents"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *ElectronicDocumentModel) ToEntity() *entities.ElectronicDocument {
+	document := &entities.ElectronicDocument{
+		ID:                 m.ID,
+		Type:               entities.ElectronicDocumentType(m.Type),
+		Status:             entities.ElectronicDocumentStatus(m.Status),
+		ResolutionID:       m.ResolutionID,
+		Prefix:             m.Prefix,
+		Number:             m.Number,
+		Environment:        m.Environment,
+		OrderID:            m.OrderID,
+		CustomerID:         m.CustomerID,
+		ReferenceID:        m.ReferenceID,
+		ReferenceNumber:    m.ReferenceNumber,
+		ReferenceCUFE:      m.ReferenceCUFE,
+		ReferenceIssueDate: m.ReferenceIssueDate,
+		CorrectionCode:     m.CorrectionCode,
+		CorrectionReason:   m.CorrectionReason,
+		BuyerIDType:        m.BuyerIDType,
+		BuyerIDNumber:      m.BuyerIDNumber,
+		BuyerName:          m.BuyerName,
+		BuyerEmail:         m.BuyerEmail,
+		BuyerAddress:       m.BuyerAddress,
+		BuyerPhone:         m.BuyerPhone,
+		IssueDate:          m.IssueDate,
+		PaymentMeans:       m.PaymentMeans,
+		PaymentDueDate:     m.PaymentDueDate,
+		Notes:              m.Notes,
+		TaxResponsible:     m.TaxResponsible,
+		Lines:              make([]entities.ElectronicDocumentLine, len(m.Lines)),
+		Subtotal:           m.Subtotal,
+		TaxTotal:           m.TaxTotal,
+		Total:              m.Total,
+		CUFE:               m.CUFE,
+		XML:                m.XML,
+		ProviderTrackID:    m.ProviderTrackID,
+		ProviderMessage:    m.ProviderMessage,
+		SubmittedAt:        m.SubmittedAt,
+		CreatedByID:        m.CreatedByID,
+		CreatedAt:          m.CreatedAt,
+		UpdatedAt:          m.UpdatedAt,
+	}
+	for i := range m.Lines {
+		document.Lines[i] = m.Lines[i].ToEntity()
+	}
+	return document
+}
+
+// FromEntity convierte una entidad de dominio a modelo con sus líneas
+func (m *ElectronicDocumentModel) FromEntity(document *entities.ElectronicDocument) {
+	m.ID = document.ID
+	m.Type = string(document.Type)
+	m.Status = string(document.Status)
+	m.ResolutionID = document.ResolutionID
+	m.Prefix = document.Prefix
+	m.Number = document.Number
+	m.Environment = document.Environment
+	m.OrderID = document.OrderID
+	m.CustomerID = document.CustomerID
+	m.ReferenceID = document.ReferenceID
+	m.ReferenceNumber = document.ReferenceNumber
+	m.ReferenceCUFE = document.ReferenceCUFE
+	m.ReferenceIssueDate = document.ReferenceIssueDate
+	m.CorrectionCode = document.CorrectionCode
+	m.CorrectionReason = document.CorrectionReason
+	m.BuyerIDType = document.BuyerIDType
+	m.BuyerIDNumber = document.BuyerIDNumber
+	m.BuyerName = document.BuyerName
+	m.BuyerEmail = document.BuyerEmail
+	m.BuyerAddress = document.BuyerAddress
+	m.BuyerPhone = document.BuyerPhone
+	m.IssueDate = document.IssueDate
+	m.PaymentMeans = document.PaymentMeans
+	m.PaymentDueDate = document.PaymentDueDate
+	m.Notes = document.Notes
+	m.TaxResponsible = document.TaxResponsible
+	m.Subtotal = document.Subtotal
+	m.TaxTotal = document.TaxTotal
+	m.Total = document.Total
+	m.CUFE = document.CUFE
+	m.XML = document.XML
+	m.ProviderTrackID = document.ProviderTrackID
+	m.ProviderMessage = document.ProviderMessage
+	m.SubmittedAt = document.SubmittedAt
+	m.CreatedByID = document.CreatedByID
+	m.CreatedAt = document.CreatedAt
+	m.UpdatedAt = document.UpdatedAt
+	m.Lines = make([]ElectronicDocumentLineModel, len(document.Lines))
+	for i := range document.Lines {
+		m.Lines[i].FromEntity(&document.Lines[i])
+	}
+}
+
+// ElectronicDocumentLineModel representa el modelo de persistencia de un renglón de factura o nota crédito
+type ElectronicDocumentLineModel struct {
+	ID          uint    `gorm:"primaryKey"`
+	DocumentID  uint    `gorm:"not null;index"`
+	LineNumber  int     `gorm:"not null"`
+	OrderItemID *uint   `gorm:"index"`
+	Code        string  `gorm:"type:varchar(50)"`
+	Description string  `gorm:"type:text;not null"`
+	Quantity    int     `gorm:"not null"`
+	UnitPrice   float64 `gorm:"not null"` // Sin IVA
+	Subtotal    float64 `gorm:"not null"`
+	TaxPercent  float64 `gorm:"not null;default:0"`
+	TaxAmount   float64 `gorm:"not null;default:0"`
+}
+
+// TableName especifica el nombre de la tabla
+func (ElectronicDocumentLineModel) TableName() string {
+	return "electronic_document_lines"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *ElectronicDocumentLineModel) ToEntity() entities.ElectronicDocumentLine {
+	return entities.ElectronicDocumentLine{
+		ID:          m.ID,
+		DocumentID:  m.DocumentID,
+		LineNumber:  m.LineNumber,
+		OrderItemID: m.OrderItemID,
+		Code:        m.Code,
+		Description: m.Description,
+		Quantity:    m.Quantity,
+		UnitPrice:   m.UnitPrice,
+		Subtotal:    m.Subtotal,
+		TaxPercent:  m.TaxPercent,
+		TaxAmount:   m.TaxAmount,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *ElectronicDocumentLineModel) FromEntity(line *entities.ElectronicDocumentLine) {
+	m.ID = line.ID
+	m.DocumentID = line.DocumentID
+	m.LineNumber = line.LineNumber
+	m.OrderItemID = line.OrderItemID
+	m.Code = line.Code
+	m.Description = line.Description
+	m.Quantity = line.Quantity
+	m.UnitPrice = line.UnitPrice
+	m.Subtotal = line.Subtotal
+	m.TaxPercent = line.TaxPercent
+	m.TaxAmount = line.TaxAmount
+}
@@ -16,6 +16,10 @@ type FinancialTransactionModel struct {
 	Date        time.Time `gorm:"not null;index"`
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+
+	RecurringTransactionID *uint `gorm:"index"` // Plantilla que generó la transacción
+	PaymentMethodID        *uint `gorm:"index"` // Cuenta por la que entró o salió el dinero
+	OrderID                *uint `gorm:"index"` // Venta que generó el ingreso automático
 }
 
 // TableName especifica el nombre de la tabla
@@ -34,6 +38,10 @@ func (m *FinancialTransactionModel) ToEntity() *entities.FinancialTransaction {
 		Date:        m.Date,
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
+
+		RecurringTransactionID: m.RecurringTransactionID,
+		PaymentMethodID:        m.PaymentMethodID,
+		OrderID:                m.OrderID,
 	}
 }
 
@@ -47,4 +55,7 @@ func (m *FinancialTransactionModel) FromEntity(transaction *entities.FinancialTr
 	m.Date = transaction.Date
 	m.CreatedAt = transaction.CreatedAt
 	m.UpdatedAt = transaction.UpdatedAt
+	m.RecurringTransactionID = transaction.RecurringTransactionID
+	m.PaymentMethodID = transaction.PaymentMethodID
+	m.OrderID = transaction.OrderID
 }
@@ -0,0 +1,152 @@
+package models
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// LedgerAccountModel representa el modelo de persistencia del plan de cuentas
+type LedgerAccountModel struct {
+	ID              uint   `gorm:"primaryKey"`
+	Code            string `gorm:"type:varchar(20);not null;uniqueIndex"`
+	Name            string `gorm:"type:varchar(150);not null"`
+	Type            string `gorm:"type:varchar(20);not null;index"` // ASSET, LIABILITY, EQUITY, REVENUE, EXPENSE o COST
+	PaymentMethodID *uint  `gorm:"uniqueIndex"`                     // Cuenta de caja o banco de un método de pago
+	System          bool   `gorm:"not null;default:false"`          // Usada por los asientos automáticos
+	IsActive        bool   `gorm:"not null;default:true"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (LedgerAccountModel) TableName() string {
+	return "ledger_accounts"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *LedgerAccountModel) ToEntity() *entities.LedgerAccount {
+	return &entities.LedgerAccount{
+		ID:              m.ID,
+		Code:            m.Code,
+		Name:            m.Name,
+		Type:            entities.LedgerAccountType(m.Type),
+		PaymentMethodID: m.PaymentMethodID,
+		System:          m.System,
+		IsActive:        m.IsActive,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *LedgerAccountModel) FromEntity(account *entities.LedgerAccount) {
+	m.ID = account.ID
+	m.Code = account.Code
+	m.Name = account.Name
+	m.Type = string(account.Type)
+	m.PaymentMethodID = account.PaymentMethodID
+	m.System = account.System
+	m.IsActive = account.IsActive
+	m.CreatedAt = account.CreatedAt
+	m.UpdatedAt = account.UpdatedAt
+}
+
+// JournalEntryModel representa el modelo de persistencia de un asiento contable
+// El índice parcial impide que un registro de origen tenga dos asientos vigentes
+type JournalEntryModel struct {
+	ID           uint               `gorm:"primaryKey"`
+	Date         time.Time          `gorm:"not null;index"`
+	Description  string             `gorm:"type:text;not null"`
+	SourceType   string             `gorm:"type:varchar(30);not null;index;uniqueIndex:idx_journal_entries_active_source,where:reversal_of_id IS NULL AND reversed_by_id IS NULL"`
+	SourceID     *uint              `gorm:"index;uniqueIndex:idx_journal_entries_active_source"`
+	ReversalOfID *uint              `gorm:"uniqueIndex"` // Asiento que este reverso anula
+	ReversedByID *uint              `gorm:"index"`       // Reverso que anuló este asiento
+	CreatedByID  *uint              `gorm:"index"`
+	Lines        []JournalLineModel `gorm:"foreignKey:EntryID"`
+	CreatedAt    time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (JournalEntryModel) TableName() string {
+	return "journal_entries"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *JournalEntryModel) ToEntity() *entities.JournalEntry {
+	entry := &entities.JournalEntry{
+		ID:           m.ID,
+		Date:         m.Date,
+		Description:  m.Description,
+		SourceType:   entities.JournalSourceType(m.SourceType),
+		SourceID:     m.SourceID,
+		ReversalOfID: m.ReversalOfID,
+		ReversedByID: m.ReversedByID,
+		CreatedByID:  m.CreatedByID,
+		Lines:        make([]entities.JournalLine, len(m.Lines)),
+		CreatedAt:    m.CreatedAt,
+	}
+	for i := range m.Lines {
+		entry.Lines[i] = m.Lines[i].ToEntity()
+	}
+	return entry
+}
+
+// FromEntity convierte una entidad de dominio a modelo con sus líneas
+func (m *JournalEntryModel) FromEntity(entry *entities.JournalEntry) {
+	m.ID = entry.ID
+	m.Date = entry.Date
+	m.Description = entry.Description
+	m.SourceType = string(entry.SourceType)
+	m.SourceID = entry.SourceID
+	m.ReversalOfID = entry.ReversalOfID
+	m.ReversedByID = entry.ReversedByID
+	m.CreatedByID = entry.CreatedByID
+	m.CreatedAt = entry.CreatedAt
+	m.Lines = make([]JournalLineModel, len(entry.Lines))
+	for i := range entry.Lines {
+		m.Lines[i].FromEntity(&entry.Lines[i])
+	}
+}
+
+// JournalLineModel representa el modelo de persistencia de un débito o crédito de un asiento
+type JournalLineModel struct {
+	ID          uint                `gorm:"primaryKey"`
+	EntryID     uint                `gorm:"not null;index"`
+	AccountID   uint                `gorm:"not null;index"`
+	Account     *LedgerAccountModel `gorm:"foreignKey:AccountID"`
+	Debit       float64             `gorm:"not null;default:0"`
+	Credit      float64             `gorm:"not null;default:0"`
+	Description string              `gorm:"type:text"`
+}
+
+// TableName especifica el nombre de la tabla
+func (JournalLineModel) TableName() string {
+	return "journal_lines"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *JournalLineModel) ToEntity() entities.JournalLine {
+	line := entities.JournalLine{
+		ID:          m.ID,
+		EntryID:     m.EntryID,
+		AccountID:   m.AccountID,
+		Debit:       m.Debit,
+		Credit:      m.Credit,
+		Description: m.Description,
+	}
+	if m.Account != nil {
+		line.Account = m.Account.ToEntity()
+	}
+	return line
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *JournalLineModel) FromEntity(line *entities.JournalLine) {
+	m.ID = line.ID
+	m.EntryID = line.EntryID
+	m.AccountID = line.AccountID
+	m.Debit = line.Debit
+	m.Credit = line.Credit
+	m.Description = line.Description
+}
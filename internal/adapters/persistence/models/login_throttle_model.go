@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// LoginThrottleModel representa el modelo de persistencia para el control de intentos de login
+type LoginThrottleModel struct {
+	ID            uint   `gorm:"primaryKey"`
+	Scope         string `gorm:"type:varchar(20);not null;uniqueIndex:idx_login_throttle_scope_key"` // ACCOUNT, IP, PORTAL_PHONE, PORTAL_IP, RESET_EMAIL o RESET_IP
+	Key           string `gorm:"type:varchar(255);not null;uniqueIndex:idx_login_throttle_scope_key"`
+	FailedCount   int    `gorm:"not null;default:0"`
+	LockedUntil   *time.Time
+	LastFailureAt *time.Time
+	UpdatedAt     time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (LoginThrottleModel) TableName() string {
+	return "login_throttles"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *LoginThrottleModel) ToEntity() *entities.LoginThrottle {
+	return &entities.LoginThrottle{
+		ID:            m.ID,
+		Scope:         entities.LoginThrottleScope(m.Scope),
+		Key:           m.Key,
+		FailedCount:   m.FailedCount,
+		LockedUntil:   m.LockedUntil,
+		LastFailureAt: m.LastFailureAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *LoginThrottleModel) FromEntity(throttle *entities.LoginThrottle) {
+	m.ID = throttle.ID
+	m.Scope = string(throttle.Scope)
+	m.Key = throttle.Key
+	m.FailedCount = throttle.FailedCount
+	m.LockedUntil = throttle.LockedUntil
+	m.LastFailureAt = throttle.LastFailureAt
+	m.UpdatedAt = throttle.UpdatedAt
+}
@@ -0,0 +1,105 @@
+package models
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// LoyaltyRuleModel representa el modelo de persistencia para reglas de puntos
+type LoyaltyRuleModel struct {
+	ID         uint   `gorm:"primaryKey"`
+	Name       string `gorm:"not null"`
+	Type       string `gorm:"type:varchar(30);not null;index"` // PER_AMOUNT, PER_CATEGORY, BIRTHDAY_MULTIPLIER
+	CategoryID *uint  `gorm:"index"`
+	AmountStep float64
+	Points     int
+	Multiplier float64
+	IsActive   bool `gorm:"default:true"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (LoyaltyRuleModel) TableName() string {
+	return "loyalty_rules"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *LoyaltyRuleModel) ToEntity() *entities.LoyaltyRule {
+	return &entities.LoyaltyRule{
+		ID:         m.ID,
+		Name:       m.Name,
+		Type:       entities.LoyaltyRuleType(m.Type),
+		CategoryID: m.CategoryID,
+		AmountStep: m.AmountStep,
+		Points:     m.Points,
+		Multiplier: m.Multiplier,
+		IsActive:   m.IsActive,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *LoyaltyRuleModel) FromEntity(rule *entities.LoyaltyRule) {
+	m.ID = rule.ID
+	m.Name = rule.Name
+	m.Type = string(rule.Type)
+	m.CategoryID = rule.CategoryID
+	m.AmountStep = rule.AmountStep
+	m.Points = rule.Points
+	m.Multiplier = rule.Multiplier
+	m.IsActive = rule.IsActive
+	m.CreatedAt = rule.CreatedAt
+	m.UpdatedAt = rule.UpdatedAt
+}
+
+// LoyaltyPointsEntryModel representa el modelo de persistencia del libro de puntos
+type LoyaltyPointsEntryModel struct {
+	ID          uint       `gorm:"primaryKey"`
+	CustomerID  uint       `gorm:"not null;index"`
+	OrderID     *uint      `gorm:"index"`
+	Type        string     `gorm:"type:varchar(20);not null;index"` // EARN, REDEEM, EXPIRE
+	Points      int        `gorm:"not null"`
+	Remaining   int        `gorm:"not null;default:0"`
+	Description string     `gorm:"type:text"`
+	ExpiresAt   *time.Time `gorm:"index"`
+	CreatedAt   time.Time
+
+	// Relaciones
+	Customer *CustomerModel `gorm:"foreignKey:CustomerID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (LoyaltyPointsEntryModel) TableName() string {
+	return "loyalty_points_entries"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *LoyaltyPointsEntryModel) ToEntity() *entities.LoyaltyPointsEntry {
+	return &entities.LoyaltyPointsEntry{
+		ID:          m.ID,
+		CustomerID:  m.CustomerID,
+		OrderID:     m.OrderID,
+		Type:        entities.LoyaltyEntryType(m.Type),
+		Points:      m.Points,
+		Remaining:   m.Remaining,
+		Description: m.Description,
+		ExpiresAt:   m.ExpiresAt,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *LoyaltyPointsEntryModel) FromEntity(entry *entities.LoyaltyPointsEntry) {
+	m.ID = entry.ID
+	m.CustomerID = entry.CustomerID
+	m.OrderID = entry.OrderID
+	m.Type = string(entry.Type)
+	m.Points = entry.Points
+	m.Remaining = entry.Remaining
+	m.Description = entry.Description
+	m.ExpiresAt = entry.ExpiresAt
+	m.CreatedAt = entry.CreatedAt
+}
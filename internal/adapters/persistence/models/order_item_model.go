@@ -19,6 +19,7 @@ type OrderItemModel struct {
 	Quantity         int     `gorm:"not null"`           // Cantidad total solicitada
 	ReservedQuantity int     `gorm:"not null;default:0"` // Cantidad reservada del stock existente
 	UnitPrice        float64 `gorm:"not null"`
+	UnitCost         float64 `gorm:"not null;default:0"` // Snapshot del costo de producción unitario
 	Subtotal         float64 `gorm:"not null"`
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
@@ -48,6 +49,7 @@ func (m *OrderItemModel) ToEntity() *entities.OrderItem {
 		Quantity:         m.Quantity,
 		ReservedQuantity: m.ReservedQuantity,
 		UnitPrice:        m.UnitPrice,
+		UnitCost:         m.UnitCost,
 		Subtotal:         m.Subtotal,
 		CreatedAt:        m.CreatedAt,
 		UpdatedAt:        m.UpdatedAt,
@@ -91,6 +93,7 @@ func (m *OrderItemModel) FromEntity(item *entities.OrderItem) {
 	m.Quantity = item.Quantity
 	m.ReservedQuantity = item.ReservedQuantity
 	m.UnitPrice = item.UnitPrice
+	m.UnitCost = item.UnitCost
 	m.Subtotal = item.Subtotal
 }
 
@@ -19,7 +19,11 @@ type OrderModel struct {
 	Status                string    `gorm:"not null;type:varchar(20);index"`
 	TotalAmount           float64   `gorm:"not null;default:0"`
 	Discount              float64   `gorm:"not null;default:0"`
+	CouponCode            string    `gorm:"type:varchar(30);index"` // Código de campaña aplicado
+	LoyaltyPointsRedeemed int       `gorm:"default:0"`              // Puntos de fidelización usados
 	Notes                 string    `gorm:"type:text"`
+	PaymentMethodID       *uint     `gorm:"index"` // Método de pago de una venta de contado
+	CashSessionID         *uint     `gorm:"index"` // Sesión de caja en la que se registró la venta
 	OrderDate             time.Time `gorm:"not null;index"`
 	EstimatedDeliveryDate *time.Time
 	ActualDeliveryDate    *time.Time
@@ -56,7 +60,11 @@ func (m *OrderModel) ToEntity() *entities.Order {
 		Status:                entities.OrderStatus(m.Status),
 		TotalAmount:           m.TotalAmount,
 		Discount:              m.Discount,
+		CouponCode:            m.CouponCode,
+		LoyaltyPointsRedeemed: m.LoyaltyPointsRedeemed,
 		Notes:                 m.Notes,
+		PaymentMethodID:       m.PaymentMethodID,
+		CashSessionID:         m.CashSessionID,
 		OrderDate:             m.OrderDate,
 		EstimatedDeliveryDate: m.EstimatedDeliveryDate,
 		ActualDeliveryDate:    m.ActualDeliveryDate,
@@ -88,6 +96,12 @@ func (m *OrderModel) ToEntity() *entities.Order {
 	return order
 }
 
+// BeforeCreate es un hook de GORM que se ejecuta dentro de la transacción del insert
+// Una venta solo se asocia a una caja que siga abierta
+func (m *OrderModel) BeforeCreate(tx *gorm.DB) error {
+	return lockOpenCashSession(tx, m.CashSessionID)
+}
+
 // FromEntity convierte la entidad de dominio a modelo
 func (m *OrderModel) FromEntity(order *entities.Order) {
 	m.ID = order.ID
@@ -100,7 +114,11 @@ func (m *OrderModel) FromEntity(order *entities.Order) {
 	m.Status = string(order.Status)
 	m.TotalAmount = order.TotalAmount
 	m.Discount = order.Discount
+	m.CouponCode = order.CouponCode
+	m.LoyaltyPointsRedeemed = order.LoyaltyPointsRedeemed
 	m.Notes = order.Notes
+	m.PaymentMethodID = order.PaymentMethodID
+	m.CashSessionID = order.CashSessionID
 	m.OrderDate = order.OrderDate
 	m.EstimatedDeliveryDate = order.EstimatedDeliveryDate
 	m.ActualDeliveryDate = order.ActualDeliveryDate
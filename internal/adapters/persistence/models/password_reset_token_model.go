@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// PasswordResetTokenModel representa el modelo de persistencia para tokens de recuperación de contraseña
+type PasswordResetTokenModel struct {
+	ID          uint      `gorm:"primaryKey"`
+	UserID      uint      `gorm:"not null;index"`
+	TokenHash   string    `gorm:"type:varchar(64);uniqueIndex;not null"`
+	ExpiresAt   time.Time `gorm:"not null"`
+	UsedAt      *time.Time
+	RequestedIP string `gorm:"type:varchar(64)"`
+	CreatedAt   time.Time
+
+	// Relaciones
+	User *UserModel `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (PasswordResetTokenModel) TableName() string {
+	return "password_reset_tokens"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *PasswordResetTokenModel) ToEntity() *entities.PasswordResetToken {
+	return &entities.PasswordResetToken{
+		ID:          m.ID,
+		UserID:      m.UserID,
+		TokenHash:   m.TokenHash,
+		ExpiresAt:   m.ExpiresAt,
+		UsedAt:      m.UsedAt,
+		RequestedIP: m.RequestedIP,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *PasswordResetTokenModel) FromEntity(token *entities.PasswordResetToken) {
+	m.ID = token.ID
+	m.UserID = token.UserID
+	m.TokenHash = token.TokenHash
+	m.ExpiresAt = token.ExpiresAt
+	m.UsedAt = token.UsedAt
+	m.RequestedIP = token.RequestedIP
+	m.CreatedAt = token.CreatedAt
+}
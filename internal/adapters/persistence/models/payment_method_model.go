@@ -8,11 +8,13 @@ import (
 
 // PaymentMethodModel representa el modelo de persistencia para métodos de pago
 type PaymentMethodModel struct {
-	ID        uint   `gorm:"primaryKey"`
-	Name      string `gorm:"uniqueIndex;not null"`
-	IsActive  bool   `gorm:"default:true"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID             uint    `gorm:"primaryKey"`
+	Name           string  `gorm:"uniqueIndex;not null"`
+	IsActive       bool    `gorm:"default:true"`
+	OpeningBalance float64 `gorm:"not null;default:0"`     // Saldo de la cuenta antes del primer movimiento
+	IsCash         bool    `gorm:"not null;default:false"` // Efectivo (se cuenta al cerrar la caja)
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
 // TableName especifica el nombre de la tabla
@@ -23,11 +25,13 @@ func (PaymentMethodModel) TableName() string {
 // ToEntity convierte el modelo a entidad de dominio
 func (m *PaymentMethodModel) ToEntity() *entities.PaymentMethodOption {
 	return &entities.PaymentMethodOption{
-		ID:        m.ID,
-		Name:      m.Name,
-		IsActive:  m.IsActive,
-		CreatedAt: m.CreatedAt,
-		UpdatedAt: m.UpdatedAt,
+		ID:             m.ID,
+		Name:           m.Name,
+		IsActive:       m.IsActive,
+		OpeningBalance: m.OpeningBalance,
+		IsCash:         m.IsCash,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
 	}
 }
 
@@ -36,6 +40,8 @@ func (m *PaymentMethodModel) FromEntity(pm *entities.PaymentMethodOption) {
 	m.ID = pm.ID
 	m.Name = pm.Name
 	m.IsActive = pm.IsActive
+	m.OpeningBalance = pm.OpeningBalance
+	m.IsCash = pm.IsCash
 	m.CreatedAt = pm.CreatedAt
 	m.UpdatedAt = pm.UpdatedAt
 }
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// RecordShareModel representa el modelo de persistencia para órdenes y clientes compartidos
+type RecordShareModel struct {
+	ID           uint   `gorm:"primaryKey"`
+	ResourceType string `gorm:"type:varchar(20);not null;uniqueIndex:idx_record_share"`
+	ResourceID   uint   `gorm:"not null;uniqueIndex:idx_record_share"`
+	UserID       uint   `gorm:"not null;uniqueIndex:idx_record_share;index"`
+	SharedBy     uint   `gorm:"not null"`
+	CreatedAt    time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (RecordShareModel) TableName() string {
+	return "record_shares"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *RecordShareModel) ToEntity() *entities.RecordShare {
+	return &entities.RecordShare{
+		ID:           m.ID,
+		ResourceType: entities.ShareResourceType(m.ResourceType),
+		ResourceID:   m.ResourceID,
+		UserID:       m.UserID,
+		SharedBy:     m.SharedBy,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *RecordShareModel) FromEntity(share *entities.RecordShare) {
+	m.ID = share.ID
+	m.ResourceType = string(share.ResourceType)
+	m.ResourceID = share.ResourceID
+	m.UserID = share.UserID
+	m.SharedBy = share.SharedBy
+	m.CreatedAt = share.CreatedAt
+}
@@ -0,0 +1,108 @@
+package models
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// RecurringTransactionModel representa el modelo de persistencia para plantillas de transacciones recurrentes
+type RecurringTransactionModel struct {
+	ID          uint       `gorm:"primaryKey"`
+	Type        string     `gorm:"type:varchar(20);not null"` // INCOME o EXPENSE
+	Category    string     `gorm:"type:varchar(50);not null"`
+	Amount      float64    `gorm:"not null"`
+	Description string     `gorm:"type:text;not null"`
+	Frequency   string     `gorm:"type:varchar(20);not null"` // WEEKLY, BIWEEKLY, MONTHLY
+	DayOfMonth  int        `gorm:"not null;default:0"`
+	StartDate   time.Time  `gorm:"type:date;not null"`
+	EndDate     *time.Time `gorm:"type:date"`
+	NextRunDate time.Time  `gorm:"type:date;not null;index"`
+	LastRunDate *time.Time `gorm:"type:date"`
+	IsActive    bool       `gorm:"default:true;index"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (RecurringTransactionModel) TableName() string {
+	return "recurring_transactions"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *RecurringTransactionModel) ToEntity() *entities.RecurringTransaction {
+	return &entities.RecurringTransaction{
+		ID:          m.ID,
+		Type:        entities.FinancialTransactionType(m.Type),
+		Category:    entities.FinancialTransactionCategory(m.Category),
+		Amount:      m.Amount,
+		Description: m.Description,
+		Frequency:   entities.RecurrenceFrequency(m.Frequency),
+		DayOfMonth:  m.DayOfMonth,
+		StartDate:   m.StartDate,
+		EndDate:     m.EndDate,
+		NextRunDate: m.NextRunDate,
+		LastRunDate: m.LastRunDate,
+		IsActive:    m.IsActive,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *RecurringTransactionModel) FromEntity(recurring *entities.RecurringTransaction) {
+	m.ID = recurring.ID
+	m.Type = string(recurring.Type)
+	m.Category = string(recurring.Category)
+	m.Amount = recurring.Amount
+	m.Description = recurring.Description
+	m.Frequency = string(recurring.Frequency)
+	m.DayOfMonth = recurring.DayOfMonth
+	m.StartDate = recurring.StartDate
+	m.EndDate = recurring.EndDate
+	m.NextRunDate = recurring.NextRunDate
+	m.LastRunDate = recurring.LastRunDate
+	m.IsActive = recurring.IsActive
+	m.CreatedAt = recurring.CreatedAt
+	m.UpdatedAt = recurring.UpdatedAt
+}
+
+// BudgetModel representa el modelo de persistencia para presupuestos mensuales por categoría
+type BudgetModel struct {
+	ID        uint       `gorm:"primaryKey"`
+	Category  string     `gorm:"type:varchar(50);not null;uniqueIndex:idx_budgets_category_month"`
+	Month     time.Time  `gorm:"type:date;not null;uniqueIndex:idx_budgets_category_month"` // Primer día del mes
+	Amount    float64    `gorm:"not null"`
+	AlertedAt *time.Time // Aviso de sobregiro ya enviado
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (BudgetModel) TableName() string {
+	return "budgets"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *BudgetModel) ToEntity() *entities.Budget {
+	return &entities.Budget{
+		ID:        m.ID,
+		Category:  entities.FinancialTransactionCategory(m.Category),
+		Month:     m.Month,
+		Amount:    m.Amount,
+		AlertedAt: m.AlertedAt,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *BudgetModel) FromEntity(budget *entities.Budget) {
+	m.ID = budget.ID
+	m.Category = string(budget.Category)
+	m.Month = budget.Month
+	m.Amount = budget.Amount
+	m.AlertedAt = budget.AlertedAt
+	m.CreatedAt = budget.CreatedAt
+	m.UpdatedAt = budget.UpdatedAt
+}
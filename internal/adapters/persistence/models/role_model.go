@@ -0,0 +1,62 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// RoleModel representa el modelo de persistencia para roles
+type RoleModel struct {
+	ID          uint   `gorm:"primaryKey"`
+	Name        string `gorm:"type:varchar(50);uniqueIndex;not null"`
+	Description string `gorm:"type:varchar(255)"`
+	Permissions string `gorm:"type:jsonb;not null;default:'[]'"` // Lista de permisos (ej: ["orders:read"])
+	IsSystem    bool   `gorm:"not null;default:false"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (RoleModel) TableName() string {
+	return "roles"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *RoleModel) ToEntity() *entities.Role {
+	role := &entities.Role{
+		ID:          m.ID,
+		Name:        entities.UserRole(m.Name),
+		Description: m.Description,
+		IsSystem:    m.IsSystem,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+
+	var stored []string
+	if err := json.Unmarshal([]byte(m.Permissions), &stored); err == nil {
+		for _, p := range stored {
+			role.Permissions = append(role.Permissions, entities.Permission(p))
+		}
+	}
+
+	return role
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *RoleModel) FromEntity(role *entities.Role) {
+	m.ID = role.ID
+	m.Name = string(role.Name)
+	m.Description = role.Description
+	m.IsSystem = role.IsSystem
+	m.CreatedAt = role.CreatedAt
+	m.UpdatedAt = role.UpdatedAt
+
+	stored := make([]string, len(role.Permissions))
+	for i, p := range role.Permissions {
+		stored[i] = string(p)
+	}
+	permissions, _ := json.Marshal(stored)
+	m.Permissions = string(permissions)
+}
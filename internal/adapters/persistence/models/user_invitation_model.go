@@ -0,0 +1,101 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// UserInvitationModel representa el modelo de persistencia para invitaciones de usuario
+type UserInvitationModel struct {
+	ID             uint      `gorm:"primaryKey"`
+	Email          string    `gorm:"not null;index"`
+	FirstName      string    `gorm:"not null"`
+	LastName       string    `gorm:"not null"`
+	Role           string    `gorm:"type:varchar(50);not null"`
+	Permissions    string    `gorm:"type:jsonb;not null;default:'[]'"` // Permisos por categoría a aplicar al aceptar
+	TokenHash      string    `gorm:"type:varchar(64);uniqueIndex;not null"`
+	ExpiresAt      time.Time `gorm:"not null"`
+	AcceptedAt     *time.Time
+	AcceptedUserID *uint
+	RevokedAt      *time.Time
+	InvitedBy      uint `gorm:"not null;index"`
+	CreatedAt      time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (UserInvitationModel) TableName() string {
+	return "user_invitations"
+}
+
+// invitationPermission es la forma en que se guarda cada permiso dentro del JSON
+type invitationPermission struct {
+	CategoryID uint `json:"categoryId"`
+	CanView    bool `json:"canView"`
+	CanCreate  bool `json:"canCreate"`
+	CanEdit    bool `json:"canEdit"`
+	CanDelete  bool `json:"canDelete"`
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *UserInvitationModel) ToEntity() *entities.UserInvitation {
+	invitation := &entities.UserInvitation{
+		ID:             m.ID,
+		Email:          m.Email,
+		FirstName:      m.FirstName,
+		LastName:       m.LastName,
+		Role:           entities.UserRole(m.Role),
+		TokenHash:      m.TokenHash,
+		ExpiresAt:      m.ExpiresAt,
+		AcceptedAt:     m.AcceptedAt,
+		AcceptedUserID: m.AcceptedUserID,
+		RevokedAt:      m.RevokedAt,
+		InvitedBy:      m.InvitedBy,
+		CreatedAt:      m.CreatedAt,
+	}
+
+	var stored []invitationPermission
+	if err := json.Unmarshal([]byte(m.Permissions), &stored); err == nil {
+		for _, perm := range stored {
+			invitation.Permissions = append(invitation.Permissions, entities.UserCategoryPermission{
+				CategoryID: perm.CategoryID,
+				CanView:    perm.CanView,
+				CanCreate:  perm.CanCreate,
+				CanEdit:    perm.CanEdit,
+				CanDelete:  perm.CanDelete,
+			})
+		}
+	}
+
+	return invitation
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *UserInvitationModel) FromEntity(invitation *entities.UserInvitation) {
+	m.ID = invitation.ID
+	m.Email = invitation.Email
+	m.FirstName = invitation.FirstName
+	m.LastName = invitation.LastName
+	m.Role = string(invitation.Role)
+	m.TokenHash = invitation.TokenHash
+	m.ExpiresAt = invitation.ExpiresAt
+	m.AcceptedAt = invitation.AcceptedAt
+	m.AcceptedUserID = invitation.AcceptedUserID
+	m.RevokedAt = invitation.RevokedAt
+	m.InvitedBy = invitation.InvitedBy
+	m.CreatedAt = invitation.CreatedAt
+
+	stored := make([]invitationPermission, len(invitation.Permissions))
+	for i, perm := range invitation.Permissions {
+		stored[i] = invitationPermission{
+			CategoryID: perm.CategoryID,
+			CanView:    perm.CanView,
+			CanCreate:  perm.CanCreate,
+			CanEdit:    perm.CanEdit,
+			CanDelete:  perm.CanDelete,
+		}
+	}
+	permissionsJSON, _ := json.Marshal(stored)
+	m.Permissions = string(permissionsJSON)
+}
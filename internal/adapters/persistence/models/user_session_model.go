@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// UserSessionModel representa el modelo de persistencia para sesiones de usuario
+type UserSessionModel struct {
+	ID                uint      `gorm:"primaryKey"`
+	UserID            uint      `gorm:"not null;index"`
+	RefreshTokenHash  string    `gorm:"type:varchar(64);uniqueIndex;not null"`
+	PreviousTokenHash string    `gorm:"type:varchar(64);index"`
+	UserAgent         string    `gorm:"type:varchar(255)"`
+	IPAddress         string    `gorm:"type:varchar(45)"`
+	ExpiresAt         time.Time `gorm:"not null;index"`
+	LastUsedAt        time.Time
+	RevokedAt         *time.Time `gorm:"index"`
+	RevokedReason     string     `gorm:"type:varchar(30)"`
+	CreatedAt         time.Time
+
+	// Relaciones
+	User *UserModel `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (UserSessionModel) TableName() string {
+	return "user_sessions"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *UserSessionModel) ToEntity() *entities.UserSession {
+	return &entities.UserSession{
+		ID:                m.ID,
+		UserID:            m.UserID,
+		RefreshTokenHash:  m.RefreshTokenHash,
+		PreviousTokenHash: m.PreviousTokenHash,
+		UserAgent:         m.UserAgent,
+		IPAddress:         m.IPAddress,
+		ExpiresAt:         m.ExpiresAt,
+		LastUsedAt:        m.LastUsedAt,
+		RevokedAt:         m.RevokedAt,
+		RevokedReason:     m.RevokedReason,
+		CreatedAt:         m.CreatedAt,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *UserSessionModel) FromEntity(session *entities.UserSession) {
+	m.ID = session.ID
+	m.UserID = session.UserID
+	m.RefreshTokenHash = session.RefreshTokenHash
+	m.PreviousTokenHash = session.PreviousTokenHash
+	m.UserAgent = session.UserAgent
+	m.IPAddress = session.IPAddress
+	m.ExpiresAt = session.ExpiresAt
+	m.LastUsedAt = session.LastUsedAt
+	m.RevokedAt = session.RevokedAt
+	m.RevokedReason = session.RevokedReason
+	m.CreatedAt = session.CreatedAt
+}
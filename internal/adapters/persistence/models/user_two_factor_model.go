@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// UserTwoFactorModel representa el modelo de persistencia para la configuración TOTP del usuario
+type UserTwoFactorModel struct {
+	ID           uint   `gorm:"primaryKey"`
+	UserID       uint   `gorm:"not null;uniqueIndex"`
+	Secret       string `gorm:"type:varchar(255);not null"` // Cifrado (AES-GCM)
+	EnabledAt    *time.Time
+	LastUsedStep int64 `gorm:"not null;default:0"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+
+	// Relaciones
+	User *UserModel `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (UserTwoFactorModel) TableName() string {
+	return "user_two_factors"
+}
+
+// ToEntity convierte el modelo a entidad de dominio
+func (m *UserTwoFactorModel) ToEntity() *entities.UserTwoFactor {
+	return &entities.UserTwoFactor{
+		ID:           m.ID,
+		UserID:       m.UserID,
+		Secret:       m.Secret,
+		EnabledAt:    m.EnabledAt,
+		LastUsedStep: m.LastUsedStep,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+// FromEntity convierte una entidad de dominio a modelo
+func (m *UserTwoFactorModel) FromEntity(twoFactor *entities.UserTwoFactor) {
+	m.ID = twoFactor.ID
+	m.UserID = twoFactor.UserID
+	m.Secret = twoFactor.Secret
+	m.EnabledAt = twoFactor.EnabledAt
+	m.LastUsedStep = twoFactor.LastUsedStep
+	m.CreatedAt = twoFactor.CreatedAt
+	m.UpdatedAt = twoFactor.UpdatedAt
+}
+
+// UserRecoveryCodeModel representa un código de recuperación de un solo uso (solo se guarda el hash)
+type UserRecoveryCodeModel struct {
+	ID        uint   `gorm:"primaryKey"`
+	UserID    uint   `gorm:"not null;index"`
+	CodeHash  string `gorm:"type:varchar(64);not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+
+	// Relaciones
+	User *UserModel `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (UserRecoveryCodeModel) TableName() string {
+	return "user_recovery_codes"
+}
@@ -0,0 +1,184 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// orderTypeColumn resuelve el tipo de la orden (order_type, o type en órdenes antiguas)
+const orderTypeColumn = "COALESCE(NULLIF(orders.order_type, ''), orders.type)"
+
+type analyticsRepository struct {
+	db *gorm.DB
+}
+
+// NewAnalyticsRepository crea una nueva instancia del repositorio de analytics
+func NewAnalyticsRepository(db *gorm.DB) ports.AnalyticsRepository {
+	return &analyticsRepository{db: db}
+}
+
+// orders retorna la consulta base de órdenes (sin eliminadas) con los filtros aplicados
+func (r *analyticsRepository) orders(ctx context.Context, filters entities.AnalyticsFilters) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&models.OrderModel{})
+
+	if filters.StartDate != nil {
+		query = query.Where("orders.order_date >= ?", *filters.StartDate)
+	}
+	if filters.EndDate != nil {
+		query = query.Where("orders.order_date < ?", nextDay(*filters.EndDate))
+	}
+	if filters.SellerID != nil {
+		query = query.Where("orders.seller_id = ?", *filters.SellerID)
+	}
+	if filters.OrderType != "" {
+		query = query.Where(orderTypeColumn+" = ?", string(filters.OrderType))
+	}
+	if filters.CategoryID != nil {
+		query = query.Where(
+			"EXISTS (SELECT 1 FROM order_items WHERE order_items.order_id = orders.id AND order_items.category_id = ? AND order_items.deleted_at IS NULL)",
+			*filters.CategoryID,
+		)
+	}
+	if filters.VisibleToUserID != nil {
+		query = query.Where(
+			"(orders.seller_id = ? OR orders.id IN (SELECT resource_id FROM record_shares WHERE resource_type = ? AND user_id = ?))",
+			*filters.VisibleToUserID, string(entities.ShareResourceOrder), *filters.VisibleToUserID,
+		)
+	}
+
+	return query
+}
+
+func (r *analyticsRepository) CountOrders(ctx context.Context, filters entities.AnalyticsFilters) (map[entities.OrderStatus]int, map[entities.OrderType]int, error) {
+	var byStatus []struct {
+		Status string
+		Total  int
+	}
+	if err := r.orders(ctx, filters).
+		Select("orders.status AS status, COUNT(*) AS total").
+		Group("orders.status").
+		Scan(&byStatus).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var byType []struct {
+		OrderType string
+		Total     int
+	}
+	if err := r.orders(ctx, filters).
+		Select(orderTypeColumn + " AS order_type, COUNT(*) AS total").
+		Group(orderTypeColumn).
+		Scan(&byType).Error; err != nil {
+		return nil, nil, err
+	}
+
+	statusCounts := make(map[entities.OrderStatus]int, len(byStatus))
+	for _, row := range byStatus {
+		statusCounts[entities.OrderStatus(row.Status)] = row.Total
+	}
+	typeCounts := make(map[entities.OrderType]int, len(byType))
+	for _, row := range byType {
+		typeCounts[entities.OrderType(row.OrderType)] = row.Total
+	}
+	return statusCounts, typeCounts, nil
+}
+
+func (r *analyticsRepository) GetDeliveredRevenue(ctx context.Context, filters entities.AnalyticsFilters) (float64, error) {
+	var revenue float64
+	err := r.orders(ctx, filters).
+		Where("orders.status = ?", string(entities.OrderStatusDelivered)).
+		Select("COALESCE(SUM(orders.total_amount), 0)").
+		Scan(&revenue).Error
+	return revenue, err
+}
+
+func (r *analyticsRepository) GetPaymentsCollected(ctx context.Context, filters entities.AnalyticsFilters) (float64, error) {
+	query := r.db.WithContext(ctx).
+		Model(&models.CustomerTransactionModel{}).
+		Where("customer_transactions.type = ?", string(entities.TransactionTypePayment)).
+		Where("customer_transactions.reversed_by_id IS NULL AND customer_transactions.reversal_of_id IS NULL")
+
+	if filters.StartDate != nil {
+		query = query.Where("customer_transactions.date >= ?", *filters.StartDate)
+	}
+	if filters.EndDate != nil {
+		query = query.Where("customer_transactions.date < ?", nextDay(*filters.EndDate))
+	}
+	// Los abonos son del cliente: el vendedor se filtra por el vendedor asignado al cliente
+	if filters.SellerID != nil {
+		query = query.Where("customer_transactions.customer_id IN (SELECT id FROM customers WHERE assigned_seller_id = ?)", *filters.SellerID)
+	}
+	if filters.VisibleToUserID != nil {
+		query = query.Where(
+			"(customer_transactions.customer_id IN (SELECT id FROM customers WHERE assigned_seller_id = ?) OR customer_transactions.customer_id IN (SELECT resource_id FROM record_shares WHERE resource_type = ? AND user_id = ?))",
+			*filters.VisibleToUserID, string(entities.ShareResourceCustomer), *filters.VisibleToUserID,
+		)
+	}
+
+	var total float64
+	err := query.Select("COALESCE(SUM(customer_transactions.amount), 0)").Scan(&total).Error
+	return total, err
+}
+
+func (r *analyticsRepository) GetManufacturingTime(ctx context.Context, filters entities.AnalyticsFilters) (float64, int, error) {
+	// Primer paso de cada orden a MANUFACTURING y a FINISHED según la auditoría de estados
+	var result struct {
+		AvgSeconds float64
+		Orders     int
+	}
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT COALESCE(AVG(EXTRACT(EPOCH FROM finished.at - started.at)), 0) AS avg_seconds, COUNT(*) AS orders
+		FROM (SELECT order_id, MIN(created_at) AS at FROM audit_logs WHERE new_status = ? AND order_id IS NOT NULL GROUP BY order_id) started
+		JOIN (SELECT order_id, MIN(created_at) AS at FROM audit_logs WHERE new_status = ? AND order_id IS NOT NULL GROUP BY order_id) finished
+			ON finished.order_id = started.order_id AND finished.at > started.at
+		WHERE started.order_id IN (?)`,
+		string(entities.OrderStatusManufacturing),
+		string(entities.OrderStatusFinished),
+		r.orders(ctx, filters).Select("orders.id"),
+	).Scan(&result).Error
+	return result.AvgSeconds, result.Orders, err
+}
+
+func (r *analyticsRepository) GetRevenueByCategory(ctx context.Context, filters entities.AnalyticsFilters) ([]entities.CategorySales, error) {
+	query := r.db.WithContext(ctx).
+		Table("order_items").
+		Select("order_items.category_id, COALESCE(categories.name, '') AS category_name, SUM(order_items.quantity) AS quantity, SUM(order_items.subtotal) AS revenue").
+		Joins("LEFT JOIN categories ON categories.id = order_items.category_id").
+		Where("order_items.deleted_at IS NULL").
+		Where("order_items.order_id IN (?)", r.orders(ctx, filters).
+			Where("orders.status = ?", string(entities.OrderStatusDelivered)).
+			Select("orders.id"))
+	// Con filtro de categoría solo interesan los items de esa categoría
+	if filters.CategoryID != nil {
+		query = query.Where("order_items.category_id = ?", *filters.CategoryID)
+	}
+
+	var sales []entities.CategorySales
+	err := query.
+		Group("order_items.category_id, categories.name").
+		Order("revenue DESC").
+		Scan(&sales).Error
+	return sales, err
+}
+
+func (r *analyticsRepository) GetRevenueBySeller(ctx context.Context, filters entities.AnalyticsFilters) ([]entities.SellerSales, error) {
+	var sales []entities.SellerSales
+	err := r.orders(ctx, filters).
+		Select("orders.seller_id, COALESCE(users.first_name || ' ' || users.last_name, '') AS seller_name, COUNT(*) AS orders, SUM(orders.total_amount) AS revenue").
+		Joins("LEFT JOIN users ON users.id = orders.seller_id").
+		Where("orders.status = ?", string(entities.OrderStatusDelivered)).
+		Group("orders.seller_id, users.first_name, users.last_name").
+		Order("revenue DESC").
+		Scan(&sales).Error
+	return sales, err
+}
+
+// nextDay convierte la fecha final en límite exclusivo: el día siguiente a las 00:00
+func nextDay(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location()).AddDate(0, 0, 1)
+}
@@ -0,0 +1,118 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// customerBalance es el saldo con signo de cada movimiento del cliente (DEUDA suma, ABONO resta)
+const customerBalance = "SUM(CASE WHEN customer_transactions.type = 'DEUDA' THEN customer_transactions.amount ELSE -customer_transactions.amount END)"
+
+type cashFlowRepository struct {
+	db *gorm.DB
+}
+
+// NewCashFlowRepository crea una nueva instancia del repositorio de proyección de caja
+func NewCashFlowRepository(db *gorm.DB) ports.CashFlowRepository {
+	return &cashFlowRepository{db: db}
+}
+
+func (r *cashFlowRepository) ListOpenReceivables(ctx context.Context, since time.Time) ([]entities.OpenReceivable, error) {
+	var rows []struct {
+		CustomerID       uint
+		CustomerName     string
+		PaymentFrequency string
+		PaymentDays      string
+		Balance          float64
+		AveragePayment   float64
+	}
+	err := r.db.WithContext(ctx).
+		Table("customers").
+		Select("customers.id AS customer_id, customers.name AS customer_name, "+
+			"customers.payment_frequency, customers.payment_days, "+
+			customerBalance+" AS balance, "+
+			"COALESCE(AVG(CASE WHEN customer_transactions.type = 'ABONO' "+
+			"AND customer_transactions.reversal_of_id IS NULL AND customer_transactions.reversed_by_id IS NULL "+
+			"AND customer_transactions.date >= ? THEN customer_transactions.amount END), 0) AS average_payment", since).
+		Joins("JOIN customer_transactions ON customer_transactions.customer_id = customers.id").
+		Where("customers.is_active = ?", true).
+		Group("customers.id").
+		Having(customerBalance + " > 0").
+		Order("customers.name").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	receivables := make([]entities.OpenReceivable, len(rows))
+	for i, row := range rows {
+		receivables[i] = entities.OpenReceivable{
+			Customer: entities.Customer{
+				ID:               row.CustomerID,
+				Name:             row.CustomerName,
+				PaymentFrequency: entities.PaymentFrequency(row.PaymentFrequency),
+				PaymentDays:      row.PaymentDays,
+			},
+			Balance:        row.Balance,
+			AveragePayment: row.AveragePayment,
+		}
+	}
+	return receivables, nil
+}
+
+func (r *cashFlowRepository) ListPendingCustomOrders(ctx context.Context) ([]entities.Order, error) {
+	statuses := make([]string, 0, len(entities.PendingCustomOrderStatuses()))
+	for _, status := range entities.PendingCustomOrderStatuses() {
+		statuses = append(statuses, string(status))
+	}
+
+	var modelList []models.OrderModel
+	err := r.db.WithContext(ctx).
+		Where(orderTypeColumn+" = ?", string(entities.OrderTypeCustom)).
+		Where("status IN ?", statuses).
+		Order("estimated_delivery_date ASC NULLS LAST, id ASC").
+		Find(&modelList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]entities.Order, len(modelList))
+	for i, model := range modelList {
+		orders[i] = *model.ToEntity()
+	}
+	return orders, nil
+}
+
+func (r *cashFlowRepository) SumExpensesByCategory(ctx context.Context, categories []entities.FinancialTransactionCategory, from, to time.Time) (map[entities.FinancialTransactionCategory]float64, error) {
+	names := make([]string, len(categories))
+	for i, category := range categories {
+		names[i] = string(category)
+	}
+
+	var rows []struct {
+		Category string
+		Total    float64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&models.FinancialTransactionModel{}).
+		Select("category, COALESCE(SUM(amount), 0) AS total").
+		Where("type = ?", string(entities.FinancialTransactionTypeExpense)).
+		Where("category IN ?", names).
+		Where("date >= ? AND date < ?", from, to).
+		Group("category").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make(map[entities.FinancialTransactionCategory]float64, len(rows))
+	for _, row := range rows {
+		totals[entities.FinancialTransactionCategory(row.Category)] = row.Total
+	}
+	return totals, nil
+}
@@ -0,0 +1,110 @@
+package analytics
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// marginGrouping es la expresión SQL de la clave, la etiqueta y el orden de cada agrupación
+type marginGrouping struct {
+	key   string
+	label string
+	order string
+}
+
+var marginGroupings = map[entities.MarginGrouping]marginGrouping{
+	entities.MarginByOrder: {
+		key:   "CAST(orders.id AS TEXT)",
+		label: "orders.order_number || ' - ' || orders.customer_name",
+		order: "MIN(orders.order_date), MIN(orders.id)",
+	},
+	entities.MarginByProduct: {
+		key:   "order_items.product_name",
+		label: "order_items.product_name",
+		order: "revenue DESC, quantity DESC",
+	},
+}
+
+// marginIntervals son los argumentos de DATE_TRUNC y el formato de la etiqueta de cada intervalo
+var marginIntervals = map[entities.MarginInterval]struct {
+	trunc  string
+	format string
+}{
+	entities.MarginIntervalDay:   {trunc: "day", format: "YYYY-MM-DD"},
+	entities.MarginIntervalWeek:  {trunc: "week", format: "YYYY-MM-DD"},
+	entities.MarginIntervalMonth: {trunc: "month", format: "YYYY-MM"},
+}
+
+// orderSubtotals es el subtotal de items de cada orden, base para prorratear su descuento
+const orderSubtotals = "LEFT JOIN (SELECT order_id, SUM(subtotal) AS subtotal FROM order_items WHERE deleted_at IS NULL GROUP BY order_id) order_totals ON order_totals.order_id = orders.id"
+
+type marginReportRepository struct {
+	db *gorm.DB
+}
+
+// NewMarginReportRepository crea una nueva instancia del repositorio de reportes de márgenes
+func NewMarginReportRepository(db *gorm.DB) ports.MarginReportRepository {
+	return &marginReportRepository{db: db}
+}
+
+func (r *marginReportRepository) soldItems(ctx context.Context, filters entities.MarginReportFilters) *gorm.DB {
+	return soldItems(ctx, r.db, soldItemsFilter{
+		start:           filters.StartDate,
+		end:             filters.EndDate,
+		orderType:       filters.OrderType,
+		sellerID:        filters.SellerID,
+		categoryID:      filters.CategoryID,
+		visibleToUserID: filters.VisibleToUserID,
+	})
+}
+
+func (r *marginReportRepository) GetMargins(ctx context.Context, filters entities.MarginReportFilters) ([]entities.MarginRow, error) {
+	grouping, err := marginGroupingFor(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []entities.MarginRow
+	err = r.soldItems(ctx, filters).
+		Select(grouping.key + " AS key, MIN(" + grouping.label + ") AS label, " +
+			"COALESCE(SUM(order_items.quantity), 0) AS quantity, " +
+			"COUNT(DISTINCT orders.id) AS orders, " +
+			"COALESCE(SUM(order_items.subtotal), 0) AS revenue, " +
+			"COALESCE(SUM(orders.discount * order_items.subtotal / NULLIF(order_totals.subtotal, 0)), 0) AS discount, " +
+			"COALESCE(SUM(order_items.unit_cost * order_items.quantity), 0) AS cost, " +
+			"COALESCE(SUM(CASE WHEN order_items.unit_cost > 0 THEN 0 ELSE order_items.quantity END), 0) AS uncosted_quantity").
+		Joins(orderSubtotals).
+		Group(grouping.key).
+		Order(grouping.order).
+		Scan(&rows).Error
+	return rows, err
+}
+
+func (r *marginReportRepository) CountOrders(ctx context.Context, filters entities.MarginReportFilters) (int, error) {
+	var count int64
+	err := r.soldItems(ctx, filters).
+		Distinct("orders.id").
+		Count(&count).Error
+	return int(count), err
+}
+
+// marginGroupingFor arma la agrupación por periodo según el intervalo; las demás son fijas
+func marginGroupingFor(filters entities.MarginReportFilters) (marginGrouping, error) {
+	if filters.GroupBy == entities.MarginByPeriod {
+		interval, ok := marginIntervals[filters.Interval]
+		if !ok {
+			return marginGrouping{}, entities.ErrInvalidMarginInterval
+		}
+		period := "TO_CHAR(DATE_TRUNC('" + interval.trunc + "', orders.order_date), '" + interval.format + "')"
+		return marginGrouping{key: period, label: period, order: period}, nil
+	}
+
+	grouping, ok := marginGroupings[filters.GroupBy]
+	if !ok {
+		return marginGrouping{}, entities.ErrInvalidMarginGrouping
+	}
+	return grouping, nil
+}
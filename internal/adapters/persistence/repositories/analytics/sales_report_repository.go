@@ -0,0 +1,82 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// salesGrouping es la expresión SQL de la clave y la etiqueta de cada dimensión
+type salesGrouping struct {
+	key   string
+	label string
+}
+
+var salesGroupings = map[entities.SalesDimension]salesGrouping{
+	entities.SalesByProduct: {
+		key:   "order_items.product_name",
+		label: "order_items.product_name",
+	},
+	entities.SalesByVariant: {
+		key:   "CAST(order_items.product_variant_id AS TEXT)",
+		label: "order_items.product_name || ' - ' || COALESCE(NULLIF(order_items.color, ''), 'Sin color') || ' - ' || COALESCE(sizes.value, 'Sin talla')",
+	},
+	entities.SalesBySize: {
+		key:   "COALESCE(CAST(order_items.size_id AS TEXT), '')",
+		label: "COALESCE(sizes.value, 'Sin talla')",
+	},
+	entities.SalesByColor: {
+		key:   "LOWER(TRIM(order_items.color))",
+		label: "COALESCE(NULLIF(TRIM(order_items.color), ''), 'Sin color')",
+	},
+	entities.SalesByCategory: {
+		key:   "CAST(order_items.category_id AS TEXT)",
+		label: "COALESCE(categories.name, 'Sin categoría')",
+	},
+	entities.SalesBySeller: {
+		key:   "CAST(orders.seller_id AS TEXT)",
+		label: "COALESCE(users.first_name || ' ' || users.last_name, 'Sin vendedor')",
+	},
+}
+
+type salesReportRepository struct {
+	db *gorm.DB
+}
+
+// NewSalesReportRepository crea una nueva instancia del repositorio de reportes de ventas
+func NewSalesReportRepository(db *gorm.DB) ports.SalesReportRepository {
+	return &salesReportRepository{db: db}
+}
+
+func (r *salesReportRepository) GetSales(ctx context.Context, filters entities.SalesReportFilters, start, end time.Time) ([]entities.SalesRow, error) {
+	grouping, ok := salesGroupings[filters.Dimension]
+	if !ok {
+		return nil, entities.ErrInvalidSalesDimension
+	}
+
+	query := soldItems(ctx, r.db, soldItemsFilter{
+		start:           start,
+		end:             end,
+		orderType:       filters.OrderType,
+		sellerID:        filters.SellerID,
+		categoryID:      filters.CategoryID,
+		visibleToUserID: filters.VisibleToUserID,
+	}).
+		Select(grouping.key + " AS key, MIN(" + grouping.label + ") AS label, " +
+			"COALESCE(SUM(order_items.quantity), 0) AS quantity, " +
+			"COUNT(DISTINCT orders.id) AS orders, " +
+			"COALESCE(SUM(order_items.subtotal), 0) AS revenue").
+		Joins("LEFT JOIN sizes ON sizes.id = order_items.size_id").
+		Joins("LEFT JOIN categories ON categories.id = order_items.category_id").
+		Joins("LEFT JOIN users ON users.id = orders.seller_id")
+
+	var rows []entities.SalesRow
+	err := query.
+		Group(grouping.key).
+		Order("revenue DESC, quantity DESC").
+		Scan(&rows).Error
+	return rows, err
+}
@@ -0,0 +1,54 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"gorm.io/gorm"
+)
+
+// soldItemsFilter son los filtros comunes de los reportes construidos sobre los items vendidos
+type soldItemsFilter struct {
+	start           time.Time
+	end             time.Time // Inclusive
+	orderType       entities.OrderType
+	sellerID        *uint
+	categoryID      *uint
+	visibleToUserID *uint
+}
+
+// soldItems parte de los items de las órdenes vendidas en el periodo (order_items JOIN orders)
+// Sin tipo de orden se excluyen las órdenes INVENTORY
+func soldItems(ctx context.Context, db *gorm.DB, filter soldItemsFilter) *gorm.DB {
+	statuses := make([]string, 0, len(entities.SoldOrderStatuses()))
+	for _, status := range entities.SoldOrderStatuses() {
+		statuses = append(statuses, string(status))
+	}
+
+	query := db.WithContext(ctx).
+		Table("order_items").
+		Joins("JOIN orders ON orders.id = order_items.order_id AND orders.deleted_at IS NULL").
+		Where("order_items.deleted_at IS NULL").
+		Where("orders.status IN ?", statuses).
+		Where("orders.order_date >= ? AND orders.order_date < ?", filter.start, nextDay(filter.end))
+
+	if filter.orderType != "" {
+		query = query.Where(orderTypeColumn+" = ?", string(filter.orderType))
+	} else {
+		query = query.Where(orderTypeColumn+" <> ?", string(entities.OrderTypeInventory))
+	}
+	if filter.sellerID != nil {
+		query = query.Where("orders.seller_id = ?", *filter.sellerID)
+	}
+	if filter.categoryID != nil {
+		query = query.Where("order_items.category_id = ?", *filter.categoryID)
+	}
+	if filter.visibleToUserID != nil {
+		query = query.Where(
+			"(orders.seller_id = ? OR orders.id IN (SELECT resource_id FROM record_shares WHERE resource_type = ? AND user_id = ?))",
+			*filter.visibleToUserID, string(entities.ShareResourceOrder), *filter.visibleToUserID,
+		)
+	}
+	return query
+}
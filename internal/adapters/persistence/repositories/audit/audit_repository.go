@@ -43,6 +43,18 @@ func (r *auditLogRepository) List(ctx context.Context, filters entities.AuditLog
 		query = query.Where("event_type = ?", filters.EventType)
 	}
 
+	if filters.EntityType != "" {
+		query = query.Where("entity_type = ?", filters.EntityType)
+	}
+
+	if filters.EntityID != nil {
+		query = query.Where("entity_id = ?", *filters.EntityID)
+	}
+
+	if filters.Action != "" {
+		query = query.Where("action = ?", filters.Action)
+	}
+
 	if filters.OrderID != nil {
 		query = query.Where("order_id = ?", *filters.OrderID)
 	}
@@ -51,6 +63,10 @@ func (r *auditLogRepository) List(ctx context.Context, filters entities.AuditLog
 		query = query.Where("user_id = ?", *filters.UserID)
 	}
 
+	if filters.APIKeyID != nil {
+		query = query.Where("api_key_id = ?", *filters.APIKeyID)
+	}
+
 	if filters.StartDate != nil {
 		query = query.Where("created_at >= ?", *filters.StartDate)
 	}
@@ -85,6 +101,16 @@ func (r *auditLogRepository) GetByOrderID(ctx context.Context, orderID uint) ([]
 	return logs, err
 }
 
+// GetByEntity obtiene el historial de cambios de una entidad
+func (r *auditLogRepository) GetByEntity(ctx context.Context, entityType string, entityID uint) ([]entities.AuditLog, error) {
+	var logs []entities.AuditLog
+	err := r.db.WithContext(ctx).
+		Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		Order("created_at DESC").
+		Find(&logs).Error
+	return logs, err
+}
+
 // GetByUserID obtiene todos los logs de un usuario
 func (r *auditLogRepository) GetByUserID(ctx context.Context, userID uint) ([]entities.AuditLog, error) {
 	var logs []entities.AuditLog
@@ -106,6 +132,18 @@ func (r *auditLogRepository) Count(ctx context.Context, filters entities.AuditLo
 		query = query.Where("event_type = ?", filters.EventType)
 	}
 
+	if filters.EntityType != "" {
+		query = query.Where("entity_type = ?", filters.EntityType)
+	}
+
+	if filters.EntityID != nil {
+		query = query.Where("entity_id = ?", *filters.EntityID)
+	}
+
+	if filters.Action != "" {
+		query = query.Where("action = ?", filters.Action)
+	}
+
 	if filters.OrderID != nil {
 		query = query.Where("order_id = ?", *filters.OrderID)
 	}
@@ -114,6 +152,10 @@ func (r *auditLogRepository) Count(ctx context.Context, filters entities.AuditLo
 		query = query.Where("user_id = ?", *filters.UserID)
 	}
 
+	if filters.APIKeyID != nil {
+		query = query.Where("api_key_id = ?", *filters.APIKeyID)
+	}
+
 	if filters.StartDate != nil {
 		query = query.Where("created_at >= ?", *filters.StartDate)
 	}
@@ -0,0 +1,288 @@
+package campaign
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// deliveredOrdersSubquery agrega las órdenes entregadas de cada cliente
+const deliveredOrdersSubquery = `(SELECT customer_id,
+		COUNT(*) AS orders_count,
+		COALESCE(SUM(total_amount), 0) AS total_spent,
+		MAX(order_date) AS last_order_date
+	FROM orders
+	WHERE status = 'DELIVERED' AND deleted_at IS NULL AND customer_id IS NOT NULL
+	GROUP BY customer_id) AS purchases`
+
+type campaignRepository struct {
+	db *gorm.DB
+}
+
+// NewCampaignRepository crea una nueva instancia del repositorio de campañas
+func NewCampaignRepository(db *gorm.DB) ports.CampaignRepository {
+	return &campaignRepository{db: db}
+}
+
+func (r *campaignRepository) Create(ctx context.Context, campaign *entities.Campaign) error {
+	model := &models.CampaignModel{}
+	model.FromEntity(campaign)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*campaign = *model.ToEntity()
+	return nil
+}
+
+func (r *campaignRepository) GetByID(ctx context.Context, id uint) (*entities.Campaign, error) {
+	var model models.CampaignModel
+	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *campaignRepository) List(ctx context.Context, filters map[string]interface{}) ([]entities.Campaign, error) {
+	var modelList []models.CampaignModel
+	query := r.db.WithContext(ctx)
+
+	if campaignType, ok := filters["type"].(string); ok && campaignType != "" {
+		query = query.Where("type = ?", campaignType)
+	}
+	if isActive, ok := filters["is_active"].(bool); ok {
+		query = query.Where("is_active = ?", isActive)
+	}
+
+	if err := query.Order("created_at DESC").Find(&modelList).Error; err != nil {
+		return nil, err
+	}
+
+	campaigns := make([]entities.Campaign, len(modelList))
+	for i, model := range modelList {
+		campaigns[i] = *model.ToEntity()
+	}
+	return campaigns, nil
+}
+
+func (r *campaignRepository) Update(ctx context.Context, campaign *entities.Campaign) error {
+	model := &models.CampaignModel{}
+	model.FromEntity(campaign)
+
+	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+		return err
+	}
+
+	*campaign = *model.ToEntity()
+	return nil
+}
+
+// FindCustomers selecciona clientes activos según los criterios de la campaña
+func (r *campaignRepository) FindCustomers(ctx context.Context, criteria entities.CampaignCriteria) ([]entities.Customer, error) {
+	var modelList []models.CustomerModel
+	query := r.db.WithContext(ctx).
+		Model(&models.CustomerModel{}).
+		Select("customers.*").
+		Where("customers.is_active = ?", true)
+
+	if criteria.BirthdayMonth != nil {
+		query = query.Where("customers.birthday IS NOT NULL AND EXTRACT(MONTH FROM customers.birthday) = ?", *criteria.BirthdayMonth)
+	}
+	if criteria.ShirtSizeID != nil {
+		query = query.Where("customers.shirt_size_id = ?", *criteria.ShirtSizeID)
+	}
+	if criteria.PantsSizeID != nil {
+		query = query.Where("customers.pants_size_id = ?", *criteria.PantsSizeID)
+	}
+	if criteria.ShoesSizeID != nil {
+		query = query.Where("customers.shoes_size_id = ?", *criteria.ShoesSizeID)
+	}
+
+	// Filtros de historial de compras sobre órdenes entregadas
+	if criteria.MinOrders != nil || criteria.MinSpent != nil ||
+		criteria.PurchasedSinceDays != nil || criteria.InactiveSinceDays != nil {
+		query = query.Joins("LEFT JOIN " + deliveredOrdersSubquery + " ON purchases.customer_id = customers.id")
+
+		if criteria.MinOrders != nil {
+			query = query.Where("COALESCE(purchases.orders_count, 0) >= ?", *criteria.MinOrders)
+		}
+		if criteria.MinSpent != nil {
+			query = query.Where("COALESCE(purchases.total_spent, 0) >= ?", *criteria.MinSpent)
+		}
+		if criteria.PurchasedSinceDays != nil {
+			since := time.Now().AddDate(0, 0, -*criteria.PurchasedSinceDays)
+			query = query.Where("purchases.last_order_date >= ?", since)
+		}
+		if criteria.InactiveSinceDays != nil {
+			since := time.Now().AddDate(0, 0, -*criteria.InactiveSinceDays)
+			query = query.Where("purchases.last_order_date IS NOT NULL AND purchases.last_order_date < ?", since)
+		}
+	}
+
+	if err := query.Order("customers.name ASC").Find(&modelList).Error; err != nil {
+		return nil, err
+	}
+
+	customers := make([]entities.Customer, len(modelList))
+	for i, model := range modelList {
+		customers[i] = *model.ToEntity()
+	}
+	return customers, nil
+}
+
+// CampaignCouponRepository
+type campaignCouponRepository struct {
+	db *gorm.DB
+}
+
+// NewCampaignCouponRepository crea una nueva instancia del repositorio de cupones
+func NewCampaignCouponRepository(db *gorm.DB) ports.CampaignCouponRepository {
+	return &campaignCouponRepository{db: db}
+}
+
+func (r *campaignCouponRepository) Create(ctx context.Context, coupon *entities.CampaignCoupon) error {
+	model := &models.CampaignCouponModel{}
+	model.FromEntity(coupon)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*coupon = *model.ToEntity()
+	return nil
+}
+
+func (r *campaignCouponRepository) GetByCode(ctx context.Context, code string) (*entities.CampaignCoupon, error) {
+	var model models.CampaignCouponModel
+	err := r.db.WithContext(ctx).
+		Preload("Campaign").
+		Preload("Customer").
+		Where("code = ?", code).
+		First(&model).Error
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *campaignCouponRepository) ListByCampaign(ctx context.Context, campaignID uint) ([]entities.CampaignCoupon, error) {
+	var modelList []models.CampaignCouponModel
+	err := r.db.WithContext(ctx).
+		Preload("Customer").
+		Where("campaign_id = ?", campaignID).
+		Order("created_at DESC").
+		Find(&modelList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	coupons := make([]entities.CampaignCoupon, len(modelList))
+	for i, model := range modelList {
+		coupons[i] = *model.ToEntity()
+	}
+	return coupons, nil
+}
+
+func (r *campaignCouponRepository) ListByCustomer(ctx context.Context, customerID uint) ([]entities.CampaignCoupon, error) {
+	var modelList []models.CampaignCouponModel
+	err := r.db.WithContext(ctx).
+		Preload("Campaign").
+		Where("customer_id = ?", customerID).
+		Order("created_at DESC").
+		Find(&modelList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	coupons := make([]entities.CampaignCoupon, len(modelList))
+	for i, model := range modelList {
+		coupons[i] = *model.ToEntity()
+	}
+	return coupons, nil
+}
+
+func (r *campaignCouponRepository) ExistsForCustomer(ctx context.Context, campaignID, customerID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.CampaignCouponModel{}).
+		Where("campaign_id = ? AND customer_id = ?", campaignID, customerID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// MarkRedeemed marca el cupón como redimido de forma atómica
+// Si otro proceso lo redimió primero, retorna ErrCouponRedeemed
+func (r *campaignCouponRepository) MarkRedeemed(ctx context.Context, couponID, orderID uint, discount float64) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.CampaignCouponModel{}).
+		Where("id = ? AND redeemed_at IS NULL", couponID).
+		Updates(map[string]interface{}{
+			"redeemed_at":       time.Now(),
+			"redeemed_order_id": orderID,
+			"discount_applied":  discount,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrCouponRedeemed
+	}
+	return nil
+}
+
+func (r *campaignCouponRepository) ReleaseRedemption(ctx context.Context, couponID uint) error {
+	return r.db.WithContext(ctx).
+		Model(&models.CampaignCouponModel{}).
+		Where("id = ?", couponID).
+		Updates(map[string]interface{}{
+			"redeemed_at":       nil,
+			"redeemed_order_id": nil,
+			"discount_applied":  0,
+		}).Error
+}
+
+func (r *campaignCouponRepository) ReleaseOrderRedemption(ctx context.Context, orderID uint) error {
+	return r.db.WithContext(ctx).
+		Model(&models.CampaignCouponModel{}).
+		Where("redeemed_order_id = ?", orderID).
+		Updates(map[string]interface{}{
+			"redeemed_at":       nil,
+			"redeemed_order_id": nil,
+			"discount_applied":  0,
+		}).Error
+}
+
+func (r *campaignCouponRepository) GetStats(ctx context.Context, campaignID uint) (*entities.CampaignStats, error) {
+	var result struct {
+		Issued   int64
+		Redeemed int64
+		Discount float64
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&models.CampaignCouponModel{}).
+		Where("campaign_id = ?", campaignID).
+		Select(`COUNT(*) AS issued,
+			COUNT(redeemed_at) AS redeemed,
+			COALESCE(SUM(discount_applied), 0) AS discount`).
+		Scan(&result).Error
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &entities.CampaignStats{
+		CampaignID:      campaignID,
+		CouponsIssued:   result.Issued,
+		CouponsRedeemed: result.Redeemed,
+		TotalDiscount:   result.Discount,
+	}
+	if result.Issued > 0 {
+		stats.RedemptionRate = float64(result.Redeemed) / float64(result.Issued) * 100
+	}
+	return stats, nil
+}
@@ -0,0 +1,208 @@
+package cash_session
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// sessionEntries une los abonos cobrados en la sesión (los reversos de abono restan)
+// y las ventas SALE no canceladas, con el método de pago de cada uno
+const sessionEntries = `
+SELECT ct.date AS date, 'PAYMENT' AS kind, ct.id AS source_id,
+	CONCAT(CASE WHEN ct.type = 'ABONO' THEN 'Abono' ELSE 'Reverso de abono' END, ' - ', COALESCE(c.name, '')) AS description,
+	ct.payment_method_id AS payment_method_id, COALESCE(pm.name, '') AS payment_method_name, COALESCE(pm.is_cash, false) AS is_cash,
+	CASE WHEN ct.type = 'ABONO' THEN ct.amount ELSE -ct.amount END AS amount
+FROM customer_transactions ct
+LEFT JOIN customers c ON c.id = ct.customer_id
+LEFT JOIN payment_methods pm ON pm.id = ct.payment_method_id
+WHERE ct.cash_session_id = ? AND ct.payment_method_id IS NOT NULL
+UNION ALL
+SELECT o.order_date, 'SALE', o.id, CONCAT('Venta ', o.order_number, ' - ', o.customer_name),
+	o.payment_method_id, COALESCE(pm.name, ''), COALESCE(pm.is_cash, false), o.total_amount
+FROM orders o
+LEFT JOIN payment_methods pm ON pm.id = o.payment_method_id
+WHERE o.cash_session_id = ? AND o.status <> 'CANCELLED' AND o.deleted_at IS NULL
+ORDER BY date ASC, source_id ASC
+`
+
+type cashSessionRepository struct {
+	db *gorm.DB
+}
+
+// NewCashSessionRepository crea una nueva instancia del repositorio de sesiones de caja
+func NewCashSessionRepository(db *gorm.DB) ports.CashSessionRepository {
+	return &cashSessionRepository{db: db}
+}
+
+// orderedTotals carga los totales en el orden en que se guardaron
+func orderedTotals(db *gorm.DB) *gorm.DB {
+	return db.Order("id ASC")
+}
+
+func (r *cashSessionRepository) Create(ctx context.Context, session *entities.CashSession) error {
+	model := &models.CashSessionModel{}
+	model.FromEntity(session)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*session = *model.ToEntity()
+	return nil
+}
+
+func (r *cashSessionRepository) GetByID(ctx context.Context, id uint) (*entities.CashSession, error) {
+	var model models.CashSessionModel
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Preload("Totals", orderedTotals).
+		First(&model, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *cashSessionRepository) GetOpenByUser(ctx context.Context, userID uint) (*entities.CashSession, error) {
+	var model models.CashSessionModel
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("user_id = ? AND status = ?", userID, string(entities.CashSessionOpen)).
+		First(&model).Error
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *cashSessionRepository) List(ctx context.Context, filters map[string]interface{}) ([]entities.CashSession, error) {
+	query := r.db.WithContext(ctx).
+		Preload("User").
+		Preload("Totals", orderedTotals).
+		Order("opened_at DESC, id DESC")
+
+	if userID, ok := filters["user_id"].(uint); ok && userID != 0 {
+		query = query.Where("user_id = ?", userID)
+	}
+	if status, ok := filters["status"].(string); ok && status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if location, ok := filters["location"].(string); ok && location != "" {
+		query = query.Where("location ILIKE ?", "%"+location+"%")
+	}
+	if startDate, ok := filters["start_date"].(string); ok && startDate != "" {
+		query = query.Where("DATE(opened_at) >= ?", startDate)
+	}
+	if endDate, ok := filters["end_date"].(string); ok && endDate != "" {
+		query = query.Where("DATE(opened_at) <= ?", endDate)
+	}
+
+	var modelList []models.CashSessionModel
+	if err := query.Find(&modelList).Error; err != nil {
+		return nil, err
+	}
+
+	sessions := make([]entities.CashSession, len(modelList))
+	for i := range modelList {
+		sessions[i] = *modelList[i].ToEntity()
+	}
+	return sessions, nil
+}
+
+func (r *cashSessionRepository) Close(ctx context.Context, session *entities.CashSession, reconcile func(entries []entities.CashSessionEntry) error) ([]entities.CashSessionEntry, error) {
+	var entries []entities.CashSessionEntry
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// El bloqueo espera a los abonos y ventas en curso (toman la sesión FOR SHARE al insertarse)
+		// y deja fuera los que lleguen después: los totales cuadran con lo que quedó asociado
+		var locked models.CashSessionModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "status").
+			First(&locked, session.ID).Error
+		if err != nil {
+			return err
+		}
+		if locked.Status != string(entities.CashSessionOpen) {
+			return entities.ErrCashSessionNotOpen
+		}
+
+		entries, err = listEntries(tx, session.ID)
+		if err != nil {
+			return err
+		}
+		if err := reconcile(entries); err != nil {
+			return err
+		}
+
+		err = tx.Model(&models.CashSessionModel{}).
+			Where("id = ?", session.ID).
+			Updates(map[string]interface{}{
+				"status":        string(session.Status),
+				"closed_at":     session.ClosedAt,
+				"closed_by_id":  session.ClosedByID,
+				"expected_cash": session.ExpectedCash,
+				"counted_cash":  session.CountedCash,
+				"difference":    session.Difference,
+				"notes":         session.Notes,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		if len(session.Totals) == 0 {
+			return nil
+		}
+		totals := make([]models.CashSessionTotalModel, len(session.Totals))
+		for i := range session.Totals {
+			totals[i].FromEntity(session.ID, &session.Totals[i])
+		}
+		return tx.Create(&totals).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (r *cashSessionRepository) ListEntries(ctx context.Context, sessionID uint) ([]entities.CashSessionEntry, error) {
+	return listEntries(r.db.WithContext(ctx), sessionID)
+}
+
+// listEntries lee los movimientos de la sesión con db (la conexión o la transacción del cierre)
+func listEntries(db *gorm.DB, sessionID uint) ([]entities.CashSessionEntry, error) {
+	var rows []struct {
+		Date              time.Time
+		Kind              string
+		SourceID          uint
+		Description       string
+		PaymentMethodID   *uint
+		PaymentMethodName string
+		IsCash            bool
+		Amount            float64
+	}
+	err := db.Raw(sessionEntries, sessionID, sessionID).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]entities.CashSessionEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = entities.CashSessionEntry{
+			Date:              row.Date,
+			Kind:              entities.CashSessionEntryKind(row.Kind),
+			SourceID:          row.SourceID,
+			Description:       row.Description,
+			PaymentMethodID:   row.PaymentMethodID,
+			PaymentMethodName: row.PaymentMethodName,
+			IsCash:            row.IsCash,
+			Amount:            row.Amount,
+		}
+	}
+	return entries, nil
+}
@@ -0,0 +1,209 @@
+package commission
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+type commissionRuleRepository struct {
+	db *gorm.DB
+}
+
+// NewCommissionRuleRepository crea una nueva instancia del repositorio de reglas de comisión
+func NewCommissionRuleRepository(db *gorm.DB) ports.CommissionRuleRepository {
+	return &commissionRuleRepository{db: db}
+}
+
+func (r *commissionRuleRepository) Create(ctx context.Context, rule *entities.CommissionRule) error {
+	model := &models.CommissionRuleModel{}
+	model.FromEntity(rule)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*rule = *model.ToEntity()
+	return nil
+}
+
+func (r *commissionRuleRepository) GetByID(ctx context.Context, id uint) (*entities.CommissionRule, error) {
+	var model models.CommissionRuleModel
+	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *commissionRuleRepository) List(ctx context.Context, activeOnly bool) ([]entities.CommissionRule, error) {
+	var modelList []models.CommissionRuleModel
+	query := r.db.WithContext(ctx)
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+
+	if err := query.Order("created_at ASC").Find(&modelList).Error; err != nil {
+		return nil, err
+	}
+
+	rules := make([]entities.CommissionRule, len(modelList))
+	for i, model := range modelList {
+		rules[i] = *model.ToEntity()
+	}
+	return rules, nil
+}
+
+func (r *commissionRuleRepository) Update(ctx context.Context, rule *entities.CommissionRule) error {
+	model := &models.CommissionRuleModel{}
+	model.FromEntity(rule)
+
+	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+		return err
+	}
+
+	*rule = *model.ToEntity()
+	return nil
+}
+
+func (r *commissionRuleRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&models.CommissionRuleModel{}, id).Error
+}
+
+// CommissionEntryRepository
+type commissionEntryRepository struct {
+	db *gorm.DB
+}
+
+// NewCommissionEntryRepository crea una nueva instancia del repositorio del libro de comisiones
+func NewCommissionEntryRepository(db *gorm.DB) ports.CommissionEntryRepository {
+	return &commissionEntryRepository{db: db}
+}
+
+func (r *commissionEntryRepository) Create(ctx context.Context, entry *entities.CommissionEntry) error {
+	model := &models.CommissionEntryModel{}
+	model.FromEntity(entry)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*entry = *model.ToEntity()
+	return nil
+}
+
+func (r *commissionEntryRepository) GetByOrder(ctx context.Context, orderID uint, entryType entities.CommissionEntryType) (*entities.CommissionEntry, error) {
+	var model models.CommissionEntryModel
+	err := r.db.WithContext(ctx).
+		Where("order_id = ? AND type = ?", orderID, string(entryType)).
+		First(&model).Error
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *commissionEntryRepository) SumBaseAmount(ctx context.Context, sellerID uint, from, to time.Time) (float64, error) {
+	var total float64
+	err := r.db.WithContext(ctx).
+		Model(&models.CommissionEntryModel{}).
+		Where("seller_id = ? AND created_at >= ? AND created_at < ?", sellerID, from, to).
+		Select("COALESCE(SUM(base_amount), 0)").
+		Scan(&total).Error
+	return total, err
+}
+
+func (r *commissionEntryRepository) ListBySeller(ctx context.Context, sellerID uint, from, to time.Time) ([]entities.CommissionEntry, error) {
+	var modelList []models.CommissionEntryModel
+	err := r.db.WithContext(ctx).
+		Where("seller_id = ? AND created_at >= ? AND created_at < ?", sellerID, from, to).
+		Order("created_at ASC, id ASC").
+		Find(&modelList).Error
+	if err != nil {
+		return nil, err
+	}
+	return toCommissionEntries(modelList), nil
+}
+
+func (r *commissionEntryRepository) ListUnpaid(ctx context.Context, sellerID uint, before time.Time) ([]entities.CommissionEntry, error) {
+	var modelList []models.CommissionEntryModel
+	err := r.db.WithContext(ctx).
+		Where("seller_id = ? AND payout_id IS NULL AND created_at < ?", sellerID, before).
+		Order("created_at ASC, id ASC").
+		Find(&modelList).Error
+	if err != nil {
+		return nil, err
+	}
+	return toCommissionEntries(modelList), nil
+}
+
+func toCommissionEntries(modelList []models.CommissionEntryModel) []entities.CommissionEntry {
+	entries := make([]entities.CommissionEntry, len(modelList))
+	for i, model := range modelList {
+		entries[i] = *model.ToEntity()
+	}
+	return entries
+}
+
+// CommissionPayoutRepository
+type commissionPayoutRepository struct {
+	db *gorm.DB
+}
+
+// NewCommissionPayoutRepository crea una nueva instancia del repositorio de liquidaciones de comisiones
+func NewCommissionPayoutRepository(db *gorm.DB) ports.CommissionPayoutRepository {
+	return &commissionPayoutRepository{db: db}
+}
+
+func (r *commissionPayoutRepository) Settle(ctx context.Context, payout *entities.CommissionPayout, transaction *entities.FinancialTransaction, entryIDs []uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		transactionModel := &models.FinancialTransactionModel{}
+		transactionModel.FromEntity(transaction)
+		if err := tx.Create(transactionModel).Error; err != nil {
+			return err
+		}
+
+		payout.FinancialTransactionID = transactionModel.ID
+		payoutModel := &models.CommissionPayoutModel{}
+		payoutModel.FromEntity(payout)
+		if err := tx.Create(payoutModel).Error; err != nil {
+			return err
+		}
+
+		// Solo se marcan los que siguen sin pagar: si otro proceso ganó la carrera se deshace todo
+		result := tx.Model(&models.CommissionEntryModel{}).
+			Where("id IN ? AND payout_id IS NULL", entryIDs).
+			Update("payout_id", payoutModel.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != int64(len(entryIDs)) {
+			return entities.ErrCommissionsAlreadyPaid
+		}
+
+		*transaction = *transactionModel.ToEntity()
+		*payout = *payoutModel.ToEntity()
+		return nil
+	})
+}
+
+func (r *commissionPayoutRepository) List(ctx context.Context, sellerID *uint, from, to time.Time) ([]entities.CommissionPayout, error) {
+	var modelList []models.CommissionPayoutModel
+	query := r.db.WithContext(ctx).Where("created_at >= ? AND created_at < ?", from, to)
+	if sellerID != nil {
+		query = query.Where("seller_id = ?", *sellerID)
+	}
+
+	if err := query.Order("created_at DESC").Find(&modelList).Error; err != nil {
+		return nil, err
+	}
+
+	payouts := make([]entities.CommissionPayout, len(modelList))
+	for i, model := range modelList {
+		payouts[i] = *model.ToEntity()
+	}
+	return payouts, nil
+}
@@ -0,0 +1,54 @@
+package customer
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+type customerAccessLinkRepository struct {
+	db *gorm.DB
+}
+
+// NewCustomerAccessLinkRepository crea una nueva instancia del repositorio de enlaces de acceso
+func NewCustomerAccessLinkRepository(db *gorm.DB) ports.CustomerAccessLinkRepository {
+	return &customerAccessLinkRepository{db: db}
+}
+
+func (r *customerAccessLinkRepository) Create(ctx context.Context, link *entities.CustomerAccessLink) error {
+	model := &models.CustomerAccessLinkModel{}
+	model.FromEntity(link)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*link = *model.ToEntity()
+	return nil
+}
+
+func (r *customerAccessLinkRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entities.CustomerAccessLink, error) {
+	var model models.CustomerAccessLinkModel
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&model).Error; err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *customerAccessLinkRepository) MarkUsed(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.CustomerAccessLinkModel{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrInvalidAccessLink
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package customer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type customerMergeRepository struct {
+	db *gorm.DB
+}
+
+// NewCustomerMergeRepository crea una nueva instancia del repositorio de fusiones
+func NewCustomerMergeRepository(db *gorm.DB) ports.CustomerMergeRepository {
+	return &customerMergeRepository{db: db}
+}
+
+// Merge ejecuta la fusión completa dentro de una transacción
+// Bloquea ambos clientes y verifica que sigan activos, para que dos fusiones simultáneas no crucen datos
+func (r *customerMergeRepository) Merge(ctx context.Context, survivor *entities.Customer, record *entities.CustomerMerge) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		survivorID, mergedID := record.SurvivorID, record.MergedID
+
+		var locked []models.CustomerModel
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ?", []uint{survivorID, mergedID}).
+			Order("id").
+			Find(&locked).Error; err != nil {
+			return err
+		}
+		if len(locked) != 2 {
+			return gorm.ErrRecordNotFound
+		}
+		for _, customer := range locked {
+			if !customer.IsActive {
+				return entities.ErrMergeInactiveCustomer
+			}
+		}
+
+		// Guardar los datos completados del cliente conservado (tallas, cumpleaños, etc.)
+		survivorModel := &models.CustomerModel{}
+		survivorModel.FromEntity(survivor)
+		if err := tx.Omit("ShirtSize", "PantsSize", "ShoesSize").Save(survivorModel).Error; err != nil {
+			return err
+		}
+
+		// Mover transacciones (el saldo se calcula a partir de ellas)
+		result := tx.Model(&models.CustomerTransactionModel{}).
+			Where("customer_id = ?", mergedID).
+			Update("customer_id", survivorID)
+		if result.Error != nil {
+			return result.Error
+		}
+		record.TransactionsMoved = result.RowsAffected
+
+		// Mover órdenes (se conserva el nombre del cliente como snapshot de la orden)
+		result = tx.Model(&models.OrderModel{}).
+			Where("customer_id = ?", mergedID).
+			Update("customer_id", survivorID)
+		if result.Error != nil {
+			return result.Error
+		}
+		record.OrdersMoved = result.RowsAffected
+
+		// Mover cupones, salvo los de campañas donde el conservado ya tiene uno
+		result = tx.Model(&models.CampaignCouponModel{}).
+			Where("customer_id = ?", mergedID).
+			Where("campaign_id NOT IN (?)", tx.Model(&models.CampaignCouponModel{}).
+				Select("campaign_id").
+				Where("customer_id = ?", survivorID)).
+			Update("customer_id", survivorID)
+		if result.Error != nil {
+			return result.Error
+		}
+		record.CouponsMoved = result.RowsAffected
+
+		// Mover el libro de puntos
+		result = tx.Model(&models.LoyaltyPointsEntryModel{}).
+			Where("customer_id = ?", mergedID).
+			Update("customer_id", survivorID)
+		if result.Error != nil {
+			return result.Error
+		}
+		record.LoyaltyEntriesMoved = result.RowsAffected
+
+		// Desactivar el cliente fusionado (se conserva para la auditoría)
+		var merged models.CustomerModel
+		if err := tx.First(&merged, mergedID).Error; err != nil {
+			return err
+		}
+		note := fmt.Sprintf("Fusionado en el cliente #%d", survivorID)
+		if strings.TrimSpace(merged.Notes) != "" {
+			note = merged.Notes + "\n" + note
+		}
+		if err := tx.Model(&models.CustomerModel{}).
+			Where("id = ?", mergedID).
+			Updates(map[string]interface{}{
+				"is_active": false,
+				"notes":     note,
+			}).Error; err != nil {
+			return err
+		}
+
+		// Recalcular el saldo del cliente conservado
+		if err := tx.Model(&models.CustomerTransactionModel{}).
+			Where("customer_id = ?", survivorID).
+			Select(`COALESCE(
+				SUM(CASE WHEN type = 'DEUDA' THEN amount ELSE 0 END) -
+				SUM(CASE WHEN type = 'ABONO' THEN amount ELSE 0 END),
+				0
+			)`).
+			Scan(&record.ResultingBalance).Error; err != nil {
+			return err
+		}
+
+		model := &models.CustomerMergeModel{}
+		model.FromEntity(record)
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+
+		*record = *model.ToEntity()
+		return nil
+	})
+}
+
+func (r *customerMergeRepository) ListByCustomer(ctx context.Context, customerID uint) ([]entities.CustomerMerge, error) {
+	var modelList []models.CustomerMergeModel
+	err := r.db.WithContext(ctx).
+		Where("survivor_id = ? OR merged_id = ?", customerID, customerID).
+		Order("created_at DESC").
+		Find(&modelList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	merges := make([]entities.CustomerMerge, len(modelList))
+	for i, model := range modelList {
+		merges[i] = *model.ToEntity()
+	}
+	return merges, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type customerRepository struct {
@@ -60,6 +61,25 @@ func (r *customerRepository) GetByDocument(ctx context.Context, documentNum stri
 	return model.ToEntity(), nil
 }
 
+// ListByPhone busca clientes activos comparando los últimos 10 dígitos del teléfono
+// (ignora espacios, guiones y el indicativo del país)
+func (r *customerRepository) ListByPhone(ctx context.Context, phoneDigits string) ([]entities.Customer, error) {
+	var modelList []models.CustomerModel
+	err := r.db.WithContext(ctx).
+		Where("is_active = ?", true).
+		Where("RIGHT(regexp_replace(phone, '[^0-9]', '', 'g'), 10) = ?", phoneDigits).
+		Find(&modelList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	customers := make([]entities.Customer, len(modelList))
+	for i, model := range modelList {
+		customers[i] = *model.ToEntity()
+	}
+	return customers, nil
+}
+
 func (r *customerRepository) List(ctx context.Context, filters map[string]interface{}) ([]entities.Customer, error) {
 	var modelList []models.CustomerModel
 	query := r.db.WithContext(ctx).
@@ -79,6 +99,15 @@ func (r *customerRepository) List(ctx context.Context, filters map[string]interf
 		query = query.Where("name ILIKE ?", "%"+name+"%")
 	}
 
+	// Clientes asignados o compartidos con el usuario (vendedores sin customers:all)
+	// Un alcance sin usuario (0) no ve ningún registro
+	if userID, ok := filters["visible_to_user_id"].(uint); ok {
+		query = query.Where(
+			"customers.assigned_seller_id = ? OR customers.id IN (SELECT resource_id FROM record_shares WHERE resource_type = ? AND user_id = ?)",
+			userID, string(entities.ShareResourceCustomer), userID,
+		)
+	}
+
 	// Aplicar ordenamiento
 	sortBy := "name ASC" // Por defecto ordenar por nombre
 	if sort, ok := filters["sort"].(string); ok && sort != "" {
@@ -140,6 +169,20 @@ func (r *customerRepository) Update(ctx context.Context, customer *entities.Cust
 	return nil
 }
 
+func (r *customerRepository) UpdateAssignedSeller(ctx context.Context, id uint, sellerID *uint) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.CustomerModel{}).
+		Where("id = ?", id).
+		Update("assigned_seller_id", sellerID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
 func (r *customerRepository) Delete(ctx context.Context, id uint) error {
 	return r.db.WithContext(ctx).Delete(&models.CustomerModel{}, id).Error
 }
@@ -188,6 +231,22 @@ func (r *customerRepository) GetBalance(ctx context.Context, customerID uint) (f
 	return balance, err
 }
 
+// GetTotalBalance calcula la cartera total de todos los clientes
+// Balance = Σ(DEUDA) - Σ(ABONO)
+func (r *customerRepository) GetTotalBalance(ctx context.Context) (float64, error) {
+	var balance float64
+
+	err := r.db.WithContext(ctx).
+		Model(&models.CustomerTransactionModel{}).
+		Select(`COALESCE(
+			SUM(CASE WHEN type = 'DEUDA' THEN amount ELSE -amount END),
+			0
+		)`).
+		Scan(&balance).Error
+
+	return balance, err
+}
+
 // CustomerTransactionRepository
 type customerTransactionRepository struct {
 	db *gorm.DB
@@ -259,3 +318,34 @@ func (r *customerTransactionRepository) List(ctx context.Context, filters map[st
 
 	return transactions, nil
 }
+
+func (r *customerTransactionRepository) Reverse(ctx context.Context, originalID uint, reversal *entities.CustomerTransaction) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Bloquear el original para que dos reversos simultáneos no lo anulen dos veces
+		var original models.CustomerTransactionModel
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&original, originalID).Error; err != nil {
+			return err
+		}
+		if original.ReversalOfID != nil {
+			return entities.ErrCannotReverseReversal
+		}
+		if original.ReversedByID != nil {
+			return entities.ErrTransactionAlreadyReversed
+		}
+
+		model := &models.CustomerTransactionModel{}
+		model.FromEntity(reversal)
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.CustomerTransactionModel{}).
+			Where("id = ?", originalID).
+			Update("reversed_by_id", model.ID).Error; err != nil {
+			return err
+		}
+
+		*reversal = *model.ToEntity()
+		return nil
+	})
+}
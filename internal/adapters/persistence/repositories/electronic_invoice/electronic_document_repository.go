@@ -0,0 +1,203 @@
+package electronic_invoice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type electronicDocumentRepository struct {
+	db *gorm.DB
+}
+
+// NewElectronicDocumentRepository crea una nueva instancia del repositorio de documentos electrónicos
+func NewElectronicDocumentRepository(db *gorm.DB) ports.ElectronicDocumentRepository {
+	return &electronicDocumentRepository{db: db}
+}
+
+// orderedDocumentLines carga las líneas en el orden del documento
+func orderedDocumentLines(db *gorm.DB) *gorm.DB {
+	return db.Order("line_number ASC")
+}
+
+func (r *electronicDocumentRepository) CreateNumbered(ctx context.Context, document *entities.ElectronicDocument, prepare func(resolution *entities.InvoiceResolution) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if document.Type == entities.ElectronicInvoice && document.OrderID != nil {
+			if err := ensureOrderNotInvoiced(tx, *document.OrderID); err != nil {
+				return err
+			}
+		}
+
+		// El bloqueo serializa la numeración: dos emisiones simultáneas no toman el mismo consecutivo
+		issueDay := document.IssueDate.In(entities.DianLocation).Format("2006-01-02")
+		var resolutionModel models.InvoiceResolutionModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("document_type = ? AND is_active = ? AND next_number <= range_to", string(document.Type), true).
+			Where("valid_from <= ? AND valid_to >= ?", issueDay, issueDay).
+			Order("valid_to ASC, id ASC").
+			First(&resolutionModel).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entities.ErrNoActiveInvoiceResolution
+		}
+		if err != nil {
+			return err
+		}
+
+		resolution := resolutionModel.ToEntity()
+		document.ResolutionID = resolution.ID
+		document.Prefix = resolution.Prefix
+		document.Number = resolution.NextNumber
+		if err := prepare(resolution); err != nil {
+			return err
+		}
+
+		model := &models.ElectronicDocumentModel{}
+		model.FromEntity(document)
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.InvoiceResolutionModel{}).
+			Where("id = ?", resolution.ID).
+			Update("next_number", gorm.Expr("next_number + 1")).Error; err != nil {
+			return err
+		}
+
+		document.ID = model.ID
+		document.CreatedAt = model.CreatedAt
+		document.UpdatedAt = model.UpdatedAt
+		for i := range document.Lines {
+			document.Lines[i].ID = model.Lines[i].ID
+			document.Lines[i].DocumentID = model.ID
+		}
+		return nil
+	})
+}
+
+// ensureOrderNotInvoiced bloquea la orden y verifica que no tenga una factura vigente
+// El bloqueo serializa dos facturas simultáneas de la misma orden: la segunda ve la primera
+func ensureOrderNotInvoiced(tx *gorm.DB, orderID uint) error {
+	var order models.OrderModel
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		First(&order, orderID).Error
+	if err != nil {
+		return err
+	}
+
+	var previous models.ElectronicDocumentModel
+	err = tx.Select("id", "total").
+		Where("type = ? AND order_id = ? AND status <> ?", string(entities.ElectronicInvoice), orderID, string(entities.ElectronicDocumentRejected)).
+		Order("id DESC").
+		First(&previous).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var credited float64
+	err = tx.Model(&models.ElectronicDocumentModel{}).
+		Select("COALESCE(SUM(total), 0)").
+		Where("type = ? AND reference_id = ? AND status <> ?", string(entities.ElectronicCreditNote), previous.ID, string(entities.ElectronicDocumentRejected)).
+		Scan(&credited).Error
+	if err != nil {
+		return err
+	}
+	if credited < previous.Total-0.005 {
+		return entities.ErrOrderAlreadyInvoiced
+	}
+	return nil
+}
+
+func (r *electronicDocumentRepository) UpdateSubmission(ctx context.Context, document *entities.ElectronicDocument) error {
+	return r.db.WithContext(ctx).
+		Model(&models.ElectronicDocumentModel{}).
+		Where("id = ?", document.ID).
+		Updates(map[string]interface{}{
+			"status":            string(document.Status),
+			"provider_track_id": document.ProviderTrackID,
+			"provider_message":  document.ProviderMessage,
+			"submitted_at":      document.SubmittedAt,
+		}).Error
+}
+
+func (r *electronicDocumentRepository) GetByID(ctx context.Context, id uint) (*entities.ElectronicDocument, error) {
+	var model models.ElectronicDocumentModel
+	if err := r.db.WithContext(ctx).Preload("Lines", orderedDocumentLines).First(&model, id).Error; err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *electronicDocumentRepository) GetLastInvoiceByOrder(ctx context.Context, orderID uint) (*entities.ElectronicDocument, error) {
+	var model models.ElectronicDocumentModel
+	err := r.db.WithContext(ctx).
+		Preload("Lines", orderedDocumentLines).
+		Where("type = ? AND order_id = ? AND status <> ?", string(entities.ElectronicInvoice), orderID, string(entities.ElectronicDocumentRejected)).
+		Order("id DESC").
+		First(&model).Error
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *electronicDocumentRepository) ListCreditNotes(ctx context.Context, invoiceID uint) ([]entities.ElectronicDocument, error) {
+	var modelList []models.ElectronicDocumentModel
+	if err := r.db.WithContext(ctx).
+		Preload("Lines", orderedDocumentLines).
+		Where("type = ? AND reference_id = ? AND status <> ?", string(entities.ElectronicCreditNote), invoiceID, string(entities.ElectronicDocumentRejected)).
+		Order("id ASC").
+		Find(&modelList).Error; err != nil {
+		return nil, err
+	}
+
+	documents := make([]entities.ElectronicDocument, len(modelList))
+	for i := range modelList {
+		documents[i] = *modelList[i].ToEntity()
+	}
+	return documents, nil
+}
+
+func (r *electronicDocumentRepository) List(ctx context.Context, filters map[string]interface{}) ([]entities.ElectronicDocument, error) {
+	// El XML puede ser extenso: el listado no lo carga (se descarga por documento)
+	query := r.db.WithContext(ctx).
+		Omit("xml").
+		Order("issue_date DESC, id DESC")
+
+	if documentType, ok := filters["type"].(string); ok && documentType != "" {
+		query = query.Where("type = ?", documentType)
+	}
+	if status, ok := filters["status"].(string); ok && status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if orderID, ok := filters["order_id"].(uint); ok && orderID != 0 {
+		query = query.Where("order_id = ?", orderID)
+	}
+	if customerID, ok := filters["customer_id"].(uint); ok && customerID != 0 {
+		query = query.Where("customer_id = ?", customerID)
+	}
+	if startDate, ok := filters["start_date"].(string); ok && startDate != "" {
+		query = query.Where("DATE(issue_date) >= ?", startDate)
+	}
+	if endDate, ok := filters["end_date"].(string); ok && endDate != "" {
+		query = query.Where("DATE(issue_date) <= ?", endDate)
+	}
+
+	var modelList []models.ElectronicDocumentModel
+	if err := query.Find(&modelList).Error; err != nil {
+		return nil, err
+	}
+
+	documents := make([]entities.ElectronicDocument, len(modelList))
+	for i := range modelList {
+		documents[i] = *modelList[i].ToEntity()
+	}
+	return documents, nil
+}
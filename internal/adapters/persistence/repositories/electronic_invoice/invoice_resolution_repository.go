@@ -0,0 +1,78 @@
+package electronic_invoice
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+type invoiceResolutionRepository struct {
+	db *gorm.DB
+}
+
+// NewInvoiceResolutionRepository crea una nueva instancia del repositorio de resoluciones de numeración
+func NewInvoiceResolutionRepository(db *gorm.DB) ports.InvoiceResolutionRepository {
+	return &invoiceResolutionRepository{db: db}
+}
+
+func (r *invoiceResolutionRepository) Create(ctx context.Context, resolution *entities.InvoiceResolution) error {
+	// Dos rangos del mismo prefijo no pueden cruzarse: el número completo del documento sería el mismo
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&models.InvoiceResolutionModel{}).
+		Where("prefix = ? AND range_from <= ? AND range_to >= ?", resolution.Prefix, resolution.RangeTo, resolution.RangeFrom).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return entities.ErrInvoiceResolutionOverlap
+	}
+
+	model := &models.InvoiceResolutionModel{}
+	model.FromEntity(resolution)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*resolution = *model.ToEntity()
+	return nil
+}
+
+func (r *invoiceResolutionRepository) Update(ctx context.Context, resolution *entities.InvoiceResolution) error {
+	return r.db.WithContext(ctx).
+		Model(&models.InvoiceResolutionModel{}).
+		Where("id = ?", resolution.ID).
+		Updates(map[string]interface{}{
+			"valid_to":  resolution.ValidTo,
+			"is_active": resolution.IsActive,
+		}).Error
+}
+
+func (r *invoiceResolutionRepository) GetByID(ctx context.Context, id uint) (*entities.InvoiceResolution, error) {
+	var model models.InvoiceResolutionModel
+	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *invoiceResolutionRepository) List(ctx context.Context, documentType entities.ElectronicDocumentType) ([]entities.InvoiceResolution, error) {
+	query := r.db.WithContext(ctx).Order("valid_to DESC, id DESC")
+	if documentType != "" {
+		query = query.Where("document_type = ?", string(documentType))
+	}
+
+	var modelList []models.InvoiceResolutionModel
+	if err := query.Find(&modelList).Error; err != nil {
+		return nil, err
+	}
+
+	resolutions := make([]entities.InvoiceResolution, len(modelList))
+	for i := range modelList {
+		resolutions[i] = *modelList[i].ToEntity()
+	}
+	return resolutions, nil
+}
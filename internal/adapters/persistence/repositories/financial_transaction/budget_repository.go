@@ -0,0 +1,92 @@
+package financial_transaction
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type budgetRepository struct {
+	db *gorm.DB
+}
+
+// NewBudgetRepository crea una nueva instancia del repositorio de presupuestos
+func NewBudgetRepository(db *gorm.DB) ports.BudgetRepository {
+	return &budgetRepository{db: db}
+}
+
+func (r *budgetRepository) Upsert(ctx context.Context, budget *entities.Budget) error {
+	model := &models.BudgetModel{}
+	model.FromEntity(budget)
+	model.AlertedAt = nil
+
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "category"}, {Name: "month"}},
+			DoUpdates: clause.AssignmentColumns([]string{"amount", "alerted_at", "updated_at"}),
+		}).
+		Create(model).Error
+	if err != nil {
+		return err
+	}
+
+	// Con ON CONFLICT el ID del modelo puede no venir; se relee la fila guardada
+	var saved models.BudgetModel
+	err = r.db.WithContext(ctx).
+		Where("category = ? AND month = ?", model.Category, model.Month).
+		First(&saved).Error
+	if err != nil {
+		return err
+	}
+
+	*budget = *saved.ToEntity()
+	return nil
+}
+
+func (r *budgetRepository) GetByID(ctx context.Context, id uint) (*entities.Budget, error) {
+	var model models.BudgetModel
+	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *budgetRepository) ListByMonth(ctx context.Context, month time.Time) ([]entities.Budget, error) {
+	var modelList []models.BudgetModel
+	err := r.db.WithContext(ctx).
+		Where("month = ?", entities.MonthStart(month)).
+		Order("category ASC").
+		Find(&modelList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	budgets := make([]entities.Budget, len(modelList))
+	for i, model := range modelList {
+		budgets[i] = *model.ToEntity()
+	}
+	return budgets, nil
+}
+
+func (r *budgetRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.BudgetModel{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *budgetRepository) MarkAlerted(ctx context.Context, id uint, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&models.BudgetModel{}).
+		Where("id = ?", id).
+		Update("alerted_at", at).Error
+}
@@ -77,6 +77,9 @@ func (r *financialTransactionRepository) List(ctx context.Context, filters map[s
 	if endDate, ok := filters["end_date"].(string); ok && endDate != "" {
 		query = query.Where("date <= ?", endDate)
 	}
+	if paymentMethodID, ok := filters["payment_method_id"].(string); ok && paymentMethodID != "" {
+		query = query.Where("payment_method_id = ?", paymentMethodID)
+	}
 
 	if err := query.Find(&models).Error; err != nil {
 		return nil, err
@@ -0,0 +1,123 @@
+package financial_transaction
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+type recurringTransactionRepository struct {
+	db *gorm.DB
+}
+
+// NewRecurringTransactionRepository crea una nueva instancia del repositorio de transacciones recurrentes
+func NewRecurringTransactionRepository(db *gorm.DB) ports.RecurringTransactionRepository {
+	return &recurringTransactionRepository{db: db}
+}
+
+func (r *recurringTransactionRepository) Create(ctx context.Context, recurring *entities.RecurringTransaction) error {
+	model := &models.RecurringTransactionModel{}
+	model.FromEntity(recurring)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*recurring = *model.ToEntity()
+	return nil
+}
+
+func (r *recurringTransactionRepository) GetByID(ctx context.Context, id uint) (*entities.RecurringTransaction, error) {
+	var model models.RecurringTransactionModel
+	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *recurringTransactionRepository) List(ctx context.Context, activeOnly bool) ([]entities.RecurringTransaction, error) {
+	query := r.db.WithContext(ctx)
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+	return r.find(query.Order("next_run_date ASC, id ASC"))
+}
+
+func (r *recurringTransactionRepository) Update(ctx context.Context, recurring *entities.RecurringTransaction) error {
+	model := &models.RecurringTransactionModel{}
+	model.FromEntity(recurring)
+
+	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+		return err
+	}
+
+	*recurring = *model.ToEntity()
+	return nil
+}
+
+func (r *recurringTransactionRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.RecurringTransactionModel{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *recurringTransactionRepository) ListDue(ctx context.Context, today time.Time) ([]entities.RecurringTransaction, error) {
+	query := r.db.WithContext(ctx).
+		Where("is_active = ? AND next_run_date <= ?", true, today).
+		Where("end_date IS NULL OR next_run_date <= end_date").
+		Order("next_run_date ASC, id ASC")
+	return r.find(query)
+}
+
+func (r *recurringTransactionRepository) find(query *gorm.DB) ([]entities.RecurringTransaction, error) {
+	var modelList []models.RecurringTransactionModel
+	if err := query.Find(&modelList).Error; err != nil {
+		return nil, err
+	}
+
+	recurringList := make([]entities.RecurringTransaction, len(modelList))
+	for i, model := range modelList {
+		recurringList[i] = *model.ToEntity()
+	}
+	return recurringList, nil
+}
+
+func (r *recurringTransactionRepository) Generate(ctx context.Context, recurring *entities.RecurringTransaction, transaction *entities.FinancialTransaction, nextRunDate time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Avanzar la plantilla solo si sigue en la misma ocurrencia: si otro proceso ganó, no se duplica
+		runDate := recurring.NextRunDate
+		result := tx.Model(&models.RecurringTransactionModel{}).
+			Where("id = ? AND next_run_date = ?", recurring.ID, runDate).
+			Updates(map[string]interface{}{
+				"next_run_date": nextRunDate,
+				"last_run_date": runDate,
+				"updated_at":    time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entities.ErrRecurringAlreadyGenerated
+		}
+
+		transactionModel := &models.FinancialTransactionModel{}
+		transactionModel.FromEntity(transaction)
+		if err := tx.Create(transactionModel).Error; err != nil {
+			return err
+		}
+
+		*transaction = *transactionModel.ToEntity()
+		recurring.LastRunDate = &runDate
+		recurring.NextRunDate = nextRunDate
+		return nil
+	})
+}
@@ -0,0 +1,249 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// sourceFilter filtra los registros de la tabla que no tienen ningún asiento del tipo de origen
+func sourceFilter(table string) string {
+	return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM journal_entries je WHERE je.source_type = ? AND je.source_id = %s.id)", table)
+}
+
+type journalRepository struct {
+	db *gorm.DB
+}
+
+// NewJournalRepository crea una nueva instancia del repositorio del libro diario
+func NewJournalRepository(db *gorm.DB) ports.JournalRepository {
+	return &journalRepository{db: db}
+}
+
+// orderedLines carga las líneas en el orden del asiento con su cuenta
+func orderedLines(db *gorm.DB) *gorm.DB {
+	return db.Order("id ASC").Preload("Account")
+}
+
+func (r *journalRepository) Create(ctx context.Context, entry *entities.JournalEntry) error {
+	return r.create(r.db.WithContext(ctx), entry)
+}
+
+func (r *journalRepository) create(db *gorm.DB, entry *entities.JournalEntry) error {
+	model := &models.JournalEntryModel{}
+	model.FromEntity(entry)
+	if err := db.Omit("Lines.Account").Create(model).Error; err != nil {
+		return err
+	}
+
+	entry.ID = model.ID
+	entry.CreatedAt = model.CreatedAt
+	for i := range entry.Lines {
+		entry.Lines[i].ID = model.Lines[i].ID
+		entry.Lines[i].EntryID = model.ID
+	}
+	return nil
+}
+
+func (r *journalRepository) Reverse(ctx context.Context, originalID uint, reversal, replacement *entities.JournalEntry) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := r.create(tx, reversal); err != nil {
+			return err
+		}
+
+		// Solo anula si sigue vigente: dos reversos simultáneos no duplican el asiento
+		result := tx.Model(&models.JournalEntryModel{}).
+			Where("id = ? AND reversed_by_id IS NULL", originalID).
+			Update("reversed_by_id", reversal.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entities.ErrJournalEntryAlreadyReversed
+		}
+
+		if replacement == nil {
+			return nil
+		}
+		return r.create(tx, replacement)
+	})
+}
+
+func (r *journalRepository) GetByID(ctx context.Context, id uint) (*entities.JournalEntry, error) {
+	var model models.JournalEntryModel
+	if err := r.db.WithContext(ctx).Preload("Lines", orderedLines).First(&model, id).Error; err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *journalRepository) GetActiveBySource(ctx context.Context, sourceType entities.JournalSourceType, sourceID uint) (*entities.JournalEntry, error) {
+	var model models.JournalEntryModel
+	err := r.db.WithContext(ctx).
+		Preload("Lines", orderedLines).
+		Where("source_type = ? AND source_id = ?", string(sourceType), sourceID).
+		Where("reversal_of_id IS NULL AND reversed_by_id IS NULL").
+		First(&model).Error
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *journalRepository) List(ctx context.Context, filters map[string]interface{}) ([]entities.JournalEntry, error) {
+	query := r.db.WithContext(ctx).
+		Preload("Lines", orderedLines).
+		Order("date DESC, id DESC")
+
+	if startDate, ok := filters["start_date"].(string); ok && startDate != "" {
+		query = query.Where("DATE(date) >= ?", startDate)
+	}
+	if endDate, ok := filters["end_date"].(string); ok && endDate != "" {
+		query = query.Where("DATE(date) <= ?", endDate)
+	}
+	if sourceType, ok := filters["source_type"].(string); ok && sourceType != "" {
+		query = query.Where("source_type = ?", sourceType)
+	}
+	if sourceID, ok := filters["source_id"].(uint); ok && sourceID != 0 {
+		query = query.Where("source_id = ?", sourceID)
+	}
+	if accountID, ok := filters["account_id"].(uint); ok && accountID != 0 {
+		query = query.Where("id IN (SELECT entry_id FROM journal_lines WHERE account_id = ?)", accountID)
+	}
+
+	var modelList []models.JournalEntryModel
+	if err := query.Find(&modelList).Error; err != nil {
+		return nil, err
+	}
+
+	entries := make([]entities.JournalEntry, len(modelList))
+	for i := range modelList {
+		entries[i] = *modelList[i].ToEntity()
+	}
+	return entries, nil
+}
+
+func (r *journalRepository) SumByAccount(ctx context.Context, from, to time.Time) (map[uint]entities.LedgerTotals, error) {
+	query := r.db.WithContext(ctx).
+		Table("journal_lines jl").
+		Select("jl.account_id AS account_id, COALESCE(SUM(jl.debit), 0) AS debit, COALESCE(SUM(jl.credit), 0) AS credit").
+		Joins("JOIN journal_entries je ON je.id = jl.entry_id").
+		Group("jl.account_id")
+	if !from.IsZero() {
+		query = query.Where("je.date >= ?", from)
+	}
+	if !to.IsZero() {
+		query = query.Where("je.date < ?", to)
+	}
+
+	var rows []struct {
+		AccountID uint
+		Debit     float64
+		Credit    float64
+	}
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	totals := make(map[uint]entities.LedgerTotals, len(rows))
+	for _, row := range rows {
+		totals[row.AccountID] = entities.LedgerTotals{Debit: row.Debit, Credit: row.Credit}
+	}
+	return totals, nil
+}
+
+func (r *journalRepository) ListUnpostedSales(ctx context.Context) ([]entities.Order, error) {
+	var modelList []models.OrderModel
+	err := r.db.WithContext(ctx).
+		Preload("Items").
+		Where("orders.status = ?", string(entities.OrderStatusDelivered)).
+		Where("COALESCE(NULLIF(orders.order_type, ''), orders.type) IN ?", []string{string(entities.OrderTypeCustom), string(entities.OrderTypeSale)}).
+		Where(sourceFilter("orders"), string(entities.JournalSourceSale)).
+		Order("orders.id ASC").
+		Find(&modelList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]entities.Order, len(modelList))
+	for i := range modelList {
+		orders[i] = *modelList[i].ToEntity()
+	}
+	return orders, nil
+}
+
+func (r *journalRepository) ListUnpostedCustomerTransactions(ctx context.Context) ([]entities.CustomerTransaction, error) {
+	var modelList []models.CustomerTransactionModel
+	err := r.db.WithContext(ctx).
+		Where("customer_transactions.order_id IS NULL").
+		Where(sourceFilter("customer_transactions"), string(entities.JournalSourceCustomerTransaction)).
+		Order("customer_transactions.id ASC"). // Los originales antes que sus reversos
+		Find(&modelList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]entities.CustomerTransaction, len(modelList))
+	for i := range modelList {
+		transactions[i] = *modelList[i].ToEntity()
+	}
+	return transactions, nil
+}
+
+func (r *journalRepository) ListUnpostedFinancialTransactions(ctx context.Context) ([]entities.FinancialTransaction, error) {
+	var modelList []models.FinancialTransactionModel
+	err := r.db.WithContext(ctx).
+		Where("financial_transactions.order_id IS NULL").
+		Where(sourceFilter("financial_transactions"), string(entities.JournalSourceFinancialTransaction)).
+		Order("financial_transactions.id ASC").
+		Find(&modelList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]entities.FinancialTransaction, len(modelList))
+	for i := range modelList {
+		transactions[i] = *modelList[i].ToEntity()
+	}
+	return transactions, nil
+}
+
+func (r *journalRepository) ListUnpostedTransfers(ctx context.Context) ([]entities.AccountTransfer, error) {
+	var modelList []models.AccountTransferModel
+	err := r.db.WithContext(ctx).
+		Where(sourceFilter("account_transfers"), string(entities.JournalSourceAccountTransfer)).
+		Order("account_transfers.id ASC").
+		Find(&modelList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := make([]entities.AccountTransfer, len(modelList))
+	for i := range modelList {
+		transfers[i] = *modelList[i].ToEntity()
+	}
+	return transfers, nil
+}
+
+func (r *journalRepository) ListUnpostedOpeningBalances(ctx context.Context) ([]entities.PaymentMethodOption, error) {
+	var modelList []models.PaymentMethodModel
+	err := r.db.WithContext(ctx).
+		Where("payment_methods.opening_balance <> 0").
+		Where(sourceFilter("payment_methods"), string(entities.JournalSourceOpeningBalance)).
+		Order("payment_methods.id ASC").
+		Find(&modelList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]entities.PaymentMethodOption, len(modelList))
+	for i := range modelList {
+		accounts[i] = *modelList[i].ToEntity()
+	}
+	return accounts, nil
+}
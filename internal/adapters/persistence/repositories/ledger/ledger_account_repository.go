@@ -0,0 +1,103 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ledgerAccountRepository struct {
+	db *gorm.DB
+}
+
+// NewLedgerAccountRepository crea una nueva instancia del repositorio del plan de cuentas
+func NewLedgerAccountRepository(db *gorm.DB) ports.LedgerAccountRepository {
+	return &ledgerAccountRepository{db: db}
+}
+
+func (r *ledgerAccountRepository) EnsureDefaults(ctx context.Context, accounts []entities.LedgerAccount) error {
+	for i := range accounts {
+		model := &models.LedgerAccountModel{}
+		model.FromEntity(&accounts[i])
+		if err := r.db.WithContext(ctx).
+			Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "code"}}, DoNothing: true}).
+			Create(model).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ledgerAccountRepository) Create(ctx context.Context, account *entities.LedgerAccount) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.LedgerAccountModel{}).Where("code = ?", account.Code).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return entities.ErrLedgerAccountAlreadyExists
+	}
+
+	model := &models.LedgerAccountModel{}
+	model.FromEntity(account)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*account = *model.ToEntity()
+	return nil
+}
+
+func (r *ledgerAccountRepository) Update(ctx context.Context, account *entities.LedgerAccount) error {
+	return r.db.WithContext(ctx).
+		Model(&models.LedgerAccountModel{}).
+		Where("id = ?", account.ID).
+		Updates(map[string]interface{}{
+			"name":      account.Name,
+			"is_active": account.IsActive,
+		}).Error
+}
+
+func (r *ledgerAccountRepository) GetByID(ctx context.Context, id uint) (*entities.LedgerAccount, error) {
+	var model models.LedgerAccountModel
+	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *ledgerAccountRepository) GetByCode(ctx context.Context, code string) (*entities.LedgerAccount, error) {
+	var model models.LedgerAccountModel
+	if err := r.db.WithContext(ctx).Where("code = ?", code).First(&model).Error; err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *ledgerAccountRepository) GetByPaymentMethod(ctx context.Context, paymentMethodID uint) (*entities.LedgerAccount, error) {
+	var model models.LedgerAccountModel
+	if err := r.db.WithContext(ctx).Where("payment_method_id = ?", paymentMethodID).First(&model).Error; err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *ledgerAccountRepository) List(ctx context.Context, activeOnly bool) ([]entities.LedgerAccount, error) {
+	var modelList []models.LedgerAccountModel
+	query := r.db.WithContext(ctx).Order("code ASC")
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+	if err := query.Find(&modelList).Error; err != nil {
+		return nil, err
+	}
+
+	accounts := make([]entities.LedgerAccount, len(modelList))
+	for i := range modelList {
+		accounts[i] = *modelList[i].ToEntity()
+	}
+	return accounts, nil
+}
@@ -0,0 +1,239 @@
+package loyalty
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type loyaltyRuleRepository struct {
+	db *gorm.DB
+}
+
+// NewLoyaltyRuleRepository crea una nueva instancia del repositorio de reglas de puntos
+func NewLoyaltyRuleRepository(db *gorm.DB) ports.LoyaltyRuleRepository {
+	return &loyaltyRuleRepository{db: db}
+}
+
+func (r *loyaltyRuleRepository) Create(ctx context.Context, rule *entities.LoyaltyRule) error {
+	model := &models.LoyaltyRuleModel{}
+	model.FromEntity(rule)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*rule = *model.ToEntity()
+	return nil
+}
+
+func (r *loyaltyRuleRepository) GetByID(ctx context.Context, id uint) (*entities.LoyaltyRule, error) {
+	var model models.LoyaltyRuleModel
+	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *loyaltyRuleRepository) List(ctx context.Context, activeOnly bool) ([]entities.LoyaltyRule, error) {
+	var modelList []models.LoyaltyRuleModel
+	query := r.db.WithContext(ctx)
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+
+	if err := query.Order("created_at ASC").Find(&modelList).Error; err != nil {
+		return nil, err
+	}
+
+	rules := make([]entities.LoyaltyRule, len(modelList))
+	for i, model := range modelList {
+		rules[i] = *model.ToEntity()
+	}
+	return rules, nil
+}
+
+func (r *loyaltyRuleRepository) Update(ctx context.Context, rule *entities.LoyaltyRule) error {
+	model := &models.LoyaltyRuleModel{}
+	model.FromEntity(rule)
+
+	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+		return err
+	}
+
+	*rule = *model.ToEntity()
+	return nil
+}
+
+func (r *loyaltyRuleRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&models.LoyaltyRuleModel{}, id).Error
+}
+
+// LoyaltyPointsRepository
+type loyaltyPointsRepository struct {
+	db *gorm.DB
+}
+
+// NewLoyaltyPointsRepository crea una nueva instancia del repositorio del libro de puntos
+func NewLoyaltyPointsRepository(db *gorm.DB) ports.LoyaltyPointsRepository {
+	return &loyaltyPointsRepository{db: db}
+}
+
+func (r *loyaltyPointsRepository) Earn(ctx context.Context, entry *entities.LoyaltyPointsEntry) error {
+	entry.Type = entities.LoyaltyEntryEarn
+	entry.Remaining = entry.Points
+
+	model := &models.LoyaltyPointsEntryModel{}
+	model.FromEntity(entry)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*entry = *model.ToEntity()
+	return nil
+}
+
+// Redeem consume los puntos vigentes empezando por los que vencen primero
+func (r *loyaltyPointsRepository) Redeem(ctx context.Context, customerID uint, orderID *uint, points int, description string) error {
+	now := time.Now()
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var earnList []models.LoyaltyPointsEntryModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("customer_id = ? AND type = ? AND remaining > 0", customerID, entities.LoyaltyEntryEarn).
+			Where("expires_at IS NULL OR expires_at > ?", now).
+			Order("expires_at ASC NULLS LAST, created_at ASC").
+			Find(&earnList).Error
+		if err != nil {
+			return err
+		}
+
+		available := 0
+		for _, earn := range earnList {
+			available += earn.Remaining
+		}
+		if available < points {
+			return entities.ErrInsufficientPoints
+		}
+
+		pending := points
+		for _, earn := range earnList {
+			if pending == 0 {
+				break
+			}
+			consumed := earn.Remaining
+			if consumed > pending {
+				consumed = pending
+			}
+			if err := tx.Model(&models.LoyaltyPointsEntryModel{}).
+				Where("id = ?", earn.ID).
+				Update("remaining", earn.Remaining-consumed).Error; err != nil {
+				return err
+			}
+			pending -= consumed
+		}
+
+		return tx.Create(&models.LoyaltyPointsEntryModel{
+			CustomerID:  customerID,
+			OrderID:     orderID,
+			Type:        string(entities.LoyaltyEntryRedeem),
+			Points:      -points,
+			Description: description,
+		}).Error
+	})
+}
+
+// ExpirePoints registra un movimiento EXPIRE por cada ganancia vencida con saldo pendiente
+func (r *loyaltyPointsRepository) ExpirePoints(ctx context.Context, customerID *uint, at time.Time) (int, error) {
+	expired := 0
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var earnList []models.LoyaltyPointsEntryModel
+		query := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("type = ? AND remaining > 0 AND expires_at IS NOT NULL AND expires_at <= ?", entities.LoyaltyEntryEarn, at)
+		if customerID != nil {
+			query = query.Where("customer_id = ?", *customerID)
+		}
+		if err := query.Find(&earnList).Error; err != nil {
+			return err
+		}
+
+		for _, earn := range earnList {
+			if err := tx.Model(&models.LoyaltyPointsEntryModel{}).
+				Where("id = ?", earn.ID).
+				Update("remaining", 0).Error; err != nil {
+				return err
+			}
+
+			if err := tx.Create(&models.LoyaltyPointsEntryModel{
+				CustomerID:  earn.CustomerID,
+				OrderID:     earn.OrderID,
+				Type:        string(entities.LoyaltyEntryExpire),
+				Points:      -earn.Remaining,
+				Description: fmt.Sprintf("Vencimiento de puntos ganados el %s", earn.CreatedAt.Format("02/01/2006")),
+			}).Error; err != nil {
+				return err
+			}
+			expired += earn.Remaining
+		}
+		return nil
+	})
+
+	return expired, err
+}
+
+// GetBalance suma los puntos vigentes (ganados, no usados y no vencidos)
+func (r *loyaltyPointsRepository) GetBalance(ctx context.Context, customerID uint) (int, error) {
+	var balance int
+	err := r.db.WithContext(ctx).
+		Model(&models.LoyaltyPointsEntryModel{}).
+		Where("customer_id = ? AND type = ? AND remaining > 0", customerID, entities.LoyaltyEntryEarn).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Select("COALESCE(SUM(remaining), 0)").
+		Scan(&balance).Error
+	return balance, err
+}
+
+func (r *loyaltyPointsRepository) GetExpiringPoints(ctx context.Context, customerID uint, before time.Time) (int, error) {
+	var points int
+	err := r.db.WithContext(ctx).
+		Model(&models.LoyaltyPointsEntryModel{}).
+		Where("customer_id = ? AND type = ? AND remaining > 0", customerID, entities.LoyaltyEntryEarn).
+		Where("expires_at > ? AND expires_at <= ?", time.Now(), before).
+		Select("COALESCE(SUM(remaining), 0)").
+		Scan(&points).Error
+	return points, err
+}
+
+func (r *loyaltyPointsRepository) ListByCustomer(ctx context.Context, customerID uint) ([]entities.LoyaltyPointsEntry, error) {
+	var modelList []models.LoyaltyPointsEntryModel
+	err := r.db.WithContext(ctx).
+		Where("customer_id = ?", customerID).
+		Order("created_at DESC, id DESC").
+		Find(&modelList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]entities.LoyaltyPointsEntry, len(modelList))
+	for i, model := range modelList {
+		entries[i] = *model.ToEntity()
+	}
+	return entries, nil
+}
+
+func (r *loyaltyPointsRepository) ExistsForOrder(ctx context.Context, orderID uint, entryType entities.LoyaltyEntryType) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.LoyaltyPointsEntryModel{}).
+		Where("order_id = ? AND type = ?", orderID, entryType).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -86,9 +86,20 @@ func (r *orderRepository) List(ctx context.Context, filters map[string]interface
 	if sellerID, ok := filters["seller_id"].(uint); ok && sellerID > 0 {
 		query = query.Where("seller_id = ?", sellerID)
 	}
+	// Órdenes propias o compartidas con el usuario (vendedores sin orders:all)
+	// Un alcance sin usuario (0) no ve ningún registro
+	if userID, ok := filters["visible_to_user_id"].(uint); ok {
+		query = query.Where(
+			"seller_id = ? OR id IN (SELECT resource_id FROM record_shares WHERE resource_type = ? AND user_id = ?)",
+			userID, string(entities.ShareResourceOrder), userID,
+		)
+	}
 	if orderType, ok := filters["type"].(string); ok && orderType != "" {
 		query = query.Where("type = ?", orderType)
 	}
+	if customerID, ok := filters["customer_id"].(uint); ok && customerID > 0 {
+		query = query.Where("customer_id = ?", customerID)
+	}
 	if startDate, ok := filters["start_date"].(time.Time); ok {
 		query = query.Where("order_date >= ?", startDate)
 	}
@@ -143,6 +154,20 @@ func (r *orderRepository) UpdateStatus(ctx context.Context, id uint, status enti
 		Update("status", string(status)).Error
 }
 
+func (r *orderRepository) UpdateSeller(ctx context.Context, id uint, sellerID uint) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.OrderModel{}).
+		Where("id = ?", id).
+		Update("seller_id", sellerID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
 func (r *orderRepository) Delete(ctx context.Context, id uint) error {
 	return r.db.WithContext(ctx).Delete(&models.OrderModel{}, id).Error
 }
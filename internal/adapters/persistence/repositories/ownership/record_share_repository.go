@@ -0,0 +1,86 @@
+package ownership
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type recordShareRepository struct {
+	db *gorm.DB
+}
+
+// NewRecordShareRepository crea una nueva instancia del repositorio de registros compartidos
+func NewRecordShareRepository(db *gorm.DB) ports.RecordShareRepository {
+	return &recordShareRepository{db: db}
+}
+
+func (r *recordShareRepository) Share(ctx context.Context, share *entities.RecordShare) error {
+	model := &models.RecordShareModel{}
+	model.FromEntity(share)
+
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "resource_type"}, {Name: "resource_id"}, {Name: "user_id"}},
+			DoNothing: true,
+		}).
+		Create(model).Error
+	if err != nil {
+		return err
+	}
+
+	*share = *model.ToEntity()
+	return nil
+}
+
+func (r *recordShareRepository) Unshare(ctx context.Context, resourceType entities.ShareResourceType, resourceID, userID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("resource_type = ? AND resource_id = ? AND user_id = ?", string(resourceType), resourceID, userID).
+		Delete(&models.RecordShareModel{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrRecordShareNotFound
+	}
+	return nil
+}
+
+func (r *recordShareRepository) ListByResource(ctx context.Context, resourceType entities.ShareResourceType, resourceID uint) ([]entities.RecordShare, error) {
+	var shareModels []models.RecordShareModel
+	err := r.db.WithContext(ctx).
+		Where("resource_type = ? AND resource_id = ?", string(resourceType), resourceID).
+		Order("created_at ASC").
+		Find(&shareModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	shares := make([]entities.RecordShare, len(shareModels))
+	for i, model := range shareModels {
+		shares[i] = *model.ToEntity()
+	}
+	return shares, nil
+}
+
+func (r *recordShareRepository) IsShared(ctx context.Context, resourceType entities.ShareResourceType, resourceID, userID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.RecordShareModel{}).
+		Where("resource_type = ? AND resource_id = ? AND user_id = ?", string(resourceType), resourceID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *recordShareRepository) ListSharedIDs(ctx context.Context, resourceType entities.ShareResourceType, userID uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).
+		Model(&models.RecordShareModel{}).
+		Where("resource_type = ? AND user_id = ?", string(resourceType), userID).
+		Pluck("resource_id", &ids).Error
+	return ids, err
+}
@@ -0,0 +1,97 @@
+package payment_method
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+type bankStatementRepository struct {
+	db *gorm.DB
+}
+
+// NewBankStatementRepository crea una nueva instancia del repositorio de extractos
+func NewBankStatementRepository(db *gorm.DB) ports.BankStatementRepository {
+	return &bankStatementRepository{db: db}
+}
+
+// orderedLines carga las líneas en el orden del archivo
+func orderedLines(db *gorm.DB) *gorm.DB {
+	return db.Order("line_number ASC")
+}
+
+func (r *bankStatementRepository) Create(ctx context.Context, statement *entities.BankStatement) error {
+	model := &models.BankStatementModel{}
+	model.FromEntity(statement)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*statement = *model.ToEntity()
+	return nil
+}
+
+func (r *bankStatementRepository) GetByID(ctx context.Context, id uint) (*entities.BankStatement, error) {
+	var model models.BankStatementModel
+	if err := r.db.WithContext(ctx).Preload("Lines", orderedLines).First(&model, id).Error; err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *bankStatementRepository) ListByPaymentMethod(ctx context.Context, paymentMethodID uint) ([]entities.BankStatement, error) {
+	var modelList []models.BankStatementModel
+	err := r.db.WithContext(ctx).
+		Preload("Lines", orderedLines).
+		Where("payment_method_id = ?", paymentMethodID).
+		Order("end_date DESC, id DESC").
+		Find(&modelList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	statements := make([]entities.BankStatement, len(modelList))
+	for i := range modelList {
+		statements[i] = *modelList[i].ToEntity()
+	}
+	return statements, nil
+}
+
+func (r *bankStatementRepository) UpdateLine(ctx context.Context, line *entities.BankStatementLine) error {
+	model := &models.BankStatementLineModel{}
+	model.FromEntity(line)
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Un movimiento se cruza con una sola línea de su cuenta (el índice único lo garantiza en la base)
+		if model.MatchedSource != nil && model.MatchedID != nil {
+			var count int64
+			err := tx.Model(&models.BankStatementLineModel{}).
+				Where("payment_method_id = ? AND matched_source = ? AND matched_id = ? AND id <> ?",
+					model.PaymentMethodID, *model.MatchedSource, *model.MatchedID, model.ID).
+				Count(&count).Error
+			if err != nil {
+				return err
+			}
+			if count > 0 {
+				return entities.ErrMovementAlreadyReconciled
+			}
+		}
+
+		return tx.Model(model).
+			Select("status", "matched_source", "matched_id", "matched_at").
+			Updates(model).Error
+	})
+}
+
+func (r *bankStatementRepository) IsReconciled(ctx context.Context, source entities.AccountMovementSource, sourceID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.BankStatementLineModel{}).
+		Where("matched_source = ? AND matched_id = ?", string(source), sourceID).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,172 @@
+package payment_method
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// accountMovements une todo lo que mueve dinero de una cuenta con el signo de la cuenta:
+// ABONO entra (su reverso DEUDA sale), INCOME entra, EXPENSE sale y cada traslado sale de una cuenta y entra a otra
+const accountMovements = `
+SELECT ct.date AS date, 'CUSTOMER_TRANSACTION' AS source, ct.id AS source_id, ct.payment_method_id AS payment_method_id,
+	CONCAT(CASE WHEN ct.type = 'ABONO' THEN 'Abono' ELSE 'Reverso de abono' END, ' - ', COALESCE(c.name, '')) AS description,
+	CASE WHEN ct.type = 'ABONO' THEN ct.amount ELSE -ct.amount END AS amount
+FROM customer_transactions ct
+LEFT JOIN customers c ON c.id = ct.customer_id
+WHERE ct.payment_method_id IS NOT NULL
+UNION ALL
+SELECT ft.date, 'FINANCIAL_TRANSACTION', ft.id, ft.payment_method_id, ft.description,
+	CASE WHEN ft.type = 'INCOME' THEN ft.amount ELSE -ft.amount END
+FROM financial_transactions ft
+WHERE ft.payment_method_id IS NOT NULL
+UNION ALL
+SELECT t.date, 'ACCOUNT_TRANSFER', t.id, t.from_payment_method_id,
+	CONCAT('Traslado a ', COALESCE(pm.name, ''), ' - ', t.description), -t.amount
+FROM account_transfers t
+LEFT JOIN payment_methods pm ON pm.id = t.to_payment_method_id
+UNION ALL
+SELECT t.date, 'ACCOUNT_TRANSFER', t.id, t.to_payment_method_id,
+	CONCAT('Traslado desde ', COALESCE(pm.name, ''), ' - ', t.description), t.amount
+FROM account_transfers t
+LEFT JOIN payment_methods pm ON pm.id = t.from_payment_method_id
+`
+
+// reconciledMovement indica si el movimiento m está cruzado con una línea de extracto de su cuenta
+const reconciledMovement = `EXISTS (
+	SELECT 1 FROM bank_statement_lines l
+	WHERE l.payment_method_id = m.payment_method_id AND l.matched_source = m.source AND l.matched_id = m.source_id
+) AS reconciled`
+
+type cashAccountRepository struct {
+	db *gorm.DB
+}
+
+// NewCashAccountRepository crea una nueva instancia del repositorio de cuentas
+func NewCashAccountRepository(db *gorm.DB) ports.CashAccountRepository {
+	return &cashAccountRepository{db: db}
+}
+
+type accountMovementRow struct {
+	Date        time.Time
+	Source      string
+	SourceID    uint
+	Description string
+	Amount      float64
+	Reconciled  bool
+}
+
+func (row *accountMovementRow) toEntity() entities.AccountMovement {
+	return entities.AccountMovement{
+		Date:        row.Date,
+		Source:      entities.AccountMovementSource(row.Source),
+		SourceID:    row.SourceID,
+		Description: row.Description,
+		Amount:      row.Amount,
+		Reconciled:  row.Reconciled,
+	}
+}
+
+func (r *cashAccountRepository) CreateTransfer(ctx context.Context, transfer *entities.AccountTransfer) error {
+	model := &models.AccountTransferModel{}
+	model.FromEntity(transfer)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*transfer = *model.ToEntity()
+	return nil
+}
+
+func (r *cashAccountRepository) ListTransfers(ctx context.Context, paymentMethodID *uint, from, to time.Time) ([]entities.AccountTransfer, error) {
+	query := r.db.WithContext(ctx).
+		Where("date >= ? AND date < ?", from, to)
+	if paymentMethodID != nil {
+		query = query.Where("from_payment_method_id = ? OR to_payment_method_id = ?", *paymentMethodID, *paymentMethodID)
+	}
+
+	var modelList []models.AccountTransferModel
+	if err := query.Order("date DESC, id DESC").Find(&modelList).Error; err != nil {
+		return nil, err
+	}
+
+	transfers := make([]entities.AccountTransfer, len(modelList))
+	for i := range modelList {
+		transfers[i] = *modelList[i].ToEntity()
+	}
+	return transfers, nil
+}
+
+func (r *cashAccountRepository) SumByAccount(ctx context.Context) (map[uint]entities.AccountTotals, error) {
+	var rows []struct {
+		PaymentMethodID uint
+		Inflows         float64
+		Outflows        float64
+	}
+	err := r.db.WithContext(ctx).
+		Raw("SELECT m.payment_method_id, " +
+			"COALESCE(SUM(CASE WHEN m.amount > 0 THEN m.amount ELSE 0 END), 0) AS inflows, " +
+			"COALESCE(SUM(CASE WHEN m.amount < 0 THEN -m.amount ELSE 0 END), 0) AS outflows " +
+			"FROM (" + accountMovements + ") m GROUP BY m.payment_method_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make(map[uint]entities.AccountTotals, len(rows))
+	for _, row := range rows {
+		totals[row.PaymentMethodID] = entities.AccountTotals{Inflows: row.Inflows, Outflows: row.Outflows}
+	}
+	return totals, nil
+}
+
+func (r *cashAccountRepository) SumBefore(ctx context.Context, paymentMethodID uint, before time.Time) (float64, error) {
+	var total float64
+	err := r.db.WithContext(ctx).
+		Raw("SELECT COALESCE(SUM(m.amount), 0) FROM ("+accountMovements+") m "+
+			"WHERE m.payment_method_id = ? AND m.date < ?", paymentMethodID, before).
+		Scan(&total).Error
+	return total, err
+}
+
+func (r *cashAccountRepository) ListMovements(ctx context.Context, paymentMethodID uint, from, to time.Time) ([]entities.AccountMovement, error) {
+	var rows []accountMovementRow
+	err := r.db.WithContext(ctx).
+		Raw("SELECT m.date, m.source, m.source_id, m.description, m.amount, "+reconciledMovement+" "+
+			"FROM ("+accountMovements+") m "+
+			"WHERE m.payment_method_id = ? AND m.date >= ? AND m.date < ? "+
+			"ORDER BY m.date ASC, m.source ASC, m.source_id ASC", paymentMethodID, from, to).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	movements := make([]entities.AccountMovement, len(rows))
+	for i := range rows {
+		movements[i] = rows[i].toEntity()
+	}
+	return movements, nil
+}
+
+func (r *cashAccountRepository) GetMovement(ctx context.Context, paymentMethodID uint, source entities.AccountMovementSource, sourceID uint) (*entities.AccountMovement, error) {
+	var rows []accountMovementRow
+	err := r.db.WithContext(ctx).
+		Raw("SELECT m.date, m.source, m.source_id, m.description, m.amount, "+reconciledMovement+" "+
+			"FROM ("+accountMovements+") m "+
+			"WHERE m.payment_method_id = ? AND m.source = ? AND m.source_id = ?", paymentMethodID, string(source), sourceID).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	movement := rows[0].toEntity()
+	return &movement, nil
+}
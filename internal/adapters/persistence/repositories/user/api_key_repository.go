@@ -0,0 +1,103 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+type apiKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewAPIKeyRepository crea una nueva instancia del repositorio de llaves de API
+func NewAPIKeyRepository(db *gorm.DB) ports.APIKeyRepository {
+	return &apiKeyRepository{db: db}
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, key *entities.APIKey) error {
+	model := &models.APIKeyModel{}
+	model.FromEntity(key)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*key = *model.ToEntity()
+	return nil
+}
+
+func (r *apiKeyRepository) GetByID(ctx context.Context, id uint) (*entities.APIKey, error) {
+	var model models.APIKeyModel
+	err := r.db.WithContext(ctx).First(&model, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, entities.ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *apiKeyRepository) GetByPrefix(ctx context.Context, prefix string) (*entities.APIKey, error) {
+	var model models.APIKeyModel
+	err := r.db.WithContext(ctx).Where("prefix = ?", prefix).First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, entities.ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *apiKeyRepository) List(ctx context.Context) ([]entities.APIKey, error) {
+	var keyModels []models.APIKeyModel
+	if err := r.db.WithContext(ctx).Order("created_at DESC").Find(&keyModels).Error; err != nil {
+		return nil, err
+	}
+
+	keys := make([]entities.APIKey, len(keyModels))
+	for i, model := range keyModels {
+		keys[i] = *model.ToEntity()
+	}
+	return keys, nil
+}
+
+func (r *apiKeyRepository) Revoke(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.APIKeyModel{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		if _, err := r.GetByID(ctx, id); err != nil {
+			return err
+		}
+		return entities.ErrAPIKeyRevoked
+	}
+	return nil
+}
+
+func (r *apiKeyRepository) SetExpiration(ctx context.Context, id uint, expiresAt time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&models.APIKeyModel{}).
+		Where("id = ?", id).
+		Update("expires_at", expiresAt).Error
+}
+
+func (r *apiKeyRepository) TouchLastUsed(ctx context.Context, id uint, usedAt time.Time, ipAddress string) error {
+	return r.db.WithContext(ctx).
+		Model(&models.APIKeyModel{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"last_used_at": usedAt,
+			"last_used_ip": ipAddress,
+		}).Error
+}
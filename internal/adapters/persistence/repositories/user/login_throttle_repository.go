@@ -0,0 +1,76 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type loginThrottleRepository struct {
+	db *gorm.DB
+}
+
+// NewLoginThrottleRepository crea una nueva instancia del repositorio de intentos de login
+func NewLoginThrottleRepository(db *gorm.DB) ports.LoginThrottleRepository {
+	return &loginThrottleRepository{db: db}
+}
+
+func (r *loginThrottleRepository) Get(ctx context.Context, scope entities.LoginThrottleScope, key string) (*entities.LoginThrottle, error) {
+	var model models.LoginThrottleModel
+	err := r.db.WithContext(ctx).Where("scope = ? AND key = ?", string(scope), key).First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *loginThrottleRepository) RegisterFailure(
+	ctx context.Context,
+	scope entities.LoginThrottleScope,
+	key string,
+	policy entities.LockoutPolicy,
+	at time.Time,
+) (*entities.LoginThrottle, bool, error) {
+	var throttle *entities.LoginThrottle
+	var locked bool
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Crear el contador si no existe (sin fallar si otra petición lo creó al mismo tiempo)
+		seed := &models.LoginThrottleModel{Scope: string(scope), Key: key}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(seed).Error; err != nil {
+			return err
+		}
+
+		var model models.LoginThrottleModel
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("scope = ? AND key = ?", string(scope), key).
+			First(&model).Error; err != nil {
+			return err
+		}
+
+		throttle = model.ToEntity()
+		locked = throttle.RegisterFailure(at, policy)
+		model.FromEntity(throttle)
+		return tx.Save(&model).Error
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return throttle, locked, nil
+}
+
+func (r *loginThrottleRepository) Reset(ctx context.Context, scope entities.LoginThrottleScope, key string) error {
+	return r.db.WithContext(ctx).
+		Where("scope = ? AND key = ?", string(scope), key).
+		Delete(&models.LoginThrottleModel{}).Error
+}
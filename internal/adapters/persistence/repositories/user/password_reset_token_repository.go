@@ -0,0 +1,61 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+type passwordResetTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewPasswordResetTokenRepository crea una nueva instancia del repositorio de tokens de recuperación
+func NewPasswordResetTokenRepository(db *gorm.DB) ports.PasswordResetTokenRepository {
+	return &passwordResetTokenRepository{db: db}
+}
+
+func (r *passwordResetTokenRepository) Create(ctx context.Context, token *entities.PasswordResetToken) error {
+	model := &models.PasswordResetTokenModel{}
+	model.FromEntity(token)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*token = *model.ToEntity()
+	return nil
+}
+
+func (r *passwordResetTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entities.PasswordResetToken, error) {
+	var model models.PasswordResetTokenModel
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&model).Error; err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *passwordResetTokenRepository) MarkUsed(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.PasswordResetTokenModel{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrInvalidResetToken
+	}
+	return nil
+}
+
+func (r *passwordResetTokenRepository) InvalidatePending(ctx context.Context, userID uint) error {
+	return r.db.WithContext(ctx).
+		Model(&models.PasswordResetTokenModel{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Update("used_at", time.Now()).Error
+}
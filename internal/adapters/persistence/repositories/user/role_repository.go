@@ -0,0 +1,119 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type roleRepository struct {
+	db *gorm.DB
+}
+
+// NewRoleRepository crea una nueva instancia del repositorio de roles
+func NewRoleRepository(db *gorm.DB) ports.RoleRepository {
+	return &roleRepository{db: db}
+}
+
+func (r *roleRepository) EnsureDefaults(ctx context.Context, roles []entities.Role) error {
+	for i := range roles {
+		model := &models.RoleModel{}
+		model.FromEntity(&roles[i])
+		if err := r.db.WithContext(ctx).
+			Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "name"}}, DoNothing: true}).
+			Create(model).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *roleRepository) Create(ctx context.Context, role *entities.Role) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.RoleModel{}).Where("name = ?", string(role.Name)).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return entities.ErrRoleAlreadyExists
+	}
+
+	model := &models.RoleModel{}
+	model.FromEntity(role)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*role = *model.ToEntity()
+	return nil
+}
+
+func (r *roleRepository) GetByName(ctx context.Context, name entities.UserRole) (*entities.Role, error) {
+	var model models.RoleModel
+	err := r.db.WithContext(ctx).Where("name = ?", string(name)).First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, entities.ErrRoleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *roleRepository) List(ctx context.Context) ([]entities.Role, error) {
+	var roleModels []models.RoleModel
+	if err := r.db.WithContext(ctx).Order("name ASC").Find(&roleModels).Error; err != nil {
+		return nil, err
+	}
+
+	roles := make([]entities.Role, len(roleModels))
+	for i, model := range roleModels {
+		roles[i] = *model.ToEntity()
+	}
+	return roles, nil
+}
+
+func (r *roleRepository) Update(ctx context.Context, role *entities.Role) error {
+	model := &models.RoleModel{}
+	model.FromEntity(role)
+
+	result := r.db.WithContext(ctx).
+		Model(&models.RoleModel{}).
+		Where("name = ?", model.Name).
+		Updates(map[string]interface{}{
+			"description": model.Description,
+			"permissions": model.Permissions,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrRoleNotFound
+	}
+	return nil
+}
+
+func (r *roleRepository) Delete(ctx context.Context, name entities.UserRole) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.UserModel{}).Where("role = ?", string(name)).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return entities.ErrRoleInUse
+		}
+
+		result := tx.Where("name = ?", string(name)).Delete(&models.RoleModel{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entities.ErrRoleNotFound
+		}
+		return nil
+	})
+}
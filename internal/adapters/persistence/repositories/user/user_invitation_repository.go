@@ -0,0 +1,142 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+type userInvitationRepository struct {
+	db *gorm.DB
+}
+
+// NewUserInvitationRepository crea una nueva instancia del repositorio de invitaciones
+func NewUserInvitationRepository(db *gorm.DB) ports.UserInvitationRepository {
+	return &userInvitationRepository{db: db}
+}
+
+func (r *userInvitationRepository) Create(ctx context.Context, invitation *entities.UserInvitation) error {
+	model := &models.UserInvitationModel{}
+	model.FromEntity(invitation)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*invitation = *model.ToEntity()
+	return nil
+}
+
+func (r *userInvitationRepository) GetByID(ctx context.Context, id uint) (*entities.UserInvitation, error) {
+	var model models.UserInvitationModel
+	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *userInvitationRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entities.UserInvitation, error) {
+	var model models.UserInvitationModel
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&model).Error; err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *userInvitationRepository) GetPendingByEmail(ctx context.Context, email string) (*entities.UserInvitation, error) {
+	var model models.UserInvitationModel
+	err := r.db.WithContext(ctx).
+		Where("LOWER(email) = LOWER(?)", strings.TrimSpace(email)).
+		Where("accepted_at IS NULL AND revoked_at IS NULL AND expires_at > ?", time.Now()).
+		First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *userInvitationRepository) List(ctx context.Context, filters map[string]interface{}) ([]entities.UserInvitation, error) {
+	var modelList []models.UserInvitationModel
+	query := r.db.WithContext(ctx)
+
+	if status, ok := filters["status"].(string); ok && status != "" {
+		switch status {
+		case "PENDING":
+			query = query.Where("accepted_at IS NULL AND revoked_at IS NULL AND expires_at > ?", time.Now())
+		case "ACCEPTED":
+			query = query.Where("accepted_at IS NOT NULL")
+		case "REVOKED":
+			query = query.Where("revoked_at IS NOT NULL")
+		case "EXPIRED":
+			query = query.Where("accepted_at IS NULL AND revoked_at IS NULL AND expires_at <= ?", time.Now())
+		}
+	}
+
+	if err := query.Order("created_at DESC").Find(&modelList).Error; err != nil {
+		return nil, err
+	}
+
+	invitations := make([]entities.UserInvitation, len(modelList))
+	for i, model := range modelList {
+		invitations[i] = *model.ToEntity()
+	}
+	return invitations, nil
+}
+
+func (r *userInvitationRepository) Accept(ctx context.Context, invitation *entities.UserInvitation, user *entities.User) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		userModel := &models.UserModel{}
+		userModel.FromEntity(user)
+		if err := tx.Create(userModel).Error; err != nil {
+			return err
+		}
+
+		for _, perm := range invitation.Permissions {
+			perm.UserID = userModel.ID
+			permModel := &models.UserCategoryPermissionModel{}
+			permModel.FromEntity(&perm)
+			if err := tx.Create(permModel).Error; err != nil {
+				return err
+			}
+		}
+
+		result := tx.Model(&models.UserInvitationModel{}).
+			Where("id = ? AND accepted_at IS NULL AND revoked_at IS NULL", invitation.ID).
+			Updates(map[string]interface{}{
+				"accepted_at":      time.Now(),
+				"accepted_user_id": userModel.ID,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entities.ErrInvitationAlreadyClosed
+		}
+
+		*user = *userModel.ToEntity()
+		return nil
+	})
+}
+
+func (r *userInvitationRepository) Revoke(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.UserInvitationModel{}).
+		Where("id = ? AND accepted_at IS NULL AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrInvitationAlreadyClosed
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+type userSessionRepository struct {
+	db *gorm.DB
+}
+
+// NewUserSessionRepository crea una nueva instancia del repositorio de sesiones
+func NewUserSessionRepository(db *gorm.DB) ports.UserSessionRepository {
+	return &userSessionRepository{db: db}
+}
+
+func (r *userSessionRepository) Create(ctx context.Context, session *entities.UserSession) error {
+	model := &models.UserSessionModel{}
+	model.FromEntity(session)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	*session = *model.ToEntity()
+	return nil
+}
+
+func (r *userSessionRepository) GetByID(ctx context.Context, id uint) (*entities.UserSession, error) {
+	var model models.UserSessionModel
+	if err := r.db.WithContext(ctx).First(&model, id).Error; err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *userSessionRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entities.UserSession, error) {
+	var model models.UserSessionModel
+	err := r.db.WithContext(ctx).
+		Where("refresh_token_hash = ? OR previous_token_hash = ?", tokenHash, tokenHash).
+		First(&model).Error
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *userSessionRepository) Rotate(ctx context.Context, id uint, currentHash, newHash string, expiresAt time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.UserSessionModel{}).
+		Where("id = ? AND refresh_token_hash = ? AND revoked_at IS NULL", id, currentHash).
+		Updates(map[string]interface{}{
+			"refresh_token_hash":  newHash,
+			"previous_token_hash": currentHash,
+			"expires_at":          expiresAt,
+			"last_used_at":        time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrInvalidRefreshToken
+	}
+	return nil
+}
+
+func (r *userSessionRepository) ListActiveByUser(ctx context.Context, userID uint) ([]entities.UserSession, error) {
+	var modelList []models.UserSessionModel
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("last_used_at DESC").
+		Find(&modelList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]entities.UserSession, len(modelList))
+	for i, model := range modelList {
+		sessions[i] = *model.ToEntity()
+	}
+	return sessions, nil
+}
+
+func (r *userSessionRepository) Revoke(ctx context.Context, id uint, reason string) error {
+	return r.db.WithContext(ctx).
+		Model(&models.UserSessionModel{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"revoked_at":     time.Now(),
+			"revoked_reason": reason,
+		}).Error
+}
+
+func (r *userSessionRepository) RevokeAllByUser(ctx context.Context, userID uint, reason string) error {
+	return r.db.WithContext(ctx).
+		Model(&models.UserSessionModel{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Updates(map[string]interface{}{
+			"revoked_at":     time.Now(),
+			"revoked_reason": reason,
+		}).Error
+}
@@ -0,0 +1,140 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/adapters/persistence/models"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+type userTwoFactorRepository struct {
+	db *gorm.DB
+}
+
+// NewUserTwoFactorRepository crea una nueva instancia del repositorio de segundo factor
+func NewUserTwoFactorRepository(db *gorm.DB) ports.UserTwoFactorRepository {
+	return &userTwoFactorRepository{db: db}
+}
+
+func (r *userTwoFactorRepository) GetByUserID(ctx context.Context, userID uint) (*entities.UserTwoFactor, error) {
+	var model models.UserTwoFactorModel
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+func (r *userTwoFactorRepository) SavePending(ctx context.Context, twoFactor *entities.UserTwoFactor) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Un secreto pendiente se puede reemplazar; uno activo no
+		if err := tx.Where("user_id = ? AND enabled_at IS NULL", twoFactor.UserID).
+			Delete(&models.UserTwoFactorModel{}).Error; err != nil {
+			return err
+		}
+
+		model := &models.UserTwoFactorModel{}
+		model.FromEntity(twoFactor)
+		model.EnabledAt = nil
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+
+		*twoFactor = *model.ToEntity()
+		return nil
+	})
+}
+
+func (r *userTwoFactorRepository) Enable(ctx context.Context, userID uint, step int64, recoveryCodeHashes []string, at time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.UserTwoFactorModel{}).
+			Where("user_id = ? AND enabled_at IS NULL", userID).
+			Updates(map[string]interface{}{
+				"enabled_at":     at,
+				"last_used_step": step,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entities.ErrTwoFactorAlreadyEnabled
+		}
+
+		return replaceRecoveryCodes(tx, userID, recoveryCodeHashes)
+	})
+}
+
+func (r *userTwoFactorRepository) Disable(ctx context.Context, userID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&models.UserRecoveryCodeModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&models.UserTwoFactorModel{}).Error
+	})
+}
+
+func (r *userTwoFactorRepository) MarkStepUsed(ctx context.Context, userID uint, step int64) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.UserTwoFactorModel{}).
+		Where("user_id = ? AND last_used_step < ?", userID, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrInvalidTwoFactorCode
+	}
+	return nil
+}
+
+func (r *userTwoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, userID uint, recoveryCodeHashes []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return replaceRecoveryCodes(tx, userID, recoveryCodeHashes)
+	})
+}
+
+func (r *userTwoFactorRepository) UseRecoveryCode(ctx context.Context, userID uint, codeHash string) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.UserRecoveryCodeModel{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrInvalidTwoFactorCode
+	}
+	return nil
+}
+
+func (r *userTwoFactorRepository) CountRecoveryCodes(ctx context.Context, userID uint) (int, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.UserRecoveryCodeModel{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	return int(count), err
+}
+
+// replaceRecoveryCodes borra los códigos del usuario y crea los nuevos dentro de la transacción dada
+func replaceRecoveryCodes(tx *gorm.DB, userID uint, recoveryCodeHashes []string) error {
+	if err := tx.Where("user_id = ?", userID).Delete(&models.UserRecoveryCodeModel{}).Error; err != nil {
+		return err
+	}
+	if len(recoveryCodeHashes) == 0 {
+		return nil
+	}
+
+	codes := make([]models.UserRecoveryCodeModel, len(recoveryCodeHashes))
+	for i, hash := range recoveryCodeHashes {
+		codes[i] = models.UserRecoveryCodeModel{UserID: userID, CodeHash: hash}
+	}
+	return tx.Create(&codes).Error
+}
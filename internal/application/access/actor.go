@@ -0,0 +1,112 @@
+package access
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/events"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// Actor identifica quién ejecuta la petición y desde dónde: un usuario con sesión o una llave de API
+type Actor struct {
+	User      *entities.User
+	Role      *entities.Role   // Rol efectivo; con llave de API, limitado a los permisos de la llave
+	APIKey    *entities.APIKey // nil cuando la petición usa un token de sesión
+	IPAddress string
+	UserAgent string
+	RequestID string
+}
+
+type actorContextKey struct{}
+
+// WithActor agrega el actor autenticado al contexto de la petición
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext obtiene el actor de la petición (no existe en tareas programadas ni en el portal)
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorContextKey{}).(Actor)
+	return actor, ok
+}
+
+// CheckRoleGrant verifica que el actor de la petición pueda otorgar el rol: todos sus permisos deben
+// estar dentro de los del actor y SUPER_ADMIN solo lo asigna otro SUPER_ADMIN. Sin actor se rechaza
+func CheckRoleGrant(ctx context.Context, role *entities.Role) error {
+	actor, ok := ActorFromContext(ctx)
+	if !ok || actor.User == nil || actor.Role == nil {
+		return entities.ErrRoleEscalation
+	}
+	if role.Name == entities.RoleSuperAdmin && !actor.User.IsSuperAdmin() {
+		return entities.ErrRoleEscalation
+	}
+	if !actor.Role.CanGrant(role) {
+		return entities.ErrRoleEscalation
+	}
+	return nil
+}
+
+// CheckRoleGrantByName busca el rol por nombre y verifica con CheckRoleGrant que el actor pueda otorgarlo
+// Se usa antes de asignar un rol y antes de editar, eliminar o reiniciar el 2FA de un usuario que lo tiene
+// Retorna entities.ErrInvalidRole si el rol no existe
+func CheckRoleGrantByName(ctx context.Context, roleRepo ports.RoleRepository, name entities.UserRole) error {
+	role, err := roleRepo.GetByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, entities.ErrRoleNotFound) {
+			return entities.ErrInvalidRole
+		}
+		return err
+	}
+	return CheckRoleGrant(ctx, role)
+}
+
+// EventActor convierte el actor al formato que viaja en los eventos
+func (a Actor) EventActor() *events.Actor {
+	eventActor := &events.Actor{
+		IPAddress: a.IPAddress,
+		UserAgent: a.UserAgent,
+		RequestID: a.RequestID,
+	}
+	if a.User != nil {
+		eventActor.UserID = &a.User.ID
+		eventActor.UserName = a.User.FullName()
+	}
+	if a.APIKey != nil {
+		eventActor.APIKeyID = &a.APIKey.ID
+		eventActor.UserName = a.APIKey.DisplayName()
+	}
+	return eventActor
+}
+
+// ApplyTo completa el registro de auditoría con el actor de la petición
+func (a Actor) ApplyTo(auditLog *entities.AuditLog) {
+	auditLog.SetActor(a.User, a.APIKey)
+	auditLog.IPAddress = a.IPAddress
+	auditLog.UserAgent = a.UserAgent
+	auditLog.RequestID = a.RequestID
+}
+
+// actorPublisher agrega el actor de la petición a cada evento antes de publicarlo
+type actorPublisher struct {
+	publisher ports.EventPublisher
+	actor     *events.Actor
+}
+
+// Publisher retorna un publicador que marca los eventos con el actor del contexto
+// Sin actor (tareas programadas, portal) retorna el publicador original
+func Publisher(ctx context.Context, publisher ports.EventPublisher) ports.EventPublisher {
+	actor, ok := ActorFromContext(ctx)
+	if !ok || publisher == nil {
+		return publisher
+	}
+	return &actorPublisher{publisher: publisher, actor: actor.EventActor()}
+}
+
+func (p *actorPublisher) Publish(event events.OrderEvent) {
+	if event.Actor == nil {
+		event.Actor = p.actor
+	}
+	p.publisher.Publish(event)
+}
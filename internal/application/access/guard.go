@@ -0,0 +1,172 @@
+package access
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// Guard aplica las reglas de propiedad de órdenes y clientes dentro de los casos de uso:
+// un vendedor sin orders:all / customers:all solo accede a sus órdenes, a sus clientes
+// asignados y a lo que un administrador le haya compartido
+type Guard struct {
+	customerRepo ports.CustomerRepository
+	shareRepo    ports.RecordShareRepository
+}
+
+// NewGuard crea una nueva instancia del guard
+func NewGuard(customerRepo ports.CustomerRepository, shareRepo ports.RecordShareRepository) *Guard {
+	return &Guard{
+		customerRepo: customerRepo,
+		shareRepo:    shareRepo,
+	}
+}
+
+// CheckOrder verifica que el usuario de la petición pueda acceder a la orden
+func (g *Guard) CheckOrder(ctx context.Context, order *entities.Order) error {
+	scope := ScopeFromContext(ctx)
+	if scope.AllOrders {
+		return nil
+	}
+	if scope.CustomerID != 0 {
+		if order.CustomerID != nil && *order.CustomerID == scope.CustomerID {
+			return nil
+		}
+		return entities.ErrRecordAccessDenied
+	}
+	if scope.UserID == 0 {
+		return entities.ErrRecordAccessDenied
+	}
+	if order.SellerID == scope.UserID {
+		return nil
+	}
+	return g.checkShared(ctx, entities.ShareResourceOrder, order.ID, scope.UserID)
+}
+
+// CheckCustomer verifica que el usuario de la petición pueda acceder al cliente
+func (g *Guard) CheckCustomer(ctx context.Context, customer *entities.Customer) error {
+	scope := ScopeFromContext(ctx)
+	if scope.AllCustomers {
+		return nil
+	}
+	if scope.CustomerID != 0 {
+		if customer.ID == scope.CustomerID {
+			return nil
+		}
+		return entities.ErrRecordAccessDenied
+	}
+	if scope.UserID == 0 {
+		return entities.ErrRecordAccessDenied
+	}
+	if customer.AssignedSellerID != nil && *customer.AssignedSellerID == scope.UserID {
+		return nil
+	}
+	return g.checkShared(ctx, entities.ShareResourceCustomer, customer.ID, scope.UserID)
+}
+
+// CheckCustomerID carga el cliente y verifica el acceso (para operaciones que solo reciben el ID)
+func (g *Guard) CheckCustomerID(ctx context.Context, customerID uint) error {
+	scope := ScopeFromContext(ctx)
+	if scope.AllCustomers {
+		return nil
+	}
+	if scope.UserID == 0 {
+		// Portal o petición sin alcance: solo el propio cliente
+		if scope.CustomerID != 0 && customerID == scope.CustomerID {
+			return nil
+		}
+		return entities.ErrRecordAccessDenied
+	}
+	customer, err := g.customerRepo.GetByID(ctx, customerID)
+	if err != nil {
+		return err
+	}
+	return g.CheckCustomer(ctx, customer)
+}
+
+// ScopeOrderFilters restringe un listado de órdenes a las propias o compartidas
+// (las del cliente en el portal; ninguna sin alcance)
+func (g *Guard) ScopeOrderFilters(ctx context.Context, filters map[string]interface{}) {
+	scope := ScopeFromContext(ctx)
+	if scope.AllOrders {
+		return
+	}
+	if scope.CustomerID != 0 {
+		filters["customer_id"] = scope.CustomerID
+		return
+	}
+	filters["visible_to_user_id"] = scope.UserID
+}
+
+// ScopeCustomerFilters restringe un listado de clientes a los asignados o compartidos (ninguno sin alcance)
+func (g *Guard) ScopeCustomerFilters(ctx context.Context, filters map[string]interface{}) {
+	if scope := ScopeFromContext(ctx); !scope.AllCustomers {
+		filters["visible_to_user_id"] = scope.UserID
+	}
+}
+
+// FilterCustomers descarta de una lista ya cargada los clientes a los que el usuario no tiene acceso
+func (g *Guard) FilterCustomers(ctx context.Context, customers []entities.Customer) ([]entities.Customer, error) {
+	scope := ScopeFromContext(ctx)
+	if scope.AllCustomers {
+		return customers, nil
+	}
+	if scope.UserID == 0 {
+		visible := make([]entities.Customer, 0, 1)
+		for _, customer := range customers {
+			if scope.CustomerID != 0 && customer.ID == scope.CustomerID {
+				visible = append(visible, customer)
+			}
+		}
+		return visible, nil
+	}
+
+	sharedIDs, err := g.shareRepo.ListSharedIDs(ctx, entities.ShareResourceCustomer, scope.UserID)
+	if err != nil {
+		return nil, err
+	}
+	shared := make(map[uint]bool, len(sharedIDs))
+	for _, id := range sharedIDs {
+		shared[id] = true
+	}
+
+	visible := make([]entities.Customer, 0, len(customers))
+	for _, customer := range customers {
+		assigned := customer.AssignedSellerID != nil && *customer.AssignedSellerID == scope.UserID
+		if assigned || shared[customer.ID] {
+			visible = append(visible, customer)
+		}
+	}
+	return visible, nil
+}
+
+// AssignOrderSeller define el vendedor de una orden nueva: quien no tiene orders:all
+// solo puede crear órdenes a su nombre
+func (g *Guard) AssignOrderSeller(ctx context.Context, order *entities.Order) {
+	scope := ScopeFromContext(ctx)
+	if !scope.AllOrders || order.SellerID == 0 {
+		order.SellerID = scope.UserID
+	}
+}
+
+// AssignCustomerSeller asigna el cliente nuevo a quien lo crea si no se indicó otro vendedor
+// (quien no tiene customers:all siempre queda como responsable)
+func (g *Guard) AssignCustomerSeller(ctx context.Context, customer *entities.Customer) {
+	scope := ScopeFromContext(ctx)
+	if !scope.AllCustomers || customer.AssignedSellerID == nil {
+		userID := scope.UserID
+		customer.AssignedSellerID = &userID
+	}
+}
+
+func (g *Guard) checkShared(ctx context.Context, resourceType entities.ShareResourceType, resourceID, userID uint) error {
+	shared, err := g.shareRepo.IsShared(ctx, resourceType, resourceID, userID)
+	if err != nil {
+		return err
+	}
+	if !shared {
+		return entities.ErrRecordAccessDenied
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package access
+
+import "context"
+
+// Scope limita qué órdenes y clientes puede ver y modificar el usuario de la petición
+// El alcance vacío no da acceso a nada
+type Scope struct {
+	UserID       uint
+	CustomerID   uint // Portal: el cliente autenticado solo accede a sus propios datos y órdenes
+	AllOrders    bool // orders:all: ve todas las órdenes, no solo las propias o compartidas
+	AllCustomers bool // customers:all: ve todos los clientes, no solo los asignados o compartidos
+}
+
+// System retorna el alcance sin restricciones de las tareas programadas y los handlers de eventos
+func System() Scope {
+	return Scope{AllOrders: true, AllCustomers: true}
+}
+
+// Customer retorna el alcance del portal para el cliente autenticado
+func Customer(customerID uint) Scope {
+	return Scope{CustomerID: customerID}
+}
+
+type scopeContextKey struct{}
+
+// WithScope agrega el alcance del usuario autenticado al contexto de la petición
+func WithScope(ctx context.Context, scope Scope) context.Context {
+	return context.WithValue(ctx, scopeContextKey{}, scope)
+}
+
+// ScopeFromContext obtiene el alcance de la petición
+// Sin alcance se retorna el alcance vacío, que niega todo: las tareas programadas y los eventos
+// deben usar System() y el portal Customer()
+func ScopeFromContext(ctx context.Context) Scope {
+	scope, _ := ctx.Value(scopeContextKey{}).(Scope)
+	return scope
+}
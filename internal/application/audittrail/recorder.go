@@ -0,0 +1,172 @@
+package audittrail
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// maskedValue reemplaza los valores sensibles: se ve que cambiaron, no a qué
+const maskedValue = "***"
+
+// ignoredFields no aportan al historial (se normalizan en minúsculas y sin "_")
+var ignoredFields = map[string]bool{
+	"createdat": true,
+	"updatedat": true,
+}
+
+// sensitiveFields se registran enmascarados
+var sensitiveFields = map[string]bool{
+	"password":     true,
+	"passwordhash": true,
+	"keyhash":      true,
+	"tokenhash":    true,
+	"secret":       true,
+}
+
+// Recorder guarda el historial de cambios de las entidades con el diff campo a campo
+// Un fallo al guardar solo se registra en el log: no deshace el cambio ya aplicado
+type Recorder struct {
+	auditRepo ports.AuditLogRepository
+}
+
+// NewRecorder crea una nueva instancia del registrador de cambios
+func NewRecorder(auditRepo ports.AuditLogRepository) *Recorder {
+	return &Recorder{auditRepo: auditRepo}
+}
+
+// Created registra la creación de una entidad con todos sus campos
+func (r *Recorder) Created(ctx context.Context, entityType string, entityID uint, after interface{}) {
+	r.record(ctx, entityType, entityID, entities.AuditActionCreate, nil, after)
+}
+
+// Updated registra solo los campos que cambiaron; si no cambió nada no guarda nada
+func (r *Recorder) Updated(ctx context.Context, entityType string, entityID uint, before, after interface{}) {
+	r.record(ctx, entityType, entityID, entities.AuditActionUpdate, before, after)
+}
+
+// Deleted registra la eliminación con el último valor de cada campo
+func (r *Recorder) Deleted(ctx context.Context, entityType string, entityID uint, before interface{}) {
+	r.record(ctx, entityType, entityID, entities.AuditActionDelete, before, nil)
+}
+
+func (r *Recorder) record(ctx context.Context, entityType string, entityID uint, action string, before, after interface{}) {
+	changes := Diff(before, after)
+	if len(changes) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(changes)
+	if err != nil {
+		log.Printf("⚠️  Failed to serialize audit changes for %s #%d: %v", entityType, entityID, err)
+		return
+	}
+
+	auditLog := &entities.AuditLog{
+		EventType:   strings.ToLower(entityType) + "." + strings.ToLower(action),
+		EntityType:  entityType,
+		EntityID:    &entityID,
+		Action:      action,
+		Changes:     payload,
+		Description: fmt.Sprintf("%s #%d %s (%d fields)", entityType, entityID, strings.ToLower(action), len(changes)),
+		Metadata:    "{}",
+	}
+	if actor, ok := access.ActorFromContext(ctx); ok {
+		actor.ApplyTo(auditLog)
+	}
+
+	if err := r.auditRepo.Create(ctx, auditLog); err != nil {
+		log.Printf("⚠️  Failed to save audit trail for %s #%d: %v", entityType, entityID, err)
+	}
+}
+
+// Diff compara dos versiones de una entidad y retorna los campos que cambiaron
+// before o after pueden ser nil (creación y eliminación). Solo se comparan valores simples
+// y listas de valores simples; las relaciones anidadas se auditan como su propia entidad
+func Diff(before, after interface{}) map[string]entities.FieldChange {
+	beforeFields := flatten(before)
+	afterFields := flatten(after)
+
+	changes := make(map[string]entities.FieldChange)
+	for name, value := range afterFields {
+		previous, existed := beforeFields[name]
+		if existed && reflect.DeepEqual(previous, value) {
+			continue
+		}
+		changes[name] = fieldChange(name, previous, value)
+	}
+	for name, previous := range beforeFields {
+		if _, exists := afterFields[name]; !exists {
+			changes[name] = fieldChange(name, previous, nil)
+		}
+	}
+	return changes
+}
+
+func fieldChange(name string, before, after interface{}) entities.FieldChange {
+	if sensitiveFields[normalizeField(name)] {
+		if before != nil {
+			before = maskedValue
+		}
+		if after != nil {
+			after = maskedValue
+		}
+	}
+	return entities.FieldChange{Before: before, After: after}
+}
+
+// flatten convierte la entidad a un mapa campo → valor usando su representación JSON
+func flatten(value interface{}) map[string]interface{} {
+	fields := make(map[string]interface{})
+	if value == nil || (reflect.ValueOf(value).Kind() == reflect.Ptr && reflect.ValueOf(value).IsNil()) {
+		return fields
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fields
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return fields
+	}
+
+	for name, field := range decoded {
+		if ignoredFields[normalizeField(name)] || !isSimple(field) {
+			continue
+		}
+		fields[name] = field
+	}
+	return fields
+}
+
+func normalizeField(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// isSimple indica si el valor es un escalar o una lista de escalares
+func isSimple(value interface{}) bool {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return false
+	case []interface{}:
+		for _, item := range v {
+			if !isSimple(item) {
+				return false
+			}
+			if _, isList := item.([]interface{}); isList {
+				return false
+			}
+		}
+		return true
+	default:
+		return true
+	}
+}
@@ -0,0 +1,59 @@
+package cashregister
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// Register relaciona los abonos y las ventas con la caja abierta de quien los registra
+// y protege los movimientos de las cajas ya cerradas
+type Register struct {
+	sessionRepo ports.CashSessionRepository
+}
+
+// NewRegister crea una nueva instancia de la caja registradora
+func NewRegister(sessionRepo ports.CashSessionRepository) *Register {
+	return &Register{sessionRepo: sessionRepo}
+}
+
+// CurrentSessionID retorna la caja abierta del usuario de la petición
+// Sin caja abierta, con una llave de API o fuera de una petición (eventos, tareas) retorna nil
+func (r *Register) CurrentSessionID(ctx context.Context) (*uint, error) {
+	actor, ok := access.ActorFromContext(ctx)
+	if !ok || actor.User == nil {
+		return nil, nil
+	}
+
+	session, err := r.sessionRepo.GetOpenByUser(ctx, actor.User.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &session.ID, nil
+}
+
+// CheckUnlocked retorna entities.ErrCashSessionLocked si el movimiento pertenece a una caja cerrada
+func (r *Register) CheckUnlocked(ctx context.Context, sessionID *uint) error {
+	if sessionID == nil {
+		return nil
+	}
+
+	session, err := r.sessionRepo.GetByID(ctx, *sessionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !session.IsOpen() {
+		return entities.ErrCashSessionLocked
+	}
+	return nil
+}
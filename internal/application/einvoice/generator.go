@@ -0,0 +1,176 @@
+package einvoice
+
+import (
+	"context"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"math"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// Consulta pública de documentos por CUFE (código QR)
+const (
+	qrURLProduction = "https://catalogo-vpfe.dian.gov.co/document/searchqr?documentkey="
+	qrURLTesting    = "https://catalogo-vpfe-hab.dian.gov.co/document/searchqr?documentkey="
+)
+
+// Settings son los datos del software de facturación registrado ante la DIAN
+type Settings struct {
+	Environment         string  // entities.DianEnvironmentProduction o entities.DianEnvironmentTesting
+	SoftwareID          string  // Identificador del software asignado por la DIAN
+	SoftwarePIN         string  // PIN del software: reemplaza la clave técnica en el CUDE de las notas crédito
+	SoftwareProviderNIT string  // NIT del dueño del software (el facturador si es software propio)
+	IVARate             float64 // Tarifa de IVA de las ventas cuando el emisor es responsable
+	PricesIncludeIVA    bool    // Los precios de las órdenes ya incluyen el IVA (precio al público)
+}
+
+// SecurityCode calcula el código de seguridad del software: SHA-384(SoftwareID + PIN + número del documento)
+func (s Settings) SecurityCode(number string) string {
+	sum := sha512.Sum384([]byte(s.SoftwareID + s.SoftwarePIN + number))
+	return hex.EncodeToString(sum[:])
+}
+
+// QRCode arma el contenido del código QR que se imprime en la representación gráfica
+func (s Settings) QRCode(document *entities.ElectronicDocument, issuer entities.InvoiceIssuer) string {
+	url := qrURLTesting
+	if s.Environment == entities.DianEnvironmentProduction {
+		url = qrURLProduction
+	}
+	issueDate := document.IssueDate.In(entities.DianLocation)
+	return fmt.Sprintf("NumFac: %s FecFac: %s HorFac: %s NitFac: %s DocAdq: %s ValFac: %s ValIva: %s ValOtroIm: 0.00 ValTolFac: %s CUFE: %s QRCode: %s%s",
+		document.FullNumber(),
+		issueDate.Format("2006-01-02"),
+		issueDate.Format("15:04:05-07:00"),
+		issuer.NIT,
+		document.BuyerIDNumber,
+		entities.FormatDianAmount(document.Subtotal),
+		entities.FormatDianAmount(document.TaxTotal),
+		entities.FormatDianAmount(document.Total),
+		document.CUFE,
+		url,
+		document.CUFE,
+	)
+}
+
+// Generator arma el XML UBL 2.1 de facturas y notas crédito, calcula el CUFE/CUDE y lo firma
+type Generator struct {
+	issuer   entities.InvoiceIssuer
+	settings Settings
+	signer   ports.DocumentSigner
+}
+
+// NewGenerator crea el generador de documentos electrónicos del facturador
+func NewGenerator(issuer entities.InvoiceIssuer, settings Settings, signer ports.DocumentSigner) *Generator {
+	if settings.SoftwareProviderNIT == "" {
+		settings.SoftwareProviderNIT = issuer.NIT
+	}
+	return &Generator{issuer: issuer, settings: settings, signer: signer}
+}
+
+// Issuer retorna los datos del facturador
+func (g *Generator) Issuer() entities.InvoiceIssuer {
+	return g.issuer
+}
+
+// Prepare completa un documento ya numerado: ambiente, CUFE (o CUDE), XML y firma
+// La factura usa la clave técnica de la resolución y la nota crédito el PIN del software
+func (g *Generator) Prepare(ctx context.Context, document *entities.ElectronicDocument, resolution *entities.InvoiceResolution) error {
+	document.Environment = g.settings.Environment
+	document.TaxResponsible = g.issuer.IVAResponsible
+
+	key := resolution.TechnicalKey
+	if document.IsCreditNote() {
+		key = g.settings.SoftwarePIN
+	}
+	document.ComputeCUFE(g.issuer.NIT, key)
+
+	unsigned, err := marshalUBL(document, resolution, g.issuer, g.settings)
+	if err != nil {
+		return err
+	}
+	signed, err := g.signer.Sign(ctx, unsigned)
+	if err != nil {
+		return fmt.Errorf("failed to sign electronic document: %w", err)
+	}
+
+	document.XML = string(signed)
+	return nil
+}
+
+// OrderLines arma los renglones de la factura de una orden
+// El total de la orden ya tiene descontados cupones y puntos: el descuento se reparte entre los items
+// en proporción a su valor y el último renglón absorbe el redondeo para que la factura sume lo cobrado
+func (g *Generator) OrderLines(order *entities.Order) []entities.ElectronicDocumentLine {
+	if len(order.Items) == 0 {
+		return []entities.ElectronicDocumentLine{
+			g.line(nil, "", fmt.Sprintf("Venta de productos según orden %s", order.OrderNumber), 1, order.TotalAmount),
+		}
+	}
+
+	itemsTotal := 0.0
+	for _, item := range order.Items {
+		itemsTotal += item.Subtotal
+	}
+
+	lines := make([]entities.ElectronicDocumentLine, 0, len(order.Items))
+	assigned := 0.0
+	for i, item := range order.Items {
+		gross := order.TotalAmount
+		if itemsTotal > 0 {
+			gross = roundCents(item.Subtotal * order.TotalAmount / itemsTotal)
+		}
+		if i == len(order.Items)-1 {
+			gross = roundCents(order.TotalAmount - assigned)
+		}
+		assigned += gross
+
+		description := item.ProductName
+		if item.Color != "" {
+			description += " - " + item.Color
+		}
+		if item.Size != nil && item.Size.Value != "" {
+			description += " - Talla " + item.Size.Value
+		}
+
+		itemID := item.ID
+		code := ""
+		if item.ProductVariantID != 0 {
+			code = fmt.Sprintf("VAR-%d", item.ProductVariantID)
+		}
+		lines = append(lines, g.line(&itemID, code, description, item.Quantity, gross))
+	}
+	return lines
+}
+
+// roundCents redondea un valor a centavos
+func roundCents(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+// line calcula base, IVA y precio unitario sin IVA de un renglón a partir de su valor cobrado
+func (g *Generator) line(orderItemID *uint, code, description string, quantity int, gross float64) entities.ElectronicDocumentLine {
+	line := entities.ElectronicDocumentLine{
+		OrderItemID: orderItemID,
+		Code:        code,
+		Description: description,
+		Quantity:    quantity,
+		Subtotal:    roundCents(gross),
+	}
+
+	if g.issuer.IVAResponsible && g.settings.IVARate > 0 {
+		line.TaxPercent = g.settings.IVARate
+		if g.settings.PricesIncludeIVA {
+			line.Subtotal = roundCents(gross / (1 + g.settings.IVARate/100))
+			line.TaxAmount = roundCents(gross - line.Subtotal)
+		} else {
+			line.TaxAmount = roundCents(gross * g.settings.IVARate / 100)
+		}
+	}
+	if quantity > 0 {
+		line.UnitPrice = roundCents(line.Subtotal / float64(quantity))
+	}
+	return line
+}
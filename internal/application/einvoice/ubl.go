@@ -0,0 +1,559 @@
+package einvoice
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// Espacios de nombres de UBL 2.1 y de las extensiones de la DIAN
+const (
+	namespaceInvoice    = "urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"
+	namespaceCreditNote = "urn:oasis:names:specification:ubl:schema:xsd:CreditNote-2"
+	namespaceCAC        = "urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2"
+	namespaceCBC        = "urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2"
+	namespaceEXT        = "urn:oasis:names:specification:ubl:schema:xsd:CommonExtensionComponents-2"
+	namespaceSTS        = "dian:gov:co:facturaelectronica:Structures-2-1"
+	namespaceDS         = "http://www.w3.org/2000/09/xmldsig#"
+	namespaceXADES      = "http://uri.etsi.org/01903/v1.3.2#"
+	namespaceXADES141   = "http://uri.etsi.org/01903/v1.4.1#"
+	namespaceXSI        = "http://www.w3.org/2001/XMLSchema-instance"
+)
+
+// Atributos de los identificadores emitidos por la DIAN
+const (
+	dianAgencyID   = "195"
+	dianAgencyName = "CO, DIAN (Dirección de Impuestos y Aduanas Nacionales)"
+	dianNIT        = "800197268" // NIT de la DIAN como proveedor de autorización
+	unitCode       = "94"        // Unidad: pieza
+	currencyCode   = "COP"
+)
+
+// ublDocument es la raíz común de la factura (Invoice) y la nota crédito (CreditNote)
+// Los elementos que solo aplican a uno de los dos tipos se omiten cuando están vacíos
+type ublDocument struct {
+	XMLName             xml.Name
+	Xmlns               string                  `xml:"xmlns,attr"`
+	XmlnsCAC            string                  `xml:"xmlns:cac,attr"`
+	XmlnsCBC            string                  `xml:"xmlns:cbc,attr"`
+	XmlnsDS             string                  `xml:"xmlns:ds,attr"`
+	XmlnsEXT            string                  `xml:"xmlns:ext,attr"`
+	XmlnsSTS            string                  `xml:"xmlns:sts,attr"`
+	XmlnsXADES          string                  `xml:"xmlns:xades,attr"`
+	XmlnsXADES141       string                  `xml:"xmlns:xades141,attr"`
+	XmlnsXSI            string                  `xml:"xmlns:xsi,attr"`
+	UBLExtensions       ublExtensions           `xml:"ext:UBLExtensions"`
+	UBLVersionID        string                  `xml:"cbc:UBLVersionID"`
+	CustomizationID     string                  `xml:"cbc:CustomizationID"`
+	ProfileID           string                  `xml:"cbc:ProfileID"`
+	ProfileExecutionID  string                  `xml:"cbc:ProfileExecutionID"`
+	ID                  string                  `xml:"cbc:ID"`
+	UUID                ublScheme               `xml:"cbc:UUID"`
+	IssueDate           string                  `xml:"cbc:IssueDate"`
+	IssueTime           string                  `xml:"cbc:IssueTime"`
+	InvoiceTypeCode     string                  `xml:"cbc:InvoiceTypeCode,omitempty"`
+	CreditNoteTypeCode  string                  `xml:"cbc:CreditNoteTypeCode,omitempty"`
+	Note                string                  `xml:"cbc:Note,omitempty"`
+	DocumentCurrency    string                  `xml:"cbc:DocumentCurrencyCode"`
+	LineCountNumeric    int                     `xml:"cbc:LineCountNumeric"`
+	DiscrepancyResponse *ublDiscrepancyResponse `xml:"cac:DiscrepancyResponse,omitempty"`
+	BillingReference    *ublBillingReference    `xml:"cac:BillingReference,omitempty"`
+	Supplier            ublParty                `xml:"cac:AccountingSupplierParty"`
+	Customer            ublParty                `xml:"cac:AccountingCustomerParty"`
+	PaymentMeans        ublPaymentMeans         `xml:"cac:PaymentMeans"`
+	TaxTotals           []ublTaxTotal           `xml:"cac:TaxTotal,omitempty"`
+	MonetaryTotal       ublMonetaryTotal        `xml:"cac:LegalMonetaryTotal"`
+	InvoiceLines        []ublLine               `xml:"cac:InvoiceLine,omitempty"`
+	CreditNoteLines     []ublLine               `xml:"cac:CreditNoteLine,omitempty"`
+}
+
+// ublExtensions lleva las extensiones de la DIAN y el espacio reservado para la firma
+type ublExtensions struct {
+	Extensions []ublExtension `xml:"ext:UBLExtension"`
+}
+
+type ublExtension struct {
+	Content ublExtensionContent `xml:"ext:ExtensionContent"`
+}
+
+type ublExtensionContent struct {
+	DianExtensions *ublDianExtensions `xml:"sts:DianExtensions,omitempty"`
+}
+
+type ublDianExtensions struct {
+	InvoiceControl        *ublInvoiceControl       `xml:"sts:InvoiceControl,omitempty"`
+	InvoiceSource         ublInvoiceSource         `xml:"sts:InvoiceSource"`
+	SoftwareProvider      ublSoftwareProvider      `xml:"sts:SoftwareProvider"`
+	SoftwareSecurityCode  ublScheme                `xml:"sts:SoftwareSecurityCode"`
+	AuthorizationProvider ublAuthorizationProvider `xml:"sts:AuthorizationProvider"`
+	QRCode                string                   `xml:"sts:QRCode"`
+}
+
+type ublInvoiceControl struct {
+	InvoiceAuthorization string                `xml:"sts:InvoiceAuthorization"`
+	AuthorizationPeriod  ublPeriod             `xml:"sts:AuthorizationPeriod"`
+	AuthorizedInvoices   ublAuthorizedInvoices `xml:"sts:AuthorizedInvoices"`
+}
+
+type ublPeriod struct {
+	StartDate string `xml:"cbc:StartDate"`
+	EndDate   string `xml:"cbc:EndDate"`
+}
+
+type ublAuthorizedInvoices struct {
+	Prefix string `xml:"sts:Prefix,omitempty"`
+	From   int64  `xml:"sts:From"`
+	To     int64  `xml:"sts:To"`
+}
+
+type ublInvoiceSource struct {
+	IdentificationCode ublCountryCode `xml:"cbc:IdentificationCode"`
+}
+
+type ublCountryCode struct {
+	ListAgencyID   string `xml:"listAgencyID,attr"`
+	ListAgencyName string `xml:"listAgencyName,attr"`
+	ListSchemeURI  string `xml:"listSchemeURI,attr"`
+	Value          string `xml:",chardata"`
+}
+
+type ublSoftwareProvider struct {
+	ProviderID ublScheme `xml:"sts:ProviderID"`
+	SoftwareID ublScheme `xml:"sts:SoftwareID"`
+}
+
+type ublAuthorizationProvider struct {
+	AuthorizationProviderID ublScheme `xml:"sts:AuthorizationProviderID"`
+}
+
+// ublScheme es un identificador con los atributos de esquema de la DIAN
+type ublScheme struct {
+	SchemeAgencyID   string `xml:"schemeAgencyID,attr,omitempty"`
+	SchemeAgencyName string `xml:"schemeAgencyName,attr,omitempty"`
+	SchemeID         string `xml:"schemeID,attr,omitempty"`
+	SchemeName       string `xml:"schemeName,attr,omitempty"`
+	Value            string `xml:",chardata"`
+}
+
+type ublDiscrepancyResponse struct {
+	ReferenceID  string `xml:"cbc:ReferenceID"`
+	ResponseCode string `xml:"cbc:ResponseCode"`
+	Description  string `xml:"cbc:Description"`
+}
+
+type ublBillingReference struct {
+	InvoiceDocumentReference ublDocumentReference `xml:"cac:InvoiceDocumentReference"`
+}
+
+type ublDocumentReference struct {
+	ID        string    `xml:"cbc:ID"`
+	UUID      ublScheme `xml:"cbc:UUID"`
+	IssueDate string    `xml:"cbc:IssueDate"`
+}
+
+// ublParty es el emisor (AccountingSupplierParty) o el adquiriente (AccountingCustomerParty)
+type ublParty struct {
+	AdditionalAccountID string          `xml:"cbc:AdditionalAccountID"` // 1 persona jurídica, 2 persona natural
+	Party               ublPartyDetails `xml:"cac:Party"`
+}
+
+type ublPartyDetails struct {
+	PartyIdentification *ublPartyIdentification `xml:"cac:PartyIdentification,omitempty"`
+	PartyName           ublPartyName            `xml:"cac:PartyName"`
+	PhysicalLocation    *ublLocation            `xml:"cac:PhysicalLocation,omitempty"`
+	PartyTaxScheme      ublPartyTaxScheme       `xml:"cac:PartyTaxScheme"`
+	PartyLegalEntity    ublPartyLegalEntity     `xml:"cac:PartyLegalEntity"`
+	Contact             *ublContact             `xml:"cac:Contact,omitempty"`
+}
+
+type ublPartyIdentification struct {
+	ID ublScheme `xml:"cbc:ID"`
+}
+
+type ublPartyName struct {
+	Name string `xml:"cbc:Name"`
+}
+
+type ublLocation struct {
+	Address ublAddress `xml:"cac:Address"`
+}
+
+type ublAddress struct {
+	ID                   string         `xml:"cbc:ID"` // Código DANE del municipio
+	CityName             string         `xml:"cbc:CityName"`
+	CountrySubentity     string         `xml:"cbc:CountrySubentity"`
+	CountrySubentityCode string         `xml:"cbc:CountrySubentityCode"`
+	AddressLine          ublAddressLine `xml:"cac:AddressLine"`
+	Country              ublCountry     `xml:"cac:Country"`
+}
+
+type ublAddressLine struct {
+	Line string `xml:"cbc:Line"`
+}
+
+type ublCountry struct {
+	IdentificationCode string      `xml:"cbc:IdentificationCode"`
+	Name               ublLanguage `xml:"cbc:Name"`
+}
+
+type ublLanguage struct {
+	LanguageID string `xml:"languageID,attr"`
+	Value      string `xml:",chardata"`
+}
+
+type ublPartyTaxScheme struct {
+	RegistrationName    string          `xml:"cbc:RegistrationName"`
+	CompanyID           ublScheme       `xml:"cbc:CompanyID"`
+	TaxLevelCode        ublTaxLevelCode `xml:"cbc:TaxLevelCode"`
+	RegistrationAddress *ublAddress     `xml:"cac:RegistrationAddress,omitempty"`
+	TaxScheme           ublTaxScheme    `xml:"cac:TaxScheme"`
+}
+
+type ublTaxLevelCode struct {
+	ListName string `xml:"listName,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type ublTaxScheme struct {
+	ID   string `xml:"cbc:ID"`
+	Name string `xml:"cbc:Name"`
+}
+
+type ublPartyLegalEntity struct {
+	RegistrationName            string                 `xml:"cbc:RegistrationName"`
+	CompanyID                   ublScheme              `xml:"cbc:CompanyID"`
+	CorporateRegistrationScheme *ublRegistrationScheme `xml:"cac:CorporateRegistrationScheme,omitempty"`
+}
+
+type ublRegistrationScheme struct {
+	ID string `xml:"cbc:ID"`
+}
+
+type ublContact struct {
+	Telephone      string `xml:"cbc:Telephone,omitempty"`
+	ElectronicMail string `xml:"cbc:ElectronicMail,omitempty"`
+}
+
+type ublPaymentMeans struct {
+	ID               string `xml:"cbc:ID"` // 1 contado, 2 crédito
+	PaymentMeansCode string `xml:"cbc:PaymentMeansCode"`
+	PaymentDueDate   string `xml:"cbc:PaymentDueDate,omitempty"`
+}
+
+type ublAmount struct {
+	CurrencyID string `xml:"currencyID,attr"`
+	Value      string `xml:",chardata"`
+}
+
+type ublQuantity struct {
+	UnitCode string `xml:"unitCode,attr"`
+	Value    int    `xml:",chardata"`
+}
+
+type ublTaxTotal struct {
+	TaxAmount    ublAmount        `xml:"cbc:TaxAmount"`
+	TaxSubtotals []ublTaxSubtotal `xml:"cac:TaxSubtotal"`
+}
+
+type ublTaxSubtotal struct {
+	TaxableAmount ublAmount      `xml:"cbc:TaxableAmount"`
+	TaxAmount     ublAmount      `xml:"cbc:TaxAmount"`
+	TaxCategory   ublTaxCategory `xml:"cac:TaxCategory"`
+}
+
+type ublTaxCategory struct {
+	Percent   string       `xml:"cbc:Percent"`
+	TaxScheme ublTaxScheme `xml:"cac:TaxScheme"`
+}
+
+type ublMonetaryTotal struct {
+	LineExtensionAmount ublAmount `xml:"cbc:LineExtensionAmount"`
+	TaxExclusiveAmount  ublAmount `xml:"cbc:TaxExclusiveAmount"`
+	TaxInclusiveAmount  ublAmount `xml:"cbc:TaxInclusiveAmount"`
+	PayableAmount       ublAmount `xml:"cbc:PayableAmount"`
+}
+
+// ublLine es un renglón: InvoicedQuantity en facturas y CreditedQuantity en notas crédito
+type ublLine struct {
+	ID                  int           `xml:"cbc:ID"`
+	InvoicedQuantity    *ublQuantity  `xml:"cbc:InvoicedQuantity,omitempty"`
+	CreditedQuantity    *ublQuantity  `xml:"cbc:CreditedQuantity,omitempty"`
+	LineExtensionAmount ublAmount     `xml:"cbc:LineExtensionAmount"`
+	TaxTotals           []ublTaxTotal `xml:"cac:TaxTotal,omitempty"`
+	Item                ublItem       `xml:"cac:Item"`
+	Price               ublPrice      `xml:"cac:Price"`
+}
+
+type ublItem struct {
+	Description                string                 `xml:"cbc:Description"`
+	StandardItemIdentification *ublItemIdentification `xml:"cac:StandardItemIdentification,omitempty"`
+}
+
+type ublItemIdentification struct {
+	ID ublScheme `xml:"cbc:ID"`
+}
+
+type ublPrice struct {
+	PriceAmount  ublAmount   `xml:"cbc:PriceAmount"`
+	BaseQuantity ublQuantity `xml:"cbc:BaseQuantity"`
+}
+
+// amount formatea un valor en pesos con el atributo de moneda
+func amount(value float64) ublAmount {
+	return ublAmount{CurrencyID: currencyCode, Value: entities.FormatDianAmount(value)}
+}
+
+// dianScheme arma un identificador con la agencia DIAN
+func dianScheme(schemeID, schemeName, value string) ublScheme {
+	return ublScheme{
+		SchemeAgencyID:   dianAgencyID,
+		SchemeAgencyName: dianAgencyName,
+		SchemeID:         schemeID,
+		SchemeName:       schemeName,
+		Value:            value,
+	}
+}
+
+// ivaScheme retorna el tributo del responsable de IVA o "no aplica"
+func ivaScheme(responsible bool) ublTaxScheme {
+	if responsible {
+		return ublTaxScheme{ID: "01", Name: "IVA"}
+	}
+	return ublTaxScheme{ID: "ZZ", Name: "No aplica"}
+}
+
+// taxTotal arma el grupo de IVA de un conjunto de subtotales por tarifa
+func taxTotal(subtotals []entities.ElectronicTaxSubtotal) ublTaxTotal {
+	total := 0.0
+	group := ublTaxTotal{TaxSubtotals: make([]ublTaxSubtotal, len(subtotals))}
+	for i, subtotal := range subtotals {
+		total += subtotal.TaxAmount
+		group.TaxSubtotals[i] = ublTaxSubtotal{
+			TaxableAmount: amount(subtotal.TaxableAmount),
+			TaxAmount:     amount(subtotal.TaxAmount),
+			TaxCategory: ublTaxCategory{
+				Percent:   strconv.FormatFloat(subtotal.Percent, 'f', 2, 64),
+				TaxScheme: ivaScheme(true),
+			},
+		}
+	}
+	group.TaxAmount = amount(total)
+	return group
+}
+
+// issuerAddress arma la dirección del emisor con los códigos DANE configurados
+func issuerAddress(issuer entities.InvoiceIssuer) ublAddress {
+	return ublAddress{
+		ID:                   issuer.CityCode,
+		CityName:             issuer.City,
+		CountrySubentity:     issuer.Department,
+		CountrySubentityCode: issuer.DepartmentCode,
+		AddressLine:          ublAddressLine{Line: issuer.Address},
+		Country: ublCountry{
+			IdentificationCode: "CO",
+			Name:               ublLanguage{LanguageID: "es", Value: "Colombia"},
+		},
+	}
+}
+
+// supplierParty arma el grupo del facturador
+func supplierParty(issuer entities.InvoiceIssuer, prefix string) ublParty {
+	companyID := dianScheme(issuer.CheckDigit(), entities.BuyerIDTypeNIT, issuer.NIT)
+	address := issuerAddress(issuer)
+
+	party := ublParty{
+		AdditionalAccountID: issuer.PersonType,
+		Party: ublPartyDetails{
+			PartyName:        ublPartyName{Name: issuer.Name},
+			PhysicalLocation: &ublLocation{Address: address},
+			PartyTaxScheme: ublPartyTaxScheme{
+				RegistrationName:    issuer.Name,
+				CompanyID:           companyID,
+				TaxLevelCode:        ublTaxLevelCode{ListName: "48", Value: issuer.TaxLevelCode},
+				RegistrationAddress: &address,
+				TaxScheme:           ivaScheme(issuer.IVAResponsible),
+			},
+			PartyLegalEntity: ublPartyLegalEntity{
+				RegistrationName:            issuer.Name,
+				CompanyID:                   companyID,
+				CorporateRegistrationScheme: &ublRegistrationScheme{ID: prefix},
+			},
+		},
+	}
+	if issuer.Phone != "" || issuer.Email != "" {
+		party.Party.Contact = &ublContact{Telephone: issuer.Phone, ElectronicMail: issuer.Email}
+	}
+	return party
+}
+
+// customerParty arma el grupo del adquiriente; solo los NIT llevan dígito de verificación
+func customerParty(document *entities.ElectronicDocument) ublParty {
+	personType, checkDigit := "2", ""
+	if document.BuyerIDType == entities.BuyerIDTypeNIT {
+		personType, checkDigit = "1", entities.NITCheckDigit(document.BuyerIDNumber)
+	}
+	companyID := dianScheme(checkDigit, document.BuyerIDType, document.BuyerIDNumber)
+
+	party := ublParty{
+		AdditionalAccountID: personType,
+		Party: ublPartyDetails{
+			PartyIdentification: &ublPartyIdentification{ID: ublScheme{SchemeName: document.BuyerIDType, SchemeID: checkDigit, Value: document.BuyerIDNumber}},
+			PartyName:           ublPartyName{Name: document.BuyerName},
+			PartyTaxScheme: ublPartyTaxScheme{
+				RegistrationName: document.BuyerName,
+				CompanyID:        companyID,
+				TaxLevelCode:     ublTaxLevelCode{ListName: "48", Value: "R-99-PN"},
+				TaxScheme:        ivaScheme(false),
+			},
+			PartyLegalEntity: ublPartyLegalEntity{
+				RegistrationName: document.BuyerName,
+				CompanyID:        companyID,
+			},
+		},
+	}
+	if document.BuyerPhone != "" || document.BuyerEmail != "" {
+		party.Party.Contact = &ublContact{Telephone: document.BuyerPhone, ElectronicMail: document.BuyerEmail}
+	}
+	return party
+}
+
+// marshalUBL genera el XML UBL 2.1 del documento con la firma pendiente en la última extensión
+func marshalUBL(document *entities.ElectronicDocument, resolution *entities.InvoiceResolution, issuer entities.InvoiceIssuer, settings Settings) ([]byte, error) {
+	issueDate := document.IssueDate.In(entities.DianLocation)
+
+	root := ublDocument{
+		XmlnsCAC:           namespaceCAC,
+		XmlnsCBC:           namespaceCBC,
+		XmlnsDS:            namespaceDS,
+		XmlnsEXT:           namespaceEXT,
+		XmlnsSTS:           namespaceSTS,
+		XmlnsXADES:         namespaceXADES,
+		XmlnsXADES141:      namespaceXADES141,
+		XmlnsXSI:           namespaceXSI,
+		UBLVersionID:       "UBL 2.1",
+		ProfileExecutionID: document.Environment,
+		ID:                 document.FullNumber(),
+		IssueDate:          issueDate.Format("2006-01-02"),
+		IssueTime:          issueDate.Format("15:04:05-07:00"),
+		Note:               document.Notes,
+		DocumentCurrency:   currencyCode,
+		LineCountNumeric:   len(document.Lines),
+		Supplier:           supplierParty(issuer, document.Prefix),
+		Customer:           customerParty(document),
+		PaymentMeans:       ublPaymentMeans{ID: "1", PaymentMeansCode: document.PaymentMeans},
+		MonetaryTotal: ublMonetaryTotal{
+			LineExtensionAmount: amount(document.Subtotal),
+			TaxExclusiveAmount:  amount(document.Subtotal),
+			TaxInclusiveAmount:  amount(document.Total),
+			PayableAmount:       amount(document.Total),
+		},
+	}
+	if document.PaymentDueDate != nil {
+		root.PaymentMeans.ID = "2"
+		root.PaymentMeans.PaymentDueDate = document.PaymentDueDate.Format("2006-01-02")
+	}
+	if subtotals := document.TaxSubtotals(); len(subtotals) > 0 {
+		root.TaxTotals = []ublTaxTotal{taxTotal(subtotals)}
+	}
+
+	dianExtensions := &ublDianExtensions{
+		InvoiceSource: ublInvoiceSource{IdentificationCode: ublCountryCode{
+			ListAgencyID:   "6",
+			ListAgencyName: "United Nations Economic Commission for Europe",
+			ListSchemeURI:  "urn:oasis:names:specification:ubl:codelist:gc:CountryIdentificationCode-2.1",
+			Value:          "CO",
+		}},
+		SoftwareProvider: ublSoftwareProvider{
+			ProviderID: dianScheme(entities.NITCheckDigit(settings.SoftwareProviderNIT), entities.BuyerIDTypeNIT, settings.SoftwareProviderNIT),
+			SoftwareID: dianScheme("", "", settings.SoftwareID),
+		},
+		SoftwareSecurityCode:  dianScheme("", "", settings.SecurityCode(document.FullNumber())),
+		AuthorizationProvider: ublAuthorizationProvider{AuthorizationProviderID: dianScheme("4", entities.BuyerIDTypeNIT, dianNIT)},
+		QRCode:                settings.QRCode(document, issuer),
+	}
+
+	if document.IsCreditNote() {
+		root.XMLName = xml.Name{Local: "CreditNote"}
+		root.Xmlns = namespaceCreditNote
+		root.CustomizationID = "20" // Nota crédito que referencia una factura electrónica
+		root.ProfileID = "DIAN 2.1: Nota Crédito de Factura Electrónica de Venta"
+		root.UUID = ublScheme{SchemeID: document.Environment, SchemeName: "CUDE-SHA384", Value: document.CUFE}
+		root.CreditNoteTypeCode = "91"
+		root.DiscrepancyResponse = &ublDiscrepancyResponse{
+			ReferenceID:  document.ReferenceNumber,
+			ResponseCode: document.CorrectionCode,
+			Description:  document.CorrectionReason,
+		}
+		reference := ublDocumentReference{
+			ID:   document.ReferenceNumber,
+			UUID: ublScheme{SchemeName: "CUFE-SHA384", Value: document.ReferenceCUFE},
+		}
+		if document.ReferenceIssueDate != nil {
+			reference.IssueDate = document.ReferenceIssueDate.In(entities.DianLocation).Format("2006-01-02")
+		}
+		root.BillingReference = &ublBillingReference{InvoiceDocumentReference: reference}
+	} else {
+		root.XMLName = xml.Name{Local: "Invoice"}
+		root.Xmlns = namespaceInvoice
+		root.CustomizationID = "10" // Factura estándar
+		root.ProfileID = "DIAN 2.1: Factura Electrónica de Venta"
+		root.UUID = ublScheme{SchemeID: document.Environment, SchemeName: "CUFE-SHA384", Value: document.CUFE}
+		root.InvoiceTypeCode = "01"
+		dianExtensions.InvoiceControl = &ublInvoiceControl{
+			InvoiceAuthorization: resolution.ResolutionNumber,
+			AuthorizationPeriod: ublPeriod{
+				StartDate: resolution.ValidFrom.Format("2006-01-02"),
+				EndDate:   resolution.ValidTo.Format("2006-01-02"),
+			},
+			AuthorizedInvoices: ublAuthorizedInvoices{
+				Prefix: resolution.Prefix,
+				From:   resolution.RangeFrom,
+				To:     resolution.RangeTo,
+			},
+		}
+	}
+
+	// La segunda extensión queda vacía: ahí se inserta la firma
+	root.UBLExtensions = ublExtensions{Extensions: []ublExtension{
+		{Content: ublExtensionContent{DianExtensions: dianExtensions}},
+		{},
+	}}
+
+	for _, line := range document.Lines {
+		quantity := &ublQuantity{UnitCode: unitCode, Value: line.Quantity}
+		entry := ublLine{
+			ID:                  line.LineNumber,
+			LineExtensionAmount: amount(line.Subtotal),
+			Item:                ublItem{Description: line.Description},
+			Price: ublPrice{
+				PriceAmount:  amount(line.UnitPrice),
+				BaseQuantity: ublQuantity{UnitCode: unitCode, Value: 1},
+			},
+		}
+		if line.Code != "" {
+			entry.Item.StandardItemIdentification = &ublItemIdentification{ID: ublScheme{SchemeID: "999", Value: line.Code}}
+		}
+		if document.TaxResponsible {
+			entry.TaxTotals = []ublTaxTotal{taxTotal([]entities.ElectronicTaxSubtotal{{
+				Percent:       line.TaxPercent,
+				TaxableAmount: line.Subtotal,
+				TaxAmount:     line.TaxAmount,
+			}})}
+		}
+		if document.IsCreditNote() {
+			entry.CreditedQuantity = quantity
+			root.CreditNoteLines = append(root.CreditNoteLines, entry)
+		} else {
+			entry.InvoicedQuantity = quantity
+			root.InvoiceLines = append(root.InvoiceLines, entry)
+		}
+	}
+
+	body, err := xml.Marshal(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build UBL document: %w", err)
+	}
+	return append([]byte(xml.Header), body...), nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"log"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/events"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
@@ -67,12 +68,12 @@ func (h *AuditHandler) handleEvent(event events.OrderEvent) {
 
 	// Guardar en base de datos si hay repositorio configurado
 	if h.repository != nil {
-		ctx := context.Background()
+		ctx := access.WithScope(context.Background(), access.System())
 
 		// Crear el log de auditoría
 		auditLog := &entities.AuditLog{
 			EventType:   string(event.Type),
-			OrderID:     event.OrderID,
+			OrderID:     &event.OrderID,
 			OldStatus:   string(event.OldStatus),
 			NewStatus:   string(event.NewStatus),
 			Description: h.generateDescription(event),
@@ -85,6 +86,16 @@ func (h *AuditHandler) handleEvent(event events.OrderEvent) {
 			auditLog.OrderNumber = event.Order.OrderNumber
 		}
 
+		// Quién originó el evento y desde dónde
+		if actor := event.Actor; actor != nil {
+			auditLog.UserID = actor.UserID
+			auditLog.UserName = actor.UserName
+			auditLog.APIKeyID = actor.APIKeyID
+			auditLog.IPAddress = actor.IPAddress
+			auditLog.UserAgent = actor.UserAgent
+			auditLog.RequestID = actor.RequestID
+		}
+
 		// Serializar metadata adicional si existe
 		if event.Data != nil {
 			if metadataJSON, err := json.Marshal(event.Data); err == nil {
@@ -0,0 +1,150 @@
+package event_handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/events"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// CommissionHandler causa la comisión del vendedor cuando se completa una venta
+// Una venta completada ya no se puede cancelar: los reversos por devolución se registran
+// manualmente con ClawbackCommissionUseCase
+type CommissionHandler struct {
+	eventBus  *events.EventBus
+	eventChan chan events.OrderEvent
+	stopChan  chan bool
+	ruleRepo  ports.CommissionRuleRepository
+	entryRepo ports.CommissionEntryRepository
+	orderRepo ports.OrderRepository
+}
+
+// NewCommissionHandler crea un nuevo handler
+func NewCommissionHandler(
+	eventBus *events.EventBus,
+	ruleRepo ports.CommissionRuleRepository,
+	entryRepo ports.CommissionEntryRepository,
+	orderRepo ports.OrderRepository,
+) *CommissionHandler {
+	handler := &CommissionHandler{
+		eventBus:  eventBus,
+		eventChan: make(chan events.OrderEvent, 100),
+		stopChan:  make(chan bool),
+		ruleRepo:  ruleRepo,
+		entryRepo: entryRepo,
+		orderRepo: orderRepo,
+	}
+	eventBus.Subscribe(events.EventSaleCompleted, handler.eventChan)
+	// Las ventas de inventario (SALE) publican EventSaleDelivered al entregarse
+	eventBus.Subscribe(events.EventSaleDelivered, handler.eventChan)
+
+	return handler
+}
+
+// Start inicia el procesamiento de eventos
+func (h *CommissionHandler) Start() {
+	log.Println("🤝 Commission Handler started")
+
+	go func() {
+		for {
+			select {
+			case event := <-h.eventChan:
+				ctx := access.WithScope(context.Background(), access.System())
+				if err := h.Handle(ctx, event); err != nil {
+					log.Printf("❌ [COMMISSION ERROR] Failed to handle event: %v", err)
+				}
+			case <-h.stopChan:
+				log.Println("🤝 Commission Handler stopped")
+				return
+			}
+		}
+	}()
+}
+
+// Stop detiene el procesamiento de eventos
+func (h *CommissionHandler) Stop() {
+	h.stopChan <- true
+}
+
+// Handle causa la comisión de las ventas completadas o entregadas
+func (h *CommissionHandler) Handle(ctx context.Context, event events.OrderEvent) error {
+	switch event.Type {
+	case events.EventSaleCompleted, events.EventSaleDelivered:
+		return h.accrue(ctx, event.Order)
+	default:
+		return nil
+	}
+}
+
+// accrue calcula la comisión de la orden con las reglas activas y la registra una sola vez
+func (h *CommissionHandler) accrue(ctx context.Context, order *entities.Order) error {
+	if order == nil {
+		log.Printf("⚠️  [WARNING] Order is nil in event")
+		return nil
+	}
+
+	// Evitar causar dos veces la misma orden
+	if _, err := h.entryRepo.GetByOrder(ctx, order.ID, entities.CommissionEntryAccrual); err == nil {
+		log.Printf("ℹ️  [SKIP] Order #%d already accrued commission", order.ID)
+		return nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	// Recargar la orden si el evento no trae los items (necesarios para reglas por categoría)
+	if len(order.Items) == 0 {
+		fullOrder, err := h.orderRepo.GetByID(ctx, order.ID)
+		if err != nil {
+			return err
+		}
+		order = fullOrder
+	}
+
+	rules, err := h.ruleRepo.List(ctx, true)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	// Venta neta del vendedor en el mes, para ubicar el escalón de la regla
+	now := time.Now()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	monthlySales, err := h.entryRepo.SumBaseAmount(ctx, order.SellerID, monthStart, monthStart.AddDate(0, 1, 0))
+	if err != nil {
+		return err
+	}
+
+	accrual := entities.CalculateCommission(order, rules, monthlySales)
+	if accrual.Amount <= 0 {
+		return nil
+	}
+
+	entry := &entities.CommissionEntry{
+		SellerID:    order.SellerID,
+		OrderID:     order.ID,
+		OrderNumber: order.OrderNumber,
+		Type:        entities.CommissionEntryAccrual,
+		BaseAmount:  accrual.BaseAmount,
+		Amount:      accrual.Amount,
+		Description: fmt.Sprintf("Venta - Orden %s (%s)", order.OrderNumber, strings.Join(accrual.Details, ", ")),
+	}
+	if err := h.entryRepo.Create(ctx, entry); err != nil {
+		log.Printf("❌ [ERROR] Failed to record commission for order #%d: %v", order.ID, err)
+		return err
+	}
+
+	log.Printf("🤝 [COMMISSION] Seller #%d accrued $%.2f on $%.2f: Order #%d",
+		order.SellerID, entry.Amount, entry.BaseAmount, order.ID)
+
+	return nil
+}
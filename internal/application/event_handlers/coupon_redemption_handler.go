@@ -0,0 +1,69 @@
+package event_handlers
+
+import (
+	"context"
+	"log"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/events"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// CouponRedemptionHandler libera el cupón de campaña de una orden cancelada
+// para que el cliente lo pueda usar de nuevo y no cuente en las estadísticas de la campaña
+type CouponRedemptionHandler struct {
+	eventBus   *events.EventBus
+	eventChan  chan events.OrderEvent
+	stopChan   chan bool
+	couponRepo ports.CampaignCouponRepository
+}
+
+// NewCouponRedemptionHandler crea un nuevo handler
+func NewCouponRedemptionHandler(eventBus *events.EventBus, couponRepo ports.CampaignCouponRepository) *CouponRedemptionHandler {
+	handler := &CouponRedemptionHandler{
+		eventBus:   eventBus,
+		eventChan:  make(chan events.OrderEvent, 100),
+		stopChan:   make(chan bool),
+		couponRepo: couponRepo,
+	}
+	eventBus.Subscribe(events.EventOrderCancelled, handler.eventChan)
+
+	return handler
+}
+
+// Start inicia el procesamiento de eventos
+func (h *CouponRedemptionHandler) Start() {
+	log.Println("🎟️  Coupon Redemption Handler started")
+
+	go func() {
+		for {
+			select {
+			case event := <-h.eventChan:
+				if err := h.Handle(context.Background(), event); err != nil {
+					log.Printf("❌ [COUPON ERROR] Failed to handle event: %v", err)
+				}
+			case <-h.stopChan:
+				log.Println("🎟️  Coupon Redemption Handler stopped")
+				return
+			}
+		}
+	}()
+}
+
+// Stop detiene el procesamiento de eventos
+func (h *CouponRedemptionHandler) Stop() {
+	h.stopChan <- true
+}
+
+// Handle libera el cupón redimido por la orden cancelada
+// Es idempotente: si la orden no usó cupón o ya se liberó no cambia nada
+func (h *CouponRedemptionHandler) Handle(ctx context.Context, event events.OrderEvent) error {
+	if event.Type != events.EventOrderCancelled || event.OrderID == 0 {
+		return nil
+	}
+
+	if err := h.couponRepo.ReleaseOrderRedemption(ctx, event.OrderID); err != nil {
+		log.Printf("❌ [ERROR] Failed to release coupon of order #%d: %v", event.OrderID, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package event_handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/events"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// fakeCouponRepository registra las órdenes cuyos cupones se liberaron
+type fakeCouponRepository struct {
+	ports.CampaignCouponRepository
+	released []uint
+}
+
+func (r *fakeCouponRepository) ReleaseOrderRedemption(ctx context.Context, orderID uint) error {
+	r.released = append(r.released, orderID)
+	return nil
+}
+
+func TestCouponRedemptionHandler(t *testing.T) {
+	tests := []struct {
+		name  string
+		event events.OrderEvent
+		want  []uint
+	}{
+		{"cancelled order releases its coupon", events.OrderEvent{Type: events.EventOrderCancelled, OrderID: 42, NewStatus: entities.OrderStatusCancelled}, []uint{42}},
+		{"delivered order keeps its coupon", events.OrderEvent{Type: events.EventOrderDelivered, OrderID: 42}, nil},
+		{"event without order is ignored", events.OrderEvent{Type: events.EventOrderCancelled}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeCouponRepository{}
+			handler := NewCouponRedemptionHandler(events.NewEventBus(), repo)
+
+			if err := handler.Handle(context.Background(), tt.event); err != nil {
+				t.Fatalf("Handle: %v", err)
+			}
+			if len(repo.released) != len(tt.want) || (len(tt.want) > 0 && repo.released[0] != tt.want[0]) {
+				t.Errorf("released = %v, want %v", repo.released, tt.want)
+			}
+		})
+	}
+}
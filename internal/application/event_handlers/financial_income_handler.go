@@ -6,13 +6,14 @@ import (
 	"log"
 	"time"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/events"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 // FinancialIncomeHandler maneja la creación automática de ingresos financieros
-// cuando se completa una venta (para cualquier tipo de cliente)
+// cuando se completa una venta CUSTOM o se entrega una venta SALE (para cualquier tipo de cliente)
 type FinancialIncomeHandler struct {
 	eventBus                 *events.EventBus
 	eventChan                chan events.OrderEvent
@@ -32,6 +33,8 @@ func NewFinancialIncomeHandler(
 		financialTransactionRepo: financialTransactionRepo,
 	}
 	eventBus.Subscribe(events.EventSaleCompleted, handler.eventChan)
+	// Las ventas de inventario (SALE) publican EventSaleDelivered al entregarse
+	eventBus.Subscribe(events.EventSaleDelivered, handler.eventChan)
 
 	return handler
 }
@@ -44,7 +47,7 @@ func (h *FinancialIncomeHandler) Start() {
 		for {
 			select {
 			case event := <-h.eventChan:
-				ctx := context.Background()
+				ctx := access.WithScope(context.Background(), access.System())
 				if err := h.Handle(ctx, event); err != nil {
 					log.Printf("❌ [FINANCIAL INCOME ERROR] Failed to handle event: %v", err)
 				}
@@ -64,7 +67,7 @@ func (h *FinancialIncomeHandler) Stop() {
 // Handle procesa el evento de venta completada y crea el ingreso financiero
 func (h *FinancialIncomeHandler) Handle(ctx context.Context, event events.OrderEvent) error {
 	// Solo procesar si es el evento correcto
-	if event.Type != events.EventSaleCompleted {
+	if event.Type != events.EventSaleCompleted && event.Type != events.EventSaleDelivered {
 		return nil
 	}
 
@@ -77,8 +80,8 @@ func (h *FinancialIncomeHandler) Handle(ctx context.Context, event events.OrderE
 	// Determinar la categoría de ingreso basada en el tipo de orden
 	category := entities.FinancialTransactionCategorySales
 
-	// Calcular el monto real (total - descuento)
-	amount := order.TotalAmount - order.Discount
+	// El total de la orden ya tiene aplicado el descuento
+	amount := order.TotalAmount
 	if amount <= 0 {
 		log.Printf("⚠️  [WARNING] Order #%d has zero or negative amount: $%.2f", order.ID, amount)
 		return nil
@@ -91,6 +94,10 @@ func (h *FinancialIncomeHandler) Handle(ctx context.Context, event events.OrderE
 		Amount:      amount,
 		Description: buildFinancialDescription(order),
 		Date:        time.Now(),
+		// Una venta de contado entra a la cuenta con la que se pagó
+		PaymentMethodID: order.PaymentMethodID,
+		// El asiento contable lo genera la venta; este ingreso es su reflejo
+		OrderID: &order.ID,
 	}
 
 	// Validar antes de guardar
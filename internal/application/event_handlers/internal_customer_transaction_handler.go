@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/events"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
@@ -43,7 +44,7 @@ func (h *InternalCustomerTransactionHandler) Start() {
 		for {
 			select {
 			case event := <-h.eventChan:
-				ctx := context.Background()
+				ctx := access.WithScope(context.Background(), access.System())
 				if err := h.Handle(ctx, event); err != nil {
 					log.Printf("❌ [ERROR] Failed to handle event: %v", err)
 				}
@@ -83,9 +84,10 @@ func (h *InternalCustomerTransactionHandler) Handle(ctx context.Context, event e
 	transaction := &entities.CustomerTransaction{
 		CustomerID:  *order.CustomerID,
 		Type:        entities.TransactionTypeDebt,
-		Amount:      order.TotalAmount - order.Discount,
+		Amount:      order.TotalAmount, // Ya tiene aplicado el descuento
 		Description: buildTransactionDescription(order),
 		Date:        time.Now(),
+		OrderID:     &order.ID, // El asiento contable lo genera la venta
 	}
 
 	// Guardar transacción
@@ -0,0 +1,90 @@
+package event_handlers
+
+import (
+	"context"
+	"log"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/ledger"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/events"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// LedgerHandler genera el asiento contable de las ventas entregadas (ingreso y costo de la mercancía)
+type LedgerHandler struct {
+	eventBus  *events.EventBus
+	eventChan chan events.OrderEvent
+	stopChan  chan bool
+	poster    *ledger.Poster
+	orderRepo ports.OrderRepository
+}
+
+// NewLedgerHandler crea un nuevo handler
+func NewLedgerHandler(
+	eventBus *events.EventBus,
+	poster *ledger.Poster,
+	orderRepo ports.OrderRepository,
+) *LedgerHandler {
+	handler := &LedgerHandler{
+		eventBus:  eventBus,
+		eventChan: make(chan events.OrderEvent, 100),
+		stopChan:  make(chan bool),
+		poster:    poster,
+		orderRepo: orderRepo,
+	}
+	eventBus.Subscribe(events.EventSaleCompleted, handler.eventChan)
+	// Las ventas de inventario (SALE) publican EventSaleDelivered al entregarse
+	eventBus.Subscribe(events.EventSaleDelivered, handler.eventChan)
+
+	return handler
+}
+
+// Start inicia el procesamiento de eventos
+func (h *LedgerHandler) Start() {
+	log.Println("📒 Ledger Handler started")
+
+	go func() {
+		for {
+			select {
+			case event := <-h.eventChan:
+				ctx := access.WithScope(context.Background(), access.System())
+				if err := h.Handle(ctx, event); err != nil {
+					log.Printf("❌ [LEDGER ERROR] Failed to handle event: %v", err)
+				}
+			case <-h.stopChan:
+				log.Println("📒 Ledger Handler stopped")
+				return
+			}
+		}
+	}()
+}
+
+// Stop detiene el procesamiento de eventos
+func (h *LedgerHandler) Stop() {
+	h.stopChan <- true
+}
+
+// Handle contabiliza la venta entregada
+func (h *LedgerHandler) Handle(ctx context.Context, event events.OrderEvent) error {
+	if event.Type != events.EventSaleCompleted && event.Type != events.EventSaleDelivered {
+		return nil
+	}
+
+	order := event.Order
+	if order == nil {
+		log.Printf("⚠️  [WARNING] Order is nil in event")
+		return nil
+	}
+
+	// Recargar la orden si el evento no trae los items (necesarios para el costo de la venta)
+	if len(order.Items) == 0 {
+		fullOrder, err := h.orderRepo.GetByID(ctx, order.ID)
+		if err != nil {
+			return err
+		}
+		order = fullOrder
+	}
+
+	h.poster.Sale(ctx, order)
+	return nil
+}
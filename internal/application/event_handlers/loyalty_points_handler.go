@@ -0,0 +1,209 @@
+package event_handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/events"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// LoyaltyPointsHandler otorga puntos de fidelización al cliente
+// cuando se completa una venta (CUSTOM entregada o SALE entregada)
+// y devuelve los puntos redimidos cuando la orden se cancela
+type LoyaltyPointsHandler struct {
+	eventBus         *events.EventBus
+	eventChan        chan events.OrderEvent
+	stopChan         chan bool
+	ruleRepo         ports.LoyaltyRuleRepository
+	pointsRepo       ports.LoyaltyPointsRepository
+	customerRepo     ports.CustomerRepository
+	orderRepo        ports.OrderRepository
+	expirationMonths int
+}
+
+// NewLoyaltyPointsHandler crea un nuevo handler
+func NewLoyaltyPointsHandler(
+	eventBus *events.EventBus,
+	ruleRepo ports.LoyaltyRuleRepository,
+	pointsRepo ports.LoyaltyPointsRepository,
+	customerRepo ports.CustomerRepository,
+	orderRepo ports.OrderRepository,
+	expirationMonths int,
+) *LoyaltyPointsHandler {
+	handler := &LoyaltyPointsHandler{
+		eventBus:         eventBus,
+		eventChan:        make(chan events.OrderEvent, 100),
+		stopChan:         make(chan bool),
+		ruleRepo:         ruleRepo,
+		pointsRepo:       pointsRepo,
+		customerRepo:     customerRepo,
+		orderRepo:        orderRepo,
+		expirationMonths: expirationMonths,
+	}
+	eventBus.Subscribe(events.EventSaleCompleted, handler.eventChan)
+	// Las ventas de inventario (SALE) publican EventSaleDelivered al entregarse
+	eventBus.Subscribe(events.EventSaleDelivered, handler.eventChan)
+	eventBus.Subscribe(events.EventOrderCancelled, handler.eventChan)
+
+	return handler
+}
+
+// Start inicia el procesamiento de eventos
+func (h *LoyaltyPointsHandler) Start() {
+	log.Println("⭐ Loyalty Points Handler started")
+
+	go func() {
+		for {
+			select {
+			case event := <-h.eventChan:
+				ctx := access.WithScope(context.Background(), access.System())
+				if err := h.Handle(ctx, event); err != nil {
+					log.Printf("❌ [LOYALTY ERROR] Failed to handle event: %v", err)
+				}
+			case <-h.stopChan:
+				log.Println("⭐ Loyalty Points Handler stopped")
+				return
+			}
+		}
+	}()
+}
+
+// Stop detiene el procesamiento de eventos
+func (h *LoyaltyPointsHandler) Stop() {
+	h.stopChan <- true
+}
+
+// Handle calcula y registra los puntos ganados por la orden
+func (h *LoyaltyPointsHandler) Handle(ctx context.Context, event events.OrderEvent) error {
+	// Solo procesar ventas completadas y cancelaciones
+	if event.Type != events.EventSaleCompleted && event.Type != events.EventSaleDelivered && event.Type != events.EventOrderCancelled {
+		return nil
+	}
+
+	order := event.Order
+	if order == nil {
+		log.Printf("⚠️  [WARNING] Order is nil in event")
+		return nil
+	}
+
+	if event.Type == events.EventOrderCancelled {
+		return h.refundRedeemedPoints(ctx, order)
+	}
+
+	// Solo los clientes registrados acumulan puntos
+	if !order.IsInternalCustomer() {
+		return nil
+	}
+
+	// Evitar acumular dos veces por la misma orden
+	exists, err := h.pointsRepo.ExistsForOrder(ctx, order.ID, entities.LoyaltyEntryEarn)
+	if err != nil {
+		return err
+	}
+	if exists {
+		log.Printf("ℹ️  [SKIP] Order #%d already earned loyalty points", order.ID)
+		return nil
+	}
+
+	// Recargar la orden si el evento no trae los items (necesarios para reglas por categoría)
+	if len(order.Items) == 0 {
+		fullOrder, err := h.orderRepo.GetByID(ctx, order.ID)
+		if err != nil {
+			return err
+		}
+		order = fullOrder
+	}
+
+	rules, err := h.ruleRepo.List(ctx, true)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	customer, err := h.customerRepo.GetByID(ctx, *order.CustomerID)
+	if err != nil {
+		log.Printf("❌ [ERROR] Customer #%d not found for loyalty points: %v", *order.CustomerID, err)
+		return err
+	}
+
+	now := time.Now()
+	earning := entities.CalculateLoyaltyPoints(order, rules, customer.IsBirthdayMonth(now))
+	if earning.Points <= 0 {
+		return nil
+	}
+
+	entry := &entities.LoyaltyPointsEntry{
+		CustomerID:  customer.ID,
+		OrderID:     &order.ID,
+		Points:      earning.Points,
+		Description: fmt.Sprintf("Compra - Orden %s (%s)", order.OrderNumber, strings.Join(earning.Details, ", ")),
+	}
+	if h.expirationMonths > 0 {
+		expiresAt := now.AddDate(0, h.expirationMonths, 0)
+		entry.ExpiresAt = &expiresAt
+	}
+
+	if err := h.pointsRepo.Earn(ctx, entry); err != nil {
+		log.Printf("❌ [ERROR] Failed to record loyalty points for customer #%d: %v", customer.ID, err)
+		return err
+	}
+
+	log.Printf("⭐ [LOYALTY] Customer #%d earned %d points: Order #%d (x%.1f)",
+		customer.ID, earning.Points, order.ID, earning.Multiplier)
+
+	return nil
+}
+
+// refundRedeemedPoints devuelve al cliente los puntos que usó como descuento en una orden cancelada
+// Una orden entregada no se puede cancelar, así que el único EARN de una orden cancelada es la devolución
+func (h *LoyaltyPointsHandler) refundRedeemedPoints(ctx context.Context, order *entities.Order) error {
+	// Recargar la orden para tener los puntos redimidos tal como quedaron guardados
+	fullOrder, err := h.orderRepo.GetByID(ctx, order.ID)
+	if err != nil {
+		return err
+	}
+	order = fullOrder
+
+	if !order.IsInternalCustomer() || order.LoyaltyPointsRedeemed <= 0 {
+		return nil
+	}
+
+	// Evitar devolver dos veces los puntos de la misma orden
+	exists, err := h.pointsRepo.ExistsForOrder(ctx, order.ID, entities.LoyaltyEntryEarn)
+	if err != nil {
+		return err
+	}
+	if exists {
+		log.Printf("ℹ️  [SKIP] Order #%d already refunded its loyalty points", order.ID)
+		return nil
+	}
+
+	entry := &entities.LoyaltyPointsEntry{
+		CustomerID:  *order.CustomerID,
+		OrderID:     &order.ID,
+		Points:      order.LoyaltyPointsRedeemed,
+		Description: fmt.Sprintf("Devolución - Orden %s cancelada", order.OrderNumber),
+	}
+	if h.expirationMonths > 0 {
+		expiresAt := time.Now().AddDate(0, h.expirationMonths, 0)
+		entry.ExpiresAt = &expiresAt
+	}
+
+	if err := h.pointsRepo.Earn(ctx, entry); err != nil {
+		log.Printf("❌ [ERROR] Failed to refund loyalty points for customer #%d: %v", *order.CustomerID, err)
+		return err
+	}
+
+	log.Printf("⭐ [LOYALTY] Customer #%d got back %d points: Order #%d cancelled",
+		*order.CustomerID, order.LoyaltyPointsRedeemed, order.ID)
+
+	return nil
+}
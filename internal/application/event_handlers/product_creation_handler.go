@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/events"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
@@ -69,7 +70,7 @@ func (h *ProductCreationHandler) handleEvent(event events.OrderEvent) {
 		return
 	}
 
-	ctx := context.Background()
+	ctx := access.WithScope(context.Background(), access.System())
 
 	// Obtener tipo de orden
 	orderType, ok := event.Data["orderType"].(entities.OrderType)
@@ -0,0 +1,36 @@
+package opaquetoken
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+)
+
+// New genera un token aleatorio de 32 bytes en hexadecimal
+// (refresh tokens, enlaces de recuperación, invitaciones y enlaces del portal)
+func New() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(raw), nil
+}
+
+// Hash calcula el hash SHA-256 del token en claro; solo el hash se guarda en la base de datos
+func Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Link agrega el token a la URL del frontend como ?token=..., conservando los parámetros que ya tenga
+func Link(baseURL, token string) (string, error) {
+	link, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String(), nil
+}
@@ -0,0 +1,55 @@
+package throttle
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// Limit es el límite de solicitudes de una clave (teléfono, email o IP)
+type Limit struct {
+	Scope  entities.LoginThrottleScope
+	Key    string
+	Policy entities.LockoutPolicy
+}
+
+// Limiter limita las solicitudes de los endpoints públicos que envían mensajes (enlaces del portal,
+// recuperación de contraseña) con el mismo contador del bloqueo de login: cada solicitud cuenta como
+// un intento y al llegar al máximo la clave queda bloqueada con el bloqueo progresivo de la política
+type Limiter struct {
+	throttleRepo ports.LoginThrottleRepository
+}
+
+// NewLimiter crea una nueva instancia del limitador
+func NewLimiter(throttleRepo ports.LoginThrottleRepository) *Limiter {
+	return &Limiter{throttleRepo: throttleRepo}
+}
+
+// Allow retorna false si alguna de las claves está bloqueada; si ninguna lo está, cuenta la solicitud en todas
+// Las claves vacías (ej: sin IP) se ignoran
+func (l *Limiter) Allow(ctx context.Context, at time.Time, limits ...Limit) (bool, error) {
+	for _, limit := range limits {
+		if limit.Key == "" {
+			continue
+		}
+		throttle, err := l.throttleRepo.Get(ctx, limit.Scope, limit.Key)
+		if err != nil {
+			return false, err
+		}
+		if throttle != nil && throttle.IsLocked(at) {
+			return false, nil
+		}
+	}
+
+	for _, limit := range limits {
+		if limit.Key == "" {
+			continue
+		}
+		if _, _, err := l.throttleRepo.RegisterFailure(ctx, limit.Scope, limit.Key, limit.Policy, at); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,66 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// GetOrderAnalyticsUseCase calcula las métricas de órdenes y ventas desde la base de datos
+type GetOrderAnalyticsUseCase struct {
+	analyticsRepo ports.AnalyticsRepository
+}
+
+// NewGetOrderAnalyticsUseCase crea una nueva instancia del caso de uso
+func NewGetOrderAnalyticsUseCase(analyticsRepo ports.AnalyticsRepository) *GetOrderAnalyticsUseCase {
+	return &GetOrderAnalyticsUseCase{analyticsRepo: analyticsRepo}
+}
+
+// Execute calcula las métricas con los filtros dados
+// Un usuario sin orders:all solo ve las métricas de sus órdenes propias o compartidas
+func (uc *GetOrderAnalyticsUseCase) Execute(ctx context.Context, filters entities.AnalyticsFilters) (*entities.OrderAnalytics, error) {
+	if scope := access.ScopeFromContext(ctx); !scope.AllOrders {
+		userID := scope.UserID
+		filters.VisibleToUserID = &userID
+	}
+
+	byStatus, byType, err := uc.analyticsRepo.CountOrders(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entities.OrderAnalytics{
+		OrdersByStatus: byStatus,
+		OrdersByType:   byType,
+	}
+	for _, count := range byStatus {
+		result.TotalOrders += count
+	}
+
+	if result.TotalRevenue, err = uc.analyticsRepo.GetDeliveredRevenue(ctx, filters); err != nil {
+		return nil, err
+	}
+	if result.PaymentsCollected, err = uc.analyticsRepo.GetPaymentsCollected(ctx, filters); err != nil {
+		return nil, err
+	}
+
+	avgSeconds, manufactured, err := uc.analyticsRepo.GetManufacturingTime(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	result.AverageManufacturingTime = time.Duration(avgSeconds * float64(time.Second))
+	result.ManufacturedOrders = manufactured
+
+	if result.RevenueByCategory, err = uc.analyticsRepo.GetRevenueByCategory(ctx, filters); err != nil {
+		return nil, err
+	}
+	if result.RevenueBySeller, err = uc.analyticsRepo.GetRevenueBySeller(ctx, filters); err != nil {
+		return nil, err
+	}
+
+	result.CalculateRates()
+	return result, nil
+}
@@ -0,0 +1,350 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/opaquetoken"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// apiKeyPrefix identifica las llaves de Fashion Blue (facilita detectarlas si se filtran en un repositorio)
+const apiKeyPrefix = "fbk_"
+
+// apiKeyTouchInterval evita escribir en la base de datos en cada petición de una integración
+const apiKeyTouchInterval = time.Minute
+
+// newAPIKey genera una llave "fbk_<8 hex>_<64 hex>" y retorna la llave en claro y su prefijo público
+func newAPIKey() (string, string, error) {
+	raw := make([]byte, 4)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", err
+	}
+	prefix := apiKeyPrefix + hex.EncodeToString(raw)
+
+	secret, err := opaquetoken.New()
+	if err != nil {
+		return "", "", err
+	}
+	return prefix + "_" + secret, prefix, nil
+}
+
+// saveAPIKeyAudit registra la creación, rotación o revocación de una llave
+func saveAPIKeyAudit(ctx context.Context, auditRepo ports.AuditLogRepository, eventType string, actor *entities.User, key *entities.APIKey, client ClientInfo, description string) {
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"apiKeyId":    key.ID,
+		"prefix":      key.Prefix,
+		"ownerId":     key.UserID,
+		"permissions": key.Permissions,
+	})
+	auditLog := entities.NewSecurityAuditLog(eventType, actor, client.IPAddress, client.UserAgent, description, string(metadata))
+	if err := auditRepo.Create(ctx, auditLog); err != nil {
+		log.Printf("⚠️  Failed to save api key audit log: %v", err)
+	}
+}
+
+// checkAPIKeyOwner verifica que el actor pueda otorgar el rol del dueño de la llave:
+// rotar o revocar la llave de un rol superior equivale a operar con sus permisos
+func checkAPIKeyOwner(ctx context.Context, userRepo ports.UserRepository, roleRepo ports.RoleRepository, ownerID uint) error {
+	owner, err := userRepo.GetByID(ctx, ownerID)
+	if err != nil {
+		return errors.New("owner not found")
+	}
+	return access.CheckRoleGrantByName(ctx, roleRepo, owner.Role)
+}
+
+// CreateAPIKeyRequest representa los datos para crear una llave de API
+type CreateAPIKeyRequest struct {
+	Name        string
+	Permissions []entities.Permission
+	OwnerID     uint // Usuario responsable; 0 = quien crea la llave
+	ExpiresAt   *time.Time
+	CreatedBy   *entities.User
+	Client      ClientInfo
+}
+
+// CreateAPIKeyUseCase emite una llave de API con permisos acotados
+type CreateAPIKeyUseCase struct {
+	apiKeyRepo ports.APIKeyRepository
+	userRepo   ports.UserRepository
+	roleRepo   ports.RoleRepository
+	auditRepo  ports.AuditLogRepository
+}
+
+// NewCreateAPIKeyUseCase crea una nueva instancia del caso de uso
+func NewCreateAPIKeyUseCase(
+	apiKeyRepo ports.APIKeyRepository,
+	userRepo ports.UserRepository,
+	roleRepo ports.RoleRepository,
+	auditRepo ports.AuditLogRepository,
+) *CreateAPIKeyUseCase {
+	return &CreateAPIKeyUseCase{
+		apiKeyRepo: apiKeyRepo,
+		userRepo:   userRepo,
+		roleRepo:   roleRepo,
+		auditRepo:  auditRepo,
+	}
+}
+
+// Execute crea la llave y retorna la llave en claro, que no se vuelve a mostrar
+// Los permisos deben estar dentro del rol del dueño; si el rol pierde un permiso, la llave también
+// El actor debe poder otorgar tanto el rol del dueño como los permisos de la llave
+func (uc *CreateAPIKeyUseCase) Execute(ctx context.Context, req CreateAPIKeyRequest) (*entities.APIKey, string, error) {
+	owner := req.CreatedBy
+	if req.OwnerID != 0 && req.OwnerID != req.CreatedBy.ID {
+		user, err := uc.userRepo.GetByID(ctx, req.OwnerID)
+		if err != nil {
+			return nil, "", errors.New("owner not found")
+		}
+		owner = user
+	}
+	if !owner.IsActive {
+		return nil, "", entities.ErrAPIKeyOwnerInactive
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, "", entities.ErrAPIKeyExpirationInPast
+	}
+
+	ownerRole, err := uc.roleRepo.GetByName(ctx, owner.Role)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := access.CheckRoleGrant(ctx, ownerRole); err != nil {
+		return nil, "", err
+	}
+
+	key := &entities.APIKey{
+		Name:        strings.TrimSpace(req.Name),
+		Permissions: req.Permissions,
+		UserID:      owner.ID,
+		CreatedBy:   req.CreatedBy.ID,
+		ExpiresAt:   req.ExpiresAt,
+	}
+	if err := key.Validate(ownerRole); err != nil {
+		return nil, "", err
+	}
+	if err := access.CheckRoleGrant(ctx, &entities.Role{Permissions: key.Permissions}); err != nil {
+		return nil, "", err
+	}
+
+	plain, prefix, err := newAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+	key.Prefix = prefix
+	key.KeyHash = opaquetoken.Hash(plain)
+
+	if err := uc.apiKeyRepo.Create(ctx, key); err != nil {
+		return nil, "", err
+	}
+
+	saveAPIKeyAudit(ctx, uc.auditRepo, entities.AuditEventAPIKeyCreated, req.CreatedBy, key, req.Client, "API key "+key.Name+" created")
+	return key, plain, nil
+}
+
+// ListAPIKeysUseCase lista las llaves de API (sin su secreto)
+type ListAPIKeysUseCase struct {
+	apiKeyRepo ports.APIKeyRepository
+}
+
+// NewListAPIKeysUseCase crea una nueva instancia del caso de uso
+func NewListAPIKeysUseCase(apiKeyRepo ports.APIKeyRepository) *ListAPIKeysUseCase {
+	return &ListAPIKeysUseCase{apiKeyRepo: apiKeyRepo}
+}
+
+// Execute retorna todas las llaves, incluidas las vencidas y revocadas
+func (uc *ListAPIKeysUseCase) Execute(ctx context.Context) ([]entities.APIKey, error) {
+	return uc.apiKeyRepo.List(ctx)
+}
+
+// RotateAPIKeyRequest representa los datos para rotar una llave de API
+type RotateAPIKeyRequest struct {
+	ID        uint
+	Grace     time.Duration // Tiempo que la llave anterior sigue funcionando; 0 la revoca de inmediato
+	ExpiresAt *time.Time    // Vencimiento de la llave nueva; nil conserva el de la anterior
+	Actor     *entities.User
+	Client    ClientInfo
+}
+
+// RotateAPIKeyUseCase reemplaza una llave por otra con el mismo nombre, dueño y permisos
+type RotateAPIKeyUseCase struct {
+	apiKeyRepo ports.APIKeyRepository
+	userRepo   ports.UserRepository
+	roleRepo   ports.RoleRepository
+	auditRepo  ports.AuditLogRepository
+}
+
+// NewRotateAPIKeyUseCase crea una nueva instancia del caso de uso
+func NewRotateAPIKeyUseCase(
+	apiKeyRepo ports.APIKeyRepository,
+	userRepo ports.UserRepository,
+	roleRepo ports.RoleRepository,
+	auditRepo ports.AuditLogRepository,
+) *RotateAPIKeyUseCase {
+	return &RotateAPIKeyUseCase{
+		apiKeyRepo: apiKeyRepo,
+		userRepo:   userRepo,
+		roleRepo:   roleRepo,
+		auditRepo:  auditRepo,
+	}
+}
+
+// Execute emite la llave nueva y deja la anterior vigente solo durante el periodo de gracia
+// Solo rota llaves cuyo dueño tiene un rol que el actor puede otorgar
+func (uc *RotateAPIKeyUseCase) Execute(ctx context.Context, req RotateAPIKeyRequest) (*entities.APIKey, string, error) {
+	now := time.Now()
+	current, err := uc.apiKeyRepo.GetByID(ctx, req.ID)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := checkAPIKeyOwner(ctx, uc.userRepo, uc.roleRepo, current.UserID); err != nil {
+		return nil, "", err
+	}
+	if !current.IsActive(now) {
+		return nil, "", entities.ErrInvalidAPIKey
+	}
+
+	expiresAt := current.ExpiresAt
+	if req.ExpiresAt != nil {
+		if !req.ExpiresAt.After(now) {
+			return nil, "", entities.ErrAPIKeyExpirationInPast
+		}
+		expiresAt = req.ExpiresAt
+	}
+
+	plain, prefix, err := newAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	rotated := &entities.APIKey{
+		Name:          current.Name,
+		Prefix:        prefix,
+		KeyHash:       opaquetoken.Hash(plain),
+		Permissions:   current.Permissions,
+		UserID:        current.UserID,
+		CreatedBy:     req.Actor.ID,
+		ExpiresAt:     expiresAt,
+		RotatedFromID: &current.ID,
+	}
+	if err := uc.apiKeyRepo.Create(ctx, rotated); err != nil {
+		return nil, "", err
+	}
+
+	if req.Grace <= 0 {
+		err = uc.apiKeyRepo.Revoke(ctx, current.ID)
+	} else if graceEnd := now.Add(req.Grace); current.ExpiresAt == nil || graceEnd.Before(*current.ExpiresAt) {
+		err = uc.apiKeyRepo.SetExpiration(ctx, current.ID, graceEnd)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	saveAPIKeyAudit(ctx, uc.auditRepo, entities.AuditEventAPIKeyRotated, req.Actor, rotated, req.Client, "API key "+current.Prefix+" rotated to "+rotated.Prefix)
+	return rotated, plain, nil
+}
+
+// RevokeAPIKeyUseCase desactiva una llave de API de forma permanente
+type RevokeAPIKeyUseCase struct {
+	apiKeyRepo ports.APIKeyRepository
+	userRepo   ports.UserRepository
+	roleRepo   ports.RoleRepository
+	auditRepo  ports.AuditLogRepository
+}
+
+// NewRevokeAPIKeyUseCase crea una nueva instancia del caso de uso
+func NewRevokeAPIKeyUseCase(
+	apiKeyRepo ports.APIKeyRepository,
+	userRepo ports.UserRepository,
+	roleRepo ports.RoleRepository,
+	auditRepo ports.AuditLogRepository,
+) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{
+		apiKeyRepo: apiKeyRepo,
+		userRepo:   userRepo,
+		roleRepo:   roleRepo,
+		auditRepo:  auditRepo,
+	}
+}
+
+// Execute revoca la llave; las peticiones que la usen empiezan a recibir 401 de inmediato
+// Solo revoca llaves cuyo dueño tiene un rol que el actor puede otorgar
+func (uc *RevokeAPIKeyUseCase) Execute(ctx context.Context, id uint, actor *entities.User, client ClientInfo) error {
+	key, err := uc.apiKeyRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkAPIKeyOwner(ctx, uc.userRepo, uc.roleRepo, key.UserID); err != nil {
+		return err
+	}
+	if err := uc.apiKeyRepo.Revoke(ctx, key.ID); err != nil {
+		return err
+	}
+
+	saveAPIKeyAudit(ctx, uc.auditRepo, entities.AuditEventAPIKeyRevoked, actor, key, client, "API key "+key.Name+" revoked")
+	return nil
+}
+
+// ValidateAPIKeyUseCase autentica las peticiones de integraciones que envían una llave de API
+type ValidateAPIKeyUseCase struct {
+	apiKeyRepo ports.APIKeyRepository
+	userRepo   ports.UserRepository
+	roleRepo   ports.RoleRepository
+}
+
+// NewValidateAPIKeyUseCase crea una nueva instancia del caso de uso
+func NewValidateAPIKeyUseCase(apiKeyRepo ports.APIKeyRepository, userRepo ports.UserRepository, roleRepo ports.RoleRepository) *ValidateAPIKeyUseCase {
+	return &ValidateAPIKeyUseCase{
+		apiKeyRepo: apiKeyRepo,
+		userRepo:   userRepo,
+		roleRepo:   roleRepo,
+	}
+}
+
+// Execute valida la llave y retorna el principal con el que actúa
+// El rol resultante solo tiene los permisos de la llave que el rol del dueño también otorga
+func (uc *ValidateAPIKeyUseCase) Execute(ctx context.Context, plain, ipAddress string) (*Principal, error) {
+	if !strings.HasPrefix(plain, apiKeyPrefix) || len(plain) <= entities.APIKeyPrefixLength {
+		return nil, entities.ErrInvalidAPIKey
+	}
+
+	key, err := uc.apiKeyRepo.GetByPrefix(ctx, plain[:entities.APIKeyPrefixLength])
+	if err != nil {
+		return nil, entities.ErrInvalidAPIKey
+	}
+
+	now := time.Now()
+	if subtle.ConstantTimeCompare([]byte(opaquetoken.Hash(plain)), []byte(key.KeyHash)) != 1 || !key.IsActive(now) {
+		return nil, entities.ErrInvalidAPIKey
+	}
+
+	owner, err := uc.userRepo.GetByID(ctx, key.UserID)
+	if err != nil || !owner.IsActive {
+		return nil, entities.ErrAPIKeyOwnerInactive
+	}
+
+	// Un rol eliminado o inexistente no otorga permisos, igual que con los tokens de sesión
+	ownerRole, err := uc.roleRepo.GetByName(ctx, owner.Role)
+	if errors.Is(err, entities.ErrRoleNotFound) {
+		ownerRole = &entities.Role{Name: owner.Role}
+	} else if err != nil {
+		return nil, err
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval {
+		if err := uc.apiKeyRepo.TouchLastUsed(ctx, key.ID, now, truncate(ipAddress, 45)); err != nil {
+			log.Printf("⚠️  Failed to update last use of api key #%d: %v", key.ID, err)
+		}
+	}
+
+	return &Principal{User: owner, Role: key.EffectiveRole(ownerRole), APIKey: key}, nil
+}
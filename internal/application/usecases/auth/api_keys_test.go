@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// fakeAPIKeyRepository retorna siempre la misma llave y registra si se modificó
+type fakeAPIKeyRepository struct {
+	ports.APIKeyRepository
+	key     *entities.APIKey
+	changed bool
+}
+
+func (r *fakeAPIKeyRepository) GetByID(ctx context.Context, id uint) (*entities.APIKey, error) {
+	key := *r.key
+	return &key, nil
+}
+
+func (r *fakeAPIKeyRepository) Create(ctx context.Context, key *entities.APIKey) error {
+	r.changed = true
+	return nil
+}
+
+func (r *fakeAPIKeyRepository) Revoke(ctx context.Context, id uint) error {
+	r.changed = true
+	return nil
+}
+
+func TestAPIKeysRequireOwnerGrant(t *testing.T) {
+	sellerRole, _ := (&fakeRoleRepository{}).GetByName(context.Background(), entities.RoleSeller)
+	// Un rol personalizado con api-keys:manage pero sin el comodín de SUPER_ADMIN
+	managerRole := &entities.Role{
+		Name:        "INTEGRATIONS",
+		Permissions: append([]entities.Permission{entities.PermissionAPIKeysManage}, sellerRole.Permissions...),
+	}
+	actor := &entities.User{ID: 1, Email: "integrations@fashionblue.co", Role: managerRole.Name, IsActive: true}
+	ctx := access.WithActor(context.Background(), access.Actor{User: actor, Role: managerRole})
+
+	admin := &entities.User{ID: 2, Email: "admin@fashionblue.co", Role: entities.RoleSuperAdmin, IsActive: true}
+	adminKey := &entities.APIKey{ID: 9, Name: "erp", Prefix: "fbk_00000000", UserID: admin.ID, Permissions: []entities.Permission{entities.PermissionOrdersRead}}
+
+	t.Run("create for super admin", func(t *testing.T) {
+		repo := &fakeAPIKeyRepository{}
+		uc := NewCreateAPIKeyUseCase(repo, &fakeUserRepository{user: admin}, &fakeRoleRepository{}, nil)
+
+		_, _, err := uc.Execute(ctx, CreateAPIKeyRequest{
+			Name:        "erp",
+			Permissions: []entities.Permission{entities.PermissionUsersManage},
+			OwnerID:     admin.ID,
+			CreatedBy:   actor,
+		})
+		if !errors.Is(err, entities.ErrRoleEscalation) {
+			t.Errorf("err = %v, want ErrRoleEscalation", err)
+		}
+		if repo.changed {
+			t.Error("api key was created")
+		}
+	})
+
+	t.Run("rotate super admin key", func(t *testing.T) {
+		repo := &fakeAPIKeyRepository{key: adminKey}
+		uc := NewRotateAPIKeyUseCase(repo, &fakeUserRepository{user: admin}, &fakeRoleRepository{}, nil)
+
+		if _, _, err := uc.Execute(ctx, RotateAPIKeyRequest{ID: adminKey.ID, Actor: actor}); !errors.Is(err, entities.ErrRoleEscalation) {
+			t.Errorf("err = %v, want ErrRoleEscalation", err)
+		}
+		if repo.changed {
+			t.Error("api key was rotated")
+		}
+	})
+
+	t.Run("revoke super admin key", func(t *testing.T) {
+		repo := &fakeAPIKeyRepository{key: adminKey}
+		uc := NewRevokeAPIKeyUseCase(repo, &fakeUserRepository{user: admin}, &fakeRoleRepository{}, nil)
+
+		if err := uc.Execute(ctx, adminKey.ID, actor, ClientInfo{}); !errors.Is(err, entities.ErrRoleEscalation) {
+			t.Errorf("err = %v, want ErrRoleEscalation", err)
+		}
+		if repo.changed {
+			t.Error("api key was revoked")
+		}
+	})
+}
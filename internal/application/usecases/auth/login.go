@@ -2,59 +2,203 @@ package auth
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
-	"github.com/golang-jwt/jwt/v5"
 )
 
+// LoginLimits define los límites de intentos fallidos por cuenta y por IP
+type LoginLimits struct {
+	Account entities.LockoutPolicy
+	IP      entities.LockoutPolicy
+}
+
 type LoginUseCase struct {
-	userRepo  ports.UserRepository
-	jwtSecret string
-	jwtExpiry time.Duration
+	userRepo      ports.UserRepository
+	sessionRepo   ports.UserSessionRepository
+	twoFactorRepo ports.UserTwoFactorRepository
+	guard         loginGuard
+	tokenConfig   TokenConfig
+	twoFactor     TwoFactorConfig
 }
 
-func NewLoginUseCase(userRepo ports.UserRepository, jwtSecret string, jwtExpiry time.Duration) *LoginUseCase {
+func NewLoginUseCase(
+	userRepo ports.UserRepository,
+	sessionRepo ports.UserSessionRepository,
+	throttleRepo ports.LoginThrottleRepository,
+	twoFactorRepo ports.UserTwoFactorRepository,
+	auditRepo ports.AuditLogRepository,
+	tokenConfig TokenConfig,
+	twoFactor TwoFactorConfig,
+	limits LoginLimits,
+) *LoginUseCase {
 	return &LoginUseCase{
-		userRepo:  userRepo,
-		jwtSecret: jwtSecret,
-		jwtExpiry: jwtExpiry,
+		userRepo:      userRepo,
+		sessionRepo:   sessionRepo,
+		twoFactorRepo: twoFactorRepo,
+		guard:         loginGuard{throttleRepo: throttleRepo, auditRepo: auditRepo, limits: limits},
+		tokenConfig:   tokenConfig,
+		twoFactor:     twoFactor,
 	}
 }
 
-func (uc *LoginUseCase) Execute(ctx context.Context, email, password string) (string, *entities.User, error) {
+// Execute autentica al usuario; las cuentas e IPs con demasiados fallos quedan bloqueadas temporalmente
+// Mientras dure el bloqueo se rechaza el intento sin verificar la contraseña
+// Si el usuario tiene (o debe tener) segundo factor, retorna TwoFactorRequiredError en lugar de la sesión
+func (uc *LoginUseCase) Execute(ctx context.Context, email, password string, client ClientInfo) (*AuthTokens, *entities.User, error) {
+	accountKey := strings.ToLower(strings.TrimSpace(email))
+	now := time.Now()
+
+	if err := uc.guard.checkLocked(ctx, entities.LoginThrottleIP, client.IPAddress, now); err != nil {
+		return nil, nil, err
+	}
+	if err := uc.guard.checkLocked(ctx, entities.LoginThrottleAccount, accountKey, now); err != nil {
+		return nil, nil, err
+	}
+
 	user, err := uc.userRepo.GetByEmail(ctx, email)
 	if err != nil {
-		return "", nil, errors.New("invalid credentials")
+		uc.guard.registerFailure(ctx, accountKey, nil, client, now)
+		return nil, nil, errors.New("invalid credentials")
+	}
+
+	if !user.CheckPassword(password) {
+		uc.guard.registerFailure(ctx, accountKey, user, client, now)
+		return nil, nil, errors.New("invalid credentials")
 	}
 
 	if !user.IsActive {
-		return "", nil, errors.New("user is inactive")
+		return nil, nil, errors.New("user is inactive")
 	}
 
-	if !user.CheckPassword(password) {
-		return "", nil, errors.New("invalid credentials")
+	// El contador de fallos no se reinicia hasta completar el segundo paso,
+	// para que conocer la contraseña no permita probar códigos sin límite
+	challenge, err := twoFactorChallenge(ctx, uc.twoFactorRepo, user, uc.tokenConfig, uc.twoFactor)
+	if err != nil {
+		return nil, nil, err
+	}
+	if challenge != nil {
+		return nil, nil, challenge
 	}
 
-	token, err := uc.generateToken(user)
+	uc.guard.reset(ctx, accountKey)
+
+	tokens, err := startSession(ctx, uc.sessionRepo, user, client, uc.tokenConfig)
 	if err != nil {
-		return "", nil, err
+		return nil, nil, err
 	}
 
-	return token, user, nil
+	return tokens, user, nil
 }
 
-func (uc *LoginUseCase) generateToken(user *entities.User) (string, error) {
-	claims := jwt.MapClaims{
-		"user_id": user.ID,
-		"email":   user.Email,
-		"role":    string(user.Role),
-		"exp":     time.Now().Add(uc.jwtExpiry).Unix(),
-		"iat":     time.Now().Unix(),
+// twoFactorChallenge emite el token del segundo paso si el usuario tiene 2FA activo
+// o si su rol lo exige y aún no se enroló; retorna nil si el login puede completarse
+// Lo comparten el login y el registro público, que no pueden entregar sesión sin pasar por aquí
+func twoFactorChallenge(ctx context.Context, twoFactorRepo ports.UserTwoFactorRepository, user *entities.User, tokenConfig TokenConfig, config TwoFactorConfig) (*entities.TwoFactorRequiredError, error) {
+	twoFactor, err := twoFactorRepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := twoFactor != nil && twoFactor.IsEnabled()
+	if !enabled && !config.requiredFor(user) {
+		return nil, nil
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(uc.jwtSecret))
+	token, expiresAt, err := issueTwoFactorChallenge(user, !enabled, tokenConfig.Secret, config.ChallengeExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.TwoFactorRequiredError{
+		ChallengeToken:     token,
+		ExpiresAt:          expiresAt,
+		EnrollmentRequired: !enabled,
+	}, nil
+}
+
+// loginGuard aplica el bloqueo progresivo por cuenta e IP
+// Lo comparten el login con contraseña y la verificación del segundo factor
+type loginGuard struct {
+	throttleRepo ports.LoginThrottleRepository
+	auditRepo    ports.AuditLogRepository
+	limits       LoginLimits
+}
+
+// checkLocked retorna LoginLockedError si la cuenta o IP sigue bloqueada
+func (g loginGuard) checkLocked(ctx context.Context, scope entities.LoginThrottleScope, key string, at time.Time) error {
+	if key == "" {
+		return nil
+	}
+
+	throttle, err := g.throttleRepo.Get(ctx, scope, key)
+	if err != nil {
+		return err
+	}
+	if throttle != nil && throttle.IsLocked(at) {
+		return &entities.LoginLockedError{Until: *throttle.LockedUntil}
+	}
+	return nil
+}
+
+// reset borra los fallos de la cuenta después de un login exitoso (el de la IP se mantiene)
+func (g loginGuard) reset(ctx context.Context, accountKey string) {
+	if err := g.throttleRepo.Reset(ctx, entities.LoginThrottleAccount, accountKey); err != nil {
+		log.Printf("⚠️  Failed to reset login attempts for %s: %v", accountKey, err)
+	}
+}
+
+// registerFailure suma el intento fallido a la cuenta y a la IP y audita los bloqueos
+// Los errores aquí solo se registran: no deben cambiar la respuesta de credenciales inválidas
+func (g loginGuard) registerFailure(ctx context.Context, accountKey string, user *entities.User, client ClientInfo, at time.Time) {
+	limits := []struct {
+		scope  entities.LoginThrottleScope
+		key    string
+		policy entities.LockoutPolicy
+	}{
+		{entities.LoginThrottleAccount, accountKey, g.limits.Account},
+		{entities.LoginThrottleIP, client.IPAddress, g.limits.IP},
+	}
+
+	for _, limit := range limits {
+		if limit.key == "" {
+			continue
+		}
+
+		throttle, locked, err := g.throttleRepo.RegisterFailure(ctx, limit.scope, limit.key, limit.policy, at)
+		if err != nil {
+			log.Printf("⚠️  Failed to register login failure for %s %s: %v", limit.scope, limit.key, err)
+			continue
+		}
+		if !locked {
+			continue
+		}
+
+		log.Printf("🔒 Login locked for %s %s until %s after %d failed attempts",
+			limit.scope, limit.key, throttle.LockedUntil.Format(time.RFC3339), throttle.FailedCount)
+
+		metadata, _ := json.Marshal(map[string]interface{}{
+			"scope":       limit.scope,
+			"key":         limit.key,
+			"failedCount": throttle.FailedCount,
+			"lockedUntil": throttle.LockedUntil,
+		})
+		auditLog := entities.NewSecurityAuditLog(
+			entities.AuditEventLoginLockout,
+			user,
+			client.IPAddress,
+			client.UserAgent,
+			fmt.Sprintf("Login locked for %s %s after %d failed attempts", limit.scope, limit.key, throttle.FailedCount),
+			string(metadata),
+		)
+		if err := g.auditRepo.Create(ctx, auditLog); err != nil {
+			log.Printf("⚠️  Failed to save lockout audit log: %v", err)
+		}
+	}
 }
@@ -0,0 +1,212 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/opaquetoken"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/throttle"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// RequestPasswordResetUseCase envía al usuario un enlace de un solo uso para restablecer su contraseña
+type RequestPasswordResetUseCase struct {
+	userRepo  ports.UserRepository
+	resetRepo ports.PasswordResetTokenRepository
+	auditRepo ports.AuditLogRepository
+	notifier  ports.Notifier
+	limiter   *throttle.Limiter
+	limits    LoginLimits
+	resetURL  string
+	expiry    time.Duration
+}
+
+// NewRequestPasswordResetUseCase crea una nueva instancia del caso de uso
+func NewRequestPasswordResetUseCase(
+	userRepo ports.UserRepository,
+	resetRepo ports.PasswordResetTokenRepository,
+	auditRepo ports.AuditLogRepository,
+	notifier ports.Notifier,
+	limiter *throttle.Limiter,
+	limits LoginLimits,
+	resetURL string,
+	expiry time.Duration,
+) *RequestPasswordResetUseCase {
+	return &RequestPasswordResetUseCase{
+		userRepo:  userRepo,
+		resetRepo: resetRepo,
+		auditRepo: auditRepo,
+		notifier:  notifier,
+		limiter:   limiter,
+		limits:    limits,
+		resetURL:  resetURL,
+		expiry:    expiry,
+	}
+}
+
+// Execute emite un token nuevo (invalidando los anteriores) y lo envía al email del usuario
+// No indica si el email existe, para no revelar qué cuentas están registradas: los envíos fallidos
+// y las solicitudes que superan el límite por email o IP solo se registran en el log
+func (uc *RequestPasswordResetUseCase) Execute(ctx context.Context, email string, client ClientInfo) error {
+	email = strings.TrimSpace(email)
+	allowed, err := uc.limiter.Allow(ctx, time.Now(),
+		throttle.Limit{Scope: entities.LoginThrottleResetEmail, Key: strings.ToLower(email), Policy: uc.limits.Account},
+		throttle.Limit{Scope: entities.LoginThrottleResetIP, Key: client.IPAddress, Policy: uc.limits.IP},
+	)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		log.Printf("🔒 [AUTH] Password reset request throttled for %s from %s", email, client.IPAddress)
+		return nil
+	}
+
+	user, err := uc.userRepo.GetByEmail(ctx, email)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !user.IsActive {
+		return nil
+	}
+
+	if err := uc.resetRepo.InvalidatePending(ctx, user.ID); err != nil {
+		return err
+	}
+
+	token, err := opaquetoken.New()
+	if err != nil {
+		return err
+	}
+
+	resetToken := &entities.PasswordResetToken{
+		UserID:      user.ID,
+		TokenHash:   opaquetoken.Hash(token),
+		ExpiresAt:   time.Now().Add(uc.expiry),
+		RequestedIP: truncate(client.IPAddress, 64),
+	}
+	if err := uc.resetRepo.Create(ctx, resetToken); err != nil {
+		return err
+	}
+
+	resetURL, err := opaquetoken.Link(uc.resetURL, token)
+	if err != nil {
+		return err
+	}
+
+	notification := ports.Notification{
+		To:      user.Email,
+		Subject: "Restablece tu contraseña de Fashion Blue",
+		Body: fmt.Sprintf("Hola %s,\n\nRecibimos una solicitud para restablecer tu contraseña. "+
+			"Ingresa a este enlace (válido por %d minutos) para elegir una nueva:\n\n%s\n\n"+
+			"Si no fuiste tú, ignora este mensaje; tu contraseña actual sigue funcionando.",
+			user.FirstName, int(uc.expiry.Minutes()), resetURL),
+	}
+	if err := uc.notifier.Notify(ctx, notification); err != nil {
+		log.Printf("❌ [AUTH] Failed to send password reset to user #%d: %v", user.ID, err)
+		return nil
+	}
+
+	auditLog := entities.NewSecurityAuditLog(
+		entities.AuditEventPasswordResetRequested,
+		user,
+		client.IPAddress,
+		client.UserAgent,
+		fmt.Sprintf("Password reset requested for user %s", user.Email),
+		"",
+	)
+	if err := uc.auditRepo.Create(ctx, auditLog); err != nil {
+		log.Printf("⚠️  Failed to save password reset audit log: %v", err)
+	}
+
+	return nil
+}
+
+// ConfirmPasswordResetUseCase canjea el token de recuperación por una contraseña nueva
+type ConfirmPasswordResetUseCase struct {
+	userRepo       ports.UserRepository
+	resetRepo      ports.PasswordResetTokenRepository
+	sessionRepo    ports.UserSessionRepository
+	throttleRepo   ports.LoginThrottleRepository
+	auditRepo      ports.AuditLogRepository
+	passwordPolicy entities.PasswordPolicy
+}
+
+// NewConfirmPasswordResetUseCase crea una nueva instancia del caso de uso
+func NewConfirmPasswordResetUseCase(
+	userRepo ports.UserRepository,
+	resetRepo ports.PasswordResetTokenRepository,
+	sessionRepo ports.UserSessionRepository,
+	throttleRepo ports.LoginThrottleRepository,
+	auditRepo ports.AuditLogRepository,
+	passwordPolicy entities.PasswordPolicy,
+) *ConfirmPasswordResetUseCase {
+	return &ConfirmPasswordResetUseCase{
+		userRepo:       userRepo,
+		resetRepo:      resetRepo,
+		sessionRepo:    sessionRepo,
+		throttleRepo:   throttleRepo,
+		auditRepo:      auditRepo,
+		passwordPolicy: passwordPolicy,
+	}
+}
+
+// Execute cambia la contraseña, consume el token y cierra todas las sesiones del usuario
+// También levanta el bloqueo por intentos fallidos de la cuenta
+func (uc *ConfirmPasswordResetUseCase) Execute(ctx context.Context, token, newPassword string, client ClientInfo) error {
+	resetToken, err := uc.resetRepo.GetByTokenHash(ctx, opaquetoken.Hash(strings.TrimSpace(token)))
+	if err != nil || !resetToken.IsValid(time.Now()) {
+		return entities.ErrInvalidResetToken
+	}
+
+	if err := uc.passwordPolicy.Validate(newPassword); err != nil {
+		return err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, resetToken.UserID)
+	if err != nil || !user.IsActive {
+		return entities.ErrInvalidResetToken
+	}
+
+	// Consumir el token antes de cambiar la contraseña: si dos peticiones llegan a la vez solo una gana
+	if err := uc.resetRepo.MarkUsed(ctx, resetToken.ID); err != nil {
+		return err
+	}
+
+	if err := user.HashPassword(newPassword); err != nil {
+		return err
+	}
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	if err := uc.resetRepo.InvalidatePending(ctx, user.ID); err != nil {
+		log.Printf("⚠️  Failed to invalidate pending reset tokens for user #%d: %v", user.ID, err)
+	}
+	if err := uc.throttleRepo.Reset(ctx, entities.LoginThrottleAccount, strings.ToLower(user.Email)); err != nil {
+		log.Printf("⚠️  Failed to reset login attempts for %s: %v", user.Email, err)
+	}
+
+	auditLog := entities.NewSecurityAuditLog(
+		entities.AuditEventPasswordReset,
+		user,
+		client.IPAddress,
+		client.UserAgent,
+		fmt.Sprintf("Password reset completed for user %s", user.Email),
+		"",
+	)
+	if err := uc.auditRepo.Create(ctx, auditLog); err != nil {
+		log.Printf("⚠️  Failed to save password reset audit log: %v", err)
+	}
+
+	// Cerrar todas las sesiones: quien tenga la contraseña anterior no debe seguir dentro
+	return uc.sessionRepo.RevokeAllByUser(ctx, user.ID, entities.SessionRevokedPasswordReset)
+}
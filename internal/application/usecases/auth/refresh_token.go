@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/opaquetoken"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// RefreshTokenUseCase renueva el access token rotando el refresh token de la sesión
+type RefreshTokenUseCase struct {
+	userRepo    ports.UserRepository
+	sessionRepo ports.UserSessionRepository
+	tokenConfig TokenConfig
+}
+
+// NewRefreshTokenUseCase crea una nueva instancia del caso de uso
+func NewRefreshTokenUseCase(userRepo ports.UserRepository, sessionRepo ports.UserSessionRepository, tokenConfig TokenConfig) *RefreshTokenUseCase {
+	return &RefreshTokenUseCase{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		tokenConfig: tokenConfig,
+	}
+}
+
+// Execute canjea un refresh token por un nuevo par de tokens
+// Cada refresh token sirve una sola vez: si se presenta uno ya rotado se asume robo y se revoca la sesión
+func (uc *RefreshTokenUseCase) Execute(ctx context.Context, refreshToken string) (*AuthTokens, error) {
+	if refreshToken == "" {
+		return nil, entities.ErrInvalidRefreshToken
+	}
+
+	currentHash := opaquetoken.Hash(refreshToken)
+	session, err := uc.sessionRepo.GetByTokenHash(ctx, currentHash)
+	if err != nil {
+		return nil, entities.ErrInvalidRefreshToken
+	}
+
+	if session.RefreshTokenHash != currentHash {
+		log.Printf("⚠️  Refresh token reuse detected for session #%d (user #%d), revoking session", session.ID, session.UserID)
+		if err := uc.sessionRepo.Revoke(ctx, session.ID, entities.SessionRevokedTokenReuse); err != nil {
+			return nil, err
+		}
+		return nil, entities.ErrInvalidRefreshToken
+	}
+
+	if !session.IsActive(time.Now()) {
+		return nil, entities.ErrInvalidRefreshToken
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, session.UserID)
+	if err != nil || !user.IsActive {
+		_ = uc.sessionRepo.Revoke(ctx, session.ID, entities.SessionRevokedUserDeactivated)
+		return nil, entities.ErrInvalidRefreshToken
+	}
+
+	newRefresh, err := opaquetoken.New()
+	if err != nil {
+		return nil, err
+	}
+	refreshExpiresAt := time.Now().Add(uc.tokenConfig.RefreshExpiry)
+	if err := uc.sessionRepo.Rotate(ctx, session.ID, currentHash, opaquetoken.Hash(newRefresh), refreshExpiresAt); err != nil {
+		return nil, err
+	}
+
+	accessToken, expiresAt, err := generateAccessToken(user, session.ID, uc.tokenConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthTokens{
+		AccessToken:      accessToken,
+		RefreshToken:     newRefresh,
+		ExpiresAt:        expiresAt,
+		RefreshExpiresAt: refreshExpiresAt,
+		SessionID:        session.ID,
+	}, nil
+}
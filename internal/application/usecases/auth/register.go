@@ -2,53 +2,71 @@ package auth
 
 import (
 	"context"
-	"time"
 
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
-	"github.com/golang-jwt/jwt/v5"
 )
 
+// RegisterUseCase maneja el registro público (deshabilitado por defecto; el alta normal es por invitación)
 type RegisterUseCase struct {
-	userRepo  ports.UserRepository
-	jwtSecret string
-	jwtExpiry time.Duration
+	userRepo       ports.UserRepository
+	sessionRepo    ports.UserSessionRepository
+	twoFactorRepo  ports.UserTwoFactorRepository
+	tokenConfig    TokenConfig
+	twoFactor      TwoFactorConfig
+	passwordPolicy entities.PasswordPolicy
+	enabled        bool
 }
 
-func NewRegisterUseCase(userRepo ports.UserRepository, jwtSecret string, jwtExpiry time.Duration) *RegisterUseCase {
+func NewRegisterUseCase(
+	userRepo ports.UserRepository,
+	sessionRepo ports.UserSessionRepository,
+	twoFactorRepo ports.UserTwoFactorRepository,
+	tokenConfig TokenConfig,
+	twoFactor TwoFactorConfig,
+	passwordPolicy entities.PasswordPolicy,
+	enabled bool,
+) *RegisterUseCase {
 	return &RegisterUseCase{
-		userRepo:  userRepo,
-		jwtSecret: jwtSecret,
-		jwtExpiry: jwtExpiry,
+		userRepo:       userRepo,
+		sessionRepo:    sessionRepo,
+		twoFactorRepo:  twoFactorRepo,
+		tokenConfig:    tokenConfig,
+		twoFactor:      twoFactor,
+		passwordPolicy: passwordPolicy,
+		enabled:        enabled,
 	}
 }
 
-func (uc *RegisterUseCase) Execute(ctx context.Context, user *entities.User, password string) (string, error) {
+// Execute crea la cuenta siempre con el rol SELLER (el rol que envíe el cliente se ignora)
+// Si la cuenta debe usar segundo factor retorna TwoFactorRequiredError en lugar de la sesión, igual que el login
+func (uc *RegisterUseCase) Execute(ctx context.Context, user *entities.User, password string, client ClientInfo) (*AuthTokens, error) {
+	if !uc.enabled {
+		return nil, entities.ErrRegistrationDisabled
+	}
+
+	user.Role = entities.RoleSeller
+	user.IsActive = true
+
+	if err := uc.passwordPolicy.Validate(password); err != nil {
+		return nil, err
+	}
+
 	if err := user.HashPassword(password); err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if err := uc.userRepo.Create(ctx, user); err != nil {
-		return "", err
+		return nil, err
 	}
 
-	token, err := uc.generateToken(user)
+	challenge, err := twoFactorChallenge(ctx, uc.twoFactorRepo, user, uc.tokenConfig, uc.twoFactor)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-
-	return token, nil
-}
-
-func (uc *RegisterUseCase) generateToken(user *entities.User) (string, error) {
-	claims := jwt.MapClaims{
-		"user_id": user.ID,
-		"email":   user.Email,
-		"role":    string(user.Role),
-		"exp":     time.Now().Add(uc.jwtExpiry).Unix(),
-		"iat":     time.Now().Unix(),
+	if challenge != nil {
+		return nil, challenge
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(uc.jwtSecret))
+	return startSession(ctx, uc.sessionRepo, user, client, uc.tokenConfig)
 }
@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/opaquetoken"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// TokenConfig agrupa la configuración de emisión de tokens
+type TokenConfig struct {
+	Secret        string
+	AccessExpiry  time.Duration // Vigencia corta del access token (JWT)
+	RefreshExpiry time.Duration // Vigencia de la sesión (refresh token)
+}
+
+// ClientInfo identifica el dispositivo desde el que se inicia la sesión
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+// AuthTokens es el par de tokens entregado al iniciar o renovar una sesión
+type AuthTokens struct {
+	AccessToken      string
+	RefreshToken     string
+	ExpiresAt        time.Time // Vencimiento del access token
+	RefreshExpiresAt time.Time
+	SessionID        uint
+}
+
+// generateAccessToken firma un JWT de vida corta ligado a la sesión (claim sid)
+func generateAccessToken(user *entities.User, sessionID uint, cfg TokenConfig) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(cfg.AccessExpiry)
+	claims := jwt.MapClaims{
+		"user_id": user.ID,
+		"email":   user.Email,
+		"role":    string(user.Role),
+		"sid":     sessionID,
+		"exp":     expiresAt.Unix(),
+		"iat":     now.Unix(),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString([]byte(cfg.Secret))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expiresAt, nil
+}
+
+// startSession crea una sesión nueva para el usuario y retorna su par de tokens
+func startSession(
+	ctx context.Context,
+	sessionRepo ports.UserSessionRepository,
+	user *entities.User,
+	client ClientInfo,
+	cfg TokenConfig,
+) (*AuthTokens, error) {
+	refreshToken, err := opaquetoken.New()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session := &entities.UserSession{
+		UserID:           user.ID,
+		RefreshTokenHash: opaquetoken.Hash(refreshToken),
+		UserAgent:        truncate(client.UserAgent, 255),
+		IPAddress:        truncate(client.IPAddress, 45),
+		ExpiresAt:        now.Add(cfg.RefreshExpiry),
+		LastUsedAt:       now,
+	}
+	if err := sessionRepo.Create(ctx, session); err != nil {
+		return nil, err
+	}
+
+	accessToken, expiresAt, err := generateAccessToken(user, session.ID, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthTokens{
+		AccessToken:      accessToken,
+		RefreshToken:     refreshToken,
+		ExpiresAt:        expiresAt,
+		RefreshExpiresAt: session.ExpiresAt,
+		SessionID:        session.ID,
+	}, nil
+}
+
+// truncate recorta el texto al tamaño de la columna
+func truncate(s string, max int) string {
+	if len(s) > max {
+		return s[:max]
+	}
+	return s
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ErrSessionNotFound indica que la sesión no existe o pertenece a otro usuario
+var ErrSessionNotFound = errors.New("session not found")
+
+// LogoutUseCase cierra la sesión actual o todas las sesiones del usuario
+type LogoutUseCase struct {
+	sessionRepo ports.UserSessionRepository
+}
+
+// NewLogoutUseCase crea una nueva instancia del caso de uso
+func NewLogoutUseCase(sessionRepo ports.UserSessionRepository) *LogoutUseCase {
+	return &LogoutUseCase{sessionRepo: sessionRepo}
+}
+
+// Execute revoca la sesión indicada; con allDevices revoca todas las del usuario
+func (uc *LogoutUseCase) Execute(ctx context.Context, userID, sessionID uint, allDevices bool) error {
+	if allDevices {
+		return uc.sessionRepo.RevokeAllByUser(ctx, userID, entities.SessionRevokedLogout)
+	}
+	return uc.sessionRepo.Revoke(ctx, sessionID, entities.SessionRevokedLogout)
+}
+
+// ListSessionsUseCase lista las sesiones activas (dispositivos) de un usuario
+type ListSessionsUseCase struct {
+	sessionRepo ports.UserSessionRepository
+}
+
+// NewListSessionsUseCase crea una nueva instancia del caso de uso
+func NewListSessionsUseCase(sessionRepo ports.UserSessionRepository) *ListSessionsUseCase {
+	return &ListSessionsUseCase{sessionRepo: sessionRepo}
+}
+
+// Execute retorna las sesiones activas del usuario
+func (uc *ListSessionsUseCase) Execute(ctx context.Context, userID uint) ([]entities.UserSession, error) {
+	return uc.sessionRepo.ListActiveByUser(ctx, userID)
+}
+
+// RevokeSessionUseCase cierra una sesión específica de un usuario (ej: un dispositivo perdido)
+type RevokeSessionUseCase struct {
+	sessionRepo ports.UserSessionRepository
+}
+
+// NewRevokeSessionUseCase crea una nueva instancia del caso de uso
+func NewRevokeSessionUseCase(sessionRepo ports.UserSessionRepository) *RevokeSessionUseCase {
+	return &RevokeSessionUseCase{sessionRepo: sessionRepo}
+}
+
+// Execute revoca la sesión solo si pertenece al usuario
+func (uc *RevokeSessionUseCase) Execute(ctx context.Context, userID, sessionID uint) error {
+	session, err := uc.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil || session.UserID != userID {
+		return ErrSessionNotFound
+	}
+	return uc.sessionRepo.Revoke(ctx, session.ID, entities.SessionRevokedByUser)
+}
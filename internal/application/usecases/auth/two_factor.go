@@ -0,0 +1,643 @@
+package auth
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/opaquetoken"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// recoveryCodeCount es la cantidad de códigos de recuperación que se entregan al activar 2FA
+const recoveryCodeCount = 10
+
+// TwoFactorConfig agrupa la configuración del segundo factor
+type TwoFactorConfig struct {
+	Issuer                string        // Nombre que muestra la app autenticadora
+	EncryptionKey         string        // Clave para cifrar los secretos TOTP en la base de datos
+	ChallengeExpiry       time.Duration // Vigencia del token del segundo paso del login
+	RequiredForSuperAdmin bool          // Obliga a los SUPER_ADMIN a enrolarse antes de entrar
+}
+
+// requiredFor indica si el rol del usuario obliga a usar segundo factor
+func (c TwoFactorConfig) requiredFor(user *entities.User) bool {
+	return c.RequiredForSuperAdmin && user.IsSuperAdmin()
+}
+
+// TwoFactorSetup contiene el secreto a registrar en la app autenticadora
+// OTPAuthURL es el contenido del código QR (otpauth://totp/...)
+type TwoFactorSetup struct {
+	Secret     string
+	OTPAuthURL string
+}
+
+// TwoFactorStatus resume el estado del segundo factor de un usuario
+type TwoFactorStatus struct {
+	Enabled           bool
+	EnabledAt         *time.Time
+	Required          bool
+	RecoveryCodesLeft int
+}
+
+// encryptSecret cifra el secreto TOTP con AES-GCM (clave derivada con SHA-256)
+func encryptSecret(key, plaintext string) (string, error) {
+	sum := sha256.Sum256([]byte(key))
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return "", err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptSecret descifra un secreto guardado con encryptSecret
+func decryptSecret(key, encoded string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(key))
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return "", err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", errors.New("invalid encrypted secret")
+	}
+
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// issueTwoFactorChallenge firma el token del segundo paso (sin sid: no sirve como access token)
+func issueTwoFactorChallenge(user *entities.User, enroll bool, secret string, expiry time.Duration) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(expiry)
+	claims := jwt.MapClaims{
+		"user_id": user.ID,
+		"scope":   entities.TwoFactorChallengeScope,
+		"enroll":  enroll,
+		"exp":     expiresAt.Unix(),
+		"iat":     now.Unix(),
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expiresAt, nil
+}
+
+// parseTwoFactorChallenge valida el token del segundo paso y retorna el usuario y si es de enrolamiento
+func parseTwoFactorChallenge(tokenString, secret string) (uint, bool, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return []byte(secret), nil
+	})
+	if err != nil || !token.Valid {
+		return 0, false, entities.ErrInvalidTwoFactorSession
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return 0, false, entities.ErrInvalidTwoFactorSession
+	}
+	if scope, _ := claims["scope"].(string); scope != entities.TwoFactorChallengeScope {
+		return 0, false, entities.ErrInvalidTwoFactorSession
+	}
+
+	userID, ok := claims["user_id"].(float64)
+	if !ok {
+		return 0, false, entities.ErrInvalidTwoFactorSession
+	}
+	enroll, _ := claims["enroll"].(bool)
+
+	return uint(userID), enroll, nil
+}
+
+// normalizeRecoveryCode quita guiones y espacios para comparar el código como lo escriba el usuario
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
+
+// newRecoveryCodes genera los códigos en claro (formato xxxxx-xxxxx) y sus hashes
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		raw := make([]byte, 5)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, err
+		}
+		code := hex.EncodeToString(raw)
+		codes[i] = code[:5] + "-" + code[5:]
+		hashes[i] = opaquetoken.Hash(code)
+	}
+	return codes, hashes, nil
+}
+
+// verifyTOTP valida el código contra el secreto del usuario y lo marca como usado
+func verifyTOTP(ctx context.Context, twoFactorRepo ports.UserTwoFactorRepository, twoFactor *entities.UserTwoFactor, code, encryptionKey string) (int64, error) {
+	plain, err := decryptSecret(encryptionKey, twoFactor.Secret)
+	if err != nil {
+		return 0, fmt.Errorf("error decrypting two-factor secret: %w", err)
+	}
+	secret, err := entities.DecodeTOTPSecret(plain)
+	if err != nil {
+		return 0, err
+	}
+
+	step, ok := entities.MatchTOTPCode(secret, code, time.Now(), twoFactor.LastUsedStep)
+	if !ok {
+		return 0, entities.ErrInvalidTwoFactorCode
+	}
+	if twoFactor.IsEnabled() {
+		if err := twoFactorRepo.MarkStepUsed(ctx, twoFactor.UserID, step); err != nil {
+			return 0, err
+		}
+	}
+	return step, nil
+}
+
+// saveTwoFactorAudit registra un evento de seguridad del segundo factor
+func saveTwoFactorAudit(ctx context.Context, auditRepo ports.AuditLogRepository, eventType string, user *entities.User, client ClientInfo, description string) {
+	auditLog := entities.NewSecurityAuditLog(eventType, user, client.IPAddress, client.UserAgent, description, "")
+	if err := auditRepo.Create(ctx, auditLog); err != nil {
+		log.Printf("⚠️  Failed to save two-factor audit log: %v", err)
+	}
+}
+
+// SetupTwoFactorUseCase genera un secreto TOTP pendiente de confirmar
+type SetupTwoFactorUseCase struct {
+	userRepo      ports.UserRepository
+	twoFactorRepo ports.UserTwoFactorRepository
+	config        TwoFactorConfig
+}
+
+// NewSetupTwoFactorUseCase crea una nueva instancia del caso de uso
+func NewSetupTwoFactorUseCase(userRepo ports.UserRepository, twoFactorRepo ports.UserTwoFactorRepository, config TwoFactorConfig) *SetupTwoFactorUseCase {
+	return &SetupTwoFactorUseCase{
+		userRepo:      userRepo,
+		twoFactorRepo: twoFactorRepo,
+		config:        config,
+	}
+}
+
+// Execute crea un secreto nuevo (reemplaza uno pendiente) y retorna los datos para el código QR
+// El segundo factor no queda activo hasta confirmarlo con un código de la app
+func (uc *SetupTwoFactorUseCase) Execute(ctx context.Context, userID uint) (*TwoFactorSetup, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := uc.twoFactorRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil && current.IsEnabled() {
+		return nil, entities.ErrTwoFactorAlreadyEnabled
+	}
+
+	raw := make([]byte, 20)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+	secret := entities.EncodeTOTPSecret(raw)
+
+	encrypted, err := encryptSecret(uc.config.EncryptionKey, secret)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.twoFactorRepo.SavePending(ctx, &entities.UserTwoFactor{UserID: userID, Secret: encrypted}); err != nil {
+		return nil, err
+	}
+
+	label := url.PathEscape(uc.config.Issuer + ":" + user.Email)
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", uc.config.Issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(entities.TOTPDigits))
+	query.Set("period", fmt.Sprint(entities.TOTPPeriod))
+
+	return &TwoFactorSetup{
+		Secret:     secret,
+		OTPAuthURL: "otpauth://totp/" + label + "?" + query.Encode(),
+	}, nil
+}
+
+// EnableTwoFactorUseCase confirma el enrolamiento con un código de la app
+type EnableTwoFactorUseCase struct {
+	userRepo      ports.UserRepository
+	twoFactorRepo ports.UserTwoFactorRepository
+	auditRepo     ports.AuditLogRepository
+	config        TwoFactorConfig
+}
+
+// NewEnableTwoFactorUseCase crea una nueva instancia del caso de uso
+func NewEnableTwoFactorUseCase(
+	userRepo ports.UserRepository,
+	twoFactorRepo ports.UserTwoFactorRepository,
+	auditRepo ports.AuditLogRepository,
+	config TwoFactorConfig,
+) *EnableTwoFactorUseCase {
+	return &EnableTwoFactorUseCase{
+		userRepo:      userRepo,
+		twoFactorRepo: twoFactorRepo,
+		auditRepo:     auditRepo,
+		config:        config,
+	}
+}
+
+// Execute activa el segundo factor y retorna los códigos de recuperación en claro (solo se muestran una vez)
+func (uc *EnableTwoFactorUseCase) Execute(ctx context.Context, userID uint, code string, client ClientInfo) ([]string, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	twoFactor, err := uc.twoFactorRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if twoFactor == nil {
+		return nil, entities.ErrTwoFactorNotSetUp
+	}
+	if twoFactor.IsEnabled() {
+		return nil, entities.ErrTwoFactorAlreadyEnabled
+	}
+
+	step, err := verifyTOTP(ctx, uc.twoFactorRepo, twoFactor, code, uc.config.EncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.twoFactorRepo.Enable(ctx, userID, step, hashes, time.Now()); err != nil {
+		return nil, err
+	}
+
+	saveTwoFactorAudit(ctx, uc.auditRepo, entities.AuditEventTwoFactorEnabled, user, client,
+		fmt.Sprintf("Two-factor authentication enabled for user %s", user.Email))
+
+	return codes, nil
+}
+
+// DisableTwoFactorUseCase desactiva el segundo factor de un usuario
+type DisableTwoFactorUseCase struct {
+	userRepo      ports.UserRepository
+	roleRepo      ports.RoleRepository
+	twoFactorRepo ports.UserTwoFactorRepository
+	auditRepo     ports.AuditLogRepository
+	config        TwoFactorConfig
+}
+
+// NewDisableTwoFactorUseCase crea una nueva instancia del caso de uso
+func NewDisableTwoFactorUseCase(
+	userRepo ports.UserRepository,
+	roleRepo ports.RoleRepository,
+	twoFactorRepo ports.UserTwoFactorRepository,
+	auditRepo ports.AuditLogRepository,
+	config TwoFactorConfig,
+) *DisableTwoFactorUseCase {
+	return &DisableTwoFactorUseCase{
+		userRepo:      userRepo,
+		roleRepo:      roleRepo,
+		twoFactorRepo: twoFactorRepo,
+		auditRepo:     auditRepo,
+		config:        config,
+	}
+}
+
+// Execute desactiva el segundo factor propio; pide la contraseña y un código vigente
+// No se permite si el rol del usuario lo exige
+func (uc *DisableTwoFactorUseCase) Execute(ctx context.Context, userID uint, password, code string, client ClientInfo) error {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if uc.config.requiredFor(user) {
+		return entities.ErrTwoFactorRequiredForRole
+	}
+	if !user.CheckPassword(password) {
+		return errors.New("invalid password")
+	}
+
+	twoFactor, err := uc.twoFactorRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if twoFactor == nil || !twoFactor.IsEnabled() {
+		return entities.ErrTwoFactorNotEnabled
+	}
+	if _, err := verifyTOTP(ctx, uc.twoFactorRepo, twoFactor, code, uc.config.EncryptionKey); err != nil {
+		return err
+	}
+
+	if err := uc.twoFactorRepo.Disable(ctx, userID); err != nil {
+		return err
+	}
+
+	saveTwoFactorAudit(ctx, uc.auditRepo, entities.AuditEventTwoFactorDisabled, user, client,
+		fmt.Sprintf("Two-factor authentication disabled by user %s", user.Email))
+	return nil
+}
+
+// AdminReset elimina el segundo factor de otro usuario (ej: perdió el teléfono y los códigos)
+// Si su rol lo exige, deberá enrolarse de nuevo en el próximo login
+// Solo se reinicia a quien tiene un rol que el actor puede otorgar
+func (uc *DisableTwoFactorUseCase) AdminReset(ctx context.Context, actor *entities.User, userID uint, client ClientInfo) error {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := access.CheckRoleGrantByName(ctx, uc.roleRepo, user.Role); err != nil {
+		return err
+	}
+
+	if err := uc.twoFactorRepo.Disable(ctx, userID); err != nil {
+		return err
+	}
+
+	saveTwoFactorAudit(ctx, uc.auditRepo, entities.AuditEventTwoFactorDisabled, actor, client,
+		fmt.Sprintf("Two-factor authentication reset for user %s by %s", user.Email, actor.Email))
+	return nil
+}
+
+// RegenerateRecoveryCodesUseCase reemplaza los códigos de recuperación
+type RegenerateRecoveryCodesUseCase struct {
+	userRepo      ports.UserRepository
+	twoFactorRepo ports.UserTwoFactorRepository
+	auditRepo     ports.AuditLogRepository
+	config        TwoFactorConfig
+}
+
+// NewRegenerateRecoveryCodesUseCase crea una nueva instancia del caso de uso
+func NewRegenerateRecoveryCodesUseCase(
+	userRepo ports.UserRepository,
+	twoFactorRepo ports.UserTwoFactorRepository,
+	auditRepo ports.AuditLogRepository,
+	config TwoFactorConfig,
+) *RegenerateRecoveryCodesUseCase {
+	return &RegenerateRecoveryCodesUseCase{
+		userRepo:      userRepo,
+		twoFactorRepo: twoFactorRepo,
+		auditRepo:     auditRepo,
+		config:        config,
+	}
+}
+
+// Execute invalida los códigos anteriores y retorna los nuevos en claro; pide un código TOTP vigente
+func (uc *RegenerateRecoveryCodesUseCase) Execute(ctx context.Context, userID uint, code string, client ClientInfo) ([]string, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	twoFactor, err := uc.twoFactorRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if twoFactor == nil || !twoFactor.IsEnabled() {
+		return nil, entities.ErrTwoFactorNotEnabled
+	}
+	if _, err := verifyTOTP(ctx, uc.twoFactorRepo, twoFactor, code, uc.config.EncryptionKey); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.twoFactorRepo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+
+	saveTwoFactorAudit(ctx, uc.auditRepo, entities.AuditEventRecoveryCodesRegenerated, user, client,
+		fmt.Sprintf("Recovery codes regenerated for user %s", user.Email))
+	return codes, nil
+}
+
+// GetTwoFactorStatusUseCase consulta el estado del segundo factor
+type GetTwoFactorStatusUseCase struct {
+	userRepo      ports.UserRepository
+	twoFactorRepo ports.UserTwoFactorRepository
+	config        TwoFactorConfig
+}
+
+// NewGetTwoFactorStatusUseCase crea una nueva instancia del caso de uso
+func NewGetTwoFactorStatusUseCase(userRepo ports.UserRepository, twoFactorRepo ports.UserTwoFactorRepository, config TwoFactorConfig) *GetTwoFactorStatusUseCase {
+	return &GetTwoFactorStatusUseCase{
+		userRepo:      userRepo,
+		twoFactorRepo: twoFactorRepo,
+		config:        config,
+	}
+}
+
+// Execute retorna si el usuario tiene 2FA activo, si su rol lo exige y cuántos códigos le quedan
+func (uc *GetTwoFactorStatusUseCase) Execute(ctx context.Context, userID uint) (*TwoFactorStatus, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &TwoFactorStatus{Required: uc.config.requiredFor(user)}
+
+	twoFactor, err := uc.twoFactorRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if twoFactor == nil || !twoFactor.IsEnabled() {
+		return status, nil
+	}
+
+	status.Enabled = true
+	status.EnabledAt = twoFactor.EnabledAt
+	status.RecoveryCodesLeft, err = uc.twoFactorRepo.CountRecoveryCodes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// VerifyTwoFactorUseCase completa el login con el segundo factor
+// También permite enrolarse durante el login cuando el rol lo exige y el usuario aún no tiene 2FA
+type VerifyTwoFactorUseCase struct {
+	userRepo      ports.UserRepository
+	sessionRepo   ports.UserSessionRepository
+	twoFactorRepo ports.UserTwoFactorRepository
+	guard         loginGuard
+	setupUC       *SetupTwoFactorUseCase
+	enableUC      *EnableTwoFactorUseCase
+	tokenConfig   TokenConfig
+	config        TwoFactorConfig
+}
+
+// NewVerifyTwoFactorUseCase crea una nueva instancia del caso de uso
+func NewVerifyTwoFactorUseCase(
+	userRepo ports.UserRepository,
+	sessionRepo ports.UserSessionRepository,
+	throttleRepo ports.LoginThrottleRepository,
+	twoFactorRepo ports.UserTwoFactorRepository,
+	auditRepo ports.AuditLogRepository,
+	setupUC *SetupTwoFactorUseCase,
+	enableUC *EnableTwoFactorUseCase,
+	tokenConfig TokenConfig,
+	config TwoFactorConfig,
+	limits LoginLimits,
+) *VerifyTwoFactorUseCase {
+	return &VerifyTwoFactorUseCase{
+		userRepo:      userRepo,
+		sessionRepo:   sessionRepo,
+		twoFactorRepo: twoFactorRepo,
+		guard:         loginGuard{throttleRepo: throttleRepo, auditRepo: auditRepo, limits: limits},
+		setupUC:       setupUC,
+		enableUC:      enableUC,
+		tokenConfig:   tokenConfig,
+		config:        config,
+	}
+}
+
+// challengeUser valida el token del segundo paso, el bloqueo de la cuenta y el estado del usuario
+func (uc *VerifyTwoFactorUseCase) challengeUser(ctx context.Context, challengeToken string, wantEnroll bool, client ClientInfo) (*entities.User, error) {
+	userID, enroll, err := parseTwoFactorChallenge(challengeToken, uc.tokenConfig.Secret)
+	if err != nil {
+		return nil, err
+	}
+	if enroll != wantEnroll {
+		return nil, entities.ErrInvalidTwoFactorSession
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil || !user.IsActive {
+		return nil, entities.ErrInvalidTwoFactorSession
+	}
+
+	now := time.Now()
+	if err := uc.guard.checkLocked(ctx, entities.LoginThrottleIP, client.IPAddress, now); err != nil {
+		return nil, err
+	}
+	if err := uc.guard.checkLocked(ctx, entities.LoginThrottleAccount, strings.ToLower(user.Email), now); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// Execute canjea el token del segundo paso y un código TOTP (o de recuperación) por la sesión
+// Los códigos incorrectos cuentan como intentos fallidos de login
+func (uc *VerifyTwoFactorUseCase) Execute(ctx context.Context, challengeToken, code string, client ClientInfo) (*AuthTokens, *entities.User, error) {
+	user, err := uc.challengeUser(ctx, challengeToken, false, client)
+	if err != nil {
+		return nil, nil, err
+	}
+	accountKey := strings.ToLower(user.Email)
+
+	twoFactor, err := uc.twoFactorRepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if twoFactor == nil || !twoFactor.IsEnabled() {
+		return nil, nil, entities.ErrInvalidTwoFactorSession
+	}
+
+	// Los códigos TOTP tienen 6 dígitos; cualquier otra cosa se intenta como código de recuperación
+	normalized := normalizeRecoveryCode(code)
+	if len(normalized) == entities.TOTPDigits {
+		_, err = verifyTOTP(ctx, uc.twoFactorRepo, twoFactor, normalized, uc.config.EncryptionKey)
+	} else {
+		err = uc.twoFactorRepo.UseRecoveryCode(ctx, user.ID, opaquetoken.Hash(normalized))
+		if err == nil {
+			saveTwoFactorAudit(ctx, uc.guard.auditRepo, entities.AuditEventRecoveryCodeUsed, user, client,
+				fmt.Sprintf("Recovery code used to log in by user %s", user.Email))
+		}
+	}
+	if errors.Is(err, entities.ErrInvalidTwoFactorCode) {
+		uc.guard.registerFailure(ctx, accountKey, user, client, time.Now())
+		return nil, nil, err
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uc.guard.reset(ctx, accountKey)
+
+	tokens, err := startSession(ctx, uc.sessionRepo, user, client, uc.tokenConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tokens, user, nil
+}
+
+// Setup genera el secreto TOTP para un usuario obligado a enrolarse durante el login
+func (uc *VerifyTwoFactorUseCase) Setup(ctx context.Context, challengeToken string, client ClientInfo) (*TwoFactorSetup, error) {
+	user, err := uc.challengeUser(ctx, challengeToken, true, client)
+	if err != nil {
+		return nil, err
+	}
+	return uc.setupUC.Execute(ctx, user.ID)
+}
+
+// Enroll confirma el enrolamiento durante el login y abre la sesión
+// Retorna también los códigos de recuperación en claro (solo se muestran una vez)
+func (uc *VerifyTwoFactorUseCase) Enroll(ctx context.Context, challengeToken, code string, client ClientInfo) (*AuthTokens, *entities.User, []string, error) {
+	user, err := uc.challengeUser(ctx, challengeToken, true, client)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	codes, err := uc.enableUC.Execute(ctx, user.ID, code, client)
+	if errors.Is(err, entities.ErrInvalidTwoFactorCode) {
+		uc.guard.registerFailure(ctx, strings.ToLower(user.Email), user, client, time.Now())
+		return nil, nil, nil, err
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	uc.guard.reset(ctx, strings.ToLower(user.Email))
+
+	tokens, err := startSession(ctx, uc.sessionRepo, user, client, uc.tokenConfig)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return tokens, user, codes, nil
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// fakeUserRepository retorna siempre el mismo usuario
+type fakeUserRepository struct {
+	ports.UserRepository
+	user *entities.User
+}
+
+func (r *fakeUserRepository) GetByID(ctx context.Context, id uint) (*entities.User, error) {
+	return r.user, nil
+}
+
+// fakeRoleRepository sirve los roles por defecto
+type fakeRoleRepository struct {
+	ports.RoleRepository
+}
+
+func (r *fakeRoleRepository) GetByName(ctx context.Context, name entities.UserRole) (*entities.Role, error) {
+	for _, role := range entities.DefaultRoles() {
+		if role.Name == name {
+			return &role, nil
+		}
+	}
+	return nil, entities.ErrRoleNotFound
+}
+
+// fakeTwoFactorRepository registra si se deshabilitó el segundo factor
+type fakeTwoFactorRepository struct {
+	ports.UserTwoFactorRepository
+	disabled bool
+}
+
+func (r *fakeTwoFactorRepository) Disable(ctx context.Context, userID uint) error {
+	r.disabled = true
+	return nil
+}
+
+func TestAdminResetRequiresGrant(t *testing.T) {
+	actors := []entities.UserRole{entities.RoleSeller, entities.RoleCashier, entities.RoleWorkshop, entities.RoleAccountant}
+
+	for _, actorRole := range actors {
+		t.Run(string(actorRole), func(t *testing.T) {
+			role, _ := (&fakeRoleRepository{}).GetByName(context.Background(), actorRole)
+			actor := &entities.User{ID: 1, Email: "staff@fashionblue.co", Role: actorRole}
+			ctx := access.WithActor(context.Background(), access.Actor{User: actor, Role: role})
+
+			twoFactorRepo := &fakeTwoFactorRepository{}
+			uc := NewDisableTwoFactorUseCase(
+				&fakeUserRepository{user: &entities.User{ID: 2, Email: "admin@fashionblue.co", Role: entities.RoleSuperAdmin}},
+				&fakeRoleRepository{}, twoFactorRepo, nil, TwoFactorConfig{},
+			)
+
+			if err := uc.AdminReset(ctx, actor, 2, ClientInfo{}); !errors.Is(err, entities.ErrRoleEscalation) {
+				t.Errorf("err = %v, want ErrRoleEscalation", err)
+			}
+			if twoFactorRepo.disabled {
+				t.Error("two-factor was disabled")
+			}
+		})
+	}
+}
@@ -3,25 +3,41 @@ package auth
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// Principal identifica a quién pertenece una petición autenticada y qué puede hacer
+type Principal struct {
+	User      *entities.User
+	Role      *entities.Role
+	SessionID uint             // 0 cuando la petición usa una llave de API
+	APIKey    *entities.APIKey // nil cuando la petición usa un token de sesión
+}
+
 type ValidateTokenUseCase struct {
-	userRepo  ports.UserRepository
-	jwtSecret string
+	userRepo    ports.UserRepository
+	sessionRepo ports.UserSessionRepository
+	roleRepo    ports.RoleRepository
+	jwtSecret   string
 }
 
-func NewValidateTokenUseCase(userRepo ports.UserRepository, jwtSecret string) *ValidateTokenUseCase {
+func NewValidateTokenUseCase(userRepo ports.UserRepository, sessionRepo ports.UserSessionRepository, roleRepo ports.RoleRepository, jwtSecret string) *ValidateTokenUseCase {
 	return &ValidateTokenUseCase{
-		userRepo:  userRepo,
-		jwtSecret: jwtSecret,
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		roleRepo:    roleRepo,
+		jwtSecret:   jwtSecret,
 	}
 }
 
-func (uc *ValidateTokenUseCase) Execute(ctx context.Context, tokenString string) (*entities.User, error) {
+// Execute valida el access token y retorna el usuario, su rol y la sesión a la que pertenece
+// Un token firmado correctamente deja de servir en cuanto su sesión se revoca
+func (uc *ValidateTokenUseCase) Execute(ctx context.Context, tokenString string) (*Principal, error) {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, errors.New("unexpected signing method")
@@ -42,11 +58,26 @@ func (uc *ValidateTokenUseCase) Execute(ctx context.Context, tokenString string)
 		return nil, errors.New("invalid token claims")
 	}
 
+	// Los tokens con scope (portal de clientes, segundo paso del login) nunca dan acceso a las rutas internas
+	if scope, _ := claims["scope"].(string); scope != "" {
+		return nil, fmt.Errorf("%s tokens are not allowed", scope)
+	}
+
 	userID, ok := claims["user_id"].(float64)
 	if !ok {
 		return nil, errors.New("invalid user_id in token")
 	}
 
+	sessionID, ok := claims["sid"].(float64)
+	if !ok {
+		return nil, errors.New("invalid session in token")
+	}
+
+	session, err := uc.sessionRepo.GetByID(ctx, uint(sessionID))
+	if err != nil || session.UserID != uint(userID) || !session.IsActive(time.Now()) {
+		return nil, entities.ErrSessionRevoked
+	}
+
 	user, err := uc.userRepo.GetByID(ctx, uint(userID))
 	if err != nil {
 		return nil, errors.New("user not found")
@@ -56,5 +87,13 @@ func (uc *ValidateTokenUseCase) Execute(ctx context.Context, tokenString string)
 		return nil, errors.New("user is inactive")
 	}
 
-	return user, nil
+	// Un rol eliminado o inexistente no otorga permisos, pero no impide autenticarse
+	role, err := uc.roleRepo.GetByName(ctx, user.Role)
+	if errors.Is(err, entities.ErrRoleNotFound) {
+		role = &entities.Role{Name: user.Role}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &Principal{User: user, Role: role, SessionID: session.ID}, nil
 }
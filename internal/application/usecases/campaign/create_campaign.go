@@ -0,0 +1,30 @@
+package campaign
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// CreateCampaignUseCase maneja la creación de campañas
+type CreateCampaignUseCase struct {
+	campaignRepo ports.CampaignRepository
+}
+
+// NewCreateCampaignUseCase crea una nueva instancia del caso de uso
+func NewCreateCampaignUseCase(campaignRepo ports.CampaignRepository) *CreateCampaignUseCase {
+	return &CreateCampaignUseCase{
+		campaignRepo: campaignRepo,
+	}
+}
+
+// Execute valida y guarda la campaña
+func (uc *CreateCampaignUseCase) Execute(ctx context.Context, campaign *entities.Campaign) error {
+	if err := campaign.Validate(); err != nil {
+		return err
+	}
+
+	campaign.IsActive = true
+	return uc.campaignRepo.Create(ctx, campaign)
+}
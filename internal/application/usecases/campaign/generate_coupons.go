@@ -0,0 +1,107 @@
+package campaign
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// couponAlphabet excluye caracteres ambiguos (0/O, 1/I) para dictar códigos por teléfono
+const couponAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// GenerateCouponsUseCase emite un cupón personal para cada cliente seleccionado por la campaña
+type GenerateCouponsUseCase struct {
+	campaignRepo ports.CampaignRepository
+	couponRepo   ports.CampaignCouponRepository
+}
+
+// NewGenerateCouponsUseCase crea una nueva instancia del caso de uso
+func NewGenerateCouponsUseCase(campaignRepo ports.CampaignRepository, couponRepo ports.CampaignCouponRepository) *GenerateCouponsUseCase {
+	return &GenerateCouponsUseCase{
+		campaignRepo: campaignRepo,
+		couponRepo:   couponRepo,
+	}
+}
+
+// GenerateCouponsResult resume la emisión de cupones
+type GenerateCouponsResult struct {
+	Created []entities.CampaignCoupon
+	Skipped int // Clientes que ya tenían cupón de esta campaña
+}
+
+// Execute genera cupones y mensajes personalizados; es idempotente por cliente
+func (uc *GenerateCouponsUseCase) Execute(ctx context.Context, campaignID uint) (*GenerateCouponsResult, error) {
+	campaign, err := uc.campaignRepo.GetByID(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !campaign.IsActive {
+		return nil, errors.New("campaign is not active")
+	}
+	if time.Now().After(campaign.ValidUntil) {
+		return nil, errors.New("campaign has already ended")
+	}
+
+	customers, err := uc.campaignRepo.FindCustomers(ctx, campaign.Criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &GenerateCouponsResult{Created: []entities.CampaignCoupon{}}
+	for i := range customers {
+		customer := &customers[i]
+
+		exists, err := uc.couponRepo.ExistsForCustomer(ctx, campaign.ID, customer.ID)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			result.Skipped++
+			continue
+		}
+
+		code, err := generateCouponCode()
+		if err != nil {
+			return nil, err
+		}
+
+		coupon := &entities.CampaignCoupon{
+			CampaignID:    campaign.ID,
+			CustomerID:    customer.ID,
+			Customer:      customer,
+			Code:          code,
+			Message:       campaign.RenderMessage(customer, code),
+			DiscountType:  campaign.DiscountType,
+			DiscountValue: campaign.DiscountValue,
+			ExpiresAt:     campaign.ValidUntil,
+		}
+		if err := uc.couponRepo.Create(ctx, coupon); err != nil {
+			return nil, err
+		}
+		coupon.Customer = customer
+
+		result.Created = append(result.Created, *coupon)
+	}
+
+	return result, nil
+}
+
+// generateCouponCode genera un código del tipo FB-XXXXXX
+func generateCouponCode() (string, error) {
+	code := make([]byte, 6)
+	max := big.NewInt(int64(len(couponAlphabet)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = couponAlphabet[n.Int64()]
+	}
+	return "FB-" + string(code), nil
+}
@@ -0,0 +1,45 @@
+package campaign
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// GetCampaignUseCase obtiene una campaña con sus estadísticas de redención
+type GetCampaignUseCase struct {
+	campaignRepo ports.CampaignRepository
+	couponRepo   ports.CampaignCouponRepository
+}
+
+// NewGetCampaignUseCase crea una nueva instancia del caso de uso
+func NewGetCampaignUseCase(campaignRepo ports.CampaignRepository, couponRepo ports.CampaignCouponRepository) *GetCampaignUseCase {
+	return &GetCampaignUseCase{
+		campaignRepo: campaignRepo,
+		couponRepo:   couponRepo,
+	}
+}
+
+// Execute retorna la campaña y sus estadísticas
+func (uc *GetCampaignUseCase) Execute(ctx context.Context, id uint) (*entities.Campaign, *entities.CampaignStats, error) {
+	campaign, err := uc.campaignRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats, err := uc.couponRepo.GetStats(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return campaign, stats, nil
+}
+
+// ListCoupons lista los cupones emitidos por la campaña
+func (uc *GetCampaignUseCase) ListCoupons(ctx context.Context, id uint) ([]entities.CampaignCoupon, error) {
+	if _, err := uc.campaignRepo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return uc.couponRepo.ListByCampaign(ctx, id)
+}
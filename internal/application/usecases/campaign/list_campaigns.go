@@ -0,0 +1,25 @@
+package campaign
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListCampaignsUseCase maneja el listado de campañas
+type ListCampaignsUseCase struct {
+	campaignRepo ports.CampaignRepository
+}
+
+// NewListCampaignsUseCase crea una nueva instancia del caso de uso
+func NewListCampaignsUseCase(campaignRepo ports.CampaignRepository) *ListCampaignsUseCase {
+	return &ListCampaignsUseCase{
+		campaignRepo: campaignRepo,
+	}
+}
+
+// Execute lista las campañas con filtros opcionales
+func (uc *ListCampaignsUseCase) Execute(ctx context.Context, filters map[string]interface{}) ([]entities.Campaign, error) {
+	return uc.campaignRepo.List(ctx, filters)
+}
@@ -0,0 +1,31 @@
+package campaign
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListCustomerCouponsUseCase lista los cupones de un cliente
+type ListCustomerCouponsUseCase struct {
+	couponRepo ports.CampaignCouponRepository
+	guard      *access.Guard
+}
+
+// NewListCustomerCouponsUseCase crea una nueva instancia del caso de uso
+func NewListCustomerCouponsUseCase(couponRepo ports.CampaignCouponRepository, guard *access.Guard) *ListCustomerCouponsUseCase {
+	return &ListCustomerCouponsUseCase{
+		couponRepo: couponRepo,
+		guard:      guard,
+	}
+}
+
+// Execute retorna los cupones emitidos al cliente (redimidos y pendientes)
+func (uc *ListCustomerCouponsUseCase) Execute(ctx context.Context, customerID uint) ([]entities.CampaignCoupon, error) {
+	if err := uc.guard.CheckCustomerID(ctx, customerID); err != nil {
+		return nil, err
+	}
+	return uc.couponRepo.ListByCustomer(ctx, customerID)
+}
@@ -0,0 +1,30 @@
+package campaign
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// PreviewAudienceUseCase muestra qué clientes recibirían una campaña sin emitir cupones
+type PreviewAudienceUseCase struct {
+	campaignRepo ports.CampaignRepository
+}
+
+// NewPreviewAudienceUseCase crea una nueva instancia del caso de uso
+func NewPreviewAudienceUseCase(campaignRepo ports.CampaignRepository) *PreviewAudienceUseCase {
+	return &PreviewAudienceUseCase{
+		campaignRepo: campaignRepo,
+	}
+}
+
+// Execute retorna los clientes que cumplen los criterios de la campaña
+func (uc *PreviewAudienceUseCase) Execute(ctx context.Context, campaignID uint) ([]entities.Customer, error) {
+	campaign, err := uc.campaignRepo.GetByID(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.campaignRepo.FindCustomers(ctx, campaign.Criteria)
+}
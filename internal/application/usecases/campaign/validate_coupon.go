@@ -0,0 +1,50 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// ValidateCouponUseCase verifica un código antes de aplicarlo a una orden
+type ValidateCouponUseCase struct {
+	couponRepo ports.CampaignCouponRepository
+}
+
+// NewValidateCouponUseCase crea una nueva instancia del caso de uso
+func NewValidateCouponUseCase(couponRepo ports.CampaignCouponRepository) *ValidateCouponUseCase {
+	return &ValidateCouponUseCase{
+		couponRepo: couponRepo,
+	}
+}
+
+// CouponValidation contiene el resultado de validar un cupón
+type CouponValidation struct {
+	Coupon   *entities.CampaignCoupon
+	Discount float64 // Descuento calculado sobre el subtotal indicado
+}
+
+// Execute valida el código para el cliente y calcula el descuento sobre el subtotal
+func (uc *ValidateCouponUseCase) Execute(ctx context.Context, code string, customerID *uint, subtotal float64) (*CouponValidation, error) {
+	coupon, err := uc.couponRepo.GetByCode(ctx, strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entities.ErrCouponNotFound
+		}
+		return nil, err
+	}
+
+	if err := coupon.CanRedeem(coupon.Campaign, customerID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return &CouponValidation{
+		Coupon:   coupon,
+		Discount: coupon.CalculateDiscount(subtotal),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package cash_session
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// CloseCashSessionUseCase cierra la caja con el efectivo contado (arqueo)
+type CloseCashSessionUseCase struct {
+	sessionRepo ports.CashSessionRepository
+	recorder    *audittrail.Recorder
+}
+
+// NewCloseCashSessionUseCase crea una nueva instancia del caso de uso
+func NewCloseCashSessionUseCase(sessionRepo ports.CashSessionRepository, recorder *audittrail.Recorder) *CloseCashSessionUseCase {
+	return &CloseCashSessionUseCase{sessionRepo: sessionRepo, recorder: recorder}
+}
+
+// CloseCashSessionInput son los datos del arqueo
+type CloseCashSessionInput struct {
+	SessionID   uint
+	CountedCash float64
+	Notes       string
+	ClosedByID  *uint
+}
+
+// Execute fija los totales por método de pago, calcula el sobrante o faltante y cierra la caja
+// Desde el cierre sus abonos no se pueden reversar ni sus ventas cancelar
+func (uc *CloseCashSessionUseCase) Execute(ctx context.Context, input CloseCashSessionInput) (*entities.CashSessionReport, error) {
+	session, err := uc.sessionRepo.GetByID(ctx, input.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if !session.IsOpen() {
+		return nil, entities.ErrCashSessionNotOpen
+	}
+	before := *session
+
+	// Los totales se calculan dentro del cierre, con la sesión bloqueada, para no dejar por fuera
+	// un abono o una venta que se registre mientras se cierra
+	entries, err := uc.sessionRepo.Close(ctx, session, func(entries []entities.CashSessionEntry) error {
+		session.Summarize(entries)
+		return session.Close(input.CountedCash, input.ClosedByID, input.Notes, time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.recorder.Updated(ctx, entities.AuditEntityCashSession, session.ID, &before, session)
+	return &entities.CashSessionReport{Session: *session, Entries: entries}, nil
+}
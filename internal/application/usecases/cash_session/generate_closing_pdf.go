@@ -0,0 +1,226 @@
+package cash_session
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"github.com/jung-kurt/gofpdf"
+)
+
+// GenerateClosingPDFUseCase genera el comprobante de cierre de caja en PDF
+type GenerateClosingPDFUseCase struct {
+	sessionRepo ports.CashSessionRepository
+}
+
+// NewGenerateClosingPDFUseCase crea una nueva instancia del caso de uso
+func NewGenerateClosingPDFUseCase(sessionRepo ports.CashSessionRepository) *GenerateClosingPDFUseCase {
+	return &GenerateClosingPDFUseCase{sessionRepo: sessionRepo}
+}
+
+// Execute genera el PDF de la sesión; si sigue abierta sale como arqueo parcial
+func (uc *GenerateClosingPDFUseCase) Execute(ctx context.Context, id uint) ([]byte, error) {
+	session, err := uc.sessionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	report, err := buildReport(ctx, uc.sessionRepo, session)
+	if err != nil {
+		return nil, err
+	}
+	session = &report.Session
+
+	pdf := gofpdf.New("P", "mm", "Letter", "")
+	pdf.AddPage()
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	title := "Cierre de Caja"
+	if session.IsOpen() {
+		title = "Arqueo Parcial de Caja (sesión abierta)"
+	}
+	pdf.SetFont("Arial", "B", 16)
+	pdf.Cell(0, 10, tr(fmt.Sprintf("%s #%d", title, session.ID)))
+	pdf.Ln(12)
+
+	// Datos de la sesión
+	pdf.SetFont("Arial", "", 10)
+	cashier := fmt.Sprintf("Usuario #%d", session.UserID)
+	if session.User != nil {
+		cashier = session.User.FullName()
+	}
+	pdf.Cell(0, 6, tr("Cajero: "+cashier))
+	pdf.Ln(6)
+	if session.Location != "" {
+		pdf.Cell(0, 6, tr("Punto de venta: "+session.Location))
+		pdf.Ln(6)
+	}
+	pdf.Cell(0, 6, tr("Apertura: "+session.OpenedAt.Format("02/01/2006 15:04")))
+	pdf.Ln(6)
+	if session.ClosedAt != nil {
+		pdf.Cell(0, 6, tr("Cierre: "+session.ClosedAt.Format("02/01/2006 15:04")))
+		pdf.Ln(6)
+	}
+	pdf.Cell(0, 6, tr(fmt.Sprintf("Fecha de generación: %s", time.Now().Format("02/01/2006 15:04"))))
+	pdf.Ln(10)
+
+	// Totales por método de pago
+	pdf.SetFont("Arial", "B", 12)
+	pdf.Cell(0, 8, tr("Recaudo por método de pago"))
+	pdf.Ln(9)
+
+	widths := []float64{60, 20, 35, 20, 35, 25}
+	headers := []string{"Método de pago", "Abonos", "Valor abonos", "Ventas", "Valor ventas", "Total"}
+	pdf.SetFont("Arial", "B", 9)
+	pdf.SetFillColor(200, 220, 255)
+	for i, header := range headers {
+		pdf.CellFormat(widths[i], 7, tr(header), "1", 0, "C", true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Arial", "", 9)
+	var grandTotal float64
+	for _, total := range session.Totals {
+		name := total.PaymentMethodName
+		if total.IsCash {
+			name += " (efectivo)"
+		}
+		pdf.CellFormat(widths[0], 6, tr(name), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[1], 6, strconv.Itoa(total.PaymentsCount), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[2], 6, formatCOP(total.PaymentsAmount), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[3], 6, strconv.Itoa(total.SalesCount), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[4], 6, formatCOP(total.SalesAmount), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[5], 6, formatCOP(total.Total()), "1", 0, "R", false, 0, "")
+		pdf.Ln(-1)
+		grandTotal += total.Total()
+	}
+	if len(session.Totals) == 0 {
+		pdf.CellFormat(195, 6, tr("Sin abonos ni ventas registrados en la sesión"), "1", 0, "C", false, 0, "")
+		pdf.Ln(-1)
+	}
+	pdf.SetFont("Arial", "B", 9)
+	pdf.CellFormat(170, 7, "Total recaudado", "1", 0, "R", false, 0, "")
+	pdf.CellFormat(25, 7, formatCOP(grandTotal), "1", 0, "R", false, 0, "")
+	pdf.Ln(12)
+
+	// Arqueo de efectivo
+	pdf.SetFont("Arial", "B", 12)
+	pdf.Cell(0, 8, tr("Arqueo de efectivo"))
+	pdf.Ln(9)
+	pdf.SetFont("Arial", "", 10)
+	summaryLine := func(label, value string) {
+		pdf.CellFormat(80, 6, tr(label), "", 0, "L", false, 0, "")
+		pdf.CellFormat(40, 6, value, "", 0, "R", false, 0, "")
+		pdf.Ln(6)
+	}
+	summaryLine("Base de apertura", formatCOP(session.OpeningFloat))
+	summaryLine("Recaudo en efectivo", formatCOP(session.ExpectedCash-session.OpeningFloat))
+	summaryLine("Efectivo esperado", formatCOP(session.ExpectedCash))
+	if !session.IsOpen() {
+		summaryLine("Efectivo contado", formatCOP(session.CountedCash))
+
+		pdf.SetFont("Arial", "B", 11)
+		label := "Cuadre exacto"
+		switch {
+		case session.Difference > 0:
+			label = "Sobrante"
+			pdf.SetTextColor(0, 128, 0)
+		case session.Difference < 0:
+			label = "Faltante"
+			pdf.SetTextColor(255, 0, 0)
+		}
+		summaryLine(label, formatCOP(session.Difference))
+		pdf.SetTextColor(0, 0, 0)
+	}
+	if session.Notes != "" {
+		pdf.Ln(2)
+		pdf.SetFont("Arial", "I", 9)
+		pdf.MultiCell(0, 5, tr("Observaciones: "+session.Notes), "", "L", false)
+	}
+	pdf.Ln(6)
+
+	// Detalle de movimientos
+	pdf.SetFont("Arial", "B", 12)
+	pdf.Cell(0, 8, tr("Detalle de movimientos"))
+	pdf.Ln(9)
+
+	detailWidths := []float64{28, 20, 87, 35, 25}
+	detailHeaders := []string{"Fecha", "Tipo", "Descripción", "Método de pago", "Valor"}
+	pdf.SetFont("Arial", "B", 9)
+	for i, header := range detailHeaders {
+		pdf.CellFormat(detailWidths[i], 7, tr(header), "1", 0, "C", true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Arial", "", 8)
+	for _, entry := range report.Entries {
+		kind := "Abono"
+		if entry.Kind == entities.CashSessionEntrySale {
+			kind = "Venta"
+		}
+		paymentMethod := entry.PaymentMethodName
+		if entry.PaymentMethodID == nil {
+			paymentMethod = "Sin método"
+		}
+		pdf.CellFormat(detailWidths[0], 6, entry.Date.Format("02/01/2006 15:04"), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(detailWidths[1], 6, kind, "1", 0, "L", false, 0, "")
+		pdf.CellFormat(detailWidths[2], 6, tr(truncate(entry.Description, 55)), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(detailWidths[3], 6, tr(truncate(paymentMethod, 20)), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(detailWidths[4], 6, formatCOP(entry.Amount), "1", 0, "R", false, 0, "")
+		pdf.Ln(-1)
+	}
+
+	// Firmas
+	if !session.IsOpen() {
+		pdf.Ln(20)
+		pdf.SetFont("Arial", "", 10)
+		pdf.CellFormat(90, 6, "_______________________________", "", 0, "C", false, 0, "")
+		pdf.CellFormat(15, 6, "", "", 0, "C", false, 0, "")
+		pdf.CellFormat(90, 6, "_______________________________", "", 0, "C", false, 0, "")
+		pdf.Ln(6)
+		pdf.CellFormat(90, 6, tr("Entrega (cajero)"), "", 0, "C", false, 0, "")
+		pdf.CellFormat(15, 6, "", "", 0, "C", false, 0, "")
+		pdf.CellFormat(90, 6, tr("Recibe"), "", 0, "C", false, 0, "")
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// truncate corta el texto para que quepa en una celda
+func truncate(value string, max int) string {
+	runes := []rune(value)
+	if len(runes) <= max {
+		return value
+	}
+	return string(runes[:max-3]) + "..."
+}
+
+// formatCOP formatea un valor en pesos colombianos con separador de miles (ej: $1.250.000)
+func formatCOP(amount float64) string {
+	amountStr := strconv.FormatInt(int64(amount), 10)
+
+	isNegative := strings.HasPrefix(amountStr, "-")
+	amountStr = strings.TrimPrefix(amountStr, "-")
+
+	var result strings.Builder
+	length := len(amountStr)
+	for i, digit := range amountStr {
+		if i > 0 && (length-i)%3 == 0 {
+			result.WriteString(".")
+		}
+		result.WriteRune(digit)
+	}
+
+	if isNegative {
+		return "-$" + result.String()
+	}
+	return "$" + result.String()
+}
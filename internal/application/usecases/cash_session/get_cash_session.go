@@ -0,0 +1,53 @@
+package cash_session
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// GetCashSessionUseCase obtiene una sesión de caja con sus totales y movimientos
+type GetCashSessionUseCase struct {
+	sessionRepo ports.CashSessionRepository
+}
+
+// NewGetCashSessionUseCase crea una nueva instancia del caso de uso
+func NewGetCashSessionUseCase(sessionRepo ports.CashSessionRepository) *GetCashSessionUseCase {
+	return &GetCashSessionUseCase{sessionRepo: sessionRepo}
+}
+
+// Execute obtiene la sesión; si sigue abierta los totales se calculan en vivo
+func (uc *GetCashSessionUseCase) Execute(ctx context.Context, id uint) (*entities.CashSessionReport, error) {
+	session, err := uc.sessionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return buildReport(ctx, uc.sessionRepo, session)
+}
+
+// ExecuteCurrent obtiene la caja abierta del usuario (entities.ErrNoOpenCashSession si no tiene)
+func (uc *GetCashSessionUseCase) ExecuteCurrent(ctx context.Context, userID uint) (*entities.CashSessionReport, error) {
+	session, err := uc.sessionRepo.GetOpenByUser(ctx, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, entities.ErrNoOpenCashSession
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buildReport(ctx, uc.sessionRepo, session)
+}
+
+// buildReport carga los movimientos de la sesión; las cerradas conservan los totales guardados al cierre
+func buildReport(ctx context.Context, sessionRepo ports.CashSessionRepository, session *entities.CashSession) (*entities.CashSessionReport, error) {
+	entries, err := sessionRepo.ListEntries(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+	if session.IsOpen() {
+		session.Summarize(entries)
+	}
+	return &entities.CashSessionReport{Session: *session, Entries: entries}, nil
+}
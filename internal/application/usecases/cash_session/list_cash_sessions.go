@@ -0,0 +1,23 @@
+package cash_session
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListCashSessionsUseCase lista las sesiones de caja
+type ListCashSessionsUseCase struct {
+	sessionRepo ports.CashSessionRepository
+}
+
+// NewListCashSessionsUseCase crea una nueva instancia del caso de uso
+func NewListCashSessionsUseCase(sessionRepo ports.CashSessionRepository) *ListCashSessionsUseCase {
+	return &ListCashSessionsUseCase{sessionRepo: sessionRepo}
+}
+
+// Execute lista las sesiones según los filtros (user_id, status, location, start_date, end_date)
+func (uc *ListCashSessionsUseCase) Execute(ctx context.Context, filters map[string]interface{}) ([]entities.CashSession, error) {
+	return uc.sessionRepo.List(ctx, filters)
+}
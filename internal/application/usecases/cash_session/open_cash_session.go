@@ -0,0 +1,45 @@
+package cash_session
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// OpenCashSessionUseCase abre la caja de un usuario con su base de efectivo
+type OpenCashSessionUseCase struct {
+	sessionRepo ports.CashSessionRepository
+	recorder    *audittrail.Recorder
+}
+
+// NewOpenCashSessionUseCase crea una nueva instancia del caso de uso
+func NewOpenCashSessionUseCase(sessionRepo ports.CashSessionRepository, recorder *audittrail.Recorder) *OpenCashSessionUseCase {
+	return &OpenCashSessionUseCase{sessionRepo: sessionRepo, recorder: recorder}
+}
+
+// Execute abre la caja; un usuario solo puede tener una caja abierta a la vez
+func (uc *OpenCashSessionUseCase) Execute(ctx context.Context, userID uint, location string, openingFloat float64) (*entities.CashSession, error) {
+	_, err := uc.sessionRepo.GetOpenByUser(ctx, userID)
+	if err == nil {
+		return nil, entities.ErrCashSessionAlreadyOpen
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	session, err := entities.NewCashSession(userID, location, openingFloat, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.sessionRepo.Create(ctx, session); err != nil {
+		return nil, err
+	}
+
+	uc.recorder.Created(ctx, entities.AuditEntityCashSession, session.ID, session)
+	return session, nil
+}
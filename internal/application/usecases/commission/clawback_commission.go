@@ -0,0 +1,51 @@
+package commission
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// ClawbackCommissionUseCase reversa la comisión causada de una orden (devolución o cancelación)
+type ClawbackCommissionUseCase struct {
+	entryRepo ports.CommissionEntryRepository
+}
+
+// NewClawbackCommissionUseCase crea una nueva instancia del caso de uso
+func NewClawbackCommissionUseCase(entryRepo ports.CommissionEntryRepository) *ClawbackCommissionUseCase {
+	return &ClawbackCommissionUseCase{entryRepo: entryRepo}
+}
+
+// Execute registra un CLAWBACK por el valor de la causación de la orden (ej: devolución)
+func (uc *ClawbackCommissionUseCase) Execute(ctx context.Context, orderID uint, reason string) (*entities.CommissionEntry, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, errors.New("clawback reason is required")
+	}
+
+	accrual, err := uc.entryRepo.GetByOrder(ctx, orderID, entities.CommissionEntryAccrual)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entities.ErrCommissionNotFound
+		}
+		return nil, err
+	}
+
+	_, err = uc.entryRepo.GetByOrder(ctx, orderID, entities.CommissionEntryClawback)
+	if err == nil {
+		return nil, entities.ErrCommissionAlreadyClawedBack
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	clawback := entities.NewCommissionClawback(accrual, reason)
+	if err := uc.entryRepo.Create(ctx, clawback); err != nil {
+		return nil, err
+	}
+	return clawback, nil
+}
@@ -0,0 +1,28 @@
+package commission
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// CreateRuleUseCase maneja la creación de reglas de comisión
+type CreateRuleUseCase struct {
+	ruleRepo ports.CommissionRuleRepository
+}
+
+// NewCreateRuleUseCase crea una nueva instancia del caso de uso
+func NewCreateRuleUseCase(ruleRepo ports.CommissionRuleRepository) *CreateRuleUseCase {
+	return &CreateRuleUseCase{ruleRepo: ruleRepo}
+}
+
+// Execute valida y guarda la regla
+func (uc *CreateRuleUseCase) Execute(ctx context.Context, rule *entities.CommissionRule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+
+	rule.IsActive = true
+	return uc.ruleRepo.Create(ctx, rule)
+}
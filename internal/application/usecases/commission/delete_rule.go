@@ -0,0 +1,25 @@
+package commission
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// DeleteRuleUseCase maneja la eliminación de reglas de comisión
+type DeleteRuleUseCase struct {
+	ruleRepo ports.CommissionRuleRepository
+}
+
+// NewDeleteRuleUseCase crea una nueva instancia del caso de uso
+func NewDeleteRuleUseCase(ruleRepo ports.CommissionRuleRepository) *DeleteRuleUseCase {
+	return &DeleteRuleUseCase{ruleRepo: ruleRepo}
+}
+
+// Execute elimina la regla (las comisiones ya causadas no se modifican)
+func (uc *DeleteRuleUseCase) Execute(ctx context.Context, id uint) error {
+	if _, err := uc.ruleRepo.GetByID(ctx, id); err != nil {
+		return err
+	}
+	return uc.ruleRepo.Delete(ctx, id)
+}
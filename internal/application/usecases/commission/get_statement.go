@@ -0,0 +1,64 @@
+package commission
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// GetStatementUseCase genera el estado de cuenta de comisiones de un vendedor
+type GetStatementUseCase struct {
+	entryRepo  ports.CommissionEntryRepository
+	payoutRepo ports.CommissionPayoutRepository
+	userRepo   ports.UserRepository
+}
+
+// NewGetStatementUseCase crea una nueva instancia del caso de uso
+func NewGetStatementUseCase(
+	entryRepo ports.CommissionEntryRepository,
+	payoutRepo ports.CommissionPayoutRepository,
+	userRepo ports.UserRepository,
+) *GetStatementUseCase {
+	return &GetStatementUseCase{
+		entryRepo:  entryRepo,
+		payoutRepo: payoutRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// Execute retorna los movimientos y liquidaciones del periodo [start, end] (días completos)
+// y el saldo pendiente de pago acumulado hasta el fin del periodo
+func (uc *GetStatementUseCase) Execute(ctx context.Context, sellerID uint, start, end time.Time) (*entities.CommissionStatement, error) {
+	if end.Before(start) {
+		return nil, entities.ErrInvalidReportPeriod
+	}
+
+	seller, err := uc.userRepo.GetByID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	until := end.AddDate(0, 0, 1)
+	entries, err := uc.entryRepo.ListBySeller(ctx, sellerID, start, until)
+	if err != nil {
+		return nil, err
+	}
+
+	payouts, err := uc.payoutRepo.List(ctx, &sellerID, start, until)
+	if err != nil {
+		return nil, err
+	}
+
+	unpaid, err := uc.entryRepo.ListUnpaid(ctx, sellerID, until)
+	if err != nil {
+		return nil, err
+	}
+	pending := 0.0
+	for _, entry := range unpaid {
+		pending += entry.Amount
+	}
+
+	return entities.NewCommissionStatement(seller, start, end, entries, payouts, pending), nil
+}
@@ -0,0 +1,27 @@
+package commission
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListPayoutsUseCase lista las liquidaciones de comisiones
+type ListPayoutsUseCase struct {
+	payoutRepo ports.CommissionPayoutRepository
+}
+
+// NewListPayoutsUseCase crea una nueva instancia del caso de uso
+func NewListPayoutsUseCase(payoutRepo ports.CommissionPayoutRepository) *ListPayoutsUseCase {
+	return &ListPayoutsUseCase{payoutRepo: payoutRepo}
+}
+
+// Execute retorna las liquidaciones hechas en [start, end] (días completos), de un vendedor o de todos
+func (uc *ListPayoutsUseCase) Execute(ctx context.Context, sellerID *uint, start, end time.Time) ([]entities.CommissionPayout, error) {
+	if end.Before(start) {
+		return nil, entities.ErrInvalidReportPeriod
+	}
+	return uc.payoutRepo.List(ctx, sellerID, start, end.AddDate(0, 0, 1))
+}
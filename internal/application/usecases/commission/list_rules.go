@@ -0,0 +1,23 @@
+package commission
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListRulesUseCase lista las reglas de comisión
+type ListRulesUseCase struct {
+	ruleRepo ports.CommissionRuleRepository
+}
+
+// NewListRulesUseCase crea una nueva instancia del caso de uso
+func NewListRulesUseCase(ruleRepo ports.CommissionRuleRepository) *ListRulesUseCase {
+	return &ListRulesUseCase{ruleRepo: ruleRepo}
+}
+
+// Execute retorna las reglas (solo activas si activeOnly es true)
+func (uc *ListRulesUseCase) Execute(ctx context.Context, activeOnly bool) ([]entities.CommissionRule, error) {
+	return uc.ruleRepo.List(ctx, activeOnly)
+}
@@ -0,0 +1,104 @@
+package commission
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/ledger"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// PayCommissionsUseCase liquida las comisiones pendientes de un vendedor
+type PayCommissionsUseCase struct {
+	entryRepo  ports.CommissionEntryRepository
+	payoutRepo ports.CommissionPayoutRepository
+	userRepo   ports.UserRepository
+	poster     *ledger.Poster
+	recorder   *audittrail.Recorder
+}
+
+// NewPayCommissionsUseCase crea una nueva instancia del caso de uso
+func NewPayCommissionsUseCase(
+	entryRepo ports.CommissionEntryRepository,
+	payoutRepo ports.CommissionPayoutRepository,
+	userRepo ports.UserRepository,
+	poster *ledger.Poster,
+	recorder *audittrail.Recorder,
+) *PayCommissionsUseCase {
+	return &PayCommissionsUseCase{
+		entryRepo:  entryRepo,
+		payoutRepo: payoutRepo,
+		userRepo:   userRepo,
+		poster:     poster,
+		recorder:   recorder,
+	}
+}
+
+// Execute paga todos los movimientos sin liquidar hasta el fin del periodo, incluidos los reversos
+// pendientes, y registra el pago como gasto PERSONNEL. Si el saldo no es positivo no se paga nada
+// y los reversos quedan para la siguiente liquidación
+func (uc *PayCommissionsUseCase) Execute(ctx context.Context, sellerID uint, start, end time.Time, notes string) (*entities.CommissionPayout, error) {
+	if end.Before(start) {
+		return nil, entities.ErrInvalidReportPeriod
+	}
+
+	seller, err := uc.userRepo.GetByID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	unpaid, err := uc.entryRepo.ListUnpaid(ctx, sellerID, end.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	amount := 0.0
+	entryIDs := make([]uint, len(unpaid))
+	for i, entry := range unpaid {
+		amount += entry.Amount
+		entryIDs[i] = entry.ID
+	}
+	amount = math.Round(amount*100) / 100
+	if amount <= 0 {
+		return nil, entities.ErrNothingToPay
+	}
+
+	period := fmt.Sprintf("%s - %s", start.Format("02/01/2006"), end.Format("02/01/2006"))
+	transaction := &entities.FinancialTransaction{
+		Type:        entities.FinancialTransactionTypeExpense,
+		Category:    entities.FinancialTransactionCategoryPersonnel,
+		Amount:      amount,
+		Description: fmt.Sprintf("Comisiones - %s - Periodo %s (%d movimientos)", seller.FullName(), period, len(unpaid)),
+		Date:        time.Now(),
+	}
+	if err := transaction.Validate(); err != nil {
+		return nil, err
+	}
+
+	payout := &entities.CommissionPayout{
+		SellerID:    sellerID,
+		PeriodStart: start,
+		PeriodEnd:   end,
+		Amount:      amount,
+		EntryCount:  len(unpaid),
+		Notes:       notes,
+	}
+	if actor, ok := access.ActorFromContext(ctx); ok && actor.User != nil {
+		payout.PaidByID = &actor.User.ID
+	}
+
+	if err := uc.payoutRepo.Settle(ctx, payout, transaction, entryIDs); err != nil {
+		return nil, err
+	}
+
+	uc.poster.FinancialTransaction(ctx, transaction)
+	uc.recorder.Created(ctx, entities.AuditEntityFinancialTransaction, transaction.ID, transaction)
+	uc.recorder.Created(ctx, entities.AuditEntityCommissionPayout, payout.ID, payout)
+
+	return payout, nil
+}
@@ -0,0 +1,33 @@
+package commission
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// UpdateRuleUseCase maneja la actualización de reglas de comisión
+type UpdateRuleUseCase struct {
+	ruleRepo ports.CommissionRuleRepository
+}
+
+// NewUpdateRuleUseCase crea una nueva instancia del caso de uso
+func NewUpdateRuleUseCase(ruleRepo ports.CommissionRuleRepository) *UpdateRuleUseCase {
+	return &UpdateRuleUseCase{ruleRepo: ruleRepo}
+}
+
+// Execute valida y actualiza la regla existente (las comisiones ya causadas no se recalculan)
+func (uc *UpdateRuleUseCase) Execute(ctx context.Context, rule *entities.CommissionRule) error {
+	existing, err := uc.ruleRepo.GetByID(ctx, rule.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+
+	rule.CreatedAt = existing.CreatedAt
+	return uc.ruleRepo.Update(ctx, rule)
+}
@@ -5,6 +5,10 @@ import (
 	"errors"
 	"time"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/cashregister"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/ledger"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
@@ -18,16 +22,28 @@ var (
 type AddTransactionUseCase struct {
 	transactionRepo ports.CustomerTransactionRepository
 	customerRepo    ports.CustomerRepository
+	guard           *access.Guard
+	register        *cashregister.Register
+	poster          *ledger.Poster
+	recorder        *audittrail.Recorder
 }
 
 // NewAddTransactionUseCase crea una nueva instancia del caso de uso
 func NewAddTransactionUseCase(
 	transactionRepo ports.CustomerTransactionRepository,
 	customerRepo ports.CustomerRepository,
+	guard *access.Guard,
+	register *cashregister.Register,
+	poster *ledger.Poster,
+	recorder *audittrail.Recorder,
 ) *AddTransactionUseCase {
 	return &AddTransactionUseCase{
 		transactionRepo: transactionRepo,
 		customerRepo:    customerRepo,
+		guard:           guard,
+		register:        register,
+		poster:          poster,
+		recorder:        recorder,
 	}
 }
 
@@ -56,6 +72,15 @@ func (uc *AddTransactionUseCase) Execute(ctx context.Context, req AddTransaction
 	if customer == nil {
 		return nil, ErrCustomerNotFound
 	}
+	if err := uc.guard.CheckCustomer(ctx, customer); err != nil {
+		return nil, err
+	}
+
+	// Los movimientos con método de pago quedan en la caja abierta de quien los registra
+	sessionID, err := uc.register.CurrentSessionID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// Crear las transacciones
 	var transactions []*entities.CustomerTransaction
@@ -79,12 +104,17 @@ func (uc *AddTransactionUseCase) Execute(ctx context.Context, req AddTransaction
 			PaymentMethodID: input.PaymentMethodID,
 			Date:            date,
 		}
+		if transaction.PaymentMethodID != nil {
+			transaction.CashSessionID = sessionID
+		}
 
 		// Crear la transacción
 		err := uc.transactionRepo.Create(ctx, transaction)
 		if err != nil {
 			return nil, err
 		}
+		uc.poster.CustomerTransaction(ctx, transaction)
+		uc.recorder.Created(ctx, entities.AuditEntityCustomerTransaction, transaction.ID, transaction)
 
 		transactions = append(transactions, transaction)
 	}
@@ -3,18 +3,28 @@ package customer
 import (
 	"context"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 type CreateCustomerUseCase struct {
 	customerRepo ports.CustomerRepository
+	guard        *access.Guard
+	recorder     *audittrail.Recorder
 }
 
-func NewCreateCustomerUseCase(customerRepo ports.CustomerRepository) *CreateCustomerUseCase {
-	return &CreateCustomerUseCase{customerRepo: customerRepo}
+func NewCreateCustomerUseCase(customerRepo ports.CustomerRepository, guard *access.Guard, recorder *audittrail.Recorder) *CreateCustomerUseCase {
+	return &CreateCustomerUseCase{customerRepo: customerRepo, guard: guard, recorder: recorder}
 }
 
 func (uc *CreateCustomerUseCase) Execute(ctx context.Context, customer *entities.Customer) error {
-	return uc.customerRepo.Create(ctx, customer)
+	uc.guard.AssignCustomerSeller(ctx, customer)
+	if err := uc.customerRepo.Create(ctx, customer); err != nil {
+		return err
+	}
+
+	uc.recorder.Created(ctx, entities.AuditEntityCustomer, customer.ID, customer)
+	return nil
 }
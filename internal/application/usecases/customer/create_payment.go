@@ -4,6 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/cashregister"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/ledger"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
@@ -11,15 +15,27 @@ import (
 type CreatePaymentUseCase struct {
 	transactionRepo ports.CustomerTransactionRepository
 	customerRepo    ports.CustomerRepository
+	guard           *access.Guard
+	register        *cashregister.Register
+	poster          *ledger.Poster
+	recorder        *audittrail.Recorder
 }
 
 func NewCreatePaymentUseCase(
 	transactionRepo ports.CustomerTransactionRepository,
 	customerRepo ports.CustomerRepository,
+	guard *access.Guard,
+	register *cashregister.Register,
+	poster *ledger.Poster,
+	recorder *audittrail.Recorder,
 ) *CreatePaymentUseCase {
 	return &CreatePaymentUseCase{
 		transactionRepo: transactionRepo,
 		customerRepo:    customerRepo,
+		guard:           guard,
+		register:        register,
+		poster:          poster,
+		recorder:        recorder,
 	}
 }
 
@@ -32,8 +48,17 @@ type CreatePaymentInput struct {
 }
 
 func (uc *CreatePaymentUseCase) Execute(ctx context.Context, input CreatePaymentInput) (*entities.CustomerTransaction, error) {
-	// Verificar que el cliente existe
-	_, err := uc.customerRepo.GetByID(ctx, input.CustomerID)
+	// Verificar que el cliente existe y que el usuario tiene acceso
+	customer, err := uc.customerRepo.GetByID(ctx, input.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.guard.CheckCustomer(ctx, customer); err != nil {
+		return nil, err
+	}
+
+	// El abono queda en la caja abierta de quien lo registra
+	sessionID, err := uc.register.CurrentSessionID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -45,12 +70,15 @@ func (uc *CreatePaymentUseCase) Execute(ctx context.Context, input CreatePayment
 		Amount:          input.Amount,                    // Siempre positivo
 		Description:     input.Concept,
 		PaymentMethodID: &input.PaymentMethodID,
+		CashSessionID:   sessionID,
 		Date:            input.Date,
 	}
 
 	if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
 		return nil, err
 	}
+	uc.poster.CustomerTransaction(ctx, transaction)
+	uc.recorder.Created(ctx, entities.AuditEntityCustomerTransaction, transaction.ID, transaction)
 
 	return transaction, nil
 }
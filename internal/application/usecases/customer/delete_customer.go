@@ -3,21 +3,34 @@ package customer
 import (
 	"context"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 type DeleteCustomerUseCase struct {
 	customerRepo ports.CustomerRepository
+	guard        *access.Guard
+	recorder     *audittrail.Recorder
 }
 
-func NewDeleteCustomerUseCase(customerRepo ports.CustomerRepository) *DeleteCustomerUseCase {
-	return &DeleteCustomerUseCase{customerRepo: customerRepo}
+func NewDeleteCustomerUseCase(customerRepo ports.CustomerRepository, guard *access.Guard, recorder *audittrail.Recorder) *DeleteCustomerUseCase {
+	return &DeleteCustomerUseCase{customerRepo: customerRepo, guard: guard, recorder: recorder}
 }
 
 func (uc *DeleteCustomerUseCase) Execute(ctx context.Context, id uint) error {
-	_, err := uc.customerRepo.GetByID(ctx, id)
+	customer, err := uc.customerRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
-	return uc.customerRepo.Delete(ctx, id)
+	if err := uc.guard.CheckCustomer(ctx, customer); err != nil {
+		return err
+	}
+	if err := uc.customerRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.recorder.Deleted(ctx, entities.AuditEntityCustomer, id, customer)
+	return nil
 }
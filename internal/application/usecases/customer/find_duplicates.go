@@ -0,0 +1,84 @@
+package customer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// DefaultNameSimilarity es el umbral por defecto para considerar dos nombres como duplicados
+const DefaultNameSimilarity = 0.85
+
+type FindDuplicatesUseCase struct {
+	customerRepo ports.CustomerRepository
+}
+
+func NewFindDuplicatesUseCase(customerRepo ports.CustomerRepository) *FindDuplicatesUseCase {
+	return &FindDuplicatesUseCase{customerRepo: customerRepo}
+}
+
+// Execute compara los clientes activos por nombre aproximado y teléfono normalizado
+// Retorna los pares ordenados de mayor a menor coincidencia
+func (uc *FindDuplicatesUseCase) Execute(ctx context.Context, minSimilarity float64) ([]entities.DuplicateCandidate, error) {
+	if minSimilarity <= 0 || minSimilarity > 1 {
+		minSimilarity = DefaultNameSimilarity
+	}
+
+	customers, err := uc.customerRepo.List(ctx, map[string]interface{}{
+		"is_active": true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	phones := make([]string, len(customers))
+	for i, customer := range customers {
+		phones[i] = entities.NormalizeColombianPhone(customer.Phone)
+	}
+
+	candidates := []entities.DuplicateCandidate{}
+	for i := 0; i < len(customers); i++ {
+		for j := i + 1; j < len(customers); j++ {
+			similarity := entities.NameSimilarity(customers[i].Name, customers[j].Name)
+			samePhone := len(phones[i]) >= 7 && phones[i] == phones[j]
+
+			if similarity < minSimilarity && !samePhone {
+				continue
+			}
+
+			var reasons []string
+			if similarity >= minSimilarity {
+				reasons = append(reasons, fmt.Sprintf("Nombre similar (%.0f%%)", similarity*100))
+			}
+			if samePhone {
+				reasons = append(reasons, "Mismo teléfono")
+			}
+
+			// El cliente más antiguo se sugiere como el que se conserva
+			first, second := customers[i], customers[j]
+			if second.CreatedAt.Before(first.CreatedAt) {
+				first, second = second, first
+			}
+
+			candidates = append(candidates, entities.DuplicateCandidate{
+				Customer:       first,
+				Duplicate:      second,
+				NameSimilarity: similarity,
+				SamePhone:      samePhone,
+				Reasons:        reasons,
+			})
+		}
+	}
+
+	sort.SliceStable(candidates, func(a, b int) bool {
+		if candidates[a].SamePhone != candidates[b].SamePhone {
+			return candidates[a].SamePhone
+		}
+		return candidates[a].NameSimilarity > candidates[b].NameSimilarity
+	})
+
+	return candidates, nil
+}
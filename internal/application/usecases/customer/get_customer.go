@@ -3,18 +3,27 @@ package customer
 import (
 	"context"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 type GetCustomerUseCase struct {
 	customerRepo ports.CustomerRepository
+	guard        *access.Guard
 }
 
-func NewGetCustomerUseCase(customerRepo ports.CustomerRepository) *GetCustomerUseCase {
-	return &GetCustomerUseCase{customerRepo: customerRepo}
+func NewGetCustomerUseCase(customerRepo ports.CustomerRepository, guard *access.Guard) *GetCustomerUseCase {
+	return &GetCustomerUseCase{customerRepo: customerRepo, guard: guard}
 }
 
 func (uc *GetCustomerUseCase) Execute(ctx context.Context, id uint) (*entities.Customer, error) {
-	return uc.customerRepo.GetByID(ctx, id)
+	customer, err := uc.customerRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.guard.CheckCustomer(ctx, customer); err != nil {
+		return nil, err
+	}
+	return customer, nil
 }
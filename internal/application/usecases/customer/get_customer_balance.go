@@ -3,17 +3,22 @@ package customer
 import (
 	"context"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 type GetCustomerBalanceUseCase struct {
 	customerRepo ports.CustomerRepository
+	guard        *access.Guard
 }
 
-func NewGetCustomerBalanceUseCase(customerRepo ports.CustomerRepository) *GetCustomerBalanceUseCase {
-	return &GetCustomerBalanceUseCase{customerRepo: customerRepo}
+func NewGetCustomerBalanceUseCase(customerRepo ports.CustomerRepository, guard *access.Guard) *GetCustomerBalanceUseCase {
+	return &GetCustomerBalanceUseCase{customerRepo: customerRepo, guard: guard}
 }
 
 func (uc *GetCustomerBalanceUseCase) Execute(ctx context.Context, customerID uint) (float64, error) {
+	if err := uc.guard.CheckCustomerID(ctx, customerID); err != nil {
+		return 0, err
+	}
 	return uc.customerRepo.GetBalance(ctx, customerID)
 }
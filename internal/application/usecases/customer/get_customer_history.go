@@ -3,18 +3,23 @@ package customer
 import (
 	"context"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 type GetCustomerHistoryUseCase struct {
 	customerTransactionRepo ports.CustomerTransactionRepository
+	guard                   *access.Guard
 }
 
-func NewGetCustomerHistoryUseCase(customerTransactionRepo ports.CustomerTransactionRepository) *GetCustomerHistoryUseCase {
-	return &GetCustomerHistoryUseCase{customerTransactionRepo: customerTransactionRepo}
+func NewGetCustomerHistoryUseCase(customerTransactionRepo ports.CustomerTransactionRepository, guard *access.Guard) *GetCustomerHistoryUseCase {
+	return &GetCustomerHistoryUseCase{customerTransactionRepo: customerTransactionRepo, guard: guard}
 }
 
 func (uc *GetCustomerHistoryUseCase) Execute(ctx context.Context, customerID uint) ([]entities.CustomerTransaction, error) {
+	if err := uc.guard.CheckCustomerID(ctx, customerID); err != nil {
+		return nil, err
+	}
 	return uc.customerTransactionRepo.ListByCustomer(ctx, customerID)
 }
@@ -3,16 +3,18 @@ package customer
 import (
 	"context"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 type GetUpcomingPaymentsUseCase struct {
 	customerRepo ports.CustomerRepository
+	guard        *access.Guard
 }
 
-func NewGetUpcomingPaymentsUseCase(customerRepo ports.CustomerRepository) *GetUpcomingPaymentsUseCase {
-	return &GetUpcomingPaymentsUseCase{customerRepo: customerRepo}
+func NewGetUpcomingPaymentsUseCase(customerRepo ports.CustomerRepository, guard *access.Guard) *GetUpcomingPaymentsUseCase {
+	return &GetUpcomingPaymentsUseCase{customerRepo: customerRepo, guard: guard}
 }
 
 type CustomerWithBalance struct {
@@ -26,6 +28,10 @@ func (uc *GetUpcomingPaymentsUseCase) Execute(ctx context.Context, daysRange int
 	if err != nil {
 		return nil, err
 	}
+	customers, err = uc.guard.FilterCustomers(ctx, customers)
+	if err != nil {
+		return nil, err
+	}
 
 	// Obtener balance de cada cliente
 	result := make([]CustomerWithBalance, len(customers))
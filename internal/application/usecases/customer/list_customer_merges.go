@@ -0,0 +1,20 @@
+package customer
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+type ListCustomerMergesUseCase struct {
+	mergeRepo ports.CustomerMergeRepository
+}
+
+func NewListCustomerMergesUseCase(mergeRepo ports.CustomerMergeRepository) *ListCustomerMergesUseCase {
+	return &ListCustomerMergesUseCase{mergeRepo: mergeRepo}
+}
+
+func (uc *ListCustomerMergesUseCase) Execute(ctx context.Context, customerID uint) ([]entities.CustomerMerge, error) {
+	return uc.mergeRepo.ListByCustomer(ctx, customerID)
+}
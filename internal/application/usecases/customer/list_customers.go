@@ -3,18 +3,21 @@ package customer
 import (
 	"context"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 type ListCustomersUseCase struct {
 	customerRepo ports.CustomerRepository
+	guard        *access.Guard
 }
 
-func NewListCustomersUseCase(customerRepo ports.CustomerRepository) *ListCustomersUseCase {
-	return &ListCustomersUseCase{customerRepo: customerRepo}
+func NewListCustomersUseCase(customerRepo ports.CustomerRepository, guard *access.Guard) *ListCustomersUseCase {
+	return &ListCustomersUseCase{customerRepo: customerRepo, guard: guard}
 }
 
 func (uc *ListCustomersUseCase) Execute(ctx context.Context, filters map[string]interface{}) ([]entities.Customer, error) {
+	uc.guard.ScopeCustomerFilters(ctx, filters)
 	return uc.customerRepo.List(ctx, filters)
 }
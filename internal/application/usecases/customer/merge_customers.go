@@ -0,0 +1,103 @@
+package customer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+type MergeCustomersUseCase struct {
+	customerRepo ports.CustomerRepository
+	mergeRepo    ports.CustomerMergeRepository
+}
+
+func NewMergeCustomersUseCase(customerRepo ports.CustomerRepository, mergeRepo ports.CustomerMergeRepository) *MergeCustomersUseCase {
+	return &MergeCustomersUseCase{
+		customerRepo: customerRepo,
+		mergeRepo:    mergeRepo,
+	}
+}
+
+// Execute fusiona mergedID en survivorID y retorna el registro de auditoría
+func (uc *MergeCustomersUseCase) Execute(ctx context.Context, survivorID, mergedID, userID uint) (*entities.CustomerMerge, error) {
+	if survivorID == mergedID {
+		return nil, entities.ErrCannotMergeSameCustomer
+	}
+
+	survivor, err := uc.customerRepo.GetByID(ctx, survivorID)
+	if err != nil {
+		return nil, errors.New("surviving customer not found")
+	}
+	if !survivor.IsActive {
+		return nil, errors.New("surviving customer is inactive or was already merged")
+	}
+	merged, err := uc.customerRepo.GetByID(ctx, mergedID)
+	if err != nil {
+		return nil, errors.New("duplicate customer not found")
+	}
+	if !merged.IsActive {
+		return nil, errors.New("duplicate customer is inactive or was already merged")
+	}
+
+	survivorBalance, err := uc.customerRepo.GetBalance(ctx, survivorID)
+	if err != nil {
+		return nil, err
+	}
+	mergedBalance, err := uc.customerRepo.GetBalance(ctx, mergedID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+
+	completeSurvivor(survivor, merged)
+
+	record := &entities.CustomerMerge{
+		SurvivorID:      survivorID,
+		MergedID:        mergedID,
+		MergedSnapshot:  string(snapshot),
+		SurvivorBalance: survivorBalance,
+		MergedBalance:   mergedBalance,
+		MergedBy:        userID,
+	}
+
+	if err := uc.mergeRepo.Merge(ctx, survivor, record); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// completeSurvivor completa los datos vacíos del cliente conservado con los del duplicado
+// (preferencias de talla, cumpleaños, teléfono, dirección y pagos recurrentes)
+func completeSurvivor(survivor, merged *entities.Customer) {
+	if survivor.ShirtSizeID == nil {
+		survivor.ShirtSizeID = merged.ShirtSizeID
+	}
+	if survivor.PantsSizeID == nil {
+		survivor.PantsSizeID = merged.PantsSizeID
+	}
+	if survivor.ShoesSizeID == nil {
+		survivor.ShoesSizeID = merged.ShoesSizeID
+	}
+	if survivor.Birthday == nil {
+		survivor.Birthday = merged.Birthday
+	}
+	if strings.TrimSpace(survivor.Phone) == "" {
+		survivor.Phone = merged.Phone
+	}
+	if strings.TrimSpace(survivor.Address) == "" {
+		survivor.Address = merged.Address
+	}
+	if survivor.PaymentFrequency == "" || survivor.PaymentFrequency == entities.PaymentFrequencyNone {
+		survivor.PaymentFrequency = merged.PaymentFrequency
+		survivor.PaymentDays = merged.PaymentDays
+	}
+}
@@ -0,0 +1,124 @@
+package customer
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/cashregister"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/ledger"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ReverseTransactionUseCase anula un movimiento de cliente con un asiento compensatorio
+type ReverseTransactionUseCase struct {
+	transactionRepo ports.CustomerTransactionRepository
+	auditRepo       ports.AuditLogRepository
+	guard           *access.Guard
+	register        *cashregister.Register
+	poster          *ledger.Poster
+	recorder        *audittrail.Recorder
+}
+
+// NewReverseTransactionUseCase crea una nueva instancia del caso de uso
+func NewReverseTransactionUseCase(
+	transactionRepo ports.CustomerTransactionRepository,
+	auditRepo ports.AuditLogRepository,
+	guard *access.Guard,
+	register *cashregister.Register,
+	poster *ledger.Poster,
+	recorder *audittrail.Recorder,
+) *ReverseTransactionUseCase {
+	return &ReverseTransactionUseCase{
+		transactionRepo: transactionRepo,
+		auditRepo:       auditRepo,
+		guard:           guard,
+		register:        register,
+		poster:          poster,
+		recorder:        recorder,
+	}
+}
+
+// ReverseTransactionRequest representa la solicitud de reverso
+type ReverseTransactionRequest struct {
+	TransactionID uint
+	Reason        string
+	User          *entities.User // Quien ejecuta el reverso (queda en la auditoría)
+}
+
+// Execute crea el reverso y lo registra en la auditoría
+func (uc *ReverseTransactionUseCase) Execute(ctx context.Context, req ReverseTransactionRequest) (*entities.CustomerTransaction, error) {
+	original, err := uc.transactionRepo.GetByID(ctx, req.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.guard.CheckCustomerID(ctx, original.CustomerID); err != nil {
+		return nil, err
+	}
+
+	// Un abono de una caja ya cerrada no se reversa: cambiaría el cierre
+	if err := uc.register.CheckUnlocked(ctx, original.CashSessionID); err != nil {
+		return nil, err
+	}
+
+	reversal, err := original.NewReversal(req.Reason, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if reversal.PaymentMethodID != nil {
+		if reversal.CashSessionID, err = uc.register.CurrentSessionID(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.transactionRepo.Reverse(ctx, original.ID, reversal); err != nil {
+		return nil, err
+	}
+
+	uc.poster.CustomerTransaction(ctx, reversal)
+	uc.audit(ctx, original, reversal, req.User)
+	uc.recorder.Created(ctx, entities.AuditEntityCustomerTransaction, reversal.ID, reversal)
+
+	return reversal, nil
+}
+
+// audit registra el reverso; un fallo aquí no deshace el reverso ya aplicado
+func (uc *ReverseTransactionUseCase) audit(ctx context.Context, original, reversal *entities.CustomerTransaction, user *entities.User) {
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"customerId":            original.CustomerID,
+		"originalTransactionId": original.ID,
+		"reversalTransactionId": reversal.ID,
+		"type":                  original.Type,
+		"amount":                original.Amount,
+		"reason":                reversal.ReversalReason,
+	})
+
+	changes, _ := json.Marshal(map[string]entities.FieldChange{
+		"ReversedByID":   {Before: nil, After: reversal.ID},
+		"ReversalReason": {Before: "", After: reversal.ReversalReason},
+	})
+
+	// El reverso queda en el historial del movimiento original
+	originalID := original.ID
+	auditLog := &entities.AuditLog{
+		EventType:   entities.AuditEventCustomerTransactionReversed,
+		EntityType:  entities.AuditEntityCustomerTransaction,
+		EntityID:    &originalID,
+		Action:      entities.AuditActionReverse,
+		Changes:     changes,
+		Description: reversal.Description,
+		Metadata:    string(metadata),
+	}
+	auditLog.SetActor(user, nil)
+	if actor, ok := access.ActorFromContext(ctx); ok {
+		actor.ApplyTo(auditLog)
+	}
+
+	if err := uc.auditRepo.Create(ctx, auditLog); err != nil {
+		log.Printf("⚠️  Failed to save audit log for reversal of transaction #%d: %v", original.ID, err)
+	}
+}
@@ -3,18 +3,37 @@ package customer
 import (
 	"context"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 type UpdateCustomerUseCase struct {
 	customerRepo ports.CustomerRepository
+	guard        *access.Guard
+	recorder     *audittrail.Recorder
 }
 
-func NewUpdateCustomerUseCase(customerRepo ports.CustomerRepository) *UpdateCustomerUseCase {
-	return &UpdateCustomerUseCase{customerRepo: customerRepo}
+func NewUpdateCustomerUseCase(customerRepo ports.CustomerRepository, guard *access.Guard, recorder *audittrail.Recorder) *UpdateCustomerUseCase {
+	return &UpdateCustomerUseCase{customerRepo: customerRepo, guard: guard, recorder: recorder}
 }
 
 func (uc *UpdateCustomerUseCase) Execute(ctx context.Context, customer *entities.Customer) error {
-	return uc.customerRepo.Update(ctx, customer)
+	existing, err := uc.customerRepo.GetByID(ctx, customer.ID)
+	if err != nil {
+		return err
+	}
+	if err := uc.guard.CheckCustomer(ctx, existing); err != nil {
+		return err
+	}
+
+	// El vendedor responsable solo cambia con la reasignación explícita
+	customer.AssignedSellerID = existing.AssignedSellerID
+	if err := uc.customerRepo.Update(ctx, customer); err != nil {
+		return err
+	}
+
+	uc.recorder.Updated(ctx, entities.AuditEntityCustomer, customer.ID, existing, customer)
+	return nil
 }
@@ -0,0 +1,37 @@
+package electronic_invoice
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// CreateInvoiceResolutionUseCase registra una resolución de numeración autorizada por la DIAN
+type CreateInvoiceResolutionUseCase struct {
+	resolutionRepo ports.InvoiceResolutionRepository
+	recorder       *audittrail.Recorder
+}
+
+// NewCreateInvoiceResolutionUseCase crea una nueva instancia del caso de uso
+func NewCreateInvoiceResolutionUseCase(resolutionRepo ports.InvoiceResolutionRepository, recorder *audittrail.Recorder) *CreateInvoiceResolutionUseCase {
+	return &CreateInvoiceResolutionUseCase{resolutionRepo: resolutionRepo, recorder: recorder}
+}
+
+// Execute valida la resolución y la deja activa desde el inicio de su rango
+// Las notas crédito también se numeran con una resolución propia (prefijo y rango internos)
+func (uc *CreateInvoiceResolutionUseCase) Execute(ctx context.Context, resolution *entities.InvoiceResolution) error {
+	resolution.NextNumber = resolution.RangeFrom
+	resolution.IsActive = true
+	if err := resolution.Validate(); err != nil {
+		return err
+	}
+
+	if err := uc.resolutionRepo.Create(ctx, resolution); err != nil {
+		return err
+	}
+
+	uc.recorder.Created(ctx, entities.AuditEntityInvoiceResolution, resolution.ID, resolution)
+	return nil
+}
@@ -0,0 +1,23 @@
+package electronic_invoice
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// GetElectronicDocumentUseCase obtiene una factura o nota crédito con sus líneas y el XML firmado
+type GetElectronicDocumentUseCase struct {
+	documentRepo ports.ElectronicDocumentRepository
+}
+
+// NewGetElectronicDocumentUseCase crea una nueva instancia del caso de uso
+func NewGetElectronicDocumentUseCase(documentRepo ports.ElectronicDocumentRepository) *GetElectronicDocumentUseCase {
+	return &GetElectronicDocumentUseCase{documentRepo: documentRepo}
+}
+
+// Execute retorna el documento
+func (uc *GetElectronicDocumentUseCase) Execute(ctx context.Context, id uint) (*entities.ElectronicDocument, error) {
+	return uc.documentRepo.GetByID(ctx, id)
+}
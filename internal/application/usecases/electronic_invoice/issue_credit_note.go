@@ -0,0 +1,151 @@
+package electronic_invoice
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/einvoice"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// CreditNoteLine indica el renglón de la factura que se acredita y la cantidad devuelta o ajustada
+type CreditNoteLine struct {
+	LineNumber int
+	Quantity   int
+}
+
+// IssueCreditNoteUseCase emite una nota crédito que anula o corrige una factura electrónica
+type IssueCreditNoteUseCase struct {
+	documentRepo ports.ElectronicDocumentRepository
+	generator    *einvoice.Generator
+	provider     ports.ElectronicInvoiceProvider
+	recorder     *audittrail.Recorder
+}
+
+// NewIssueCreditNoteUseCase crea una nueva instancia del caso de uso
+func NewIssueCreditNoteUseCase(
+	documentRepo ports.ElectronicDocumentRepository,
+	generator *einvoice.Generator,
+	provider ports.ElectronicInvoiceProvider,
+	recorder *audittrail.Recorder,
+) *IssueCreditNoteUseCase {
+	return &IssueCreditNoteUseCase{
+		documentRepo: documentRepo,
+		generator:    generator,
+		provider:     provider,
+		recorder:     recorder,
+	}
+}
+
+// Execute acredita los renglones indicados en proporción a la cantidad (todos los renglones completos si no se indican)
+// La suma de las notas crédito de una factura no puede superar su total
+func (uc *IssueCreditNoteUseCase) Execute(ctx context.Context, invoiceID uint, correctionCode, reason string, lines []CreditNoteLine) (*entities.ElectronicDocument, error) {
+	invoice, err := uc.documentRepo.GetByID(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.Type != entities.ElectronicInvoice || invoice.Status == entities.ElectronicDocumentRejected {
+		return nil, entities.ErrCreditNoteRequiresInvoice
+	}
+
+	reason = strings.TrimSpace(reason)
+	if !entities.ValidCorrectionCode(correctionCode) || reason == "" {
+		return nil, entities.ErrInvalidCreditNote
+	}
+
+	noteLines, err := creditLines(invoice, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	issueDate := invoice.IssueDate
+	note := &entities.ElectronicDocument{
+		Type:               entities.ElectronicCreditNote,
+		Status:             entities.ElectronicDocumentPending,
+		OrderID:            invoice.OrderID,
+		CustomerID:         invoice.CustomerID,
+		ReferenceID:        &invoice.ID,
+		ReferenceNumber:    invoice.FullNumber(),
+		ReferenceCUFE:      invoice.CUFE,
+		ReferenceIssueDate: &issueDate,
+		CorrectionCode:     correctionCode,
+		CorrectionReason:   reason,
+		BuyerIDType:        invoice.BuyerIDType,
+		BuyerIDNumber:      invoice.BuyerIDNumber,
+		BuyerName:          invoice.BuyerName,
+		BuyerEmail:         invoice.BuyerEmail,
+		BuyerAddress:       invoice.BuyerAddress,
+		BuyerPhone:         invoice.BuyerPhone,
+		IssueDate:          time.Now(),
+		PaymentMeans:       invoice.PaymentMeans,
+		Lines:              noteLines,
+	}
+	note.CalculateTotals()
+
+	credited, err := creditedTotal(ctx, uc.documentRepo, invoice.ID)
+	if err != nil {
+		return nil, err
+	}
+	if credited+note.Total > invoice.Total+0.005 {
+		return nil, entities.ErrCreditExceedsInvoice
+	}
+
+	if actor, ok := access.ActorFromContext(ctx); ok && actor.User != nil {
+		note.CreatedByID = &actor.User.ID
+	}
+
+	err = uc.documentRepo.CreateNumbered(ctx, note, func(resolution *entities.InvoiceResolution) error {
+		return uc.generator.Prepare(ctx, note, resolution)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.recorder.Created(ctx, entities.AuditEntityElectronicDocument, note.ID, note)
+
+	if err := submitDocument(ctx, uc.provider, uc.documentRepo, note); err != nil {
+		return nil, err
+	}
+	return note, nil
+}
+
+// creditLines arma los renglones de la nota crédito a partir de los renglones de la factura
+func creditLines(invoice *entities.ElectronicDocument, lines []CreditNoteLine) ([]entities.ElectronicDocumentLine, error) {
+	if len(lines) == 0 {
+		for _, line := range invoice.Lines {
+			lines = append(lines, CreditNoteLine{LineNumber: line.LineNumber, Quantity: line.Quantity})
+		}
+	}
+
+	byNumber := make(map[int]entities.ElectronicDocumentLine, len(invoice.Lines))
+	for _, line := range invoice.Lines {
+		byNumber[line.LineNumber] = line
+	}
+
+	used := map[int]bool{}
+	noteLines := make([]entities.ElectronicDocumentLine, 0, len(lines))
+	for _, requested := range lines {
+		line, ok := byNumber[requested.LineNumber]
+		if !ok || used[requested.LineNumber] || requested.Quantity <= 0 || requested.Quantity > line.Quantity {
+			return nil, entities.ErrInvalidCreditNote
+		}
+		used[requested.LineNumber] = true
+
+		share := float64(requested.Quantity) / float64(line.Quantity)
+		noteLines = append(noteLines, entities.ElectronicDocumentLine{
+			OrderItemID: line.OrderItemID,
+			Code:        line.Code,
+			Description: line.Description,
+			Quantity:    requested.Quantity,
+			UnitPrice:   line.UnitPrice,
+			Subtotal:    math.Round(line.Subtotal*share*100) / 100,
+			TaxPercent:  line.TaxPercent,
+			TaxAmount:   math.Round(line.TaxAmount*share*100) / 100,
+		})
+	}
+	return noteLines, nil
+}
@@ -0,0 +1,157 @@
+package electronic_invoice
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/einvoice"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// creditSaleTerm es el plazo por defecto de las ventas a crédito cuando no se indica vencimiento
+const creditSaleTerm = 30 * 24 * time.Hour
+
+// IssueInvoiceUseCase emite la factura electrónica de una orden
+type IssueInvoiceUseCase struct {
+	orderRepo         ports.OrderRepository
+	customerRepo      ports.CustomerRepository
+	paymentMethodRepo ports.PaymentMethodRepository
+	documentRepo      ports.ElectronicDocumentRepository
+	generator         *einvoice.Generator
+	provider          ports.ElectronicInvoiceProvider
+	guard             *access.Guard
+	recorder          *audittrail.Recorder
+}
+
+// NewIssueInvoiceUseCase crea una nueva instancia del caso de uso
+func NewIssueInvoiceUseCase(
+	orderRepo ports.OrderRepository,
+	customerRepo ports.CustomerRepository,
+	paymentMethodRepo ports.PaymentMethodRepository,
+	documentRepo ports.ElectronicDocumentRepository,
+	generator *einvoice.Generator,
+	provider ports.ElectronicInvoiceProvider,
+	guard *access.Guard,
+	recorder *audittrail.Recorder,
+) *IssueInvoiceUseCase {
+	return &IssueInvoiceUseCase{
+		orderRepo:         orderRepo,
+		customerRepo:      customerRepo,
+		paymentMethodRepo: paymentMethodRepo,
+		documentRepo:      documentRepo,
+		generator:         generator,
+		provider:          provider,
+		guard:             guard,
+		recorder:          recorder,
+	}
+}
+
+// Execute arma la factura con los items de la orden, la numera con la resolución vigente, la firma y la envía
+// document trae los datos del adquiriente, el vencimiento y las notas; sin documento del adquiriente se factura
+// al consumidor final. Una orden solo se vuelve a facturar si su factura anterior quedó anulada con notas crédito
+func (uc *IssueInvoiceUseCase) Execute(ctx context.Context, orderID uint, document *entities.ElectronicDocument) error {
+	order, err := uc.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if err := uc.guard.CheckOrder(ctx, order); err != nil {
+		return err
+	}
+	if !order.IsSold() || order.TotalAmount <= 0 {
+		return entities.ErrOrderNotInvoiceable
+	}
+
+	// Falla antes de firmar si ya está facturada; CreateNumbered lo vuelve a verificar con la orden bloqueada
+	previous, err := uc.documentRepo.GetLastInvoiceByOrder(ctx, order.ID)
+	if err == nil {
+		credited, err := creditedTotal(ctx, uc.documentRepo, previous.ID)
+		if err != nil {
+			return err
+		}
+		if credited < previous.Total-0.005 {
+			return entities.ErrOrderAlreadyInvoiced
+		}
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	if err := uc.fillBuyer(ctx, order, document); err != nil {
+		return err
+	}
+
+	document.Type = entities.ElectronicInvoice
+	document.Status = entities.ElectronicDocumentPending
+	document.OrderID = &order.ID
+	document.CustomerID = order.CustomerID
+	document.ReferenceID = nil
+	document.CorrectionCode = ""
+	document.IssueDate = time.Now()
+	if err := uc.fillPaymentMeans(order, document); err != nil {
+		return err
+	}
+	document.Lines = uc.generator.OrderLines(order)
+	document.CalculateTotals()
+	if actor, ok := access.ActorFromContext(ctx); ok && actor.User != nil {
+		document.CreatedByID = &actor.User.ID
+	}
+
+	err = uc.documentRepo.CreateNumbered(ctx, document, func(resolution *entities.InvoiceResolution) error {
+		return uc.generator.Prepare(ctx, document, resolution)
+	})
+	if err != nil {
+		return err
+	}
+	uc.recorder.Created(ctx, entities.AuditEntityElectronicDocument, document.ID, document)
+
+	return submitDocument(ctx, uc.provider, uc.documentRepo, document)
+}
+
+// fillBuyer completa el adquiriente con los datos del cliente de la orden cuando se factura a su nombre
+func (uc *IssueInvoiceUseCase) fillBuyer(ctx context.Context, order *entities.Order, document *entities.ElectronicDocument) error {
+	if document.BuyerIDNumber != "" {
+		if document.BuyerName == "" {
+			document.BuyerName = order.CustomerName
+		}
+		if order.CustomerID != nil {
+			customer, err := uc.customerRepo.GetByID(ctx, *order.CustomerID)
+			if err != nil {
+				return err
+			}
+			if document.BuyerAddress == "" {
+				document.BuyerAddress = customer.Address
+			}
+			if document.BuyerPhone == "" {
+				document.BuyerPhone = customer.Phone
+			}
+		}
+	}
+	return document.ValidateBuyer()
+}
+
+// fillPaymentMeans toma el medio de pago de la venta de contado; sin método de pago es una venta a crédito
+func (uc *IssueInvoiceUseCase) fillPaymentMeans(order *entities.Order, document *entities.ElectronicDocument) error {
+	if order.PaymentMethodID == nil {
+		document.PaymentMeans = entities.PaymentMeansMutualAgreement
+		if document.PaymentDueDate == nil || document.PaymentDueDate.Before(document.IssueDate) {
+			dueDate := document.IssueDate.Add(creditSaleTerm)
+			document.PaymentDueDate = &dueDate
+		}
+		return nil
+	}
+
+	paymentMethod, err := uc.paymentMethodRepo.GetByID(*order.PaymentMethodID)
+	if err != nil {
+		return err
+	}
+	document.PaymentMeans = entities.PaymentMeansTransfer
+	if paymentMethod.IsCash {
+		document.PaymentMeans = entities.PaymentMeansCash
+	}
+	document.PaymentDueDate = nil
+	return nil
+}
@@ -0,0 +1,23 @@
+package electronic_invoice
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListElectronicDocumentsUseCase lista las facturas y notas crédito electrónicas
+type ListElectronicDocumentsUseCase struct {
+	documentRepo ports.ElectronicDocumentRepository
+}
+
+// NewListElectronicDocumentsUseCase crea una nueva instancia del caso de uso
+func NewListElectronicDocumentsUseCase(documentRepo ports.ElectronicDocumentRepository) *ListElectronicDocumentsUseCase {
+	return &ListElectronicDocumentsUseCase{documentRepo: documentRepo}
+}
+
+// Execute retorna los documentos que cumplen los filtros, del más reciente al más antiguo
+func (uc *ListElectronicDocumentsUseCase) Execute(ctx context.Context, filters map[string]interface{}) ([]entities.ElectronicDocument, error) {
+	return uc.documentRepo.List(ctx, filters)
+}
@@ -0,0 +1,23 @@
+package electronic_invoice
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListInvoiceResolutionsUseCase lista las resoluciones de numeración
+type ListInvoiceResolutionsUseCase struct {
+	resolutionRepo ports.InvoiceResolutionRepository
+}
+
+// NewListInvoiceResolutionsUseCase crea una nueva instancia del caso de uso
+func NewListInvoiceResolutionsUseCase(resolutionRepo ports.InvoiceResolutionRepository) *ListInvoiceResolutionsUseCase {
+	return &ListInvoiceResolutionsUseCase{resolutionRepo: resolutionRepo}
+}
+
+// Execute retorna las resoluciones del tipo indicado (todas si es vacío)
+func (uc *ListInvoiceResolutionsUseCase) Execute(ctx context.Context, documentType entities.ElectronicDocumentType) ([]entities.InvoiceResolution, error) {
+	return uc.resolutionRepo.List(ctx, documentType)
+}
@@ -0,0 +1,75 @@
+package electronic_invoice
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// SubmitElectronicDocumentUseCase reenvía un documento que quedó pendiente porque el proveedor no respondió
+type SubmitElectronicDocumentUseCase struct {
+	documentRepo ports.ElectronicDocumentRepository
+	provider     ports.ElectronicInvoiceProvider
+}
+
+// NewSubmitElectronicDocumentUseCase crea una nueva instancia del caso de uso
+func NewSubmitElectronicDocumentUseCase(documentRepo ports.ElectronicDocumentRepository, provider ports.ElectronicInvoiceProvider) *SubmitElectronicDocumentUseCase {
+	return &SubmitElectronicDocumentUseCase{documentRepo: documentRepo, provider: provider}
+}
+
+// Execute envía el mismo XML firmado; un documento aceptado o rechazado no se vuelve a enviar
+func (uc *SubmitElectronicDocumentUseCase) Execute(ctx context.Context, id uint) (*entities.ElectronicDocument, error) {
+	document, err := uc.documentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if document.Status != entities.ElectronicDocumentPending {
+		return nil, entities.ErrElectronicDocumentNotPending
+	}
+
+	if err := submitDocument(ctx, uc.provider, uc.documentRepo, document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// submitDocument envía el documento al proveedor y guarda su respuesta
+// Si el proveedor no responde el documento sigue pendiente con el error como mensaje
+func submitDocument(ctx context.Context, provider ports.ElectronicInvoiceProvider, documentRepo ports.ElectronicDocumentRepository, document *entities.ElectronicDocument) error {
+	now := time.Now()
+	document.SubmittedAt = &now
+
+	submission, err := provider.Submit(ctx, document)
+	if err != nil {
+		document.Status = entities.ElectronicDocumentPending
+		document.ProviderMessage = err.Error()
+		return documentRepo.UpdateSubmission(ctx, document)
+	}
+
+	document.Status = entities.ElectronicDocumentRejected
+	if submission.Accepted {
+		document.Status = entities.ElectronicDocumentAccepted
+	}
+	document.ProviderTrackID = submission.TrackID
+	document.ProviderMessage = submission.Message
+	if len(submission.Errors) > 0 {
+		document.ProviderMessage += ": " + strings.Join(submission.Errors, "; ")
+	}
+	return documentRepo.UpdateSubmission(ctx, document)
+}
+
+// creditedTotal suma las notas crédito no rechazadas de una factura
+func creditedTotal(ctx context.Context, documentRepo ports.ElectronicDocumentRepository, invoiceID uint) (float64, error) {
+	notes, err := documentRepo.ListCreditNotes(ctx, invoiceID)
+	if err != nil {
+		return 0, err
+	}
+	total := 0.0
+	for _, note := range notes {
+		total += note.Total
+	}
+	return total, nil
+}
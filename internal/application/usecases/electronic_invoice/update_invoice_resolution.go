@@ -0,0 +1,47 @@
+package electronic_invoice
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// UpdateInvoiceResolutionUseCase prorroga o desactiva una resolución
+type UpdateInvoiceResolutionUseCase struct {
+	resolutionRepo ports.InvoiceResolutionRepository
+	recorder       *audittrail.Recorder
+}
+
+// NewUpdateInvoiceResolutionUseCase crea una nueva instancia del caso de uso
+func NewUpdateInvoiceResolutionUseCase(resolutionRepo ports.InvoiceResolutionRepository, recorder *audittrail.Recorder) *UpdateInvoiceResolutionUseCase {
+	return &UpdateInvoiceResolutionUseCase{resolutionRepo: resolutionRepo, recorder: recorder}
+}
+
+// Execute cambia la vigencia o el estado; el prefijo, el rango y la clave técnica no cambian porque ya numeraron documentos
+func (uc *UpdateInvoiceResolutionUseCase) Execute(ctx context.Context, id uint, validTo *time.Time, isActive *bool) (*entities.InvoiceResolution, error) {
+	existing, err := uc.resolutionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resolution := *existing
+	if validTo != nil {
+		resolution.ValidTo = *validTo
+	}
+	if isActive != nil {
+		resolution.IsActive = *isActive
+	}
+	if err := resolution.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.resolutionRepo.Update(ctx, &resolution); err != nil {
+		return nil, err
+	}
+
+	uc.recorder.Updated(ctx, entities.AuditEntityInvoiceResolution, resolution.ID, existing, &resolution)
+	return &resolution, nil
+}
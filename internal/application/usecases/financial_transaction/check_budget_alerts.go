@@ -0,0 +1,79 @@
+package financial_transaction
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// CheckBudgetAlertsUseCase avisa una sola vez por mes cuando una categoría se pasa de su presupuesto
+type CheckBudgetAlertsUseCase struct {
+	budgetRepo   ports.BudgetRepository
+	cashFlowRepo ports.CashFlowRepository
+	notifier     ports.Notifier
+	recipients   []string
+}
+
+// NewCheckBudgetAlertsUseCase crea una nueva instancia del caso de uso
+// recipients son los destinatarios de las alertas según el canal del notifier (correos, teléfonos...)
+func NewCheckBudgetAlertsUseCase(
+	budgetRepo ports.BudgetRepository,
+	cashFlowRepo ports.CashFlowRepository,
+	notifier ports.Notifier,
+	recipients []string,
+) *CheckBudgetAlertsUseCase {
+	return &CheckBudgetAlertsUseCase{
+		budgetRepo:   budgetRepo,
+		cashFlowRepo: cashFlowRepo,
+		notifier:     notifier,
+		recipients:   recipients,
+	}
+}
+
+// Execute revisa el mes en curso y retorna cuántas alertas nuevas envió
+func (uc *CheckBudgetAlertsUseCase) Execute(ctx context.Context) (int, error) {
+	now := time.Now()
+	report, budgets, err := budgetReport(ctx, uc.budgetRepo, uc.cashFlowRepo, now)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, line := range report.Lines {
+		if !line.Overspent || line.BudgetID == nil {
+			continue
+		}
+		alreadyAlerted := false
+		for _, budget := range budgets {
+			if budget.ID == *line.BudgetID && budget.AlertedAt != nil {
+				alreadyAlerted = true
+			}
+		}
+		if alreadyAlerted {
+			continue
+		}
+
+		month := report.Month.Format("2006-01")
+		subject := fmt.Sprintf("Presupuesto excedido: %s (%s)", line.Category, month)
+		body := fmt.Sprintf("El gasto de %s en %s va en $%.0f y el presupuesto es $%.0f (%.1f%%). Excedente: $%.0f.",
+			line.Category, month, line.Actual, line.Budget, *line.UsedPercent, -line.Remaining)
+
+		log.Printf("⚠️  [BUDGET ALERT] %s", body)
+		for _, recipient := range uc.recipients {
+			notification := ports.Notification{To: recipient, Subject: subject, Body: body}
+			if err := uc.notifier.Notify(ctx, notification); err != nil {
+				log.Printf("❌ [BUDGET ALERT] Failed to notify %s: %v", recipient, err)
+			}
+		}
+
+		if err := uc.budgetRepo.MarkAlerted(ctx, *line.BudgetID, now); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+
+	return sent, nil
+}
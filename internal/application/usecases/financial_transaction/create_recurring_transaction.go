@@ -0,0 +1,37 @@
+package financial_transaction
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// CreateRecurringTransactionUseCase maneja la creación de plantillas de transacciones recurrentes
+type CreateRecurringTransactionUseCase struct {
+	recurringRepo ports.RecurringTransactionRepository
+	recorder      *audittrail.Recorder
+}
+
+// NewCreateRecurringTransactionUseCase crea una nueva instancia del caso de uso
+func NewCreateRecurringTransactionUseCase(recurringRepo ports.RecurringTransactionRepository, recorder *audittrail.Recorder) *CreateRecurringTransactionUseCase {
+	return &CreateRecurringTransactionUseCase{recurringRepo: recurringRepo, recorder: recorder}
+}
+
+// Execute valida la plantilla y programa su primera ocurrencia
+// Si la fecha de inicio ya pasó, la generación automática pone al día las ocurrencias vencidas
+func (uc *CreateRecurringTransactionUseCase) Execute(ctx context.Context, recurring *entities.RecurringTransaction) error {
+	if err := recurring.Validate(); err != nil {
+		return err
+	}
+
+	recurring.NextRunDate = recurring.FirstRunDate()
+	recurring.LastRunDate = nil
+	if err := uc.recurringRepo.Create(ctx, recurring); err != nil {
+		return err
+	}
+
+	uc.recorder.Created(ctx, entities.AuditEntityRecurringTransaction, recurring.ID, recurring)
+	return nil
+}
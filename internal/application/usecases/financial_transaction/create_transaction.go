@@ -3,26 +3,51 @@ package financial_transaction
 import (
 	"context"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/ledger"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 type CreateTransactionUseCase struct {
-	transactionRepo ports.FinancialTransactionRepository
+	transactionRepo   ports.FinancialTransactionRepository
+	paymentMethodRepo ports.PaymentMethodRepository
+	poster            *ledger.Poster
+	recorder          *audittrail.Recorder
 }
 
-func NewCreateTransactionUseCase(transactionRepo ports.FinancialTransactionRepository) *CreateTransactionUseCase {
+func NewCreateTransactionUseCase(transactionRepo ports.FinancialTransactionRepository, paymentMethodRepo ports.PaymentMethodRepository, poster *ledger.Poster, recorder *audittrail.Recorder) *CreateTransactionUseCase {
 	return &CreateTransactionUseCase{
-		transactionRepo: transactionRepo,
+		transactionRepo:   transactionRepo,
+		paymentMethodRepo: paymentMethodRepo,
+		poster:            poster,
+		recorder:          recorder,
 	}
 }
 
 func (uc *CreateTransactionUseCase) Execute(ctx context.Context, transaction *entities.FinancialTransaction) error {
+	// Solo la generación automática enlaza la transacción con su plantilla o con una venta
+	transaction.RecurringTransactionID = nil
+	transaction.OrderID = nil
+
 	// Validar transacción
 	if err := transaction.Validate(); err != nil {
 		return err
 	}
 
+	// La cuenta debe existir y estar activa para recibir movimientos nuevos
+	if transaction.PaymentMethodID != nil {
+		if err := ensureActivePaymentMethod(uc.paymentMethodRepo, *transaction.PaymentMethodID); err != nil {
+			return err
+		}
+	}
+
 	// Crear transacción
-	return uc.transactionRepo.Create(ctx, transaction)
+	if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
+		return err
+	}
+
+	uc.poster.FinancialTransaction(ctx, transaction)
+	uc.recorder.Created(ctx, entities.AuditEntityFinancialTransaction, transaction.ID, transaction)
+	return nil
 }
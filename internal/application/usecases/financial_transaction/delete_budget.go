@@ -0,0 +1,35 @@
+package financial_transaction
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// DeleteBudgetUseCase maneja la eliminación de presupuestos
+type DeleteBudgetUseCase struct {
+	budgetRepo ports.BudgetRepository
+	recorder   *audittrail.Recorder
+}
+
+// NewDeleteBudgetUseCase crea una nueva instancia del caso de uso
+func NewDeleteBudgetUseCase(budgetRepo ports.BudgetRepository, recorder *audittrail.Recorder) *DeleteBudgetUseCase {
+	return &DeleteBudgetUseCase{budgetRepo: budgetRepo, recorder: recorder}
+}
+
+// Execute elimina el presupuesto de una categoría en un mes
+func (uc *DeleteBudgetUseCase) Execute(ctx context.Context, id uint) error {
+	existing, err := uc.budgetRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.budgetRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.recorder.Deleted(ctx, entities.AuditEntityBudget, id, existing)
+	return nil
+}
@@ -0,0 +1,35 @@
+package financial_transaction
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// DeleteRecurringTransactionUseCase maneja la eliminación de plantillas de transacciones recurrentes
+type DeleteRecurringTransactionUseCase struct {
+	recurringRepo ports.RecurringTransactionRepository
+	recorder      *audittrail.Recorder
+}
+
+// NewDeleteRecurringTransactionUseCase crea una nueva instancia del caso de uso
+func NewDeleteRecurringTransactionUseCase(recurringRepo ports.RecurringTransactionRepository, recorder *audittrail.Recorder) *DeleteRecurringTransactionUseCase {
+	return &DeleteRecurringTransactionUseCase{recurringRepo: recurringRepo, recorder: recorder}
+}
+
+// Execute elimina la plantilla (las transacciones ya generadas se conservan)
+func (uc *DeleteRecurringTransactionUseCase) Execute(ctx context.Context, id uint) error {
+	existing, err := uc.recurringRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.recurringRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.recorder.Deleted(ctx, entities.AuditEntityRecurringTransaction, id, existing)
+	return nil
+}
@@ -0,0 +1,59 @@
+package financial_transaction
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/ledger"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// GenerateRecurringTransactionsUseCase genera las transacciones financieras de las plantillas vencidas
+type GenerateRecurringTransactionsUseCase struct {
+	recurringRepo ports.RecurringTransactionRepository
+	poster        *ledger.Poster
+	recorder      *audittrail.Recorder
+}
+
+// NewGenerateRecurringTransactionsUseCase crea una nueva instancia del caso de uso
+func NewGenerateRecurringTransactionsUseCase(recurringRepo ports.RecurringTransactionRepository, poster *ledger.Poster, recorder *audittrail.Recorder) *GenerateRecurringTransactionsUseCase {
+	return &GenerateRecurringTransactionsUseCase{recurringRepo: recurringRepo, poster: poster, recorder: recorder}
+}
+
+// Execute genera todas las ocurrencias pendientes hasta hoy (incluidas las atrasadas) y retorna cuántas creó
+// Cada ocurrencia se genera una sola vez aunque el proceso corra en paralelo
+func (uc *GenerateRecurringTransactionsUseCase) Execute(ctx context.Context) (int, error) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	due, err := uc.recurringRepo.ListDue(ctx, today)
+	if err != nil {
+		return 0, err
+	}
+
+	generated := 0
+	for i := range due {
+		recurring := &due[i]
+		for recurring.IsDue(today) {
+			transaction := recurring.NewTransaction()
+			err := uc.recurringRepo.Generate(ctx, recurring, transaction, recurring.NextAfter(recurring.NextRunDate))
+			if errors.Is(err, entities.ErrRecurringAlreadyGenerated) {
+				break
+			}
+			if err != nil {
+				log.Printf("❌ [RECURRING ERROR] Failed to generate recurring transaction #%d: %v", recurring.ID, err)
+				break
+			}
+
+			uc.poster.FinancialTransaction(ctx, transaction)
+			uc.recorder.Created(ctx, entities.AuditEntityFinancialTransaction, transaction.ID, transaction)
+			generated++
+		}
+	}
+
+	return generated, nil
+}
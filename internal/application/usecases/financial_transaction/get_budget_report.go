@@ -0,0 +1,43 @@
+package financial_transaction
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// GetBudgetReportUseCase compara el presupuesto del mes con el gasto real por categoría
+type GetBudgetReportUseCase struct {
+	budgetRepo   ports.BudgetRepository
+	cashFlowRepo ports.CashFlowRepository
+}
+
+// NewGetBudgetReportUseCase crea una nueva instancia del caso de uso
+func NewGetBudgetReportUseCase(budgetRepo ports.BudgetRepository, cashFlowRepo ports.CashFlowRepository) *GetBudgetReportUseCase {
+	return &GetBudgetReportUseCase{budgetRepo: budgetRepo, cashFlowRepo: cashFlowRepo}
+}
+
+// Execute arma el reporte de presupuesto contra lo real del mes
+func (uc *GetBudgetReportUseCase) Execute(ctx context.Context, month time.Time) (*entities.BudgetReport, error) {
+	report, _, err := budgetReport(ctx, uc.budgetRepo, uc.cashFlowRepo, month)
+	return report, err
+}
+
+// budgetReport retorna el reporte del mes junto con los presupuestos usados para armarlo
+func budgetReport(ctx context.Context, budgetRepo ports.BudgetRepository, cashFlowRepo ports.CashFlowRepository, month time.Time) (*entities.BudgetReport, []entities.Budget, error) {
+	month = entities.MonthStart(month)
+
+	budgets, err := budgetRepo.ListByMonth(ctx, month)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actuals, err := cashFlowRepo.SumExpensesByCategory(ctx, entities.ExpenseCategories(), month, month.AddDate(0, 1, 0))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return entities.NewBudgetReport(month, budgets, actuals), budgets, nil
+}
@@ -0,0 +1,153 @@
+package financial_transaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+const (
+	// paymentHistoryMonths es la ventana de abonos usada para estimar la cuota de cada cliente
+	paymentHistoryMonths = 6
+
+	// expenseHistoryMonths son los meses completos usados para promediar los gastos recurrentes
+	expenseHistoryMonths = 3
+)
+
+// GetCashFlowProjectionUseCase proyecta la caja semana a semana a partir del balance actual,
+// las cuotas esperadas de los clientes, las órdenes CUSTOM pendientes y los gastos recurrentes
+type GetCashFlowProjectionUseCase struct {
+	transactionRepo ports.FinancialTransactionRepository
+	cashFlowRepo    ports.CashFlowRepository
+	recurringRepo   ports.RecurringTransactionRepository
+}
+
+// NewGetCashFlowProjectionUseCase crea una nueva instancia del caso de uso
+func NewGetCashFlowProjectionUseCase(
+	transactionRepo ports.FinancialTransactionRepository,
+	cashFlowRepo ports.CashFlowRepository,
+	recurringRepo ports.RecurringTransactionRepository,
+) *GetCashFlowProjectionUseCase {
+	return &GetCashFlowProjectionUseCase{
+		transactionRepo: transactionRepo,
+		cashFlowRepo:    cashFlowRepo,
+		recurringRepo:   recurringRepo,
+	}
+}
+
+// Execute proyecta las próximas semanas a partir de hoy
+func (uc *GetCashFlowProjectionUseCase) Execute(ctx context.Context, weeks int) (*entities.CashFlowProjection, error) {
+	if weeks < 1 || weeks > entities.MaxCashFlowWeeks {
+		return nil, entities.ErrInvalidCashFlowWeeks
+	}
+
+	now := time.Now()
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	end := start.AddDate(0, 0, weeks*7)
+
+	openingBalance, err := uc.transactionRepo.GetBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []entities.CashFlowItem{}
+
+	// Cuotas de clientes según su frecuencia y días de pago
+	receivables, err := uc.cashFlowRepo.ListOpenReceivables(ctx, start.AddDate(0, -paymentHistoryMonths, 0))
+	if err != nil {
+		return nil, err
+	}
+	unscheduled := 0.0
+	for i := range receivables {
+		collections := receivables[i].ProjectCollections(start, end)
+		if len(collections) == 0 {
+			unscheduled += receivables[i].Balance
+			continue
+		}
+		items = append(items, collections...)
+	}
+
+	// Órdenes CUSTOM pendientes: se cobran al entregarse; las atrasadas se esperan esta semana
+	orders, err := uc.cashFlowRepo.ListPendingCustomOrders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	undated := 0.0
+	for _, order := range orders {
+		if order.TotalAmount <= 0 {
+			continue
+		}
+		if order.EstimatedDeliveryDate == nil {
+			undated += order.TotalAmount
+			continue
+		}
+		date := *order.EstimatedDeliveryDate
+		if date.Before(start) {
+			date = start
+		}
+		orderID := order.ID
+		items = append(items, entities.CashFlowItem{
+			Date:        date,
+			Source:      entities.CashFlowCustomOrder,
+			ReferenceID: &orderID,
+			Description: fmt.Sprintf("Orden %s - %s", order.OrderNumber, order.CustomerName),
+			Amount:      order.TotalAmount,
+		})
+	}
+
+	// Transacciones programadas: se proyectan en sus fechas exactas
+	recurringList, err := uc.recurringRepo.List(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	scheduled := make(map[entities.FinancialTransactionCategory]bool)
+	for i := range recurringList {
+		recurring := &recurringList[i]
+		recurringID := recurring.ID
+		amount := recurring.Amount
+		if recurring.Type == entities.FinancialTransactionTypeExpense {
+			amount = -amount
+			scheduled[recurring.Category] = true
+		}
+		for _, date := range recurring.OccurrencesBetween(start, end) {
+			items = append(items, entities.CashFlowItem{
+				Date:        date,
+				Source:      entities.CashFlowScheduled,
+				ReferenceID: &recurringID,
+				Description: recurring.Description,
+				Amount:      amount,
+			})
+		}
+	}
+
+	// Gastos recurrentes sin plantilla: promedio mensual de los últimos meses completos, repartido por semana
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	totals, err := uc.cashFlowRepo.SumExpensesByCategory(ctx, entities.RecurringExpenseCategories(),
+		monthStart.AddDate(0, -expenseHistoryMonths, 0), monthStart)
+	if err != nil {
+		return nil, err
+	}
+	for week := 0; week < weeks; week++ {
+		for _, category := range entities.RecurringExpenseCategories() {
+			weekly := totals[category] / expenseHistoryMonths * 12 / 52
+			if weekly <= 0 || scheduled[category] {
+				continue
+			}
+			items = append(items, entities.CashFlowItem{
+				Date:        start.AddDate(0, 0, week*7),
+				Source:      entities.CashFlowRecurringExpense,
+				Description: fmt.Sprintf("Gasto recurrente estimado - %s", category),
+				Amount:      -weekly,
+			})
+		}
+	}
+
+	projection := entities.NewCashFlowProjection(start, weeks, openingBalance, items)
+	projection.UnscheduledReceivables = unscheduled
+	projection.UndatedOrders = undated
+	projection.ExpenseHistoryMonths = expenseHistoryMonths
+	return projection, nil
+}
@@ -0,0 +1,23 @@
+package financial_transaction
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListRecurringTransactionsUseCase maneja el listado de plantillas de transacciones recurrentes
+type ListRecurringTransactionsUseCase struct {
+	recurringRepo ports.RecurringTransactionRepository
+}
+
+// NewListRecurringTransactionsUseCase crea una nueva instancia del caso de uso
+func NewListRecurringTransactionsUseCase(recurringRepo ports.RecurringTransactionRepository) *ListRecurringTransactionsUseCase {
+	return &ListRecurringTransactionsUseCase{recurringRepo: recurringRepo}
+}
+
+// Execute lista las plantillas ordenadas por su próxima ejecución
+func (uc *ListRecurringTransactionsUseCase) Execute(ctx context.Context, activeOnly bool) ([]entities.RecurringTransaction, error) {
+	return uc.recurringRepo.List(ctx, activeOnly)
+}
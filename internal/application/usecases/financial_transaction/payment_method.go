@@ -0,0 +1,26 @@
+package financial_transaction
+
+import (
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ensureActivePaymentMethod verifica que la cuenta exista y pueda recibir movimientos
+func ensureActivePaymentMethod(paymentMethodRepo ports.PaymentMethodRepository, id uint) error {
+	paymentMethod, err := paymentMethodRepo.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if !paymentMethod.IsActive {
+		return entities.ErrPaymentMethodInactive
+	}
+	return nil
+}
+
+// samePaymentMethod indica si ambas transacciones usan la misma cuenta (o ninguna)
+func samePaymentMethod(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
@@ -0,0 +1,74 @@
+package financial_transaction
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// maxBudgetMonths limita cuántos meses se pueden presupuestar de una vez
+const maxBudgetMonths = 12
+
+// SetBudgetUseCase crea o reemplaza el presupuesto de una categoría
+type SetBudgetUseCase struct {
+	budgetRepo ports.BudgetRepository
+	recorder   *audittrail.Recorder
+}
+
+// NewSetBudgetUseCase crea una nueva instancia del caso de uso
+func NewSetBudgetUseCase(budgetRepo ports.BudgetRepository, recorder *audittrail.Recorder) *SetBudgetUseCase {
+	return &SetBudgetUseCase{budgetRepo: budgetRepo, recorder: recorder}
+}
+
+// Execute guarda el mismo presupuesto para la categoría desde el mes indicado durante months meses
+func (uc *SetBudgetUseCase) Execute(ctx context.Context, budget entities.Budget, months int) ([]entities.Budget, error) {
+	if months < 1 || months > maxBudgetMonths {
+		return nil, errors.New("months must be between 1 and 12")
+	}
+	if err := budget.Validate(); err != nil {
+		return nil, err
+	}
+
+	saved := make([]entities.Budget, 0, months)
+	for i := 0; i < months; i++ {
+		monthBudget := budget
+		monthBudget.ID = 0
+		monthBudget.Month = budget.Month.AddDate(0, i, 0)
+
+		existing, err := uc.findBudget(ctx, monthBudget.Category, monthBudget.Month)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := uc.budgetRepo.Upsert(ctx, &monthBudget); err != nil {
+			return nil, err
+		}
+
+		if existing != nil {
+			uc.recorder.Updated(ctx, entities.AuditEntityBudget, monthBudget.ID, existing, &monthBudget)
+		} else {
+			uc.recorder.Created(ctx, entities.AuditEntityBudget, monthBudget.ID, &monthBudget)
+		}
+		saved = append(saved, monthBudget)
+	}
+
+	return saved, nil
+}
+
+// findBudget retorna el presupuesto vigente de la categoría en el mes, o nil si no existe
+func (uc *SetBudgetUseCase) findBudget(ctx context.Context, category entities.FinancialTransactionCategory, month time.Time) (*entities.Budget, error) {
+	budgets, err := uc.budgetRepo.ListByMonth(ctx, month)
+	if err != nil {
+		return nil, err
+	}
+	for i := range budgets {
+		if budgets[i].Category == category {
+			return &budgets[i], nil
+		}
+	}
+	return nil, nil
+}
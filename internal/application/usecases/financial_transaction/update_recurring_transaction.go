@@ -0,0 +1,47 @@
+package financial_transaction
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// UpdateRecurringTransactionUseCase maneja la actualización de plantillas de transacciones recurrentes
+type UpdateRecurringTransactionUseCase struct {
+	recurringRepo ports.RecurringTransactionRepository
+	recorder      *audittrail.Recorder
+}
+
+// NewUpdateRecurringTransactionUseCase crea una nueva instancia del caso de uso
+func NewUpdateRecurringTransactionUseCase(recurringRepo ports.RecurringTransactionRepository, recorder *audittrail.Recorder) *UpdateRecurringTransactionUseCase {
+	return &UpdateRecurringTransactionUseCase{recurringRepo: recurringRepo, recorder: recorder}
+}
+
+// Execute actualiza la plantilla y recalcula su próxima ocurrencia sin repetir las ya generadas
+// Las transacciones generadas antes del cambio no se modifican
+func (uc *UpdateRecurringTransactionUseCase) Execute(ctx context.Context, recurring *entities.RecurringTransaction) error {
+	existing, err := uc.recurringRepo.GetByID(ctx, recurring.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := recurring.Validate(); err != nil {
+		return err
+	}
+
+	recurring.LastRunDate = existing.LastRunDate
+	recurring.NextRunDate = recurring.FirstRunDate()
+	for recurring.LastRunDate != nil && !recurring.NextRunDate.After(*recurring.LastRunDate) {
+		recurring.NextRunDate = recurring.NextAfter(recurring.NextRunDate)
+	}
+	recurring.CreatedAt = existing.CreatedAt
+
+	if err := uc.recurringRepo.Update(ctx, recurring); err != nil {
+		return err
+	}
+
+	uc.recorder.Updated(ctx, entities.AuditEntityRecurringTransaction, recurring.ID, existing, recurring)
+	return nil
+}
@@ -3,17 +3,33 @@ package financial_transaction
 import (
 	"context"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/ledger"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 type UpdateTransactionUseCase struct {
-	transactionRepo ports.FinancialTransactionRepository
+	transactionRepo   ports.FinancialTransactionRepository
+	paymentMethodRepo ports.PaymentMethodRepository
+	statementRepo     ports.BankStatementRepository
+	poster            *ledger.Poster
+	recorder          *audittrail.Recorder
 }
 
-func NewUpdateTransactionUseCase(transactionRepo ports.FinancialTransactionRepository) *UpdateTransactionUseCase {
+func NewUpdateTransactionUseCase(
+	transactionRepo ports.FinancialTransactionRepository,
+	paymentMethodRepo ports.PaymentMethodRepository,
+	statementRepo ports.BankStatementRepository,
+	poster *ledger.Poster,
+	recorder *audittrail.Recorder,
+) *UpdateTransactionUseCase {
 	return &UpdateTransactionUseCase{
-		transactionRepo: transactionRepo,
+		transactionRepo:   transactionRepo,
+		paymentMethodRepo: paymentMethodRepo,
+		statementRepo:     statementRepo,
+		poster:            poster,
+		recorder:          recorder,
 	}
 }
 
@@ -28,11 +44,42 @@ func (uc *UpdateTransactionUseCase) Execute(ctx context.Context, transaction *en
 		return entities.ErrNotFound
 	}
 
+	// El origen (plantilla recurrente o venta) no se cambia al editar
+	transaction.RecurringTransactionID = existing.RecurringTransactionID
+	transaction.OrderID = existing.OrderID
+
 	// Validar transacción
 	if err := transaction.Validate(); err != nil {
 		return err
 	}
 
+	// Cambiar de cuenta solo se permite hacia una cuenta activa
+	accountChanged := !samePaymentMethod(existing.PaymentMethodID, transaction.PaymentMethodID)
+	if accountChanged && transaction.PaymentMethodID != nil {
+		if err := ensureActivePaymentMethod(uc.paymentMethodRepo, *transaction.PaymentMethodID); err != nil {
+			return err
+		}
+	}
+
+	// Una transacción conciliada con el extracto no puede cambiar de valor, tipo ni cuenta
+	// sin deshacer antes el cruce
+	if accountChanged || existing.Amount != transaction.Amount || existing.Type != transaction.Type {
+		reconciled, err := uc.statementRepo.IsReconciled(ctx, entities.AccountMovementFinancialTransaction, transaction.ID)
+		if err != nil {
+			return err
+		}
+		if reconciled {
+			return entities.ErrMovementAlreadyReconciled
+		}
+	}
+
 	// Actualizar transacción
-	return uc.transactionRepo.Update(ctx, transaction)
+	if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+		return err
+	}
+
+	// El asiento anterior se anula y se reemplaza por uno con los datos nuevos
+	uc.poster.FinancialTransactionUpdated(ctx, transaction)
+	uc.recorder.Updated(ctx, entities.AuditEntityFinancialTransaction, transaction.ID, existing, transaction)
+	return nil
 }
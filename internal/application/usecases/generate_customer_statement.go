@@ -7,6 +7,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 	"github.com/jung-kurt/gofpdf"
@@ -15,15 +16,18 @@ import (
 type GenerateCustomerStatementUseCase struct {
 	customerRepo    ports.CustomerRepository
 	transactionRepo ports.CustomerTransactionRepository
+	guard           *access.Guard
 }
 
 func NewGenerateCustomerStatementUseCase(
 	customerRepo ports.CustomerRepository,
 	transactionRepo ports.CustomerTransactionRepository,
+	guard *access.Guard,
 ) *GenerateCustomerStatementUseCase {
 	return &GenerateCustomerStatementUseCase{
 		customerRepo:    customerRepo,
 		transactionRepo: transactionRepo,
+		guard:           guard,
 	}
 }
 
@@ -46,6 +50,9 @@ func (uc *GenerateCustomerStatementUseCase) Execute(ctx context.Context, req Sta
 	if err != nil {
 		return nil, fmt.Errorf("error obteniendo cliente: %w", err)
 	}
+	if err := uc.guard.CheckCustomer(ctx, customer); err != nil {
+		return nil, err
+	}
 
 	// Obtener todas las transacciones del cliente
 	allTransactions, err := uc.transactionRepo.ListByCustomer(ctx, req.CustomerID)
@@ -182,11 +189,15 @@ func (uc *GenerateCustomerStatementUseCase) Execute(ctx context.Context, req Sta
 		// Fecha
 		pdf.CellFormat(25, 6, tx.Date.Format("02/01/2006"), "1", 0, "C", false, 0, "")
 
-		// Tipo
+		// Tipo (los reversos se resaltan para distinguirlos de movimientos normales)
 		typeText := "DEUDA"
 		if tx.Type == entities.TransactionTypePayment {
 			typeText = "ABONO"
 		}
+		if tx.IsReversal() {
+			typeText = "REVERSO"
+			pdf.SetTextColor(233, 30, 99) // #E91E63 - Rosa/Magenta
+		}
 		pdf.CellFormat(20, 6, typeText, "1", 0, "C", false, 0, "")
 
 		// Descripción (truncar si es muy larga)
@@ -194,6 +205,9 @@ func (uc *GenerateCustomerStatementUseCase) Execute(ctx context.Context, req Sta
 		if len(description) == 0 {
 			description = "-"
 		}
+		if tx.IsReversed() {
+			description = "[REVERSADO] " + description
+		}
 		if len(description) > 50 {
 			description = description[:47] + "..."
 		}
@@ -216,6 +230,7 @@ func (uc *GenerateCustomerStatementUseCase) Execute(ctx context.Context, req Sta
 		// Saldo
 		pdf.CellFormat(25, 6, formatCurrency(currentBalance), "1", 0, "R", false, 0, "")
 		pdf.Ln(-1)
+		pdf.SetTextColor(0, 0, 0)
 	}
 
 	// Total final con color secundario (Rosa/Magenta)
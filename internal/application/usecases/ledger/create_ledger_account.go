@@ -0,0 +1,37 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// CreateLedgerAccountUseCase agrega una cuenta al plan de cuentas para los asientos manuales
+type CreateLedgerAccountUseCase struct {
+	accountRepo ports.LedgerAccountRepository
+	recorder    *audittrail.Recorder
+}
+
+// NewCreateLedgerAccountUseCase crea una nueva instancia del caso de uso
+func NewCreateLedgerAccountUseCase(accountRepo ports.LedgerAccountRepository, recorder *audittrail.Recorder) *CreateLedgerAccountUseCase {
+	return &CreateLedgerAccountUseCase{accountRepo: accountRepo, recorder: recorder}
+}
+
+// Execute valida y guarda la cuenta; las cuentas de los métodos de pago las crea el sistema
+func (uc *CreateLedgerAccountUseCase) Execute(ctx context.Context, account *entities.LedgerAccount) error {
+	if err := account.Validate(); err != nil {
+		return err
+	}
+
+	account.PaymentMethodID = nil
+	account.System = false
+	account.IsActive = true
+	if err := uc.accountRepo.Create(ctx, account); err != nil {
+		return err
+	}
+
+	uc.recorder.Created(ctx, entities.AuditEntityLedgerAccount, account.ID, account)
+	return nil
+}
@@ -0,0 +1,58 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// CreateManualEntryUseCase registra un asiento de ajuste (depreciaciones, cierres, correcciones)
+type CreateManualEntryUseCase struct {
+	accountRepo ports.LedgerAccountRepository
+	journalRepo ports.JournalRepository
+	recorder    *audittrail.Recorder
+}
+
+// NewCreateManualEntryUseCase crea una nueva instancia del caso de uso
+func NewCreateManualEntryUseCase(accountRepo ports.LedgerAccountRepository, journalRepo ports.JournalRepository, recorder *audittrail.Recorder) *CreateManualEntryUseCase {
+	return &CreateManualEntryUseCase{
+		accountRepo: accountRepo,
+		journalRepo: journalRepo,
+		recorder:    recorder,
+	}
+}
+
+// Execute valida que todas las cuentas existan y estén activas y que el asiento cuadre
+func (uc *CreateManualEntryUseCase) Execute(ctx context.Context, entry *entities.JournalEntry) error {
+	for i := range entry.Lines {
+		account, err := uc.accountRepo.GetByID(ctx, entry.Lines[i].AccountID)
+		if err != nil {
+			return err
+		}
+		if !account.IsActive {
+			return entities.ErrLedgerAccountInactive
+		}
+		entry.Lines[i].Account = account
+	}
+
+	entry.SourceType = entities.JournalSourceManual
+	entry.SourceID = nil
+	entry.ReversalOfID = nil
+	entry.ReversedByID = nil
+	if actor, ok := access.ActorFromContext(ctx); ok && actor.User != nil {
+		entry.CreatedByID = &actor.User.ID
+	}
+	if err := entry.Validate(); err != nil {
+		return err
+	}
+
+	if err := uc.journalRepo.Create(ctx, entry); err != nil {
+		return err
+	}
+
+	uc.recorder.Created(ctx, entities.AuditEntityJournalEntry, entry.ID, entry)
+	return nil
+}
@@ -0,0 +1,34 @@
+package ledger
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// GetBalanceSheetUseCase arma el balance general a una fecha
+type GetBalanceSheetUseCase struct {
+	accountRepo ports.LedgerAccountRepository
+	journalRepo ports.JournalRepository
+}
+
+// NewGetBalanceSheetUseCase crea una nueva instancia del caso de uso
+func NewGetBalanceSheetUseCase(accountRepo ports.LedgerAccountRepository, journalRepo ports.JournalRepository) *GetBalanceSheetUseCase {
+	return &GetBalanceSheetUseCase{accountRepo: accountRepo, journalRepo: journalRepo}
+}
+
+// Execute retorna el activo, el pasivo y el patrimonio acumulados hasta el final del día indicado
+func (uc *GetBalanceSheetUseCase) Execute(ctx context.Context, date time.Time) (*entities.BalanceSheet, error) {
+	accounts, err := uc.accountRepo.List(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	totals, err := uc.journalRepo.SumByAccount(ctx, time.Time{}, date.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.NewBalanceSheet(date, accounts, totals), nil
+}
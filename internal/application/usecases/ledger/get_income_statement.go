@@ -0,0 +1,38 @@
+package ledger
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// GetIncomeStatementUseCase arma el estado de resultados (P&G) de un periodo
+type GetIncomeStatementUseCase struct {
+	accountRepo ports.LedgerAccountRepository
+	journalRepo ports.JournalRepository
+}
+
+// NewGetIncomeStatementUseCase crea una nueva instancia del caso de uso
+func NewGetIncomeStatementUseCase(accountRepo ports.LedgerAccountRepository, journalRepo ports.JournalRepository) *GetIncomeStatementUseCase {
+	return &GetIncomeStatementUseCase{accountRepo: accountRepo, journalRepo: journalRepo}
+}
+
+// Execute retorna los ingresos, el costo de ventas, los gastos y la utilidad entre start y end (inclusive)
+func (uc *GetIncomeStatementUseCase) Execute(ctx context.Context, start, end time.Time) (*entities.IncomeStatement, error) {
+	if end.Before(start) {
+		return nil, entities.ErrInvalidReportPeriod
+	}
+
+	accounts, err := uc.accountRepo.List(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	period, err := uc.journalRepo.SumByAccount(ctx, start, end.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.NewIncomeStatement(start, end, accounts, period), nil
+}
@@ -0,0 +1,23 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// GetJournalEntryUseCase obtiene un asiento con sus líneas
+type GetJournalEntryUseCase struct {
+	journalRepo ports.JournalRepository
+}
+
+// NewGetJournalEntryUseCase crea una nueva instancia del caso de uso
+func NewGetJournalEntryUseCase(journalRepo ports.JournalRepository) *GetJournalEntryUseCase {
+	return &GetJournalEntryUseCase{journalRepo: journalRepo}
+}
+
+// Execute retorna el asiento
+func (uc *GetJournalEntryUseCase) Execute(ctx context.Context, id uint) (*entities.JournalEntry, error) {
+	return uc.journalRepo.GetByID(ctx, id)
+}
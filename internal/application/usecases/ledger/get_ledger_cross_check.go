@@ -0,0 +1,95 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// GetLedgerCrossCheckUseCase cruza el libro mayor con los saldos que muestran los demás módulos
+type GetLedgerCrossCheckUseCase struct {
+	accountRepo       ports.LedgerAccountRepository
+	journalRepo       ports.JournalRepository
+	customerRepo      ports.CustomerRepository
+	paymentMethodRepo ports.PaymentMethodRepository
+	cashAccountRepo   ports.CashAccountRepository
+}
+
+// NewGetLedgerCrossCheckUseCase crea una nueva instancia del caso de uso
+func NewGetLedgerCrossCheckUseCase(
+	accountRepo ports.LedgerAccountRepository,
+	journalRepo ports.JournalRepository,
+	customerRepo ports.CustomerRepository,
+	paymentMethodRepo ports.PaymentMethodRepository,
+	cashAccountRepo ports.CashAccountRepository,
+) *GetLedgerCrossCheckUseCase {
+	return &GetLedgerCrossCheckUseCase{
+		accountRepo:       accountRepo,
+		journalRepo:       journalRepo,
+		customerRepo:      customerRepo,
+		paymentMethodRepo: paymentMethodRepo,
+		cashAccountRepo:   cashAccountRepo,
+	}
+}
+
+// Execute verifica que el libro cuadre, que la cuenta de clientes sea igual a la suma de los saldos
+// de los clientes y que la cuenta de cada método de pago sea igual al saldo de la cuenta de caja o banco
+// Una diferencia suele indicar registros pendientes de contabilizar (ver Sync)
+func (uc *GetLedgerCrossCheckUseCase) Execute(ctx context.Context) (*entities.LedgerCrossCheck, error) {
+	totals, err := uc.journalRepo.SumByAccount(ctx, time.Time{}, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entities.LedgerCrossCheck{Checks: []entities.LedgerCheck{}}
+	for _, accountTotals := range totals {
+		result.TotalDebit += accountTotals.Debit
+		result.TotalCredit += accountTotals.Credit
+	}
+	result.TotalDebit = math.Round(result.TotalDebit*100) / 100
+	result.TotalCredit = math.Round(result.TotalCredit*100) / 100
+	result.TrialBalanced = math.Abs(result.TotalDebit-result.TotalCredit) < 0.005
+
+	receivables, err := uc.accountRepo.GetByCode(ctx, entities.LedgerCodeReceivables)
+	if err != nil {
+		return nil, err
+	}
+	customersBalance, err := uc.customerRepo.GetTotalBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.Checks = append(result.Checks, entities.NewLedgerCheck("Cartera de clientes", *receivables,
+		totals[receivables.ID].Balance(receivables.Type), customersBalance))
+
+	paymentMethods, err := uc.paymentMethodRepo.List(false)
+	if err != nil {
+		return nil, err
+	}
+	cashTotals, err := uc.cashAccountRepo.SumByAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, paymentMethod := range paymentMethods {
+		sourceBalance := entities.NewAccountBalance(*paymentMethod, cashTotals[paymentMethod.ID]).Balance
+
+		// Una cuenta sin movimientos contabilizados todavía no tiene cuenta contable
+		account, err := uc.accountRepo.GetByPaymentMethod(ctx, paymentMethod.ID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			pending := entities.NewPaymentMethodLedgerAccount(paymentMethod)
+			result.Checks = append(result.Checks, entities.NewLedgerCheck(paymentMethod.Name, pending, 0, sourceBalance))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		result.Checks = append(result.Checks, entities.NewLedgerCheck(paymentMethod.Name, *account,
+			totals[account.ID].Balance(account.Type), sourceBalance))
+	}
+
+	return result, nil
+}
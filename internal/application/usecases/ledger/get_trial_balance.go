@@ -0,0 +1,43 @@
+package ledger
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// GetTrialBalanceUseCase arma el balance de prueba de un periodo
+type GetTrialBalanceUseCase struct {
+	accountRepo ports.LedgerAccountRepository
+	journalRepo ports.JournalRepository
+}
+
+// NewGetTrialBalanceUseCase crea una nueva instancia del caso de uso
+func NewGetTrialBalanceUseCase(accountRepo ports.LedgerAccountRepository, journalRepo ports.JournalRepository) *GetTrialBalanceUseCase {
+	return &GetTrialBalanceUseCase{accountRepo: accountRepo, journalRepo: journalRepo}
+}
+
+// Execute retorna el saldo inicial, los débitos, los créditos y el saldo final de cada cuenta
+// entre start y end (ambos inclusive)
+func (uc *GetTrialBalanceUseCase) Execute(ctx context.Context, start, end time.Time) (*entities.TrialBalance, error) {
+	if end.Before(start) {
+		return nil, entities.ErrInvalidReportPeriod
+	}
+
+	accounts, err := uc.accountRepo.List(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	opening, err := uc.journalRepo.SumByAccount(ctx, time.Time{}, start)
+	if err != nil {
+		return nil, err
+	}
+	period, err := uc.journalRepo.SumByAccount(ctx, start, end.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.NewTrialBalance(start, end, accounts, opening, period), nil
+}
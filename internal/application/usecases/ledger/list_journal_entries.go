@@ -0,0 +1,23 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListJournalEntriesUseCase lista los asientos del libro diario
+type ListJournalEntriesUseCase struct {
+	journalRepo ports.JournalRepository
+}
+
+// NewListJournalEntriesUseCase crea una nueva instancia del caso de uso
+func NewListJournalEntriesUseCase(journalRepo ports.JournalRepository) *ListJournalEntriesUseCase {
+	return &ListJournalEntriesUseCase{journalRepo: journalRepo}
+}
+
+// Execute retorna los asientos que cumplen los filtros, del más reciente al más antiguo
+func (uc *ListJournalEntriesUseCase) Execute(ctx context.Context, filters map[string]interface{}) ([]entities.JournalEntry, error) {
+	return uc.journalRepo.List(ctx, filters)
+}
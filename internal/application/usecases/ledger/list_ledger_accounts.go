@@ -0,0 +1,23 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListLedgerAccountsUseCase lista el plan de cuentas
+type ListLedgerAccountsUseCase struct {
+	accountRepo ports.LedgerAccountRepository
+}
+
+// NewListLedgerAccountsUseCase crea una nueva instancia del caso de uso
+func NewListLedgerAccountsUseCase(accountRepo ports.LedgerAccountRepository) *ListLedgerAccountsUseCase {
+	return &ListLedgerAccountsUseCase{accountRepo: accountRepo}
+}
+
+// Execute retorna las cuentas ordenadas por código (solo las activas si activeOnly)
+func (uc *ListLedgerAccountsUseCase) Execute(ctx context.Context, activeOnly bool) ([]entities.LedgerAccount, error) {
+	return uc.accountRepo.List(ctx, activeOnly)
+}
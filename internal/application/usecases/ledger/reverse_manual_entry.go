@@ -0,0 +1,58 @@
+package ledger
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ReverseManualEntryUseCase anula un asiento manual con su asiento de reverso
+type ReverseManualEntryUseCase struct {
+	journalRepo ports.JournalRepository
+	recorder    *audittrail.Recorder
+}
+
+// NewReverseManualEntryUseCase crea una nueva instancia del caso de uso
+func NewReverseManualEntryUseCase(journalRepo ports.JournalRepository, recorder *audittrail.Recorder) *ReverseManualEntryUseCase {
+	return &ReverseManualEntryUseCase{journalRepo: journalRepo, recorder: recorder}
+}
+
+// Execute crea el reverso. Los asientos automáticos no se anulan a mano: se corrige su origen
+// (reversar el abono, editar el gasto...) y el libro se ajusta solo
+func (uc *ReverseManualEntryUseCase) Execute(ctx context.Context, id uint, reason string) (*entities.JournalEntry, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, entities.ErrReversalReasonRequired
+	}
+
+	original, err := uc.journalRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if original.SourceType != entities.JournalSourceManual {
+		return nil, entities.ErrOnlyManualEntriesReversible
+	}
+	if original.IsReversal() {
+		return nil, entities.ErrCannotReverseReversal
+	}
+
+	reversal, err := original.NewReversal("Reverso: "+original.Description+" - "+reason, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if actor, ok := access.ActorFromContext(ctx); ok && actor.User != nil {
+		reversal.CreatedByID = &actor.User.ID
+	}
+
+	if err := uc.journalRepo.Reverse(ctx, original.ID, reversal, nil); err != nil {
+		return nil, err
+	}
+
+	uc.recorder.Created(ctx, entities.AuditEntityJournalEntry, reversal.ID, reversal)
+	return reversal, nil
+}
@@ -0,0 +1,24 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/ledger"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// SyncLedgerUseCase contabiliza los registros que todavía no tienen asiento
+type SyncLedgerUseCase struct {
+	poster *ledger.Poster
+}
+
+// NewSyncLedgerUseCase crea una nueva instancia del caso de uso
+func NewSyncLedgerUseCase(poster *ledger.Poster) *SyncLedgerUseCase {
+	return &SyncLedgerUseCase{poster: poster}
+}
+
+// Execute genera los asientos de los registros anteriores al libro mayor y de los que fallaron
+// al contabilizarse. Se puede ejecutar varias veces: un registro nunca se contabiliza dos veces
+func (uc *SyncLedgerUseCase) Execute(ctx context.Context) (entities.LedgerSyncResult, error) {
+	return uc.poster.Sync(ctx)
+}
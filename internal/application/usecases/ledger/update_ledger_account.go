@@ -0,0 +1,48 @@
+package ledger
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// UpdateLedgerAccountUseCase cambia el nombre o el estado de una cuenta
+type UpdateLedgerAccountUseCase struct {
+	accountRepo ports.LedgerAccountRepository
+	recorder    *audittrail.Recorder
+}
+
+// NewUpdateLedgerAccountUseCase crea una nueva instancia del caso de uso
+func NewUpdateLedgerAccountUseCase(accountRepo ports.LedgerAccountRepository, recorder *audittrail.Recorder) *UpdateLedgerAccountUseCase {
+	return &UpdateLedgerAccountUseCase{accountRepo: accountRepo, recorder: recorder}
+}
+
+// Execute guarda el nombre y el estado; el código y la clase no cambian porque ya tienen asientos
+// Una cuenta inactiva no recibe asientos manuales pero conserva su saldo en los reportes
+func (uc *UpdateLedgerAccountUseCase) Execute(ctx context.Context, id uint, name string, isActive *bool) (*entities.LedgerAccount, error) {
+	existing, err := uc.accountRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	account := *existing
+	if name = strings.TrimSpace(name); name != "" {
+		account.Name = name
+	}
+	if isActive != nil {
+		account.IsActive = *isActive
+	}
+	if err := account.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.accountRepo.Update(ctx, &account); err != nil {
+		return nil, err
+	}
+
+	uc.recorder.Updated(ctx, entities.AuditEntityLedgerAccount, account.ID, existing, &account)
+	return &account, nil
+}
@@ -0,0 +1,28 @@
+package loyalty
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// CreateRuleUseCase maneja la creación de reglas de puntos
+type CreateRuleUseCase struct {
+	ruleRepo ports.LoyaltyRuleRepository
+}
+
+// NewCreateRuleUseCase crea una nueva instancia del caso de uso
+func NewCreateRuleUseCase(ruleRepo ports.LoyaltyRuleRepository) *CreateRuleUseCase {
+	return &CreateRuleUseCase{ruleRepo: ruleRepo}
+}
+
+// Execute valida y guarda la regla
+func (uc *CreateRuleUseCase) Execute(ctx context.Context, rule *entities.LoyaltyRule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+
+	rule.IsActive = true
+	return uc.ruleRepo.Create(ctx, rule)
+}
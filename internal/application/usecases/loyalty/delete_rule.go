@@ -0,0 +1,25 @@
+package loyalty
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// DeleteRuleUseCase maneja la eliminación de reglas de puntos
+type DeleteRuleUseCase struct {
+	ruleRepo ports.LoyaltyRuleRepository
+}
+
+// NewDeleteRuleUseCase crea una nueva instancia del caso de uso
+func NewDeleteRuleUseCase(ruleRepo ports.LoyaltyRuleRepository) *DeleteRuleUseCase {
+	return &DeleteRuleUseCase{ruleRepo: ruleRepo}
+}
+
+// Execute elimina la regla (los puntos ya otorgados no se modifican)
+func (uc *DeleteRuleUseCase) Execute(ctx context.Context, id uint) error {
+	if _, err := uc.ruleRepo.GetByID(ctx, id); err != nil {
+		return err
+	}
+	return uc.ruleRepo.Delete(ctx, id)
+}
@@ -0,0 +1,23 @@
+package loyalty
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ExpirePointsUseCase vence los puntos cuya vigencia terminó
+type ExpirePointsUseCase struct {
+	pointsRepo ports.LoyaltyPointsRepository
+}
+
+// NewExpirePointsUseCase crea una nueva instancia del caso de uso
+func NewExpirePointsUseCase(pointsRepo ports.LoyaltyPointsRepository) *ExpirePointsUseCase {
+	return &ExpirePointsUseCase{pointsRepo: pointsRepo}
+}
+
+// Execute registra los vencimientos de todos los clientes y retorna los puntos vencidos
+func (uc *ExpirePointsUseCase) Execute(ctx context.Context) (int, error) {
+	return uc.pointsRepo.ExpirePoints(ctx, nil, time.Now())
+}
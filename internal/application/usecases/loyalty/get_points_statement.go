@@ -0,0 +1,75 @@
+package loyalty
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// GetPointsStatementUseCase genera el estado de cuenta de puntos de un cliente
+type GetPointsStatementUseCase struct {
+	pointsRepo   ports.LoyaltyPointsRepository
+	customerRepo ports.CustomerRepository
+	guard        *access.Guard
+	pointValue   float64
+}
+
+// NewGetPointsStatementUseCase crea una nueva instancia del caso de uso
+func NewGetPointsStatementUseCase(
+	pointsRepo ports.LoyaltyPointsRepository,
+	customerRepo ports.CustomerRepository,
+	guard *access.Guard,
+	pointValue float64,
+) *GetPointsStatementUseCase {
+	return &GetPointsStatementUseCase{
+		pointsRepo:   pointsRepo,
+		customerRepo: customerRepo,
+		guard:        guard,
+		pointValue:   pointValue,
+	}
+}
+
+// Execute vence los puntos pendientes del cliente y retorna su saldo y movimientos
+func (uc *GetPointsStatementUseCase) Execute(ctx context.Context, customerID uint) (*entities.LoyaltyStatement, error) {
+	customer, err := uc.customerRepo.GetByID(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.guard.CheckCustomer(ctx, customer); err != nil {
+		return nil, err
+	}
+
+	// Registrar vencimientos pendientes para que el estado de cuenta los muestre
+	now := time.Now()
+	if _, err := uc.pointsRepo.ExpirePoints(ctx, &customerID, now); err != nil {
+		return nil, err
+	}
+
+	balance, err := uc.pointsRepo.GetBalance(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	expiringSoon, err := uc.pointsRepo.GetExpiringPoints(ctx, customerID, now.AddDate(0, 0, 30))
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := uc.pointsRepo.ListByCustomer(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.LoyaltyStatement{
+		CustomerID:   customer.ID,
+		CustomerName: customer.Name,
+		Balance:      balance,
+		PointValue:   uc.pointValue,
+		BalanceValue: float64(balance) * uc.pointValue,
+		ExpiringSoon: expiringSoon,
+		Entries:      entries,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package loyalty
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListRulesUseCase lista las reglas de puntos
+type ListRulesUseCase struct {
+	ruleRepo ports.LoyaltyRuleRepository
+}
+
+// NewListRulesUseCase crea una nueva instancia del caso de uso
+func NewListRulesUseCase(ruleRepo ports.LoyaltyRuleRepository) *ListRulesUseCase {
+	return &ListRulesUseCase{ruleRepo: ruleRepo}
+}
+
+// Execute retorna las reglas (solo activas si activeOnly es true)
+func (uc *ListRulesUseCase) Execute(ctx context.Context, activeOnly bool) ([]entities.LoyaltyRule, error) {
+	return uc.ruleRepo.List(ctx, activeOnly)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
@@ -13,6 +14,7 @@ type AddOrderItemUseCase struct {
 	orderItemRepo      ports.OrderItemRepository
 	productRepo        ports.ProductRepository
 	productVariantRepo ports.ProductVariantRepository
+	guard              *access.Guard
 }
 
 func NewAddOrderItemUseCase(
@@ -20,12 +22,14 @@ func NewAddOrderItemUseCase(
 	orderItemRepo ports.OrderItemRepository,
 	productRepo ports.ProductRepository,
 	productVariantRepo ports.ProductVariantRepository,
+	guard *access.Guard,
 ) *AddOrderItemUseCase {
 	return &AddOrderItemUseCase{
 		orderRepo:          orderRepo,
 		orderItemRepo:      orderItemRepo,
 		productRepo:        productRepo,
 		productVariantRepo: productVariantRepo,
+		guard:              guard,
 	}
 }
 
@@ -40,13 +44,16 @@ func (uc *AddOrderItemUseCase) Execute(ctx context.Context, item *entities.Order
 	if err != nil {
 		return err
 	}
+	if err := uc.guard.CheckOrder(ctx, order); err != nil {
+		return err
+	}
 
 	// Verificar que se pueden editar items
 	if !order.CanEditItems() {
 		return errors.New("cannot edit items in current order status")
 	}
 
-	// Obtener variante para snapshot del nombre
+	// Obtener variante para snapshot del nombre y del costo
 	if item.ProductVariantID != 0 {
 		variant, err := uc.productVariantRepo.GetByID(ctx, item.ProductVariantID)
 		if err != nil {
@@ -54,6 +61,7 @@ func (uc *AddOrderItemUseCase) Execute(ctx context.Context, item *entities.Order
 		}
 		if variant.Product != nil {
 			item.ProductName = variant.Product.Name
+			item.UnitCost = variant.Product.ProductionCost
 		}
 	}
 
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/cashregister"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/order_state"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
@@ -16,6 +18,8 @@ type ChangeOrderStatusUseCase struct {
 	productRepo        ports.ProductRepository
 	productVariantRepo ports.ProductVariantRepository
 	eventPublisher     ports.EventPublisher
+	guard              *access.Guard
+	register           *cashregister.Register
 	strategies         map[entities.OrderType]order_state.OrderStrategy
 }
 
@@ -25,6 +29,8 @@ func NewChangeOrderStatusUseCase(
 	productRepo ports.ProductRepository,
 	productVariantRepo ports.ProductVariantRepository,
 	eventPublisher ports.EventPublisher,
+	guard *access.Guard,
+	register *cashregister.Register,
 ) *ChangeOrderStatusUseCase {
 	return &ChangeOrderStatusUseCase{
 		orderRepo:          orderRepo,
@@ -32,6 +38,8 @@ func NewChangeOrderStatusUseCase(
 		productRepo:        productRepo,
 		productVariantRepo: productVariantRepo,
 		eventPublisher:     eventPublisher,
+		guard:              guard,
+		register:           register,
 		strategies: map[entities.OrderType]order_state.OrderStrategy{
 			entities.OrderTypeCustom:    strategies.NewCustomOrderStrategy(eventPublisher, productRepo, productVariantRepo),
 			entities.OrderTypeInventory: strategies.NewInventoryOrderStrategy(eventPublisher, productRepo, productVariantRepo),
@@ -58,6 +66,22 @@ func (uc *ChangeOrderStatusUseCase) Execute(
 	if err != nil {
 		return nil, err
 	}
+	if err := uc.guard.CheckOrder(ctx, order); err != nil {
+		return nil, err
+	}
+
+	// Con solo orders:manufacturing (taller) únicamente se puede avanzar la fabricación
+	if actor, ok := access.ActorFromContext(ctx); ok && actor.Role != nil &&
+		!actor.Role.HasPermission(entities.PermissionOrdersChangeStatus) && !entities.IsManufacturingTransition(newStatus) {
+		return nil, entities.ErrManufacturingOnly
+	}
+
+	// Una venta de una caja ya cerrada no se puede cancelar: cambiaría el cierre
+	if newStatus == entities.OrderStatusCancelled {
+		if err := uc.register.CheckUnlocked(ctx, order.CashSessionID); err != nil {
+			return nil, err
+		}
+	}
 
 	// Obtener estrategia para el tipo de orden
 	strategy := uc.getStrategy(order.Type)
@@ -86,7 +110,7 @@ func (uc *ChangeOrderStatusUseCase) Execute(
 	// Ejecutar OnExit del estado actual
 	if currentState != nil {
 		if err := currentState.OnExit(ctx, order, order_state.StateTransitionData{
-			Publisher:          uc.eventPublisher,
+			Publisher:          access.Publisher(ctx, uc.eventPublisher),
 			ProducedQuantities: producedQuantities,
 		}); err != nil {
 			return nil, err
@@ -99,7 +123,7 @@ func (uc *ChangeOrderStatusUseCase) Execute(
 
 	// Ejecutar OnEnter del nuevo estado
 	if err := newState.OnEnter(ctx, order, order_state.StateTransitionData{
-		Publisher:          uc.eventPublisher,
+		Publisher:          access.Publisher(ctx, uc.eventPublisher),
 		ProducedQuantities: producedQuantities,
 		OldStatus:          oldStatus,
 	}); err != nil {
@@ -133,6 +157,9 @@ func (uc *ChangeOrderStatusUseCase) GetAllowedNextStatuses(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if err := uc.guard.CheckOrder(ctx, order); err != nil {
+		return nil, err
+	}
 
 	// Obtener estrategia para el tipo de orden
 	strategy := uc.getStrategy(order.Type)
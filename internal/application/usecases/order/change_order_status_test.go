@@ -0,0 +1,65 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/events"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// fakeOrderRepository retorna siempre la misma orden
+type fakeOrderRepository struct {
+	ports.OrderRepository
+	order *entities.Order
+}
+
+func (r *fakeOrderRepository) GetByID(ctx context.Context, id uint) (*entities.Order, error) {
+	order := *r.order
+	return &order, nil
+}
+
+// roleContext arma el contexto de un actor con uno de los roles por defecto
+func roleContext(t *testing.T, name entities.UserRole) context.Context {
+	t.Helper()
+	for _, role := range entities.DefaultRoles() {
+		if role.Name == name {
+			role := role
+			ctx := access.WithScope(context.Background(), access.System())
+			return access.WithActor(ctx, access.Actor{User: &entities.User{ID: 1, Role: name}, Role: &role})
+		}
+	}
+	t.Fatalf("role %s not found", name)
+	return nil
+}
+
+func TestChangeOrderStatusManufacturingOnly(t *testing.T) {
+	tests := []struct {
+		name      string
+		role      entities.UserRole
+		newStatus entities.OrderStatus
+		wantErr   bool
+	}{
+		{"workshop cannot deliver", entities.RoleWorkshop, entities.OrderStatusDelivered, true},
+		{"workshop cannot cancel", entities.RoleWorkshop, entities.OrderStatusCancelled, true},
+		{"workshop advances manufacturing", entities.RoleWorkshop, entities.OrderStatusInProduction, false},
+		{"super admin delivers", entities.RoleSuperAdmin, entities.OrderStatusDelivered, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// La orden ya está en el estado pedido: si la regla del taller deja pasar, el caso de uso
+			// se detiene en la validación siguiente sin tocar el resto de dependencias
+			repo := &fakeOrderRepository{order: &entities.Order{ID: 7, Type: entities.OrderTypeCustom, Status: tt.newStatus}}
+			uc := NewChangeOrderStatusUseCase(repo, nil, nil, nil, events.NewEventBus(), access.NewGuard(nil, nil), nil)
+
+			_, err := uc.Execute(roleContext(t, tt.role), 7, tt.newStatus, nil)
+			if got := errors.Is(err, entities.ErrManufacturingOnly); got != tt.wantErr {
+				t.Errorf("err = %v, want ErrManufacturingOnly: %v", err, tt.wantErr)
+			}
+		})
+	}
+}
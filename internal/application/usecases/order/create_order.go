@@ -3,19 +3,29 @@ package order
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/cashregister"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/order_state"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/strategies"
+	"gorm.io/gorm"
 )
 
 type CreateOrderUseCase struct {
 	orderRepo          ports.OrderRepository
 	productRepo        ports.ProductRepository
 	productVariantRepo ports.ProductVariantRepository
+	couponRepo         ports.CampaignCouponRepository
+	loyaltyRepo        ports.LoyaltyPointsRepository
+	pointValue         float64
 	eventPublisher     ports.EventPublisher
+	guard              *access.Guard
+	register           *cashregister.Register
 	strategies         map[entities.OrderType]order_state.OrderStrategy
 }
 
@@ -23,13 +33,23 @@ func NewCreateOrderUseCase(
 	orderRepo ports.OrderRepository,
 	productRepo ports.ProductRepository,
 	productVariantRepo ports.ProductVariantRepository,
+	couponRepo ports.CampaignCouponRepository,
+	loyaltyRepo ports.LoyaltyPointsRepository,
+	pointValue float64,
 	eventPublisher ports.EventPublisher,
+	guard *access.Guard,
+	register *cashregister.Register,
 ) *CreateOrderUseCase {
 	return &CreateOrderUseCase{
 		orderRepo:          orderRepo,
 		productRepo:        productRepo,
 		productVariantRepo: productVariantRepo,
+		couponRepo:         couponRepo,
+		loyaltyRepo:        loyaltyRepo,
+		pointValue:         pointValue,
 		eventPublisher:     eventPublisher,
+		guard:              guard,
+		register:           register,
 		strategies: map[entities.OrderType]order_state.OrderStrategy{
 			entities.OrderTypeCustom:    strategies.NewCustomOrderStrategy(eventPublisher, productRepo, productVariantRepo),
 			entities.OrderTypeInventory: strategies.NewInventoryOrderStrategy(eventPublisher, productRepo, productVariantRepo),
@@ -39,6 +59,14 @@ func NewCreateOrderUseCase(
 }
 
 func (uc *CreateOrderUseCase) Execute(ctx context.Context, order *entities.Order) error {
+	// Un vendedor sin orders:all solo crea órdenes a su nombre y para clientes a los que tiene acceso
+	uc.guard.AssignOrderSeller(ctx, order)
+	if order.CustomerID != nil {
+		if err := uc.guard.CheckCustomerID(ctx, *order.CustomerID); err != nil {
+			return err
+		}
+	}
+
 	// Validar orden
 	if err := order.Validate(); err != nil {
 		return err
@@ -64,19 +92,59 @@ func (uc *CreateOrderUseCase) Execute(ctx context.Context, order *entities.Order
 		order.OrderDate = time.Now()
 	}
 
+	// Aplicar cupón de campaña si se proporcionó
+	coupon, couponDiscount, err := uc.applyCoupon(ctx, order)
+	if err != nil {
+		return err
+	}
+
+	// Aplicar puntos de fidelización si se solicitaron
+	if err := uc.applyLoyaltyPoints(ctx, order); err != nil {
+		return err
+	}
+
 	// Calcular total
 	order.TotalAmount = order.CalculateTotal()
 
+	// Las ventas de mostrador quedan en la caja abierta de quien las registra
+	if order.Type == entities.OrderTypeSale {
+		sessionID, err := uc.register.CurrentSessionID(ctx)
+		if err != nil {
+			return err
+		}
+		order.CashSessionID = sessionID
+	}
+
 	// Crear orden
 	if err := uc.orderRepo.Create(ctx, order); err != nil {
 		return err
 	}
 
+	// Registrar la redención; si otro proceso ganó la carrera, deshacer la orden
+	if coupon != nil {
+		if err := uc.couponRepo.MarkRedeemed(ctx, coupon.ID, order.ID, couponDiscount); err != nil {
+			_ = uc.orderRepo.Delete(ctx, order.ID)
+			return err
+		}
+	}
+
+	// Descontar los puntos del cliente; si ya no alcanzan, deshacer la orden
+	if order.LoyaltyPointsRedeemed > 0 {
+		description := fmt.Sprintf("Redención - Orden %s", order.OrderNumber)
+		if err := uc.loyaltyRepo.Redeem(ctx, *order.CustomerID, &order.ID, order.LoyaltyPointsRedeemed, description); err != nil {
+			_ = uc.orderRepo.Delete(ctx, order.ID)
+			if coupon != nil {
+				_ = uc.couponRepo.ReleaseRedemption(ctx, coupon.ID)
+			}
+			return err
+		}
+	}
+
 	// Ejecutar OnEnter del estado inicial
 	initialState := strategy.GetState(order.Status)
 	if initialState != nil {
 		if err := initialState.OnEnter(ctx, order, order_state.StateTransitionData{
-			Publisher: uc.eventPublisher,
+			Publisher: access.Publisher(ctx, uc.eventPublisher),
 		}); err != nil {
 			return err
 		}
@@ -85,6 +153,71 @@ func (uc *CreateOrderUseCase) Execute(ctx context.Context, order *entities.Order
 	return nil
 }
 
+// applyCoupon valida el cupón de la orden y suma su descuento al descuento manual
+func (uc *CreateOrderUseCase) applyCoupon(ctx context.Context, order *entities.Order) (*entities.CampaignCoupon, float64, error) {
+	code := strings.ToUpper(strings.TrimSpace(order.CouponCode))
+	if code == "" || uc.couponRepo == nil {
+		order.CouponCode = ""
+		return nil, 0, nil
+	}
+
+	coupon, err := uc.couponRepo.GetByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, 0, entities.ErrCouponNotFound
+		}
+		return nil, 0, err
+	}
+
+	if err := coupon.CanRedeem(coupon.Campaign, order.CustomerID, time.Now()); err != nil {
+		return nil, 0, err
+	}
+
+	subtotal := 0.0
+	for _, item := range order.Items {
+		subtotal += item.Subtotal
+	}
+
+	discount := coupon.CalculateDiscount(subtotal - order.Discount)
+	order.Discount += discount
+	order.CouponCode = code
+
+	return coupon, discount, nil
+}
+
+// applyLoyaltyPoints convierte los puntos solicitados en descuento sobre la orden
+func (uc *CreateOrderUseCase) applyLoyaltyPoints(ctx context.Context, order *entities.Order) error {
+	points := order.LoyaltyPointsRedeemed
+	if points == 0 {
+		return nil
+	}
+	if points < 0 {
+		return errors.New("loyalty points cannot be negative")
+	}
+	if !order.IsInternalCustomer() {
+		return entities.ErrLoyaltyRequiresCustomer
+	}
+	if uc.loyaltyRepo == nil || uc.pointValue <= 0 {
+		return errors.New("loyalty program is not configured")
+	}
+
+	balance, err := uc.loyaltyRepo.GetBalance(ctx, *order.CustomerID)
+	if err != nil {
+		return err
+	}
+	if balance < points {
+		return entities.ErrInsufficientPoints
+	}
+
+	discount := float64(points) * uc.pointValue
+	if discount > order.CalculateTotal() {
+		return fmt.Errorf("loyalty discount ($%.0f) exceeds order total", discount)
+	}
+
+	order.Discount += discount
+	return nil
+}
+
 // getStrategy obtiene la estrategia para un tipo de orden
 func (uc *CreateOrderUseCase) getStrategy(orderType entities.OrderType) order_state.OrderStrategy {
 	return uc.strategies[orderType]
@@ -92,6 +225,7 @@ func (uc *CreateOrderUseCase) getStrategy(orderType entities.OrderType) order_st
 
 // enrichOrderItems enriquece los items de la orden buscando variantes existentes
 // Si encuentra una variante que coincida (nombre + color + talla), asigna el ProductVariantID
+// Si el producto base existe, guarda su costo de producción como snapshot del item
 func (uc *CreateOrderUseCase) enrichOrderItems(ctx context.Context, order *entities.Order) error {
 	for i := range order.Items {
 		item := &order.Items[i]
@@ -113,6 +247,9 @@ func (uc *CreateOrderUseCase) enrichOrderItems(ctx context.Context, order *entit
 
 		product := &products[0]
 
+		// Congelar el costo de producción vigente para el reporte de márgenes
+		item.UnitCost = product.ProductionCost
+
 		// Buscar variante específica por color y talla
 		variant, err := uc.productVariantRepo.GetByProductAndAttributes(ctx, product.ID, item.Color, item.SizeID)
 		if err != nil {
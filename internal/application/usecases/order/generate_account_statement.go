@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 	"github.com/jung-kurt/gofpdf"
@@ -14,11 +15,15 @@ import (
 
 type GenerateAccountStatementUseCase struct {
 	orderRepository ports.OrderRepository
+	guard           *access.Guard
+	issuer          entities.InvoiceIssuer
 }
 
-func NewGenerateAccountStatementUseCase(orderRepository ports.OrderRepository) *GenerateAccountStatementUseCase {
+func NewGenerateAccountStatementUseCase(orderRepository ports.OrderRepository, guard *access.Guard, issuer entities.InvoiceIssuer) *GenerateAccountStatementUseCase {
 	return &GenerateAccountStatementUseCase{
 		orderRepository: orderRepository,
+		guard:           guard,
+		issuer:          issuer,
 	}
 }
 
@@ -43,6 +48,9 @@ func (uc *GenerateAccountStatementUseCase) GetDraft(ctx context.Context, orderID
 	if err != nil {
 		return nil, err
 	}
+	if err := uc.guard.CheckOrder(ctx, order); err != nil {
+		return nil, err
+	}
 
 	// Generar número de cuenta de cobro basado en el order number
 	statementNumber := fmt.Sprintf("%s-CC", order.OrderNumber)
@@ -54,14 +62,14 @@ func (uc *GenerateAccountStatementUseCase) GetDraft(ctx context.Context, orderID
 		OrderID:         orderID,
 		OrderNumber:     order.OrderNumber,
 		StatementNumber: statementNumber,
-		SellerName:      "SONIA PATRICIA ORTIZ",
-		SellerID:        "30323685",
+		SellerName:      uc.issuer.Name,
+		SellerID:        uc.issuer.NIT,
 		ClientName:      order.CustomerName,
-		City:            "MANIZALES",
+		City:            uc.issuer.City,
 		Date:            time.Now(),
 		Concept:         concept,
 		TotalAmount:     order.TotalAmount,
-		BankAccount:     "3122684372", // Este valor podría venir de configuración
+		BankAccount:     uc.issuer.BankAccount,
 	}
 
 	return &data, nil
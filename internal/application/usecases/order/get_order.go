@@ -3,20 +3,30 @@ package order
 import (
 	"context"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 type GetOrderUseCase struct {
 	orderRepo ports.OrderRepository
+	guard     *access.Guard
 }
 
-func NewGetOrderUseCase(orderRepo ports.OrderRepository) *GetOrderUseCase {
+func NewGetOrderUseCase(orderRepo ports.OrderRepository, guard *access.Guard) *GetOrderUseCase {
 	return &GetOrderUseCase{
 		orderRepo: orderRepo,
+		guard:     guard,
 	}
 }
 
 func (uc *GetOrderUseCase) Execute(ctx context.Context, id uint) (*entities.Order, error) {
-	return uc.orderRepo.GetByID(ctx, id)
+	order, err := uc.orderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.guard.CheckOrder(ctx, order); err != nil {
+		return nil, err
+	}
+	return order, nil
 }
@@ -3,20 +3,24 @@ package order
 import (
 	"context"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 type ListOrdersUseCase struct {
 	orderRepo ports.OrderRepository
+	guard     *access.Guard
 }
 
-func NewListOrdersUseCase(orderRepo ports.OrderRepository) *ListOrdersUseCase {
+func NewListOrdersUseCase(orderRepo ports.OrderRepository, guard *access.Guard) *ListOrdersUseCase {
 	return &ListOrdersUseCase{
 		orderRepo: orderRepo,
+		guard:     guard,
 	}
 }
 
 func (uc *ListOrdersUseCase) Execute(ctx context.Context, filters map[string]interface{}) ([]entities.Order, error) {
+	uc.guard.ScopeOrderFilters(ctx, filters)
 	return uc.orderRepo.List(ctx, filters)
 }
@@ -4,21 +4,25 @@ import (
 	"context"
 	"errors"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 type RemoveOrderItemUseCase struct {
 	orderRepo     ports.OrderRepository
 	orderItemRepo ports.OrderItemRepository
+	guard         *access.Guard
 }
 
 func NewRemoveOrderItemUseCase(
 	orderRepo ports.OrderRepository,
 	orderItemRepo ports.OrderItemRepository,
+	guard *access.Guard,
 ) *RemoveOrderItemUseCase {
 	return &RemoveOrderItemUseCase{
 		orderRepo:     orderRepo,
 		orderItemRepo: orderItemRepo,
+		guard:         guard,
 	}
 }
 
@@ -34,6 +38,9 @@ func (uc *RemoveOrderItemUseCase) Execute(ctx context.Context, itemID uint) erro
 	if err != nil {
 		return err
 	}
+	if err := uc.guard.CheckOrder(ctx, order); err != nil {
+		return err
+	}
 
 	// Verificar que se pueden editar items
 	if !order.CanEditItems() {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
@@ -11,15 +12,18 @@ import (
 type UpdateOrderItemUseCase struct {
 	orderRepo     ports.OrderRepository
 	orderItemRepo ports.OrderItemRepository
+	guard         *access.Guard
 }
 
 func NewUpdateOrderItemUseCase(
 	orderRepo ports.OrderRepository,
 	orderItemRepo ports.OrderItemRepository,
+	guard *access.Guard,
 ) *UpdateOrderItemUseCase {
 	return &UpdateOrderItemUseCase{
 		orderRepo:     orderRepo,
 		orderItemRepo: orderItemRepo,
+		guard:         guard,
 	}
 }
 
@@ -29,11 +33,24 @@ func (uc *UpdateOrderItemUseCase) Execute(ctx context.Context, item *entities.Or
 		return err
 	}
 
+	// El costo es un snapshot de la venta: editar cantidad o precio no lo recalcula
+	current, err := uc.orderItemRepo.GetByID(ctx, item.ID)
+	if err != nil {
+		return err
+	}
+	if item.OrderID == 0 {
+		item.OrderID = current.OrderID
+	}
+	item.UnitCost = current.UnitCost
+
 	// Obtener orden
 	order, err := uc.orderRepo.GetByID(ctx, item.OrderID)
 	if err != nil {
 		return err
 	}
+	if err := uc.guard.CheckOrder(ctx, order); err != nil {
+		return err
+	}
 
 	// Verificar que se pueden editar items
 	if !order.CanEditItems() {
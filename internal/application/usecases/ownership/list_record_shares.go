@@ -0,0 +1,26 @@
+package ownership
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListRecordSharesUseCase lista con quién está compartida una orden o cliente
+type ListRecordSharesUseCase struct {
+	shareRepo ports.RecordShareRepository
+}
+
+// NewListRecordSharesUseCase crea una nueva instancia del caso de uso
+func NewListRecordSharesUseCase(shareRepo ports.RecordShareRepository) *ListRecordSharesUseCase {
+	return &ListRecordSharesUseCase{shareRepo: shareRepo}
+}
+
+// Execute retorna los usuarios con acceso compartido al registro
+func (uc *ListRecordSharesUseCase) Execute(ctx context.Context, resourceType entities.ShareResourceType, resourceID uint) ([]entities.RecordShare, error) {
+	if !entities.IsValidShareResource(resourceType) {
+		return nil, entities.ErrInvalidShareResource
+	}
+	return uc.shareRepo.ListByResource(ctx, resourceType, resourceID)
+}
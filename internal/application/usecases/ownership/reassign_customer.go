@@ -0,0 +1,48 @@
+package ownership
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ReassignCustomerUseCase cambia el vendedor responsable de un cliente
+type ReassignCustomerUseCase struct {
+	customerRepo ports.CustomerRepository
+	userRepo     ports.UserRepository
+	recorder     *audittrail.Recorder
+}
+
+// NewReassignCustomerUseCase crea una nueva instancia del caso de uso
+func NewReassignCustomerUseCase(customerRepo ports.CustomerRepository, userRepo ports.UserRepository, recorder *audittrail.Recorder) *ReassignCustomerUseCase {
+	return &ReassignCustomerUseCase{
+		customerRepo: customerRepo,
+		userRepo:     userRepo,
+		recorder:     recorder,
+	}
+}
+
+// Execute asigna el cliente al vendedor; sellerID nil lo deja sin asignar
+func (uc *ReassignCustomerUseCase) Execute(ctx context.Context, customerID uint, sellerID *uint) (*entities.Customer, error) {
+	if sellerID != nil {
+		if err := ensureActiveUser(ctx, uc.userRepo, *sellerID); err != nil {
+			return nil, err
+		}
+	}
+	before, err := uc.customerRepo.GetByID(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.customerRepo.UpdateAssignedSeller(ctx, customerID, sellerID); err != nil {
+		return nil, err
+	}
+
+	after, err := uc.customerRepo.GetByID(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	uc.recorder.Updated(ctx, entities.AuditEntityCustomer, customerID, before, after)
+	return after, nil
+}
@@ -0,0 +1,33 @@
+package ownership
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ReassignOrderUseCase cambia el vendedor dueño de una orden
+type ReassignOrderUseCase struct {
+	orderRepo ports.OrderRepository
+	userRepo  ports.UserRepository
+}
+
+// NewReassignOrderUseCase crea una nueva instancia del caso de uso
+func NewReassignOrderUseCase(orderRepo ports.OrderRepository, userRepo ports.UserRepository) *ReassignOrderUseCase {
+	return &ReassignOrderUseCase{
+		orderRepo: orderRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// Execute reasigna la orden; el vendedor anterior pierde el acceso salvo que se le comparta
+func (uc *ReassignOrderUseCase) Execute(ctx context.Context, orderID, sellerID uint) (*entities.Order, error) {
+	if err := ensureActiveUser(ctx, uc.userRepo, sellerID); err != nil {
+		return nil, err
+	}
+	if err := uc.orderRepo.UpdateSeller(ctx, orderID, sellerID); err != nil {
+		return nil, err
+	}
+	return uc.orderRepo.GetByID(ctx, orderID)
+}
@@ -0,0 +1,83 @@
+package ownership
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// ShareRecordUseCase comparte una orden o un cliente con otro usuario
+type ShareRecordUseCase struct {
+	shareRepo    ports.RecordShareRepository
+	userRepo     ports.UserRepository
+	orderRepo    ports.OrderRepository
+	customerRepo ports.CustomerRepository
+}
+
+// NewShareRecordUseCase crea una nueva instancia del caso de uso
+func NewShareRecordUseCase(
+	shareRepo ports.RecordShareRepository,
+	userRepo ports.UserRepository,
+	orderRepo ports.OrderRepository,
+	customerRepo ports.CustomerRepository,
+) *ShareRecordUseCase {
+	return &ShareRecordUseCase{
+		shareRepo:    shareRepo,
+		userRepo:     userRepo,
+		orderRepo:    orderRepo,
+		customerRepo: customerRepo,
+	}
+}
+
+// Execute da acceso al usuario sobre el registro (no cambia su dueño)
+func (uc *ShareRecordUseCase) Execute(ctx context.Context, resourceType entities.ShareResourceType, resourceID, userID, sharedBy uint) (*entities.RecordShare, error) {
+	if err := ensureRecordExists(ctx, uc.orderRepo, uc.customerRepo, resourceType, resourceID); err != nil {
+		return nil, err
+	}
+	if err := ensureActiveUser(ctx, uc.userRepo, userID); err != nil {
+		return nil, err
+	}
+
+	share := &entities.RecordShare{
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		UserID:       userID,
+		SharedBy:     sharedBy,
+	}
+	if err := uc.shareRepo.Share(ctx, share); err != nil {
+		return nil, err
+	}
+	return share, nil
+}
+
+// ensureRecordExists valida el tipo de registro y que exista
+func ensureRecordExists(ctx context.Context, orderRepo ports.OrderRepository, customerRepo ports.CustomerRepository, resourceType entities.ShareResourceType, resourceID uint) error {
+	var err error
+	switch resourceType {
+	case entities.ShareResourceOrder:
+		_, err = orderRepo.GetByID(ctx, resourceID)
+	case entities.ShareResourceCustomer:
+		_, err = customerRepo.GetByID(ctx, resourceID)
+	default:
+		return entities.ErrInvalidShareResource
+	}
+	return err
+}
+
+// ensureActiveUser valida que el usuario que recibe el registro exista y esté activo
+func ensureActiveUser(ctx context.Context, userRepo ports.UserRepository, userID uint) error {
+	user, err := userRepo.GetByID(ctx, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return entities.ErrInvalidOwnershipTaker
+	}
+	if err != nil {
+		return err
+	}
+	if !user.IsActive {
+		return entities.ErrInvalidOwnershipTaker
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package ownership
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// UnshareRecordUseCase retira el acceso de un usuario a una orden o cliente compartido
+type UnshareRecordUseCase struct {
+	shareRepo ports.RecordShareRepository
+}
+
+// NewUnshareRecordUseCase crea una nueva instancia del caso de uso
+func NewUnshareRecordUseCase(shareRepo ports.RecordShareRepository) *UnshareRecordUseCase {
+	return &UnshareRecordUseCase{shareRepo: shareRepo}
+}
+
+// Execute elimina el acceso compartido (el dueño del registro no se ve afectado)
+func (uc *UnshareRecordUseCase) Execute(ctx context.Context, resourceType entities.ShareResourceType, resourceID, userID uint) error {
+	if !entities.IsValidShareResource(resourceType) {
+		return entities.ErrInvalidShareResource
+	}
+	return uc.shareRepo.Unshare(ctx, resourceType, resourceID, userID)
+}
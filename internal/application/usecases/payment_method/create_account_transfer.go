@@ -0,0 +1,54 @@
+package payment_method
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/ledger"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// CreateAccountTransferUseCase registra un traslado de dinero entre dos cuentas
+type CreateAccountTransferUseCase struct {
+	paymentMethodRepo ports.PaymentMethodRepository
+	cashAccountRepo   ports.CashAccountRepository
+	poster            *ledger.Poster
+	recorder          *audittrail.Recorder
+}
+
+// NewCreateAccountTransferUseCase crea una nueva instancia del caso de uso
+func NewCreateAccountTransferUseCase(paymentMethodRepo ports.PaymentMethodRepository, cashAccountRepo ports.CashAccountRepository, poster *ledger.Poster, recorder *audittrail.Recorder) *CreateAccountTransferUseCase {
+	return &CreateAccountTransferUseCase{
+		paymentMethodRepo: paymentMethodRepo,
+		cashAccountRepo:   cashAccountRepo,
+		poster:            poster,
+		recorder:          recorder,
+	}
+}
+
+// Execute valida que ambas cuentas existan y estén activas y guarda el traslado
+// El traslado no es ingreso ni gasto: no cambia el balance financiero, solo dónde está el dinero
+func (uc *CreateAccountTransferUseCase) Execute(ctx context.Context, transfer *entities.AccountTransfer) error {
+	if err := transfer.Validate(); err != nil {
+		return err
+	}
+
+	for _, id := range []uint{transfer.FromPaymentMethodID, transfer.ToPaymentMethodID} {
+		account, err := uc.paymentMethodRepo.GetByID(id)
+		if err != nil {
+			return err
+		}
+		if !account.IsActive {
+			return entities.ErrPaymentMethodInactive
+		}
+	}
+
+	if err := uc.cashAccountRepo.CreateTransfer(ctx, transfer); err != nil {
+		return err
+	}
+
+	uc.poster.Transfer(ctx, transfer)
+	uc.recorder.Created(ctx, entities.AuditEntityAccountTransfer, transfer.ID, transfer)
+	return nil
+}
@@ -0,0 +1,41 @@
+package payment_method
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/ledger"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// CreatePaymentMethodUseCase crea un método de pago (cuenta de caja o banco)
+type CreatePaymentMethodUseCase struct {
+	repo     ports.PaymentMethodRepository
+	poster   *ledger.Poster
+	recorder *audittrail.Recorder
+}
+
+// NewCreatePaymentMethodUseCase crea una nueva instancia del caso de uso
+func NewCreatePaymentMethodUseCase(repo ports.PaymentMethodRepository, poster *ledger.Poster, recorder *audittrail.Recorder) *CreatePaymentMethodUseCase {
+	return &CreatePaymentMethodUseCase{repo: repo, poster: poster, recorder: recorder}
+}
+
+// Execute valida y guarda el método de pago como cuenta activa
+func (uc *CreatePaymentMethodUseCase) Execute(ctx context.Context, paymentMethod *entities.PaymentMethodOption) error {
+	paymentMethod.Name = strings.TrimSpace(paymentMethod.Name)
+	if err := paymentMethod.Validate(); err != nil {
+		return err
+	}
+
+	paymentMethod.IsActive = true
+	if err := uc.repo.Create(paymentMethod); err != nil {
+		return err
+	}
+
+	// Crea su cuenta contable y el asiento del saldo inicial
+	uc.poster.PaymentMethod(ctx, paymentMethod)
+	uc.recorder.Created(ctx, entities.AuditEntityPaymentMethod, paymentMethod.ID, paymentMethod)
+	return nil
+}
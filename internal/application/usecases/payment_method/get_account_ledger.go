@@ -0,0 +1,48 @@
+package payment_method
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// GetAccountLedgerUseCase obtiene los movimientos de una cuenta con su saldo corrido
+type GetAccountLedgerUseCase struct {
+	paymentMethodRepo ports.PaymentMethodRepository
+	cashAccountRepo   ports.CashAccountRepository
+}
+
+// NewGetAccountLedgerUseCase crea una nueva instancia del caso de uso
+func NewGetAccountLedgerUseCase(paymentMethodRepo ports.PaymentMethodRepository, cashAccountRepo ports.CashAccountRepository) *GetAccountLedgerUseCase {
+	return &GetAccountLedgerUseCase{
+		paymentMethodRepo: paymentMethodRepo,
+		cashAccountRepo:   cashAccountRepo,
+	}
+}
+
+// Execute retorna los movimientos del periodo [start, end] (días completos) partiendo del saldo
+// que tenía la cuenta al inicio del periodo
+func (uc *GetAccountLedgerUseCase) Execute(ctx context.Context, paymentMethodID uint, start, end time.Time) (*entities.AccountLedger, error) {
+	if end.Before(start) {
+		return nil, entities.ErrInvalidReportPeriod
+	}
+
+	account, err := uc.paymentMethodRepo.GetByID(paymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := uc.cashAccountRepo.SumBefore(ctx, paymentMethodID, start)
+	if err != nil {
+		return nil, err
+	}
+
+	movements, err := uc.cashAccountRepo.ListMovements(ctx, paymentMethodID, start, end.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.NewAccountLedger(*account, start, end, account.OpeningBalance+before, movements), nil
+}
@@ -0,0 +1,31 @@
+package payment_method
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// GetBankReconciliationUseCase obtiene el estado de conciliación de un extracto
+type GetBankReconciliationUseCase struct {
+	cashAccountRepo ports.CashAccountRepository
+	statementRepo   ports.BankStatementRepository
+}
+
+// NewGetBankReconciliationUseCase crea una nueva instancia del caso de uso
+func NewGetBankReconciliationUseCase(cashAccountRepo ports.CashAccountRepository, statementRepo ports.BankStatementRepository) *GetBankReconciliationUseCase {
+	return &GetBankReconciliationUseCase{
+		cashAccountRepo: cashAccountRepo,
+		statementRepo:   statementRepo,
+	}
+}
+
+// Execute retorna las líneas del extracto y los movimientos de la cuenta en sus fechas que siguen sin conciliar
+func (uc *GetBankReconciliationUseCase) Execute(ctx context.Context, statementID uint) (*entities.BankReconciliation, error) {
+	statement, err := uc.statementRepo.GetByID(ctx, statementID)
+	if err != nil {
+		return nil, err
+	}
+	return reconciliation(ctx, uc.cashAccountRepo, statement)
+}
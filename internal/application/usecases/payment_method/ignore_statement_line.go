@@ -0,0 +1,35 @@
+package payment_method
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// IgnoreStatementLineUseCase descarta una línea del extracto que no tiene movimiento en los libros
+type IgnoreStatementLineUseCase struct {
+	statementRepo ports.BankStatementRepository
+}
+
+// NewIgnoreStatementLineUseCase crea una nueva instancia del caso de uso
+func NewIgnoreStatementLineUseCase(statementRepo ports.BankStatementRepository) *IgnoreStatementLineUseCase {
+	return &IgnoreStatementLineUseCase{statementRepo: statementRepo}
+}
+
+// Execute marca la línea pendiente como descartada
+func (uc *IgnoreStatementLineUseCase) Execute(ctx context.Context, statementID, lineID uint) (*entities.BankStatementLine, error) {
+	line, err := findStatementLine(ctx, uc.statementRepo, statementID, lineID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := line.Ignore(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.statementRepo.UpdateLine(ctx, line); err != nil {
+		return nil, err
+	}
+	return line, nil
+}
@@ -0,0 +1,330 @@
+package payment_method
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// maxHeaderSearchRows son las filas revisadas buscando los encabezados (los bancos agregan títulos antes)
+const maxHeaderSearchRows = 15
+
+// Nombres de columna reconocidos en los extractos (en minúscula y sin tildes)
+var (
+	dateHeaders        = []string{"fecha", "date", "fecha transaccion", "fecha de transaccion", "fecha movimiento", "fecha de movimiento"}
+	descriptionHeaders = []string{"descripcion", "description", "detalle", "concepto", "movimiento", "transaccion"}
+	referenceHeaders   = []string{"referencia", "reference", "ref", "documento", "numero", "comprobante"}
+	amountHeaders      = []string{"valor", "monto", "amount", "importe", "valor transaccion"}
+	creditHeaders      = []string{"credito", "creditos", "credit", "entrada", "entradas", "ingreso", "ingresos"}
+	debitHeaders       = []string{"debito", "debitos", "debit", "salida", "salidas", "egreso", "egresos", "retiro", "retiros"}
+)
+
+// Formatos de fecha aceptados; en Colombia el día va antes del mes
+var statementDateFormats = []string{
+	"2006-01-02",
+	"02/01/2006",
+	"2/1/2006",
+	"02-01-2006",
+	"2006/01/02",
+	"02/01/06",
+}
+
+// ImportBankStatementUseCase importa el extracto CSV de una cuenta y lo cruza con sus movimientos
+type ImportBankStatementUseCase struct {
+	paymentMethodRepo ports.PaymentMethodRepository
+	cashAccountRepo   ports.CashAccountRepository
+	statementRepo     ports.BankStatementRepository
+}
+
+// NewImportBankStatementUseCase crea una nueva instancia del caso de uso
+func NewImportBankStatementUseCase(
+	paymentMethodRepo ports.PaymentMethodRepository,
+	cashAccountRepo ports.CashAccountRepository,
+	statementRepo ports.BankStatementRepository,
+) *ImportBankStatementUseCase {
+	return &ImportBankStatementUseCase{
+		paymentMethodRepo: paymentMethodRepo,
+		cashAccountRepo:   cashAccountRepo,
+		statementRepo:     statementRepo,
+	}
+}
+
+// Execute lee el extracto, cruza automáticamente cada línea con un movimiento de la cuenta del mismo valor
+// y fecha cercana, y retorna el estado de la conciliación
+func (uc *ImportBankStatementUseCase) Execute(ctx context.Context, paymentMethodID uint, fileName string, data []byte, importedByID *uint) (*entities.BankReconciliation, error) {
+	if _, err := uc.paymentMethodRepo.GetByID(paymentMethodID); err != nil {
+		return nil, err
+	}
+
+	lines, err := parseStatementCSV(data)
+	if err != nil {
+		return nil, err
+	}
+
+	statement := &entities.BankStatement{
+		PaymentMethodID: paymentMethodID,
+		FileName:        fileName,
+		StartDate:       lines[0].Date,
+		EndDate:         lines[0].Date,
+		ImportedByID:    importedByID,
+		Lines:           lines,
+	}
+	for i := range statement.Lines {
+		line := &statement.Lines[i]
+		line.PaymentMethodID = paymentMethodID
+		if line.Date.Before(statement.StartDate) {
+			statement.StartDate = line.Date
+		}
+		if line.Date.After(statement.EndDate) {
+			statement.EndDate = line.Date
+		}
+	}
+
+	// Se buscan movimientos unos días antes y después del extracto por la diferencia de fechas aceptada
+	tolerance := entities.StatementMatchToleranceDays
+	candidates, err := uc.cashAccountRepo.ListMovements(ctx, paymentMethodID,
+		statement.StartDate.AddDate(0, 0, -tolerance), statement.EndDate.AddDate(0, 0, tolerance+1))
+	if err != nil {
+		return nil, err
+	}
+	entities.AutoMatchStatement(statement.Lines, candidates, time.Now())
+
+	if err := uc.statementRepo.Create(ctx, statement); err != nil {
+		return nil, err
+	}
+
+	return reconciliation(ctx, uc.cashAccountRepo, statement)
+}
+
+// reconciliation arma el estado de la conciliación con los movimientos de la cuenta en las fechas del extracto
+func reconciliation(ctx context.Context, cashAccountRepo ports.CashAccountRepository, statement *entities.BankStatement) (*entities.BankReconciliation, error) {
+	movements, err := cashAccountRepo.ListMovements(ctx, statement.PaymentMethodID,
+		statement.StartDate, statement.EndDate.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	return entities.NewBankReconciliation(*statement, movements), nil
+}
+
+// parseStatementCSV lee las líneas del extracto; las filas sin fecha o valor válidos (títulos, saldos) se omiten
+func parseStatementCSV(data []byte) ([]entities.BankStatementLine, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = detectDelimiter(data)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	var columns *statementColumns
+	lines := []entities.BankStatementLine{}
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, entities.ErrInvalidStatementFile
+		}
+
+		if columns == nil {
+			columns = findStatementColumns(record)
+			if columns == nil && row >= maxHeaderSearchRows {
+				return nil, entities.ErrInvalidStatementFile
+			}
+			continue
+		}
+
+		line, ok := columns.parse(record)
+		if !ok {
+			continue
+		}
+		line.LineNumber = row
+		line.Status = entities.StatementLineUnmatched
+		lines = append(lines, line)
+	}
+
+	if len(lines) == 0 {
+		return nil, entities.ErrInvalidStatementFile
+	}
+	return lines, nil
+}
+
+// detectDelimiter usa punto y coma cuando la primera línea tiene más ; que comas (Excel en español)
+func detectDelimiter(data []byte) rune {
+	firstLine := data
+	if index := bytes.IndexByte(data, '\n'); index >= 0 {
+		firstLine = data[:index]
+	}
+	if bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+		return ';'
+	}
+	return ','
+}
+
+// statementColumns son las posiciones de las columnas del extracto (-1 si no existe)
+type statementColumns struct {
+	date, description, reference, amount, credit, debit int
+}
+
+// findStatementColumns reconoce la fila de encabezados; retorna nil si la fila no lo es
+func findStatementColumns(record []string) *statementColumns {
+	columns := &statementColumns{date: -1, description: -1, reference: -1, amount: -1, credit: -1, debit: -1}
+	for i, value := range record {
+		header := normalizeHeader(value)
+		switch {
+		case columns.date == -1 && containsHeader(dateHeaders, header):
+			columns.date = i
+		case columns.description == -1 && containsHeader(descriptionHeaders, header):
+			columns.description = i
+		case columns.reference == -1 && containsHeader(referenceHeaders, header):
+			columns.reference = i
+		case columns.amount == -1 && containsHeader(amountHeaders, header):
+			columns.amount = i
+		case columns.credit == -1 && containsHeader(creditHeaders, header):
+			columns.credit = i
+		case columns.debit == -1 && containsHeader(debitHeaders, header):
+			columns.debit = i
+		}
+	}
+
+	if columns.date == -1 || (columns.amount == -1 && columns.credit == -1 && columns.debit == -1) {
+		return nil
+	}
+	return columns
+}
+
+// parse convierte una fila en línea del extracto; ok es false si la fila no es un movimiento
+func (c *statementColumns) parse(record []string) (entities.BankStatementLine, bool) {
+	date, ok := parseStatementDate(cell(record, c.date))
+	if !ok {
+		return entities.BankStatementLine{}, false
+	}
+
+	var amount float64
+	if c.amount != -1 {
+		amount, ok = parseStatementAmount(cell(record, c.amount))
+		if !ok {
+			return entities.BankStatementLine{}, false
+		}
+	} else {
+		credit, _ := parseStatementAmount(cell(record, c.credit))
+		debit, _ := parseStatementAmount(cell(record, c.debit))
+		if credit < 0 {
+			credit = -credit
+		}
+		if debit < 0 {
+			debit = -debit
+		}
+		amount = credit - debit
+	}
+	if amount == 0 {
+		return entities.BankStatementLine{}, false
+	}
+
+	return entities.BankStatementLine{
+		Date:        date,
+		Description: strings.TrimSpace(cell(record, c.description)),
+		Reference:   strings.TrimSpace(cell(record, c.reference)),
+		Amount:      amount,
+	}, true
+}
+
+func cell(record []string, index int) string {
+	if index < 0 || index >= len(record) {
+		return ""
+	}
+	return record[index]
+}
+
+func normalizeHeader(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	return strings.NewReplacer("á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ñ", "n", ".", "").Replace(value)
+}
+
+func containsHeader(headers []string, header string) bool {
+	for _, candidate := range headers {
+		if candidate == header {
+			return true
+		}
+	}
+	return false
+}
+
+// parseStatementDate acepta las fechas con o sin hora (se toma solo el día)
+func parseStatementDate(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if index := strings.IndexAny(value, " T"); index > 0 {
+		value = value[:index]
+	}
+	for _, format := range statementDateFormats {
+		if date, err := time.ParseInLocation(format, value, time.Local); err == nil {
+			return date, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseStatementAmount acepta "$ 1.234.567,89", "1,234,567.89", "-50000" y "(50.000)" (negativo)
+// Con un solo tipo de separador, se toma como decimal solo si le siguen uno o dos dígitos
+func parseStatementAmount(value string) (float64, bool) {
+	value = strings.NewReplacer("$", "", "COP", "", " ", "", "\u00a0", "").Replace(strings.TrimSpace(value))
+	if value == "" {
+		return 0, false
+	}
+
+	negative := false
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		negative = true
+		value = strings.Trim(value, "()")
+	}
+	if strings.HasPrefix(value, "-") {
+		negative = !negative
+		value = value[1:]
+	}
+
+	lastDot := strings.LastIndex(value, ".")
+	lastComma := strings.LastIndex(value, ",")
+	decimal := -1
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		decimal = lastDot
+		if lastComma > lastDot {
+			decimal = lastComma
+		}
+	case lastDot >= 0 && strings.Count(value, ".") == 1 && len(value)-lastDot-1 <= 2:
+		decimal = lastDot
+	case lastComma >= 0 && strings.Count(value, ",") == 1 && len(value)-lastComma-1 <= 2:
+		decimal = lastComma
+	}
+
+	integerPart, fractionPart := value, ""
+	if decimal >= 0 {
+		integerPart, fractionPart = value[:decimal], value[decimal+1:]
+	}
+	integerPart = strings.NewReplacer(".", "", ",", "").Replace(integerPart)
+	if integerPart == "" {
+		integerPart = "0"
+	}
+	normalized := integerPart
+	if fractionPart != "" {
+		normalized += "." + fractionPart
+	}
+
+	amount, err := strconv.ParseFloat(normalized, 64)
+	if err != nil {
+		return 0, false
+	}
+	if negative {
+		amount = -amount
+	}
+	return amount, true
+}
@@ -0,0 +1,36 @@
+package payment_method
+
+import "testing"
+
+func TestParseStatementAmount(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   float64
+		wantOK bool
+	}{
+		{"$ 1.234.567,89", 1234567.89, true},
+		{"1,234,567.89", 1234567.89, true},
+		{"-50000", -50000, true},
+		{"(50.000)", -50000, true},
+		{"50.000", 50000, true},
+		{"1.000.000", 1000000, true},
+		{"1,234", 1234, true},
+		{"50,5", 50.5, true},
+		{"1.5", 1.5, true},
+		{"COP 12.345", 12345, true},
+		{"$ 1 234,50", 1234.5, true},
+		{"\u00a01.000,00", 1000, true},
+		{",75", 0.75, true},
+		{"", 0, false},
+		{"   ", 0, false},
+		{"abc", 0, false},
+		{"12a", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseStatementAmount(tt.value)
+		if ok != tt.wantOK || got != tt.want {
+			t.Errorf("parseStatementAmount(%q) = (%v, %v), want (%v, %v)", tt.value, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
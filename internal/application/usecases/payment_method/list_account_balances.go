@@ -0,0 +1,41 @@
+package payment_method
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListAccountBalancesUseCase calcula el saldo actual de cada cuenta
+type ListAccountBalancesUseCase struct {
+	paymentMethodRepo ports.PaymentMethodRepository
+	cashAccountRepo   ports.CashAccountRepository
+}
+
+// NewListAccountBalancesUseCase crea una nueva instancia del caso de uso
+func NewListAccountBalancesUseCase(paymentMethodRepo ports.PaymentMethodRepository, cashAccountRepo ports.CashAccountRepository) *ListAccountBalancesUseCase {
+	return &ListAccountBalancesUseCase{
+		paymentMethodRepo: paymentMethodRepo,
+		cashAccountRepo:   cashAccountRepo,
+	}
+}
+
+// Execute retorna el saldo de las cuentas (solo las activas si activeOnly)
+func (uc *ListAccountBalancesUseCase) Execute(ctx context.Context, activeOnly bool) ([]entities.AccountBalance, error) {
+	accounts, err := uc.paymentMethodRepo.List(activeOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := uc.cashAccountRepo.SumByAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]entities.AccountBalance, len(accounts))
+	for i, account := range accounts {
+		balances[i] = entities.NewAccountBalance(*account, totals[account.ID])
+	}
+	return balances, nil
+}
@@ -0,0 +1,27 @@
+package payment_method
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListAccountTransfersUseCase lista los traslados entre cuentas de un periodo
+type ListAccountTransfersUseCase struct {
+	cashAccountRepo ports.CashAccountRepository
+}
+
+// NewListAccountTransfersUseCase crea una nueva instancia del caso de uso
+func NewListAccountTransfersUseCase(cashAccountRepo ports.CashAccountRepository) *ListAccountTransfersUseCase {
+	return &ListAccountTransfersUseCase{cashAccountRepo: cashAccountRepo}
+}
+
+// Execute retorna los traslados del periodo [start, end] (días completos), opcionalmente de una cuenta
+func (uc *ListAccountTransfersUseCase) Execute(ctx context.Context, paymentMethodID *uint, start, end time.Time) ([]entities.AccountTransfer, error) {
+	if end.Before(start) {
+		return nil, entities.ErrInvalidReportPeriod
+	}
+	return uc.cashAccountRepo.ListTransfers(ctx, paymentMethodID, start, end.AddDate(0, 0, 1))
+}
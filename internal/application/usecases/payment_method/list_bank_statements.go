@@ -0,0 +1,23 @@
+package payment_method
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListBankStatementsUseCase lista los extractos importados de una cuenta
+type ListBankStatementsUseCase struct {
+	statementRepo ports.BankStatementRepository
+}
+
+// NewListBankStatementsUseCase crea una nueva instancia del caso de uso
+func NewListBankStatementsUseCase(statementRepo ports.BankStatementRepository) *ListBankStatementsUseCase {
+	return &ListBankStatementsUseCase{statementRepo: statementRepo}
+}
+
+// Execute retorna los extractos de la cuenta, del más reciente al más antiguo
+func (uc *ListBankStatementsUseCase) Execute(ctx context.Context, paymentMethodID uint) ([]entities.BankStatement, error) {
+	return uc.statementRepo.ListByPaymentMethod(ctx, paymentMethodID)
+}
@@ -0,0 +1,59 @@
+package payment_method
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// MatchStatementLineUseCase cruza a mano una línea del extracto con un movimiento de la cuenta
+type MatchStatementLineUseCase struct {
+	cashAccountRepo ports.CashAccountRepository
+	statementRepo   ports.BankStatementRepository
+}
+
+// NewMatchStatementLineUseCase crea una nueva instancia del caso de uso
+func NewMatchStatementLineUseCase(cashAccountRepo ports.CashAccountRepository, statementRepo ports.BankStatementRepository) *MatchStatementLineUseCase {
+	return &MatchStatementLineUseCase{
+		cashAccountRepo: cashAccountRepo,
+		statementRepo:   statementRepo,
+	}
+}
+
+// Execute cruza la línea con el ABONO, la transacción financiera o el traslado indicado
+// El movimiento debe ser de la misma cuenta, tener el mismo valor y no estar cruzado con otra línea
+func (uc *MatchStatementLineUseCase) Execute(ctx context.Context, statementID, lineID uint, source entities.AccountMovementSource, sourceID uint) (*entities.BankStatementLine, error) {
+	line, err := findStatementLine(ctx, uc.statementRepo, statementID, lineID)
+	if err != nil {
+		return nil, err
+	}
+
+	movement, err := uc.cashAccountRepo.GetMovement(ctx, line.PaymentMethodID, source, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := line.Match(*movement, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.statementRepo.UpdateLine(ctx, line); err != nil {
+		return nil, err
+	}
+	return line, nil
+}
+
+// findStatementLine obtiene la línea del extracto
+func findStatementLine(ctx context.Context, statementRepo ports.BankStatementRepository, statementID, lineID uint) (*entities.BankStatementLine, error) {
+	statement, err := statementRepo.GetByID(ctx, statementID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range statement.Lines {
+		if statement.Lines[i].ID == lineID {
+			return &statement.Lines[i], nil
+		}
+	}
+	return nil, entities.ErrStatementLineNotFound
+}
@@ -0,0 +1,32 @@
+package payment_method
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ResetStatementLineUseCase deshace el cruce o el descarte de una línea del extracto
+type ResetStatementLineUseCase struct {
+	statementRepo ports.BankStatementRepository
+}
+
+// NewResetStatementLineUseCase crea una nueva instancia del caso de uso
+func NewResetStatementLineUseCase(statementRepo ports.BankStatementRepository) *ResetStatementLineUseCase {
+	return &ResetStatementLineUseCase{statementRepo: statementRepo}
+}
+
+// Execute deja la línea pendiente; el movimiento que tenía cruzado vuelve a quedar sin conciliar
+func (uc *ResetStatementLineUseCase) Execute(ctx context.Context, statementID, lineID uint) (*entities.BankStatementLine, error) {
+	line, err := findStatementLine(ctx, uc.statementRepo, statementID, lineID)
+	if err != nil {
+		return nil, err
+	}
+
+	line.Reset()
+	if err := uc.statementRepo.UpdateLine(ctx, line); err != nil {
+		return nil, err
+	}
+	return line, nil
+}
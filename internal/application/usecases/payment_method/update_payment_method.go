@@ -0,0 +1,46 @@
+package payment_method
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/ledger"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// UpdatePaymentMethodUseCase actualiza el nombre, el estado o el saldo inicial de una cuenta
+type UpdatePaymentMethodUseCase struct {
+	repo     ports.PaymentMethodRepository
+	poster   *ledger.Poster
+	recorder *audittrail.Recorder
+}
+
+// NewUpdatePaymentMethodUseCase crea una nueva instancia del caso de uso
+func NewUpdatePaymentMethodUseCase(repo ports.PaymentMethodRepository, poster *ledger.Poster, recorder *audittrail.Recorder) *UpdatePaymentMethodUseCase {
+	return &UpdatePaymentMethodUseCase{repo: repo, poster: poster, recorder: recorder}
+}
+
+// Execute valida y guarda los cambios; desactivar la cuenta conserva sus movimientos
+func (uc *UpdatePaymentMethodUseCase) Execute(ctx context.Context, paymentMethod *entities.PaymentMethodOption) error {
+	existing, err := uc.repo.GetByID(paymentMethod.ID)
+	if err != nil {
+		return err
+	}
+
+	paymentMethod.Name = strings.TrimSpace(paymentMethod.Name)
+	if err := paymentMethod.Validate(); err != nil {
+		return err
+	}
+
+	paymentMethod.CreatedAt = existing.CreatedAt
+	if err := uc.repo.Update(paymentMethod); err != nil {
+		return err
+	}
+
+	// Si cambió el saldo inicial su asiento se anula y se reemplaza
+	uc.poster.PaymentMethod(ctx, paymentMethod)
+	uc.recorder.Updated(ctx, entities.AuditEntityPaymentMethod, paymentMethod.ID, existing, paymentMethod)
+	return nil
+}
@@ -0,0 +1,40 @@
+package portal
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/opaquetoken"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// issueAccessLink genera un token aleatorio, guarda su hash y retorna la URL del enlace
+func issueAccessLink(
+	ctx context.Context,
+	linkRepo ports.CustomerAccessLinkRepository,
+	customerID uint,
+	portalURL string,
+	expiry time.Duration,
+) (string, *entities.CustomerAccessLink, error) {
+	token, err := opaquetoken.New()
+	if err != nil {
+		return "", nil, err
+	}
+
+	link := &entities.CustomerAccessLink{
+		CustomerID: customerID,
+		TokenHash:  opaquetoken.Hash(token),
+		ExpiresAt:  time.Now().Add(expiry),
+	}
+	if err := linkRepo.Create(ctx, link); err != nil {
+		return "", nil, err
+	}
+
+	linkURL, err := opaquetoken.Link(portalURL, token)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return linkURL, link, nil
+}
@@ -0,0 +1,58 @@
+package portal
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// CreateAccessLinkUseCase genera un enlace de acceso para que un usuario interno
+// lo comparta con el cliente (por ejemplo por WhatsApp)
+type CreateAccessLinkUseCase struct {
+	customerRepo ports.CustomerRepository
+	linkRepo     ports.CustomerAccessLinkRepository
+	guard        *access.Guard
+	portalURL    string
+	linkExpiry   time.Duration
+}
+
+// NewCreateAccessLinkUseCase crea una nueva instancia del caso de uso
+func NewCreateAccessLinkUseCase(
+	customerRepo ports.CustomerRepository,
+	linkRepo ports.CustomerAccessLinkRepository,
+	guard *access.Guard,
+	portalURL string,
+	linkExpiry time.Duration,
+) *CreateAccessLinkUseCase {
+	return &CreateAccessLinkUseCase{
+		customerRepo: customerRepo,
+		linkRepo:     linkRepo,
+		guard:        guard,
+		portalURL:    portalURL,
+		linkExpiry:   linkExpiry,
+	}
+}
+
+// Execute retorna la URL del enlace y su fecha de vencimiento
+func (uc *CreateAccessLinkUseCase) Execute(ctx context.Context, customerID uint) (string, time.Time, error) {
+	customer, err := uc.customerRepo.GetByID(ctx, customerID)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if err := uc.guard.CheckCustomer(ctx, customer); err != nil {
+		return "", time.Time{}, err
+	}
+	if !customer.IsActive {
+		return "", time.Time{}, errors.New("customer is inactive")
+	}
+
+	linkURL, link, err := issueAccessLink(ctx, uc.linkRepo, customer.ID, uc.portalURL, uc.linkExpiry)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return linkURL, link.ExpiresAt, nil
+}
@@ -0,0 +1,35 @@
+package portal
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ErrOrderNotFound se retorna también cuando la orden pertenece a otro cliente,
+// para no revelar que existe
+var ErrOrderNotFound = errors.New("order not found")
+
+// GetOrderUseCase obtiene una orden del cliente autenticado
+type GetOrderUseCase struct {
+	orderRepo ports.OrderRepository
+}
+
+// NewGetOrderUseCase crea una nueva instancia del caso de uso
+func NewGetOrderUseCase(orderRepo ports.OrderRepository) *GetOrderUseCase {
+	return &GetOrderUseCase{orderRepo: orderRepo}
+}
+
+// Execute retorna la orden solo si pertenece al cliente dado
+func (uc *GetOrderUseCase) Execute(ctx context.Context, customerID, orderID uint) (*entities.Order, error) {
+	order, err := uc.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, ErrOrderNotFound
+	}
+	if order.CustomerID == nil || *order.CustomerID != customerID {
+		return nil, ErrOrderNotFound
+	}
+	return order, nil
+}
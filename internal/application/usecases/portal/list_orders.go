@@ -0,0 +1,25 @@
+package portal
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListOrdersUseCase lista las órdenes del cliente autenticado
+type ListOrdersUseCase struct {
+	orderRepo ports.OrderRepository
+}
+
+// NewListOrdersUseCase crea una nueva instancia del caso de uso
+func NewListOrdersUseCase(orderRepo ports.OrderRepository) *ListOrdersUseCase {
+	return &ListOrdersUseCase{orderRepo: orderRepo}
+}
+
+// Execute retorna solo las órdenes del cliente dado
+func (uc *ListOrdersUseCase) Execute(ctx context.Context, customerID uint) ([]entities.Order, error) {
+	return uc.orderRepo.List(ctx, map[string]interface{}{
+		"customer_id": customerID,
+	})
+}
@@ -0,0 +1,102 @@
+package portal
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/throttle"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// LinkRequestLimits define cuántos enlaces se pueden pedir por teléfono y por IP
+type LinkRequestLimits struct {
+	Phone entities.LockoutPolicy
+	IP    entities.LockoutPolicy
+}
+
+// RequestAccessLinkUseCase envía un enlace mágico al teléfono del cliente
+type RequestAccessLinkUseCase struct {
+	customerRepo ports.CustomerRepository
+	linkRepo     ports.CustomerAccessLinkRepository
+	sender       ports.MessageSender
+	limiter      *throttle.Limiter
+	limits       LinkRequestLimits
+	portalURL    string
+	linkExpiry   time.Duration
+}
+
+// NewRequestAccessLinkUseCase crea una nueva instancia del caso de uso
+func NewRequestAccessLinkUseCase(
+	customerRepo ports.CustomerRepository,
+	linkRepo ports.CustomerAccessLinkRepository,
+	sender ports.MessageSender,
+	limiter *throttle.Limiter,
+	limits LinkRequestLimits,
+	portalURL string,
+	linkExpiry time.Duration,
+) *RequestAccessLinkUseCase {
+	return &RequestAccessLinkUseCase{
+		customerRepo: customerRepo,
+		linkRepo:     linkRepo,
+		sender:       sender,
+		limiter:      limiter,
+		limits:       limits,
+		portalURL:    portalURL,
+		linkExpiry:   linkExpiry,
+	}
+}
+
+// Execute busca los clientes con el teléfono dado y les envía un enlace de acceso
+// No indica si el teléfono existe, para no revelar qué números son clientes: los envíos fallidos
+// y las solicitudes que superan el límite por teléfono o IP solo se registran en el log
+func (uc *RequestAccessLinkUseCase) Execute(ctx context.Context, phone, ipAddress string) error {
+	digits := entities.NormalizeColombianPhone(phone)
+	if len(digits) < 7 {
+		return entities.ErrInvalidPortalPhone
+	}
+
+	allowed, err := uc.limiter.Allow(ctx, time.Now(),
+		throttle.Limit{Scope: entities.LoginThrottlePortalPhone, Key: digits, Policy: uc.limits.Phone},
+		throttle.Limit{Scope: entities.LoginThrottlePortalIP, Key: ipAddress, Policy: uc.limits.IP},
+	)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		log.Printf("🔒 [PORTAL] Access link request throttled for phone %s from %s", digits, ipAddress)
+		return nil
+	}
+
+	customers, err := uc.customerRepo.ListByPhone(ctx, digits)
+	if err != nil {
+		return err
+	}
+
+	for _, customer := range customers {
+		linkURL, _, err := issueAccessLink(ctx, uc.linkRepo, customer.ID, uc.portalURL, uc.linkExpiry)
+		if err != nil {
+			log.Printf("❌ [PORTAL] Failed to issue access link for customer #%d: %v", customer.ID, err)
+			continue
+		}
+
+		message := fmt.Sprintf("Hola %s, ingresa a tu cuenta de Fashion Blue con este enlace (válido por %d minutos): %s",
+			firstName(customer.Name), int(uc.linkExpiry.Minutes()), linkURL)
+		if err := uc.sender.Send(ctx, customer.Phone, message); err != nil {
+			log.Printf("❌ [PORTAL] Failed to send access link to customer #%d: %v", customer.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// firstName retorna el primer nombre del cliente
+func firstName(name string) string {
+	if parts := strings.Fields(name); len(parts) > 0 {
+		return parts[0]
+	}
+	return name
+}
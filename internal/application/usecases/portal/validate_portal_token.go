@@ -0,0 +1,66 @@
+package portal
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ValidatePortalTokenUseCase valida los tokens de sesión del portal de clientes
+type ValidatePortalTokenUseCase struct {
+	customerRepo ports.CustomerRepository
+	jwtSecret    string
+}
+
+// NewValidatePortalTokenUseCase crea una nueva instancia del caso de uso
+func NewValidatePortalTokenUseCase(customerRepo ports.CustomerRepository, jwtSecret string) *ValidatePortalTokenUseCase {
+	return &ValidatePortalTokenUseCase{
+		customerRepo: customerRepo,
+		jwtSecret:    jwtSecret,
+	}
+}
+
+// Execute retorna el cliente dueño del token; rechaza tokens de usuarios internos
+func (uc *ValidatePortalTokenUseCase) Execute(ctx context.Context, tokenString string) (*entities.Customer, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return []byte(uc.jwtSecret), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("invalid token claims")
+	}
+
+	if scope, _ := claims["scope"].(string); scope != entities.CustomerPortalScope {
+		return nil, errors.New("token is not a customer portal token")
+	}
+
+	customerID, ok := claims["customer_id"].(float64)
+	if !ok {
+		return nil, errors.New("invalid customer_id in token")
+	}
+
+	customer, err := uc.customerRepo.GetByID(ctx, uint(customerID))
+	if err != nil {
+		return nil, errors.New("customer not found")
+	}
+
+	if !customer.IsActive {
+		return nil, errors.New("customer is inactive")
+	}
+
+	return customer, nil
+}
@@ -0,0 +1,64 @@
+package portal
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/opaquetoken"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// VerifyAccessLinkUseCase canjea un enlace mágico por un token de sesión del portal
+type VerifyAccessLinkUseCase struct {
+	linkRepo      ports.CustomerAccessLinkRepository
+	customerRepo  ports.CustomerRepository
+	jwtSecret     string
+	sessionExpiry time.Duration
+}
+
+// NewVerifyAccessLinkUseCase crea una nueva instancia del caso de uso
+func NewVerifyAccessLinkUseCase(
+	linkRepo ports.CustomerAccessLinkRepository,
+	customerRepo ports.CustomerRepository,
+	jwtSecret string,
+	sessionExpiry time.Duration,
+) *VerifyAccessLinkUseCase {
+	return &VerifyAccessLinkUseCase{
+		linkRepo:      linkRepo,
+		customerRepo:  customerRepo,
+		jwtSecret:     jwtSecret,
+		sessionExpiry: sessionExpiry,
+	}
+}
+
+// Execute valida el token del enlace (un solo uso) y emite el JWT del cliente
+func (uc *VerifyAccessLinkUseCase) Execute(ctx context.Context, token string) (string, *entities.Customer, error) {
+	link, err := uc.linkRepo.GetByTokenHash(ctx, opaquetoken.Hash(token))
+	if err != nil || !link.IsValid(time.Now()) {
+		return "", nil, entities.ErrInvalidAccessLink
+	}
+
+	if err := uc.linkRepo.MarkUsed(ctx, link.ID); err != nil {
+		return "", nil, err
+	}
+
+	customer, err := uc.customerRepo.GetByID(ctx, link.CustomerID)
+	if err != nil || !customer.IsActive {
+		return "", nil, entities.ErrInvalidAccessLink
+	}
+
+	claims := jwt.MapClaims{
+		"customer_id": customer.ID,
+		"scope":       entities.CustomerPortalScope,
+		"exp":         time.Now().Add(uc.sessionExpiry).Unix(),
+		"iat":         time.Now().Unix(),
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(uc.jwtSecret))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return signed, customer, nil
+}
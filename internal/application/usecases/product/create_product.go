@@ -3,6 +3,7 @@ package product
 import (
 	"context"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
@@ -10,15 +11,18 @@ import (
 type CreateProductUseCase struct {
 	productRepo        ports.ProductRepository
 	productVariantRepo ports.ProductVariantRepository
+	recorder           *audittrail.Recorder
 }
 
 func NewCreateProductUseCase(
 	productRepo ports.ProductRepository,
 	productVariantRepo ports.ProductVariantRepository,
+	recorder *audittrail.Recorder,
 ) *CreateProductUseCase {
 	return &CreateProductUseCase{
 		productRepo:        productRepo,
 		productVariantRepo: productVariantRepo,
+		recorder:           recorder,
 	}
 }
 
@@ -43,5 +47,10 @@ func (uc *CreateProductUseCase) Execute(ctx context.Context, product *entities.P
 		return err
 	}
 
+	uc.recorder.Created(ctx, entities.AuditEntityProduct, product.ID, product)
+	for i := range product.Variants {
+		uc.recorder.Created(ctx, entities.AuditEntityProductVariant, product.Variants[i].ID, &product.Variants[i])
+	}
+
 	return nil
 }
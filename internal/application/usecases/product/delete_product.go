@@ -3,21 +3,29 @@ package product
 import (
 	"context"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 type DeleteProductUseCase struct {
 	productRepo ports.ProductRepository
+	recorder    *audittrail.Recorder
 }
 
-func NewDeleteProductUseCase(productRepo ports.ProductRepository) *DeleteProductUseCase {
-	return &DeleteProductUseCase{productRepo: productRepo}
+func NewDeleteProductUseCase(productRepo ports.ProductRepository, recorder *audittrail.Recorder) *DeleteProductUseCase {
+	return &DeleteProductUseCase{productRepo: productRepo, recorder: recorder}
 }
 
 func (uc *DeleteProductUseCase) Execute(ctx context.Context, id uint) error {
-	_, err := uc.productRepo.GetByID(ctx, id)
+	product, err := uc.productRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
-	return uc.productRepo.Delete(ctx, id)
+	if err := uc.productRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.recorder.Deleted(ctx, entities.AuditEntityProduct, id, product)
+	return nil
 }
@@ -3,19 +3,49 @@ package product
 import (
 	"context"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 type UpdateProductUseCase struct {
 	productRepo ports.ProductRepository
+	recorder    *audittrail.Recorder
 }
 
-func NewUpdateProductUseCase(productRepo ports.ProductRepository) *UpdateProductUseCase {
-	return &UpdateProductUseCase{productRepo: productRepo}
+func NewUpdateProductUseCase(productRepo ports.ProductRepository, recorder *audittrail.Recorder) *UpdateProductUseCase {
+	return &UpdateProductUseCase{productRepo: productRepo, recorder: recorder}
 }
 
 func (uc *UpdateProductUseCase) Execute(ctx context.Context, product *entities.Product) error {
+	before, err := uc.productRepo.GetByID(ctx, product.ID)
+	if err != nil {
+		return err
+	}
+
 	product.CalculateProductionCost()
-	return uc.productRepo.Update(ctx, product)
+	if err := uc.productRepo.Update(ctx, product); err != nil {
+		return err
+	}
+
+	uc.recorder.Updated(ctx, entities.AuditEntityProduct, product.ID, before, product)
+	uc.recordVariants(ctx, before.Variants, product.Variants)
+	return nil
+}
+
+// recordVariants registra los cambios de cada variante (precio, stock, color) como su propia entidad
+func (uc *UpdateProductUseCase) recordVariants(ctx context.Context, before, after []entities.ProductVariant) {
+	previous := make(map[uint]*entities.ProductVariant, len(before))
+	for i := range before {
+		previous[before[i].ID] = &before[i]
+	}
+
+	for i := range after {
+		variant := &after[i]
+		if old, exists := previous[variant.ID]; exists {
+			uc.recorder.Updated(ctx, entities.AuditEntityProductVariant, variant.ID, old, variant)
+		} else {
+			uc.recorder.Created(ctx, entities.AuditEntityProductVariant, variant.ID, variant)
+		}
+	}
 }
@@ -0,0 +1,213 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/xlsx"
+	"github.com/jung-kurt/gofpdf"
+)
+
+// ExportMarginReportUseCase exporta el reporte de márgenes como CSV, XLSX o PDF
+type ExportMarginReportUseCase struct {
+	getMarginReportUC *GetMarginReportUseCase
+}
+
+// NewExportMarginReportUseCase crea una nueva instancia del caso de uso
+func NewExportMarginReportUseCase(getMarginReportUC *GetMarginReportUseCase) *ExportMarginReportUseCase {
+	return &ExportMarginReportUseCase{getMarginReportUC: getMarginReportUC}
+}
+
+// Execute genera el reporte y lo convierte al formato pedido
+func (uc *ExportMarginReportUseCase) Execute(ctx context.Context, filters entities.MarginReportFilters, format string) (*ExportResponse, error) {
+	format, err := normalizeFormat(format)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := uc.getMarginReportUC.Execute(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	filename := fmt.Sprintf("margenes_%s_%s_%s.%s",
+		strings.ToLower(string(report.GroupBy)),
+		report.StartDate.Format("20060102"),
+		report.EndDate.Format("20060102"),
+		format,
+	)
+
+	var content []byte
+	switch format {
+	case FormatCSV:
+		content, err = marginReportCSV(report)
+	case FormatXLSX:
+		content, err = marginReportXLSX(report)
+	default:
+		content, err = marginReportPDF(report)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newExportResponse(format, filename, content), nil
+}
+
+func marginReportColumns(report *entities.MarginReport) []string {
+	return []string{
+		groupingLabel(report),
+		"Unidades",
+		"Órdenes",
+		"Ventas",
+		"Descuentos",
+		"Venta neta",
+		"Costo",
+		"Utilidad bruta",
+		"Margen %",
+		"Unidades sin costo",
+	}
+}
+
+func marginReportCSV(report *entities.MarginReport) ([]byte, error) {
+	buf, w := newCSV()
+	if err := w.Write(marginReportColumns(report)); err != nil {
+		return nil, err
+	}
+
+	lines := append(report.Lines, report.Totals)
+	for _, line := range lines {
+		if err := w.Write([]string{
+			line.Label,
+			strconv.Itoa(line.Quantity),
+			strconv.Itoa(line.Orders),
+			formatDecimal(line.Revenue),
+			formatDecimal(line.Discount),
+			formatDecimal(line.NetRevenue),
+			formatDecimal(line.Cost),
+			formatDecimal(line.GrossProfit),
+			formatChange(line.MarginPercent),
+			strconv.Itoa(line.UncostedQuantity),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func marginReportXLSX(report *entities.MarginReport) ([]byte, error) {
+	sheet := xlsx.NewSheet("Márgenes")
+	sheet.AddRow("Reporte de márgenes por " + strings.ToLower(groupingLabel(report)))
+	sheet.AddRow("Periodo", periodLabel(report.StartDate, report.EndDate))
+	sheet.AddRow()
+	sheet.AddHeader(marginReportColumns(report)...)
+
+	lines := append(report.Lines, report.Totals)
+	for _, line := range lines {
+		sheet.AddRow(
+			line.Label,
+			line.Quantity,
+			line.Orders,
+			roundTwo(line.Revenue),
+			roundTwo(line.Discount),
+			roundTwo(line.NetRevenue),
+			roundTwo(line.Cost),
+			roundTwo(line.GrossProfit),
+			roundedChange(line.MarginPercent),
+			line.UncostedQuantity,
+		)
+	}
+
+	return sheet.Bytes()
+}
+
+func marginReportPDF(report *entities.MarginReport) ([]byte, error) {
+	pdf := gofpdf.New("L", "mm", "Letter", "")
+	pdf.AddPage()
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pdf.SetFont("Arial", "B", 16)
+	pdf.Cell(0, 10, tr("Reporte de Márgenes por "+groupingLabel(report)))
+	pdf.Ln(12)
+
+	pdf.SetFont("Arial", "", 10)
+	pdf.Cell(0, 6, tr(fmt.Sprintf("Fecha de generación: %s", time.Now().Format("02/01/2006 15:04"))))
+	pdf.Ln(6)
+	pdf.Cell(0, 6, tr("Periodo: "+periodLabel(report.StartDate, report.EndDate)))
+	pdf.Ln(10)
+
+	widths := []float64{60, 17, 17, 26, 24, 26, 26, 26, 17, 19}
+	pdf.SetFont("Arial", "B", 8)
+	pdf.SetFillColor(200, 220, 255)
+	for i, column := range marginReportColumns(report) {
+		pdf.CellFormat(widths[i], 7, tr(column), "1", 0, "C", true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	writeLine := func(line entities.MarginReportLine, height float64, fill bool) {
+		label := line.Label
+		if len([]rune(label)) > 36 {
+			label = string([]rune(label)[:33]) + "..."
+		}
+		pdf.CellFormat(widths[0], height, tr(label), "1", 0, "L", fill, 0, "")
+		pdf.CellFormat(widths[1], height, strconv.Itoa(line.Quantity), "1", 0, "R", fill, 0, "")
+		pdf.CellFormat(widths[2], height, strconv.Itoa(line.Orders), "1", 0, "R", fill, 0, "")
+		pdf.CellFormat(widths[3], height, formatCOP(line.Revenue), "1", 0, "R", fill, 0, "")
+		pdf.CellFormat(widths[4], height, formatCOP(line.Discount), "1", 0, "R", fill, 0, "")
+		pdf.CellFormat(widths[5], height, formatCOP(line.NetRevenue), "1", 0, "R", fill, 0, "")
+		pdf.CellFormat(widths[6], height, formatCOP(line.Cost), "1", 0, "R", fill, 0, "")
+		pdf.CellFormat(widths[7], height, formatCOP(line.GrossProfit), "1", 0, "R", fill, 0, "")
+		pdf.CellFormat(widths[8], height, marginLabel(line.MarginPercent), "1", 0, "R", fill, 0, "")
+		pdf.CellFormat(widths[9], height, strconv.Itoa(line.UncostedQuantity), "1", 0, "R", fill, 0, "")
+		pdf.Ln(-1)
+	}
+
+	pdf.SetFont("Arial", "", 8)
+	for _, line := range report.Lines {
+		writeLine(line, 6, false)
+	}
+	pdf.SetFont("Arial", "B", 8)
+	writeLine(report.Totals, 7, true)
+	pdf.Ln(4)
+
+	pdf.SetFont("Arial", "I", 8)
+	pdf.MultiCell(0, 4, tr("Costo = costo de producción unitario congelado en cada ítem al momento de la venta. "+
+		"El descuento de la orden (manual, cupón y puntos) se prorratea entre sus ítems según el subtotal. "+
+		"Margen = utilidad bruta / venta neta. Las unidades sin costo registrado sobreestiman el margen."), "", "L", false)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func groupingLabel(report *entities.MarginReport) string {
+	switch report.GroupBy {
+	case entities.MarginByOrder:
+		return "Orden"
+	case entities.MarginByProduct:
+		return "Producto"
+	}
+
+	switch report.Interval {
+	case entities.MarginIntervalDay:
+		return "Día"
+	case entities.MarginIntervalWeek:
+		return "Semana"
+	default:
+		return "Mes"
+	}
+}
+
+func marginLabel(margin *float64) string {
+	if margin == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", *margin)
+}
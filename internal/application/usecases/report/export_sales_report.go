@@ -0,0 +1,314 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/pkg/xlsx"
+	"github.com/jung-kurt/gofpdf"
+)
+
+// Formatos de exportación de reportes
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+	FormatPDF  = "pdf"
+)
+
+// ExportResponse es el archivo generado
+type ExportResponse struct {
+	Content     []byte
+	ContentType string
+	Filename    string
+}
+
+// ExportSalesReportUseCase exporta el reporte de ventas como CSV, XLSX o PDF
+type ExportSalesReportUseCase struct {
+	getSalesReportUC *GetSalesReportUseCase
+}
+
+// NewExportSalesReportUseCase crea una nueva instancia del caso de uso
+func NewExportSalesReportUseCase(getSalesReportUC *GetSalesReportUseCase) *ExportSalesReportUseCase {
+	return &ExportSalesReportUseCase{getSalesReportUC: getSalesReportUC}
+}
+
+// Execute genera el reporte y lo convierte al formato pedido
+func (uc *ExportSalesReportUseCase) Execute(ctx context.Context, filters entities.SalesReportFilters, format string) (*ExportResponse, error) {
+	format, err := normalizeFormat(format)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := uc.getSalesReportUC.Execute(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	filename := fmt.Sprintf("ventas_%s_%s_%s.%s",
+		strings.ToLower(string(report.Dimension)),
+		report.StartDate.Format("20060102"),
+		report.EndDate.Format("20060102"),
+		format,
+	)
+
+	var content []byte
+	switch format {
+	case FormatCSV:
+		content, err = salesReportCSV(report)
+	case FormatXLSX:
+		content, err = salesReportXLSX(report)
+	default:
+		content, err = salesReportPDF(report)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newExportResponse(format, filename, content), nil
+}
+
+// normalizeFormat valida el formato pedido (sin distinguir mayúsculas)
+func normalizeFormat(format string) (string, error) {
+	format = strings.ToLower(format)
+	if format != FormatCSV && format != FormatXLSX && format != FormatPDF {
+		return "", entities.ErrInvalidExportFormat
+	}
+	return format, nil
+}
+
+func newExportResponse(format, filename string, content []byte) *ExportResponse {
+	contentType := "application/pdf"
+	switch format {
+	case FormatCSV:
+		contentType = "text/csv; charset=utf-8"
+	case FormatXLSX:
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return &ExportResponse{Content: content, ContentType: contentType, Filename: filename}
+}
+
+// salesReportColumns son los encabezados comunes a los tres formatos
+func salesReportColumns(report *entities.SalesReport) []string {
+	return []string{
+		dimensionLabel(report.Dimension),
+		"Unidades",
+		"Órdenes",
+		"Ventas",
+		"Participación %",
+		"Unidades periodo anterior",
+		"Ventas periodo anterior",
+		"Variación %",
+	}
+}
+
+func salesReportCSV(report *entities.SalesReport) ([]byte, error) {
+	buf, w := newCSV()
+	if err := w.Write(salesReportColumns(report)); err != nil {
+		return nil, err
+	}
+	for _, line := range report.Lines {
+		if err := w.Write([]string{
+			line.Label,
+			strconv.Itoa(line.Quantity),
+			strconv.Itoa(line.Orders),
+			formatDecimal(line.Revenue),
+			formatDecimal(line.Share),
+			strconv.Itoa(line.PreviousQuantity),
+			formatDecimal(line.PreviousRevenue),
+			formatChange(line.RevenueChange),
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Write([]string{
+		"TOTAL",
+		strconv.Itoa(report.TotalQuantity),
+		"",
+		formatDecimal(report.TotalRevenue),
+		"100",
+		"",
+		formatDecimal(report.PreviousRevenue),
+		formatChange(report.RevenueChange),
+	}); err != nil {
+		return nil, err
+	}
+
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func salesReportXLSX(report *entities.SalesReport) ([]byte, error) {
+	sheet := xlsx.NewSheet("Ventas")
+	sheet.AddRow("Reporte de ventas por " + strings.ToLower(dimensionLabel(report.Dimension)))
+	sheet.AddRow("Periodo", periodLabel(report.StartDate, report.EndDate))
+	sheet.AddRow("Periodo anterior", periodLabel(report.PreviousStart, report.PreviousEnd))
+	sheet.AddRow()
+	sheet.AddHeader(salesReportColumns(report)...)
+
+	for _, line := range report.Lines {
+		sheet.AddRow(
+			line.Label,
+			line.Quantity,
+			line.Orders,
+			line.Revenue,
+			roundTwo(line.Share),
+			line.PreviousQuantity,
+			line.PreviousRevenue,
+			roundedChange(line.RevenueChange),
+		)
+	}
+	sheet.AddRow("TOTAL", report.TotalQuantity, nil, report.TotalRevenue, nil, nil, report.PreviousRevenue, roundedChange(report.RevenueChange))
+
+	return sheet.Bytes()
+}
+
+func salesReportPDF(report *entities.SalesReport) ([]byte, error) {
+	pdf := gofpdf.New("L", "mm", "Letter", "")
+	pdf.AddPage()
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pdf.SetFont("Arial", "B", 16)
+	pdf.Cell(0, 10, tr("Reporte de Ventas por "+dimensionLabel(report.Dimension)))
+	pdf.Ln(12)
+
+	pdf.SetFont("Arial", "", 10)
+	pdf.Cell(0, 6, tr(fmt.Sprintf("Fecha de generación: %s", time.Now().Format("02/01/2006 15:04"))))
+	pdf.Ln(6)
+	pdf.Cell(0, 6, tr("Periodo: "+periodLabel(report.StartDate, report.EndDate)))
+	pdf.Ln(6)
+	pdf.Cell(0, 6, tr("Comparado con: "+periodLabel(report.PreviousStart, report.PreviousEnd)))
+	pdf.Ln(10)
+
+	widths := []float64{75, 20, 20, 32, 24, 26, 32, 24}
+	pdf.SetFont("Arial", "B", 8)
+	pdf.SetFillColor(200, 220, 255)
+	for i, column := range salesReportColumns(report) {
+		pdf.CellFormat(widths[i], 7, tr(column), "1", 0, "C", true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Arial", "", 8)
+	for _, line := range report.Lines {
+		label := line.Label
+		if len([]rune(label)) > 45 {
+			label = string([]rune(label)[:42]) + "..."
+		}
+		pdf.CellFormat(widths[0], 6, tr(label), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[1], 6, strconv.Itoa(line.Quantity), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[2], 6, strconv.Itoa(line.Orders), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[3], 6, formatCOP(line.Revenue), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[4], 6, fmt.Sprintf("%.1f%%", line.Share), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[5], 6, strconv.Itoa(line.PreviousQuantity), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[6], 6, formatCOP(line.PreviousRevenue), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[7], 6, changeLabel(line.RevenueChange), "1", 0, "R", false, 0, "")
+		pdf.Ln(-1)
+	}
+
+	pdf.SetFont("Arial", "B", 8)
+	pdf.CellFormat(widths[0], 7, "TOTAL", "1", 0, "L", true, 0, "")
+	pdf.CellFormat(widths[1], 7, strconv.Itoa(report.TotalQuantity), "1", 0, "R", true, 0, "")
+	pdf.CellFormat(widths[2], 7, "", "1", 0, "R", true, 0, "")
+	pdf.CellFormat(widths[3], 7, formatCOP(report.TotalRevenue), "1", 0, "R", true, 0, "")
+	pdf.CellFormat(widths[4], 7, "100%", "1", 0, "R", true, 0, "")
+	pdf.CellFormat(widths[5], 7, "", "1", 0, "R", true, 0, "")
+	pdf.CellFormat(widths[6], 7, formatCOP(report.PreviousRevenue), "1", 0, "R", true, 0, "")
+	pdf.CellFormat(widths[7], 7, changeLabel(report.RevenueChange), "1", 0, "R", true, 0, "")
+	pdf.Ln(10)
+
+	pdf.SetFont("Arial", "I", 8)
+	pdf.MultiCell(0, 4, tr("Ventas = suma de subtotales de los ítems de órdenes aprobadas, en producción, terminadas, confirmadas o entregadas. No incluye el descuento a nivel de orden ni las órdenes de inventario."), "", "L", false)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// newCSV crea el writer del CSV con BOM para que Excel abra el archivo con tildes
+func newCSV() (*bytes.Buffer, *csv.Writer) {
+	buf := &bytes.Buffer{}
+	buf.WriteString("\xEF\xBB\xBF")
+	return buf, csv.NewWriter(buf)
+}
+
+func dimensionLabel(dimension entities.SalesDimension) string {
+	switch dimension {
+	case entities.SalesByProduct:
+		return "Producto"
+	case entities.SalesByVariant:
+		return "Variante"
+	case entities.SalesBySize:
+		return "Talla"
+	case entities.SalesByColor:
+		return "Color"
+	case entities.SalesByCategory:
+		return "Categoría"
+	case entities.SalesBySeller:
+		return "Vendedor"
+	default:
+		return string(dimension)
+	}
+}
+
+func periodLabel(start, end time.Time) string {
+	return start.Format("02/01/2006") + " - " + end.Format("02/01/2006")
+}
+
+func formatDecimal(value float64) string {
+	return strconv.FormatFloat(roundTwo(value), 'f', -1, 64)
+}
+
+func formatChange(change *float64) string {
+	if change == nil {
+		return ""
+	}
+	return formatDecimal(*change)
+}
+
+func changeLabel(change *float64) string {
+	if change == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%+.1f%%", *change)
+}
+
+func roundedChange(change *float64) *float64 {
+	if change == nil {
+		return nil
+	}
+	rounded := roundTwo(*change)
+	return &rounded
+}
+
+func roundTwo(value float64) float64 {
+	rounded, _ := strconv.ParseFloat(strconv.FormatFloat(value, 'f', 2, 64), 64)
+	return rounded
+}
+
+// formatCOP formatea un valor en pesos colombianos con separador de miles (ej: $1.250.000)
+func formatCOP(amount float64) string {
+	amountStr := strconv.FormatInt(int64(amount), 10)
+
+	isNegative := strings.HasPrefix(amountStr, "-")
+	amountStr = strings.TrimPrefix(amountStr, "-")
+
+	var result strings.Builder
+	length := len(amountStr)
+	for i, digit := range amountStr {
+		if i > 0 && (length-i)%3 == 0 {
+			result.WriteString(".")
+		}
+		result.WriteRune(digit)
+	}
+
+	if isNegative {
+		return "-$" + result.String()
+	}
+	return "$" + result.String()
+}
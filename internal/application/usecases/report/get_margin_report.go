@@ -0,0 +1,43 @@
+package report
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// GetMarginReportUseCase arma el reporte de utilidad bruta con el costo congelado en cada item
+type GetMarginReportUseCase struct {
+	marginRepo ports.MarginReportRepository
+}
+
+// NewGetMarginReportUseCase crea una nueva instancia del caso de uso
+func NewGetMarginReportUseCase(marginRepo ports.MarginReportRepository) *GetMarginReportUseCase {
+	return &GetMarginReportUseCase{marginRepo: marginRepo}
+}
+
+// Execute calcula venta, descuento, costo y margen agrupados por orden, producto o periodo
+// Un usuario sin orders:all solo ve sus órdenes propias o compartidas
+func (uc *GetMarginReportUseCase) Execute(ctx context.Context, filters entities.MarginReportFilters) (*entities.MarginReport, error) {
+	if err := filters.Validate(); err != nil {
+		return nil, err
+	}
+	if scope := access.ScopeFromContext(ctx); !scope.AllOrders {
+		userID := scope.UserID
+		filters.VisibleToUserID = &userID
+	}
+
+	rows, err := uc.marginRepo.GetMargins(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := uc.marginRepo.CountOrders(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.NewMarginReport(filters, rows, orders), nil
+}
@@ -0,0 +1,44 @@
+package report
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// GetSalesReportUseCase arma el reporte de ventas de un periodo comparado con el anterior
+type GetSalesReportUseCase struct {
+	salesRepo ports.SalesReportRepository
+}
+
+// NewGetSalesReportUseCase crea una nueva instancia del caso de uso
+func NewGetSalesReportUseCase(salesRepo ports.SalesReportRepository) *GetSalesReportUseCase {
+	return &GetSalesReportUseCase{salesRepo: salesRepo}
+}
+
+// Execute calcula las ventas agrupadas por la dimensión pedida
+// Un usuario sin orders:all solo ve las ventas de sus órdenes propias o compartidas
+func (uc *GetSalesReportUseCase) Execute(ctx context.Context, filters entities.SalesReportFilters) (*entities.SalesReport, error) {
+	if err := filters.Validate(); err != nil {
+		return nil, err
+	}
+	if scope := access.ScopeFromContext(ctx); !scope.AllOrders {
+		userID := scope.UserID
+		filters.VisibleToUserID = &userID
+	}
+
+	current, err := uc.salesRepo.GetSales(ctx, filters, filters.StartDate, filters.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	previousStart, previousEnd := filters.PreviousPeriod()
+	previous, err := uc.salesRepo.GetSales(ctx, filters, previousStart, previousEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.NewSalesReport(filters, current, previous), nil
+}
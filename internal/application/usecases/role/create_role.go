@@ -0,0 +1,41 @@
+package role
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// CreateRoleUseCase crea un rol personalizado con un conjunto de permisos
+type CreateRoleUseCase struct {
+	roleRepo ports.RoleRepository
+	recorder *audittrail.Recorder
+}
+
+// NewCreateRoleUseCase crea una nueva instancia del caso de uso
+func NewCreateRoleUseCase(roleRepo ports.RoleRepository, recorder *audittrail.Recorder) *CreateRoleUseCase {
+	return &CreateRoleUseCase{roleRepo: roleRepo, recorder: recorder}
+}
+
+// Execute valida y crea el rol (los roles creados por API nunca son de sistema)
+// El rol solo puede incluir permisos que tenga quien lo crea
+func (uc *CreateRoleUseCase) Execute(ctx context.Context, role *entities.Role) error {
+	role.Name = entities.UserRole(strings.ToUpper(strings.TrimSpace(string(role.Name))))
+	role.IsSystem = false
+	if err := role.Validate(); err != nil {
+		return err
+	}
+	if err := access.CheckRoleGrant(ctx, role); err != nil {
+		return err
+	}
+	if err := uc.roleRepo.Create(ctx, role); err != nil {
+		return err
+	}
+
+	uc.recorder.Created(ctx, entities.AuditEntityRole, role.ID, role)
+	return nil
+}
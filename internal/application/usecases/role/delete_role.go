@@ -0,0 +1,37 @@
+package role
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// DeleteRoleUseCase elimina un rol personalizado
+type DeleteRoleUseCase struct {
+	roleRepo ports.RoleRepository
+	recorder *audittrail.Recorder
+}
+
+// NewDeleteRoleUseCase crea una nueva instancia del caso de uso
+func NewDeleteRoleUseCase(roleRepo ports.RoleRepository, recorder *audittrail.Recorder) *DeleteRoleUseCase {
+	return &DeleteRoleUseCase{roleRepo: roleRepo, recorder: recorder}
+}
+
+// Execute elimina el rol si no es de sistema y ningún usuario lo tiene asignado
+func (uc *DeleteRoleUseCase) Execute(ctx context.Context, name entities.UserRole) error {
+	role, err := uc.roleRepo.GetByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	if role.IsSystem {
+		return entities.ErrSystemRole
+	}
+	if err := uc.roleRepo.Delete(ctx, name); err != nil {
+		return err
+	}
+
+	uc.recorder.Deleted(ctx, entities.AuditEntityRole, role.ID, role)
+	return nil
+}
@@ -0,0 +1,23 @@
+package role
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListRolesUseCase lista los roles con sus permisos
+type ListRolesUseCase struct {
+	roleRepo ports.RoleRepository
+}
+
+// NewListRolesUseCase crea una nueva instancia del caso de uso
+func NewListRolesUseCase(roleRepo ports.RoleRepository) *ListRolesUseCase {
+	return &ListRolesUseCase{roleRepo: roleRepo}
+}
+
+// Execute retorna todos los roles ordenados por nombre
+func (uc *ListRolesUseCase) Execute(ctx context.Context) ([]entities.Role, error) {
+	return uc.roleRepo.List(ctx)
+}
@@ -0,0 +1,56 @@
+package role
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// UpdateRoleUseCase cambia la descripción y los permisos de un rol
+type UpdateRoleUseCase struct {
+	roleRepo ports.RoleRepository
+	recorder *audittrail.Recorder
+}
+
+// NewUpdateRoleUseCase crea una nueva instancia del caso de uso
+func NewUpdateRoleUseCase(roleRepo ports.RoleRepository, recorder *audittrail.Recorder) *UpdateRoleUseCase {
+	return &UpdateRoleUseCase{roleRepo: roleRepo, recorder: recorder}
+}
+
+// Execute actualiza el rol; los cambios aplican en la siguiente petición de cada usuario
+// SUPER_ADMIN no se puede editar para que nunca se pierda el acceso total
+// Quien edita debe tener todos los permisos del rol, antes y después del cambio
+func (uc *UpdateRoleUseCase) Execute(ctx context.Context, name entities.UserRole, description string, permissions []entities.Permission) (*entities.Role, error) {
+	if name == entities.RoleSuperAdmin {
+		return nil, entities.ErrSuperAdminLocked
+	}
+
+	role, err := uc.roleRepo.GetByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := access.CheckRoleGrant(ctx, role); err != nil {
+		return nil, err
+	}
+
+	before := *role
+	role.Description = description
+	role.Permissions = permissions
+	if err := role.Validate(); err != nil {
+		return nil, err
+	}
+	if err := access.CheckRoleGrant(ctx, role); err != nil {
+		return nil, err
+	}
+
+	if err := uc.roleRepo.Update(ctx, role); err != nil {
+		return nil, err
+	}
+
+	uc.recorder.Updated(ctx, entities.AuditEntityRole, role.ID, &before, role)
+	return role, nil
+}
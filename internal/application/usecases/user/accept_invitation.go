@@ -0,0 +1,79 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/opaquetoken"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// AcceptInvitationUseCase permite al invitado crear su cuenta eligiendo su contraseña
+type AcceptInvitationUseCase struct {
+	invitationRepo ports.UserInvitationRepository
+	userRepo       ports.UserRepository
+	passwordPolicy entities.PasswordPolicy
+}
+
+// NewAcceptInvitationUseCase crea una nueva instancia del caso de uso
+func NewAcceptInvitationUseCase(
+	invitationRepo ports.UserInvitationRepository,
+	userRepo ports.UserRepository,
+	passwordPolicy entities.PasswordPolicy,
+) *AcceptInvitationUseCase {
+	return &AcceptInvitationUseCase{
+		invitationRepo: invitationRepo,
+		userRepo:       userRepo,
+		passwordPolicy: passwordPolicy,
+	}
+}
+
+// Preview retorna la invitación vigente para mostrar al invitado a qué cuenta corresponde
+func (uc *AcceptInvitationUseCase) Preview(ctx context.Context, token string) (*entities.UserInvitation, error) {
+	invitation, err := uc.invitationRepo.GetByTokenHash(ctx, opaquetoken.Hash(strings.TrimSpace(token)))
+	if err != nil || !invitation.IsValid(time.Now()) {
+		return nil, entities.ErrInvalidInvitation
+	}
+	return invitation, nil
+}
+
+// Execute crea el usuario con el rol y permisos de la invitación; el token deja de servir
+func (uc *AcceptInvitationUseCase) Execute(ctx context.Context, token, password string) (*entities.User, error) {
+	invitation, err := uc.Preview(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.passwordPolicy.Validate(password); err != nil {
+		return nil, err
+	}
+
+	existingUser, err := uc.userRepo.GetByEmail(ctx, invitation.Email)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if existingUser != nil {
+		return nil, errors.New("email already exists")
+	}
+
+	user := &entities.User{
+		Email:     invitation.Email,
+		FirstName: invitation.FirstName,
+		LastName:  invitation.LastName,
+		Role:      invitation.Role,
+		IsActive:  true,
+	}
+	if err := user.HashPassword(password); err != nil {
+		return nil, err
+	}
+
+	if err := uc.invitationRepo.Accept(ctx, invitation, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -3,24 +3,38 @@ package user
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 // ChangePasswordUseCase maneja el cambio de contraseña
 type ChangePasswordUseCase struct {
-	userRepo ports.UserRepository
+	userRepo       ports.UserRepository
+	sessionRepo    ports.UserSessionRepository
+	auditRepo      ports.AuditLogRepository
+	passwordPolicy entities.PasswordPolicy
 }
 
 // NewChangePasswordUseCase crea una nueva instancia del caso de uso
-func NewChangePasswordUseCase(userRepo ports.UserRepository) *ChangePasswordUseCase {
+func NewChangePasswordUseCase(
+	userRepo ports.UserRepository,
+	sessionRepo ports.UserSessionRepository,
+	auditRepo ports.AuditLogRepository,
+	passwordPolicy entities.PasswordPolicy,
+) *ChangePasswordUseCase {
 	return &ChangePasswordUseCase{
-		userRepo: userRepo,
+		userRepo:       userRepo,
+		sessionRepo:    sessionRepo,
+		auditRepo:      auditRepo,
+		passwordPolicy: passwordPolicy,
 	}
 }
 
-// Execute ejecuta el caso de uso de cambiar contraseña
-func (uc *ChangePasswordUseCase) Execute(ctx context.Context, userID uint, oldPassword, newPassword string) error {
+// Execute ejecuta el caso de uso de cambiar contraseña; ipAddress y userAgent quedan en la auditoría
+func (uc *ChangePasswordUseCase) Execute(ctx context.Context, userID uint, oldPassword, newPassword, ipAddress, userAgent string) error {
 	user, err := uc.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		return err
@@ -30,9 +44,30 @@ func (uc *ChangePasswordUseCase) Execute(ctx context.Context, userID uint, oldPa
 		return errors.New("invalid old password")
 	}
 
+	if err := uc.passwordPolicy.Validate(newPassword); err != nil {
+		return err
+	}
+
 	if err := user.HashPassword(newPassword); err != nil {
 		return err
 	}
 
-	return uc.userRepo.Update(ctx, user)
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	auditLog := entities.NewSecurityAuditLog(
+		entities.AuditEventPasswordChanged,
+		user,
+		ipAddress,
+		userAgent,
+		fmt.Sprintf("Password changed for user %s", user.Email),
+		"",
+	)
+	if err := uc.auditRepo.Create(ctx, auditLog); err != nil {
+		log.Printf("⚠️  Failed to save password change audit log: %v", err)
+	}
+
+	// Cerrar todas las sesiones: quien tenga la contraseña anterior no debe seguir dentro
+	return uc.sessionRepo.RevokeAllByUser(ctx, user.ID, entities.SessionRevokedPasswordChanged)
 }
@@ -0,0 +1,114 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/opaquetoken"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+	"gorm.io/gorm"
+)
+
+// CreateInvitationUseCase crea una invitación para que un nuevo usuario configure su cuenta
+type CreateInvitationUseCase struct {
+	invitationRepo ports.UserInvitationRepository
+	userRepo       ports.UserRepository
+	roleRepo       ports.RoleRepository
+	categoryRepo   ports.CategoryRepository
+	invitationURL  string
+	expiry         time.Duration
+}
+
+// NewCreateInvitationUseCase crea una nueva instancia del caso de uso
+func NewCreateInvitationUseCase(
+	invitationRepo ports.UserInvitationRepository,
+	userRepo ports.UserRepository,
+	roleRepo ports.RoleRepository,
+	categoryRepo ports.CategoryRepository,
+	invitationURL string,
+	expiry time.Duration,
+) *CreateInvitationUseCase {
+	return &CreateInvitationUseCase{
+		invitationRepo: invitationRepo,
+		userRepo:       userRepo,
+		roleRepo:       roleRepo,
+		categoryRepo:   categoryRepo,
+		invitationURL:  invitationURL,
+		expiry:         expiry,
+	}
+}
+
+// CreateInvitationRequest representa los datos de la invitación
+type CreateInvitationRequest struct {
+	Email       string
+	FirstName   string
+	LastName    string
+	Role        entities.UserRole
+	Permissions []entities.UserCategoryPermission
+	InvitedBy   uint
+}
+
+// Execute crea la invitación y retorna la URL de un solo uso para compartir con el invitado
+func (uc *CreateInvitationUseCase) Execute(ctx context.Context, req CreateInvitationRequest) (*entities.UserInvitation, string, error) {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if email == "" || strings.TrimSpace(req.FirstName) == "" || strings.TrimSpace(req.LastName) == "" {
+		return nil, "", errors.New("email, first name and last name are required")
+	}
+	if err := access.CheckRoleGrantByName(ctx, uc.roleRepo, req.Role); err != nil {
+		return nil, "", err
+	}
+
+	// El email no puede pertenecer a un usuario existente ni tener otra invitación vigente
+	existingUser, err := uc.userRepo.GetByEmail(ctx, email)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, "", err
+	}
+	if existingUser != nil {
+		return nil, "", errors.New("email already exists")
+	}
+
+	pending, err := uc.invitationRepo.GetPendingByEmail(ctx, email)
+	if err != nil {
+		return nil, "", err
+	}
+	if pending != nil {
+		return nil, "", entities.ErrInvitationPending
+	}
+
+	for _, perm := range req.Permissions {
+		if _, err := uc.categoryRepo.GetByID(ctx, perm.CategoryID); err != nil {
+			return nil, "", fmt.Errorf("category not found: %d", perm.CategoryID)
+		}
+	}
+
+	token, err := opaquetoken.New()
+	if err != nil {
+		return nil, "", err
+	}
+
+	invitation := &entities.UserInvitation{
+		Email:       email,
+		FirstName:   strings.TrimSpace(req.FirstName),
+		LastName:    strings.TrimSpace(req.LastName),
+		Role:        req.Role,
+		Permissions: req.Permissions,
+		TokenHash:   opaquetoken.Hash(token),
+		ExpiresAt:   time.Now().Add(uc.expiry),
+		InvitedBy:   req.InvitedBy,
+	}
+	if err := uc.invitationRepo.Create(ctx, invitation); err != nil {
+		return nil, "", err
+	}
+
+	inviteURL, err := opaquetoken.Link(uc.invitationURL, token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return invitation, inviteURL, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 	"gorm.io/gorm"
@@ -11,13 +13,19 @@ import (
 
 // CreateUserUseCase maneja la creación de usuarios
 type CreateUserUseCase struct {
-	userRepo ports.UserRepository
+	userRepo       ports.UserRepository
+	roleRepo       ports.RoleRepository
+	passwordPolicy entities.PasswordPolicy
+	recorder       *audittrail.Recorder
 }
 
 // NewCreateUserUseCase crea una nueva instancia del caso de uso
-func NewCreateUserUseCase(userRepo ports.UserRepository) *CreateUserUseCase {
+func NewCreateUserUseCase(userRepo ports.UserRepository, roleRepo ports.RoleRepository, passwordPolicy entities.PasswordPolicy, recorder *audittrail.Recorder) *CreateUserUseCase {
 	return &CreateUserUseCase{
-		userRepo: userRepo,
+		userRepo:       userRepo,
+		roleRepo:       roleRepo,
+		passwordPolicy: passwordPolicy,
+		recorder:       recorder,
 	}
 }
 
@@ -32,11 +40,24 @@ func (uc *CreateUserUseCase) Execute(ctx context.Context, user *entities.User, p
 		return errors.New("email already exists")
 	}
 
+	if err := access.CheckRoleGrantByName(ctx, uc.roleRepo, user.Role); err != nil {
+		return err
+	}
+
+	if err := uc.passwordPolicy.Validate(password); err != nil {
+		return err
+	}
+
 	// Encriptar contraseña
 	if err := user.HashPassword(password); err != nil {
 		return err
 	}
 
 	// Crear usuario
-	return uc.userRepo.Create(ctx, user)
+	if err := uc.userRepo.Create(ctx, user); err != nil {
+		return err
+	}
+
+	uc.recorder.Created(ctx, entities.AuditEntityUser, user.ID, user)
+	return nil
 }
@@ -3,28 +3,44 @@ package user
 import (
 	"context"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 // DeleteUserUseCase maneja la eliminación de usuarios
 type DeleteUserUseCase struct {
 	userRepo ports.UserRepository
+	roleRepo ports.RoleRepository
+	recorder *audittrail.Recorder
 }
 
 // NewDeleteUserUseCase crea una nueva instancia del caso de uso
-func NewDeleteUserUseCase(userRepo ports.UserRepository) *DeleteUserUseCase {
+func NewDeleteUserUseCase(userRepo ports.UserRepository, roleRepo ports.RoleRepository, recorder *audittrail.Recorder) *DeleteUserUseCase {
 	return &DeleteUserUseCase{
 		userRepo: userRepo,
+		roleRepo: roleRepo,
+		recorder: recorder,
 	}
 }
 
 // Execute ejecuta el caso de uso de eliminar usuario
+// Solo se elimina a quien tiene un rol que el actor puede otorgar
 func (uc *DeleteUserUseCase) Execute(ctx context.Context, id uint) error {
 	// Verificar que el usuario existe
-	_, err := uc.userRepo.GetByID(ctx, id)
+	user, err := uc.userRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
+	if err := access.CheckRoleGrantByName(ctx, uc.roleRepo, user.Role); err != nil {
+		return err
+	}
+
+	if err := uc.userRepo.Delete(ctx, id); err != nil {
+		return err
+	}
 
-	return uc.userRepo.Delete(ctx, id)
+	uc.recorder.Deleted(ctx, entities.AuditEntityUser, id, user)
+	return nil
 }
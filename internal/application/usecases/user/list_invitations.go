@@ -0,0 +1,23 @@
+package user
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// ListInvitationsUseCase lista las invitaciones de usuario
+type ListInvitationsUseCase struct {
+	invitationRepo ports.UserInvitationRepository
+}
+
+// NewListInvitationsUseCase crea una nueva instancia del caso de uso
+func NewListInvitationsUseCase(invitationRepo ports.UserInvitationRepository) *ListInvitationsUseCase {
+	return &ListInvitationsUseCase{invitationRepo: invitationRepo}
+}
+
+// Execute lista las invitaciones (filtro opcional: status = PENDING, ACCEPTED, REVOKED, EXPIRED)
+func (uc *ListInvitationsUseCase) Execute(ctx context.Context, filters map[string]interface{}) ([]entities.UserInvitation, error) {
+	return uc.invitationRepo.List(ctx, filters)
+}
@@ -0,0 +1,25 @@
+package user
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// RevokeInvitationUseCase anula una invitación que aún no se ha aceptado
+type RevokeInvitationUseCase struct {
+	invitationRepo ports.UserInvitationRepository
+}
+
+// NewRevokeInvitationUseCase crea una nueva instancia del caso de uso
+func NewRevokeInvitationUseCase(invitationRepo ports.UserInvitationRepository) *RevokeInvitationUseCase {
+	return &RevokeInvitationUseCase{invitationRepo: invitationRepo}
+}
+
+// Execute revoca la invitación
+func (uc *RevokeInvitationUseCase) Execute(ctx context.Context, id uint) error {
+	if _, err := uc.invitationRepo.GetByID(ctx, id); err != nil {
+		return err
+	}
+	return uc.invitationRepo.Revoke(ctx, id)
+}
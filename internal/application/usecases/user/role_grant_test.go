@@ -0,0 +1,93 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
+)
+
+// fakeUserRepository retorna siempre el mismo usuario y registra si se modificó
+type fakeUserRepository struct {
+	ports.UserRepository
+	user    *entities.User
+	updated bool
+	deleted bool
+}
+
+func (r *fakeUserRepository) GetByID(ctx context.Context, id uint) (*entities.User, error) {
+	user := *r.user
+	return &user, nil
+}
+
+func (r *fakeUserRepository) Update(ctx context.Context, user *entities.User) error {
+	r.updated = true
+	return nil
+}
+
+func (r *fakeUserRepository) Delete(ctx context.Context, id uint) error {
+	r.deleted = true
+	return nil
+}
+
+// fakeRoleRepository sirve los roles por defecto
+type fakeRoleRepository struct {
+	ports.RoleRepository
+}
+
+func (r *fakeRoleRepository) GetByName(ctx context.Context, name entities.UserRole) (*entities.Role, error) {
+	for _, role := range entities.DefaultRoles() {
+		if role.Name == name {
+			return &role, nil
+		}
+	}
+	return nil, entities.ErrRoleNotFound
+}
+
+// actorContext arma el contexto de un actor con uno de los roles por defecto
+func actorContext(t *testing.T, role entities.UserRole) context.Context {
+	t.Helper()
+	actorRole, err := (&fakeRoleRepository{}).GetByName(context.Background(), role)
+	if err != nil {
+		t.Fatalf("GetByName(%s): %v", role, err)
+	}
+	return access.WithActor(context.Background(), access.Actor{
+		User: &entities.User{ID: 1, Role: role},
+		Role: actorRole,
+	})
+}
+
+func TestManageSuperAdminRequiresGrant(t *testing.T) {
+	actors := []entities.UserRole{entities.RoleSeller, entities.RoleCashier, entities.RoleWorkshop, entities.RoleAccountant}
+
+	for _, actorRole := range actors {
+		t.Run(string(actorRole)+" updates super admin", func(t *testing.T) {
+			repo := &fakeUserRepository{user: &entities.User{ID: 2, Email: "admin@fashionblue.co", Role: entities.RoleSuperAdmin}}
+			uc := NewUpdateUserUseCase(repo, nil, &fakeRoleRepository{}, nil)
+
+			// Mismo rol: solo cambia el email, lo que bastaría para tomar la cuenta
+			err := uc.Execute(actorContext(t, actorRole), &entities.User{ID: 2, Email: "attacker@example.com", Role: entities.RoleSuperAdmin})
+			if !errors.Is(err, entities.ErrRoleEscalation) {
+				t.Errorf("err = %v, want ErrRoleEscalation", err)
+			}
+			if repo.updated {
+				t.Error("user was updated")
+			}
+		})
+
+		t.Run(string(actorRole)+" deletes super admin", func(t *testing.T) {
+			repo := &fakeUserRepository{user: &entities.User{ID: 2, Role: entities.RoleSuperAdmin}}
+			uc := NewDeleteUserUseCase(repo, &fakeRoleRepository{}, nil)
+
+			if err := uc.Execute(actorContext(t, actorRole), 2); !errors.Is(err, entities.ErrRoleEscalation) {
+				t.Errorf("err = %v, want ErrRoleEscalation", err)
+			}
+			if repo.deleted {
+				t.Error("user was deleted")
+			}
+		})
+	}
+}
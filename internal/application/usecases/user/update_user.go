@@ -3,29 +3,62 @@ package user
 import (
 	"context"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/access"
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
 
 // UpdateUserUseCase maneja la actualización de usuarios
 type UpdateUserUseCase struct {
-	userRepo ports.UserRepository
+	userRepo    ports.UserRepository
+	sessionRepo ports.UserSessionRepository
+	roleRepo    ports.RoleRepository
+	recorder    *audittrail.Recorder
 }
 
 // NewUpdateUserUseCase crea una nueva instancia del caso de uso
-func NewUpdateUserUseCase(userRepo ports.UserRepository) *UpdateUserUseCase {
+func NewUpdateUserUseCase(userRepo ports.UserRepository, sessionRepo ports.UserSessionRepository, roleRepo ports.RoleRepository, recorder *audittrail.Recorder) *UpdateUserUseCase {
 	return &UpdateUserUseCase{
-		userRepo: userRepo,
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		roleRepo:    roleRepo,
+		recorder:    recorder,
 	}
 }
 
 // Execute ejecuta el caso de uso de actualizar usuario
 func (uc *UpdateUserUseCase) Execute(ctx context.Context, user *entities.User) error {
 	// Verificar que el usuario existe
-	_, err := uc.userRepo.GetByID(ctx, user.ID)
+	before, err := uc.userRepo.GetByID(ctx, user.ID)
 	if err != nil {
 		return err
 	}
 
-	return uc.userRepo.Update(ctx, user)
+	// Solo se edita a quien tiene un rol que el actor puede otorgar: cambiar el email de un rol superior
+	// permitiría tomar su cuenta con la recuperación de contraseña
+	if err := access.CheckRoleGrantByName(ctx, uc.roleRepo, before.Role); err != nil {
+		return err
+	}
+
+	// Cambiar el rol exige además poder otorgar el nuevo; nadie cambia el propio
+	if user.Role != "" && user.Role != before.Role {
+		if actor, ok := access.ActorFromContext(ctx); ok && actor.User != nil && actor.User.ID == user.ID {
+			return entities.ErrSelfRoleChange
+		}
+		if err := access.CheckRoleGrantByName(ctx, uc.roleRepo, user.Role); err != nil {
+			return err
+		}
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+	uc.recorder.Updated(ctx, entities.AuditEntityUser, user.ID, before, user)
+
+	// Un usuario desactivado pierde todas sus sesiones de inmediato
+	if !user.IsActive {
+		return uc.sessionRepo.RevokeAllByUser(ctx, user.ID, entities.SessionRevokedUserDeactivated)
+	}
+	return nil
 }
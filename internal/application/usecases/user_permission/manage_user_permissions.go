@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/application/audittrail"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/ports"
 )
@@ -12,17 +13,20 @@ type ManageUserPermissionsUseCase struct {
 	permissionRepo ports.UserCategoryPermissionRepository
 	categoryRepo   ports.CategoryRepository
 	userRepo       ports.UserRepository
+	recorder       *audittrail.Recorder
 }
 
 func NewManageUserPermissionsUseCase(
 	permissionRepo ports.UserCategoryPermissionRepository,
 	categoryRepo ports.CategoryRepository,
 	userRepo ports.UserRepository,
+	recorder *audittrail.Recorder,
 ) *ManageUserPermissionsUseCase {
 	return &ManageUserPermissionsUseCase{
 		permissionRepo: permissionRepo,
 		categoryRepo:   categoryRepo,
 		userRepo:       userRepo,
+		recorder:       recorder,
 	}
 }
 
@@ -42,7 +46,47 @@ func (uc *ManageUserPermissionsUseCase) SetUserPermissions(ctx context.Context,
 		}
 	}
 
-	return uc.permissionRepo.SetPermissions(ctx, userID, permissions)
+	before, err := uc.permissionRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.permissionRepo.SetPermissions(ctx, userID, permissions); err != nil {
+		return err
+	}
+
+	after, err := uc.permissionRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	uc.recordReplacement(ctx, before, after)
+	return nil
+}
+
+// recordReplacement registra el reemplazo de permisos comparando por categoría:
+// los permisos se recrean con otro ID, así que cada categoría se audita sobre su ID nuevo
+func (uc *ManageUserPermissionsUseCase) recordReplacement(ctx context.Context, before, after []entities.UserCategoryPermission) {
+	previous := make(map[uint]entities.UserCategoryPermission, len(before))
+	for _, perm := range before {
+		previous[perm.CategoryID] = perm
+	}
+
+	for i := range after {
+		current := &after[i]
+		old, existed := previous[current.CategoryID]
+		if !existed {
+			uc.recorder.Created(ctx, entities.AuditEntityUserCategoryPermission, current.ID, current)
+			continue
+		}
+		delete(previous, current.CategoryID)
+		old.ID = current.ID
+		uc.recorder.Updated(ctx, entities.AuditEntityUserCategoryPermission, current.ID, &old, current)
+	}
+
+	for _, removed := range previous {
+		removed := removed
+		uc.recorder.Deleted(ctx, entities.AuditEntityUserCategoryPermission, removed.ID, &removed)
+	}
 }
 
 // AddCategoryPermission agrega o actualiza un permiso específico
@@ -68,11 +112,19 @@ func (uc *ManageUserPermissionsUseCase) AddCategoryPermission(ctx context.Contex
 	if existing != nil {
 		// Actualizar existente
 		permission.ID = existing.ID
-		return uc.permissionRepo.Update(ctx, permission)
+		if err := uc.permissionRepo.Update(ctx, permission); err != nil {
+			return err
+		}
+		uc.recorder.Updated(ctx, entities.AuditEntityUserCategoryPermission, permission.ID, existing, permission)
+		return nil
 	}
 
 	// Crear nuevo
-	return uc.permissionRepo.Create(ctx, permission)
+	if err := uc.permissionRepo.Create(ctx, permission); err != nil {
+		return err
+	}
+	uc.recorder.Created(ctx, entities.AuditEntityUserCategoryPermission, permission.ID, permission)
+	return nil
 }
 
 // RemoveCategoryPermission elimina un permiso específico
@@ -86,7 +138,11 @@ func (uc *ManageUserPermissionsUseCase) RemoveCategoryPermission(ctx context.Con
 		return errors.New("permission not found")
 	}
 
-	return uc.permissionRepo.Delete(ctx, existing.ID)
+	if err := uc.permissionRepo.Delete(ctx, existing.ID); err != nil {
+		return err
+	}
+	uc.recorder.Deleted(ctx, entities.AuditEntityUserCategoryPermission, existing.ID, existing)
+	return nil
 }
 
 // GetUserPermissions obtiene todos los permisos de un usuario
@@ -0,0 +1,79 @@
+package entities
+
+import "time"
+
+// AnalyticsFilters define el universo de órdenes sobre el que se calculan las métricas
+// Todos los filtros son opcionales; sin fechas se consideran todas las órdenes
+type AnalyticsFilters struct {
+	StartDate       *time.Time // Fecha de la orden desde (inclusive)
+	EndDate         *time.Time // Fecha de la orden hasta (inclusive, se toma el día completo)
+	SellerID        *uint
+	CategoryID      *uint     // Órdenes con al menos un item de la categoría
+	OrderType       OrderType // CUSTOM, INVENTORY o SALE
+	VisibleToUserID *uint     // Alcance del usuario sin orders:all (propias o compartidas)
+}
+
+// OrderAnalytics son las métricas de órdenes calculadas desde la base de datos
+type OrderAnalytics struct {
+	OrdersByStatus map[OrderStatus]int // Órdenes por estado actual
+	OrdersByType   map[OrderType]int
+	TotalOrders    int
+
+	// Ventas: solo órdenes entregadas (TotalAmount ya tiene el descuento aplicado)
+	TotalRevenue      float64
+	AverageOrderValue float64
+
+	// Abonos de clientes recibidos en el rango (no incluye los reversados)
+	PaymentsCollected float64
+
+	// Tiempo entre el paso a MANUFACTURING y a FINISHED, según el historial de estados
+	AverageManufacturingTime time.Duration
+	ManufacturedOrders       int
+
+	// Tasas sobre el estado actual de las órdenes (porcentajes)
+	ApprovalRate     float64 // Órdenes que pasaron de cotización y no se cancelaron / total
+	CancellationRate float64 // Canceladas / total
+	CompletionRate   float64 // Entregadas / órdenes que salieron de cotización
+
+	RevenueByCategory []CategorySales
+	RevenueBySeller   []SellerSales
+}
+
+// CategorySales son las ventas entregadas de una categoría (suma de subtotales de sus items)
+type CategorySales struct {
+	CategoryID   uint
+	CategoryName string
+	Quantity     int
+	Revenue      float64
+}
+
+// SellerSales son las ventas entregadas de un vendedor
+type SellerSales struct {
+	SellerID   uint
+	SellerName string
+	Orders     int
+	Revenue    float64
+}
+
+// CountByStatus retorna la cantidad de órdenes en un estado
+func (a *OrderAnalytics) CountByStatus(status OrderStatus) int {
+	return a.OrdersByStatus[status]
+}
+
+// CalculateRates calcula el valor promedio y las tasas a partir de los conteos
+func (a *OrderAnalytics) CalculateRates() {
+	delivered := a.CountByStatus(OrderStatusDelivered)
+	cancelled := a.CountByStatus(OrderStatusCancelled)
+	leftQuote := a.TotalOrders - a.CountByStatus(OrderStatusQuote)
+
+	if delivered > 0 {
+		a.AverageOrderValue = a.TotalRevenue / float64(delivered)
+	}
+	if a.TotalOrders > 0 {
+		a.ApprovalRate = float64(leftQuote-cancelled) / float64(a.TotalOrders) * 100
+		a.CancellationRate = float64(cancelled) / float64(a.TotalOrders) * 100
+	}
+	if leftQuote > 0 {
+		a.CompletionRate = float64(delivered) / float64(leftQuote) * 100
+	}
+}
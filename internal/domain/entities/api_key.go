@@ -0,0 +1,87 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// APIKeyPrefixLength es el largo del prefijo público con el que se busca una llave (ej: "fbk_1a2b3c4d")
+const APIKeyPrefixLength = 12
+
+// APIKey representa una llave de API para integraciones (tienda en línea, scripts de reportes)
+// Solo se guarda el hash de la llave; la llave en claro se muestra una única vez al crearla o rotarla
+type APIKey struct {
+	ID            uint
+	Name          string
+	Prefix        string // Parte pública de la llave, permite identificarla en listados y auditoría
+	KeyHash       string
+	Permissions   []Permission
+	UserID        uint // Usuario responsable; la llave nunca otorga más de lo que permite su rol
+	CreatedBy     uint
+	ExpiresAt     *time.Time
+	LastUsedAt    *time.Time
+	LastUsedIP    string
+	RevokedAt     *time.Time
+	RotatedFromID *uint // Llave que esta reemplazó al rotar
+	CreatedAt     time.Time
+}
+
+var (
+	ErrAPIKeyNotFound          = errors.New("api key not found")
+	ErrInvalidAPIKey           = errors.New("invalid, expired or revoked api key")
+	ErrAPIKeyRevoked           = errors.New("api key is already revoked")
+	ErrAPIKeyNameRequired      = errors.New("api key name is required")
+	ErrAPIKeyNoPermissions     = errors.New("api key must have at least one permission")
+	ErrAPIKeyPermissionDenied  = errors.New("cannot grant a permission the owner's role does not have")
+	ErrAPIKeyExpirationInPast  = errors.New("api key expiration must be in the future")
+	ErrAPIKeySessionRequired   = errors.New("this action requires a user session, not an api key")
+	ErrAPIKeyOwnerInactive     = errors.New("api key owner is inactive")
+	ErrAPIKeyWildcardForbidden = errors.New("api keys cannot be granted all permissions")
+)
+
+// IsActive verifica si la llave puede usarse en la fecha dada
+func (k *APIKey) IsActive(at time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || at.Before(*k.ExpiresAt)
+}
+
+// DisplayName identifica la llave en auditoría sin revelar su secreto
+func (k *APIKey) DisplayName() string {
+	return fmt.Sprintf("API key %s (%s)", k.Name, k.Prefix)
+}
+
+// EffectiveRole retorna el rol con el que actúa la llave: sus permisos limitados a los del rol del dueño
+func (k *APIKey) EffectiveRole(ownerRole *Role) *Role {
+	role := &Role{Name: ownerRole.Name}
+	for _, p := range k.Permissions {
+		if ownerRole.HasPermission(p) {
+			role.Permissions = append(role.Permissions, p)
+		}
+	}
+	return role
+}
+
+// Validate verifica el nombre y que los permisos existan y estén dentro del rol del dueño
+func (k *APIKey) Validate(ownerRole *Role) error {
+	if k.Name == "" {
+		return ErrAPIKeyNameRequired
+	}
+	if len(k.Permissions) == 0 {
+		return ErrAPIKeyNoPermissions
+	}
+	for _, p := range k.Permissions {
+		if p == PermissionAll {
+			return ErrAPIKeyWildcardForbidden
+		}
+		if !IsKnownPermission(p) {
+			return fmt.Errorf("%w: %s", ErrUnknownPermission, p)
+		}
+		if !ownerRole.HasPermission(p) {
+			return fmt.Errorf("%w: %s", ErrAPIKeyPermissionDenied, p)
+		}
+	}
+	return nil
+}
@@ -1,22 +1,117 @@
 package entities
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // AuditLog representa un registro de auditoría
+// Los eventos de órdenes llevan OrderID; los cambios a otras entidades llevan EntityType, EntityID y Changes
 type AuditLog struct {
-	ID          uint      `json:"id" gorm:"primaryKey"`
-	EventType   string    `json:"eventType" gorm:"type:varchar(100);not null;index"`
-	OrderID     uint      `json:"orderId" gorm:"not null;index"`
-	OrderNumber string    `json:"orderNumber" gorm:"type:varchar(50)"`
-	UserID      *uint     `json:"userId" gorm:"index"`
-	UserName    string    `json:"userName" gorm:"type:varchar(100)"`
-	OldStatus   string    `json:"oldStatus" gorm:"type:varchar(50)"`
-	NewStatus   string    `json:"newStatus" gorm:"type:varchar(50)"`
-	Description string    `json:"description" gorm:"type:text"`
-	Metadata    string    `json:"metadata" gorm:"type:jsonb"` // JSON con datos adicionales
-	IPAddress   string    `json:"ipAddress" gorm:"type:varchar(45)"`
-	UserAgent   string    `json:"userAgent" gorm:"type:varchar(255)"`
-	CreatedAt   time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	ID          uint            `json:"id" gorm:"primaryKey"`
+	EventType   string          `json:"eventType" gorm:"type:varchar(100);not null;index"`
+	OrderID     *uint           `json:"orderId" gorm:"index"`
+	OrderNumber string          `json:"orderNumber" gorm:"type:varchar(50)"`
+	UserID      *uint           `json:"userId" gorm:"index"`
+	UserName    string          `json:"userName" gorm:"type:varchar(100)"`
+	APIKeyID    *uint           `json:"apiKeyId" gorm:"index"` // Llave de API con la que se ejecutó la acción, si aplica
+	OldStatus   string          `json:"oldStatus" gorm:"type:varchar(50)"`
+	NewStatus   string          `json:"newStatus" gorm:"type:varchar(50)"`
+	EntityType  string          `json:"entityType,omitempty" gorm:"type:varchar(50);index:idx_audit_logs_entity"`
+	EntityID    *uint           `json:"entityId,omitempty" gorm:"index:idx_audit_logs_entity"`
+	Action      string          `json:"action,omitempty" gorm:"type:varchar(20)"`
+	Changes     json.RawMessage `json:"changes,omitempty" gorm:"type:jsonb"` // {"campo": {"before": x, "after": y}}
+	Description string          `json:"description" gorm:"type:text"`
+	Metadata    string          `json:"metadata" gorm:"type:jsonb"` // JSON con datos adicionales
+	IPAddress   string          `json:"ipAddress" gorm:"type:varchar(45)"`
+	UserAgent   string          `json:"userAgent" gorm:"type:varchar(255)"`
+	RequestID   string          `json:"requestId" gorm:"type:varchar(64);index"`
+	CreatedAt   time.Time       `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+// Eventos de auditoría que no provienen de órdenes
+const (
+	AuditEventCustomerTransactionReversed = "CUSTOMER_TRANSACTION_REVERSED"
+	AuditEventLoginLockout                = "SECURITY_LOGIN_LOCKOUT"
+	AuditEventPasswordChanged             = "SECURITY_PASSWORD_CHANGED"
+	AuditEventPasswordResetRequested      = "SECURITY_PASSWORD_RESET_REQUESTED"
+	AuditEventPasswordReset               = "SECURITY_PASSWORD_RESET"
+	AuditEventTwoFactorEnabled            = "SECURITY_2FA_ENABLED"
+	AuditEventTwoFactorDisabled           = "SECURITY_2FA_DISABLED"
+	AuditEventRecoveryCodeUsed            = "SECURITY_2FA_RECOVERY_CODE_USED"
+	AuditEventRecoveryCodesRegenerated    = "SECURITY_2FA_RECOVERY_CODES_REGENERATED"
+	AuditEventAPIKeyCreated               = "SECURITY_API_KEY_CREATED"
+	AuditEventAPIKeyRotated               = "SECURITY_API_KEY_ROTATED"
+	AuditEventAPIKeyRevoked               = "SECURITY_API_KEY_REVOKED"
+)
+
+// Entidades con historial de cambios (además de las órdenes)
+const (
+	AuditEntityProduct                = "PRODUCT"
+	AuditEntityProductVariant         = "PRODUCT_VARIANT"
+	AuditEntityCustomer               = "CUSTOMER"
+	AuditEntityCustomerTransaction    = "CUSTOMER_TRANSACTION"
+	AuditEntityFinancialTransaction   = "FINANCIAL_TRANSACTION"
+	AuditEntityUser                   = "USER"
+	AuditEntityRole                   = "ROLE"
+	AuditEntityUserCategoryPermission = "USER_CATEGORY_PERMISSION"
+	AuditEntityCommissionPayout       = "COMMISSION_PAYOUT"
+	AuditEntityRecurringTransaction   = "RECURRING_TRANSACTION"
+	AuditEntityBudget                 = "BUDGET"
+	AuditEntityPaymentMethod          = "PAYMENT_METHOD"
+	AuditEntityAccountTransfer        = "ACCOUNT_TRANSFER"
+	AuditEntityCashSession            = "CASH_SESSION"
+	AuditEntityLedgerAccount          = "LEDGER_ACCOUNT"
+	AuditEntityJournalEntry           = "JOURNAL_ENTRY"
+	AuditEntityInvoiceResolution      = "INVOICE_RESOLUTION"
+	AuditEntityElectronicDocument     = "ELECTRONIC_DOCUMENT"
+)
+
+// Acciones registradas en el historial de una entidad
+const (
+	AuditActionCreate  = "CREATE"
+	AuditActionUpdate  = "UPDATE"
+	AuditActionDelete  = "DELETE"
+	AuditActionReverse = "REVERSE" // Movimientos que no se borran sino que se anulan con un asiento compensatorio
+)
+
+// FieldChange es el valor de un campo antes y después de un cambio
+type FieldChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// NewSecurityAuditLog crea un registro de auditoría para un evento de seguridad
+// user puede ser nil cuando el evento no corresponde a una cuenta existente (ej: bloqueo de IP)
+func NewSecurityAuditLog(eventType string, user *User, ipAddress, userAgent, description, metadata string) *AuditLog {
+	if metadata == "" {
+		metadata = "{}"
+	}
+	auditLog := &AuditLog{
+		EventType:   eventType,
+		Description: description,
+		Metadata:    metadata,
+		IPAddress:   ipAddress,
+		UserAgent:   userAgent,
+	}
+	if user != nil {
+		auditLog.UserID = &user.ID
+		auditLog.UserName = user.FullName()
+	}
+	return auditLog
+}
+
+// SetActor registra quién ejecutó la acción
+// Con una llave de API el nombre mostrado es el de la llave; UserID sigue siendo su dueño
+func (l *AuditLog) SetActor(user *User, apiKey *APIKey) {
+	if user != nil {
+		l.UserID = &user.ID
+		l.UserName = user.FullName()
+	}
+	if apiKey != nil {
+		l.APIKeyID = &apiKey.ID
+		l.UserName = apiKey.DisplayName()
+	}
 }
 
 // TableName especifica el nombre de la tabla
@@ -26,11 +121,15 @@ func (AuditLog) TableName() string {
 
 // AuditLogFilters define filtros para consultar logs de auditoría
 type AuditLogFilters struct {
-	EventType string
-	OrderID   *uint
-	UserID    *uint
-	StartDate *time.Time
-	EndDate   *time.Time
-	Limit     int
-	Offset    int
+	EventType  string
+	EntityType string
+	EntityID   *uint
+	Action     string
+	OrderID    *uint
+	UserID     *uint
+	APIKeyID   *uint
+	StartDate  *time.Time
+	EndDate    *time.Time
+	Limit      int
+	Offset     int
 }
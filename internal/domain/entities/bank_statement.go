@@ -0,0 +1,190 @@
+package entities
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// StatementLineStatus representa el estado de conciliación de una línea del extracto
+type StatementLineStatus string
+
+const (
+	StatementLineUnmatched StatementLineStatus = "UNMATCHED" // Sin cruzar con un movimiento de la cuenta
+	StatementLineMatched   StatementLineStatus = "MATCHED"   // Cruzada con un ABONO, transacción financiera o traslado
+	StatementLineIgnored   StatementLineStatus = "IGNORED"   // Descartada a mano (ej: comisión bancaria ya registrada en bloque)
+)
+
+// StatementMatchToleranceDays son los días de diferencia aceptados entre el extracto y el movimiento
+// (un ABONO registrado el viernes puede aparecer el lunes en el banco)
+const StatementMatchToleranceDays = 3
+
+var (
+	// ErrInvalidStatementFile indica que el archivo no es un extracto CSV que se pueda leer
+	ErrInvalidStatementFile = errors.New("statement file must be a CSV with date, description and amount (or credit/debit) columns")
+
+	// ErrStatementLineNotFound indica que la línea no pertenece al extracto
+	ErrStatementLineNotFound = errors.New("statement line not found")
+
+	// ErrStatementLineNotUnmatched indica que la línea ya fue cruzada o descartada
+	ErrStatementLineNotUnmatched = errors.New("statement line is already matched or ignored")
+
+	// ErrMovementAlreadyReconciled indica que el movimiento ya está cruzado con otra línea
+	ErrMovementAlreadyReconciled = errors.New("account movement is already reconciled")
+
+	// ErrStatementAmountMismatch indica que la línea y el movimiento no tienen el mismo valor
+	ErrStatementAmountMismatch = errors.New("statement line and account movement amounts do not match")
+)
+
+// BankStatement es un extracto del banco o de Nequi importado para conciliar una cuenta
+type BankStatement struct {
+	ID              uint
+	PaymentMethodID uint
+	FileName        string
+	StartDate       time.Time // Fecha de la primera línea
+	EndDate         time.Time // Fecha de la última línea
+	ImportedByID    *uint
+	Lines           []BankStatementLine
+	CreatedAt       time.Time
+}
+
+// BankStatementLine es una línea del extracto
+type BankStatementLine struct {
+	ID              uint
+	StatementID     uint
+	PaymentMethodID uint
+	LineNumber      int // Fila del archivo
+	Date            time.Time
+	Description     string
+	Reference       string
+	Amount          float64 // Positivo entra, negativo sale
+	Status          StatementLineStatus
+	MatchedSource   *AccountMovementSource
+	MatchedID       *uint
+	MatchedAt       *time.Time
+}
+
+// Match cruza la línea con un movimiento de la cuenta
+func (l *BankStatementLine) Match(movement AccountMovement, at time.Time) error {
+	if l.Status != StatementLineUnmatched {
+		return ErrStatementLineNotUnmatched
+	}
+	if movement.Reconciled {
+		return ErrMovementAlreadyReconciled
+	}
+	if roundCents(l.Amount) != roundCents(movement.Amount) {
+		return ErrStatementAmountMismatch
+	}
+
+	source := movement.Source
+	sourceID := movement.SourceID
+	l.Status = StatementLineMatched
+	l.MatchedSource = &source
+	l.MatchedID = &sourceID
+	l.MatchedAt = &at
+	return nil
+}
+
+// Ignore descarta la línea para que no quede pendiente
+func (l *BankStatementLine) Ignore(at time.Time) error {
+	if l.Status != StatementLineUnmatched {
+		return ErrStatementLineNotUnmatched
+	}
+	l.Status = StatementLineIgnored
+	l.MatchedAt = &at
+	return nil
+}
+
+// Reset deja la línea pendiente otra vez (deshace un cruce o un descarte)
+func (l *BankStatementLine) Reset() {
+	l.Status = StatementLineUnmatched
+	l.MatchedSource = nil
+	l.MatchedID = nil
+	l.MatchedAt = nil
+}
+
+// AutoMatchStatement cruza las líneas pendientes con los movimientos no conciliados de la cuenta
+// que tengan el mismo valor y una fecha cercana, prefiriendo el de fecha más próxima.
+// Cada movimiento se usa una sola vez. Retorna cuántas líneas se cruzaron
+func AutoMatchStatement(lines []BankStatementLine, movements []AccountMovement, at time.Time) int {
+	used := make([]bool, len(movements))
+	for i := range movements {
+		used[i] = movements[i].Reconciled
+	}
+
+	matched := 0
+	for i := range lines {
+		line := &lines[i]
+		if line.Status != StatementLineUnmatched {
+			continue
+		}
+
+		best := -1
+		bestDistance := 0.0
+		for j := range movements {
+			if used[j] || roundCents(movements[j].Amount) != roundCents(line.Amount) {
+				continue
+			}
+			distance := math.Abs(dayOf(movements[j].Date).Sub(dayOf(line.Date)).Hours() / 24)
+			if distance > StatementMatchToleranceDays {
+				continue
+			}
+			if best == -1 || distance < bestDistance {
+				best = j
+				bestDistance = distance
+			}
+		}
+		if best == -1 {
+			continue
+		}
+
+		if err := line.Match(movements[best], at); err == nil {
+			used[best] = true
+			matched++
+		}
+	}
+	return matched
+}
+
+// dayOf descarta la hora para comparar fechas por día calendario
+func dayOf(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// BankReconciliation es el estado de conciliación de un extracto contra la cuenta
+type BankReconciliation struct {
+	Statement    BankStatement
+	StatementNet float64 // Suma de todas las líneas del extracto
+	UnmatchedNet float64 // Suma de las líneas pendientes (en el banco y no en los libros)
+
+	// Movimientos de la cuenta en las fechas del extracto que no aparecen en ningún extracto
+	// (en los libros y no en el banco)
+	UnreconciledMovements []AccountMovement
+	UnreconciledNet       float64
+}
+
+// NewBankReconciliation resume el extracto y los movimientos de la cuenta pendientes de conciliar
+func NewBankReconciliation(statement BankStatement, movements []AccountMovement) *BankReconciliation {
+	reconciliation := &BankReconciliation{
+		Statement:             statement,
+		UnreconciledMovements: []AccountMovement{},
+	}
+	for _, line := range statement.Lines {
+		reconciliation.StatementNet += line.Amount
+		if line.Status == StatementLineUnmatched {
+			reconciliation.UnmatchedNet += line.Amount
+		}
+	}
+	for _, movement := range movements {
+		if movement.Reconciled {
+			continue
+		}
+		reconciliation.UnreconciledMovements = append(reconciliation.UnreconciledMovements, movement)
+		reconciliation.UnreconciledNet += movement.Amount
+	}
+
+	reconciliation.StatementNet = roundCents(reconciliation.StatementNet)
+	reconciliation.UnmatchedNet = roundCents(reconciliation.UnmatchedNet)
+	reconciliation.UnreconciledNet = roundCents(reconciliation.UnreconciledNet)
+	return reconciliation
+}
@@ -0,0 +1,138 @@
+package entities
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+)
+
+// ErrInvalidBudgetCategory indica que la categoría no es una categoría de gasto
+var ErrInvalidBudgetCategory = errors.New("budget category must be an expense category")
+
+// ExpenseCategories son las categorías de gasto que pueden tener presupuesto
+func ExpenseCategories() []FinancialTransactionCategory {
+	return []FinancialTransactionCategory{
+		FinancialTransactionCategoryOperational,
+		FinancialTransactionCategoryPersonnel,
+		FinancialTransactionCategoryInventory,
+		FinancialTransactionCategoryMarketing,
+		FinancialTransactionCategoryUtilities,
+		FinancialTransactionCategoryRent,
+		FinancialTransactionCategoryOther,
+	}
+}
+
+// Budget es el presupuesto de gasto de una categoría en un mes
+// AlertedAt se llena cuando se avisa que la categoría se pasó del presupuesto
+type Budget struct {
+	ID        uint
+	Category  FinancialTransactionCategory
+	Month     time.Time // Primer día del mes
+	Amount    float64
+	AlertedAt *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Validate valida el presupuesto y normaliza el mes a su primer día
+func (b *Budget) Validate() error {
+	if b.Amount <= 0 {
+		return errors.New("amount must be greater than zero")
+	}
+	if !isExpenseCategory(b.Category) {
+		return ErrInvalidBudgetCategory
+	}
+	if b.Month.IsZero() {
+		return errors.New("month is required")
+	}
+	b.Month = MonthStart(b.Month)
+	return nil
+}
+
+// MonthStart retorna el primer día del mes de date
+func MonthStart(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+}
+
+func isExpenseCategory(category FinancialTransactionCategory) bool {
+	for _, expense := range ExpenseCategories() {
+		if expense == category {
+			return true
+		}
+	}
+	return false
+}
+
+// BudgetLine es el presupuesto contra lo gastado de una categoría
+type BudgetLine struct {
+	BudgetID    *uint // nil si la categoría tuvo gastos pero no tiene presupuesto
+	Category    FinancialTransactionCategory
+	Budget      float64
+	Actual      float64
+	Remaining   float64  // Negativo cuando se pasó
+	UsedPercent *float64 // nil sin presupuesto
+	Overspent   bool
+}
+
+// BudgetReport es el presupuesto contra lo real de un mes
+type BudgetReport struct {
+	Month       time.Time
+	Lines       []BudgetLine
+	TotalBudget float64
+	TotalActual float64
+	Overspent   []FinancialTransactionCategory // Categorías que se pasaron del presupuesto
+}
+
+// NewBudgetReport cruza los presupuestos del mes con el gasto real por categoría
+// Las categorías con gasto y sin presupuesto aparecen al final, sin alerta
+func NewBudgetReport(month time.Time, budgets []Budget, actuals map[FinancialTransactionCategory]float64) *BudgetReport {
+	report := &BudgetReport{
+		Month:     MonthStart(month),
+		Lines:     []BudgetLine{},
+		Overspent: []FinancialTransactionCategory{},
+	}
+
+	budgeted := make(map[FinancialTransactionCategory]bool, len(budgets))
+	for _, budget := range budgets {
+		budgetID := budget.ID
+		actual := roundCents(actuals[budget.Category])
+		used := math.Round(actual/budget.Amount*10000) / 100
+		line := BudgetLine{
+			BudgetID:    &budgetID,
+			Category:    budget.Category,
+			Budget:      budget.Amount,
+			Actual:      actual,
+			Remaining:   roundCents(budget.Amount - actual),
+			UsedPercent: &used,
+			Overspent:   actual > budget.Amount,
+		}
+		report.Lines = append(report.Lines, line)
+		if line.Overspent {
+			report.Overspent = append(report.Overspent, budget.Category)
+		}
+		budgeted[budget.Category] = true
+		report.TotalBudget += budget.Amount
+		report.TotalActual += actual
+	}
+
+	unbudgeted := []BudgetLine{}
+	for category, actual := range actuals {
+		if budgeted[category] || actual <= 0 {
+			continue
+		}
+		actual = roundCents(actual)
+		unbudgeted = append(unbudgeted, BudgetLine{
+			Category:  category,
+			Actual:    actual,
+			Remaining: -actual,
+		})
+		report.TotalActual += actual
+	}
+	sort.Slice(unbudgeted, func(i, j int) bool { return unbudgeted[i].Category < unbudgeted[j].Category })
+	report.Lines = append(report.Lines, unbudgeted...)
+
+	report.TotalBudget = roundCents(report.TotalBudget)
+	report.TotalActual = roundCents(report.TotalActual)
+	return report
+}
@@ -0,0 +1,243 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CampaignType representa el criterio principal de selección de una campaña
+type CampaignType string
+
+const (
+	CampaignTypeBirthday        CampaignType = "BIRTHDAY"         // Clientes que cumplen años en un mes
+	CampaignTypeSizeProfile     CampaignType = "SIZE_PROFILE"     // Clientes con un perfil de tallas
+	CampaignTypePurchaseHistory CampaignType = "PURCHASE_HISTORY" // Clientes según su historial de compras
+)
+
+// DiscountType representa cómo se calcula el descuento de un cupón
+type DiscountType string
+
+const (
+	DiscountTypePercentage DiscountType = "PERCENTAGE" // Porcentaje sobre el subtotal
+	DiscountTypeFixed      DiscountType = "FIXED"      // Monto fijo
+)
+
+// CampaignCriteria define los filtros para seleccionar clientes de una campaña
+// Los filtros se combinan (AND); los que están en nil no se aplican
+type CampaignCriteria struct {
+	BirthdayMonth      *int     `json:"birthday_month"`       // Mes de cumpleaños (1-12)
+	ShirtSizeID        *uint    `json:"shirt_size_id"`        // Talla de camiseta
+	PantsSizeID        *uint    `json:"pants_size_id"`        // Talla de pantalón
+	ShoesSizeID        *uint    `json:"shoes_size_id"`        // Talla de tenis
+	MinOrders          *int     `json:"min_orders"`           // Mínimo de órdenes entregadas
+	MinSpent           *float64 `json:"min_spent"`            // Mínimo gastado en órdenes entregadas
+	PurchasedSinceDays *int     `json:"purchased_since_days"` // Con compras en los últimos N días
+	InactiveSinceDays  *int     `json:"inactive_since_days"`  // Sin compras en los últimos N días
+}
+
+// Campaign representa una campaña de fidelización o cumpleaños
+type Campaign struct {
+	ID              uint
+	Name            string
+	Type            CampaignType
+	Criteria        CampaignCriteria
+	DiscountType    DiscountType
+	DiscountValue   float64
+	MessageTemplate string // Plantilla con {nombre}, {codigo}, {descuento}, {vence}
+	ValidFrom       time.Time
+	ValidUntil      time.Time
+	IsActive        bool
+	CreatedBy       uint
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Validate valida los datos de la campaña
+func (c *Campaign) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return errors.New("campaign name is required")
+	}
+	switch c.Type {
+	case CampaignTypeBirthday:
+		if c.Criteria.BirthdayMonth == nil {
+			return errors.New("birthday campaigns require birthday_month")
+		}
+	case CampaignTypeSizeProfile:
+		if c.Criteria.ShirtSizeID == nil && c.Criteria.PantsSizeID == nil && c.Criteria.ShoesSizeID == nil {
+			return errors.New("size profile campaigns require at least one size")
+		}
+	case CampaignTypePurchaseHistory:
+		if c.Criteria.MinOrders == nil && c.Criteria.MinSpent == nil &&
+			c.Criteria.PurchasedSinceDays == nil && c.Criteria.InactiveSinceDays == nil {
+			return errors.New("purchase history campaigns require at least one purchase filter")
+		}
+	default:
+		return errors.New("invalid campaign type: must be BIRTHDAY, SIZE_PROFILE or PURCHASE_HISTORY")
+	}
+	if m := c.Criteria.BirthdayMonth; m != nil && (*m < 1 || *m > 12) {
+		return errors.New("birthday_month must be between 1 and 12")
+	}
+	switch c.DiscountType {
+	case DiscountTypePercentage:
+		if c.DiscountValue <= 0 || c.DiscountValue > 100 {
+			return errors.New("percentage discount must be between 0 and 100")
+		}
+	case DiscountTypeFixed:
+		if c.DiscountValue <= 0 {
+			return errors.New("fixed discount must be greater than zero")
+		}
+	default:
+		return errors.New("invalid discount type: must be PERCENTAGE or FIXED")
+	}
+	if c.ValidUntil.IsZero() || !c.ValidUntil.After(c.ValidFrom) {
+		return errors.New("valid_until must be after valid_from")
+	}
+	return nil
+}
+
+// DiscountLabel retorna el descuento en formato legible (ej: "15%" o "$20.000")
+func (c *Campaign) DiscountLabel() string {
+	return discountLabel(c.DiscountType, c.DiscountValue)
+}
+
+// RenderMessage genera el mensaje personalizado para un cliente y un código
+func (c *Campaign) RenderMessage(customer *Customer, code string) string {
+	template := c.MessageTemplate
+	if strings.TrimSpace(template) == "" {
+		template = defaultCampaignTemplate(c.Type)
+	}
+
+	firstName := strings.TrimSpace(customer.Name)
+	if parts := strings.Fields(firstName); len(parts) > 0 {
+		firstName = parts[0]
+	}
+
+	replacer := strings.NewReplacer(
+		"{nombre}", firstName,
+		"{codigo}", code,
+		"{descuento}", c.DiscountLabel(),
+		"{vence}", c.ValidUntil.Format("02/01/2006"),
+	)
+	return replacer.Replace(template)
+}
+
+// defaultCampaignTemplate retorna la plantilla por defecto según el tipo de campaña
+func defaultCampaignTemplate(campaignType CampaignType) string {
+	if campaignType == CampaignTypeBirthday {
+		return "¡Feliz cumpleaños {nombre}! 🎉 En Fashion Blue te regalamos {descuento} de descuento en tu próxima compra con el código {codigo}. Válido hasta el {vence}."
+	}
+	return "Hola {nombre}, en Fashion Blue tenemos {descuento} de descuento para ti con el código {codigo}. Válido hasta el {vence}."
+}
+
+// CampaignCoupon representa un código de descuento personal generado por una campaña
+type CampaignCoupon struct {
+	ID              uint
+	CampaignID      uint
+	Campaign        *Campaign
+	CustomerID      uint
+	Customer        *Customer
+	Code            string
+	Message         string
+	DiscountType    DiscountType
+	DiscountValue   float64
+	ExpiresAt       time.Time
+	RedeemedAt      *time.Time
+	RedeemedOrderID *uint
+	DiscountApplied float64 // Descuento efectivamente aplicado al redimir
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+var (
+	// ErrCouponNotFound indica que el código no existe
+	ErrCouponNotFound = errors.New("coupon not found")
+
+	// ErrCouponRedeemed indica que el código ya fue usado
+	ErrCouponRedeemed = errors.New("coupon already redeemed")
+
+	// ErrCouponExpired indica que el código está vencido
+	ErrCouponExpired = errors.New("coupon expired")
+
+	// ErrCouponCustomerMismatch indica que el código pertenece a otro cliente
+	ErrCouponCustomerMismatch = errors.New("coupon belongs to another customer")
+
+	// ErrCouponCampaignInactive indica que la campaña del código fue desactivada
+	ErrCouponCampaignInactive = errors.New("coupon campaign is not active")
+
+	// ErrCouponNotYetValid indica que la campaña del código aún no ha empezado
+	ErrCouponNotYetValid = errors.New("coupon campaign has not started yet")
+)
+
+// IsRedeemed verifica si el cupón ya fue redimido
+func (cc *CampaignCoupon) IsRedeemed() bool {
+	return cc.RedeemedAt != nil
+}
+
+// CanRedeem verifica si el cupón puede usarse para un cliente en una fecha dada
+// campaign es la campaña que generó el cupón: desactivarla o que aún no empiece invalida sus códigos
+func (cc *CampaignCoupon) CanRedeem(campaign *Campaign, customerID *uint, at time.Time) error {
+	if campaign == nil || !campaign.IsActive {
+		return ErrCouponCampaignInactive
+	}
+	if at.Before(campaign.ValidFrom) {
+		return ErrCouponNotYetValid
+	}
+	if cc.IsRedeemed() {
+		return ErrCouponRedeemed
+	}
+	if at.After(cc.ExpiresAt) {
+		return ErrCouponExpired
+	}
+	if customerID == nil || *customerID != cc.CustomerID {
+		return ErrCouponCustomerMismatch
+	}
+	return nil
+}
+
+// CalculateDiscount calcula el descuento sobre un subtotal (nunca mayor al subtotal)
+func (cc *CampaignCoupon) CalculateDiscount(subtotal float64) float64 {
+	if subtotal <= 0 {
+		return 0
+	}
+	discount := cc.DiscountValue
+	if cc.DiscountType == DiscountTypePercentage {
+		discount = subtotal * cc.DiscountValue / 100
+	}
+	if discount > subtotal {
+		return subtotal
+	}
+	return discount
+}
+
+// DiscountLabel retorna el descuento del cupón en formato legible
+func (cc *CampaignCoupon) DiscountLabel() string {
+	return discountLabel(cc.DiscountType, cc.DiscountValue)
+}
+
+// CampaignStats resume el desempeño de una campaña
+type CampaignStats struct {
+	CampaignID      uint    `json:"campaign_id"`
+	CouponsIssued   int64   `json:"coupons_issued"`
+	CouponsRedeemed int64   `json:"coupons_redeemed"`
+	RedemptionRate  float64 `json:"redemption_rate"`
+	TotalDiscount   float64 `json:"total_discount"`
+}
+
+// discountLabel formatea un descuento según su tipo
+func discountLabel(discountType DiscountType, value float64) string {
+	if discountType == DiscountTypePercentage {
+		return fmt.Sprintf("%.0f%%", value)
+	}
+
+	amount := fmt.Sprintf("%.0f", value)
+	result := ""
+	for i, digit := range amount {
+		if i > 0 && (len(amount)-i)%3 == 0 {
+			result += "."
+		}
+		result += string(digit)
+	}
+	return "$" + result
+}
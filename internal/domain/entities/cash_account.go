@@ -0,0 +1,137 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// AccountMovementSource identifica el registro que originó un movimiento de una cuenta
+type AccountMovementSource string
+
+const (
+	AccountMovementCustomerTransaction  AccountMovementSource = "CUSTOMER_TRANSACTION"  // ABONO de un cliente (o su reverso)
+	AccountMovementFinancialTransaction AccountMovementSource = "FINANCIAL_TRANSACTION" // Ingreso o gasto pagado con la cuenta
+	AccountMovementTransfer             AccountMovementSource = "ACCOUNT_TRANSFER"      // Traslado entre cuentas
+)
+
+var (
+	// ErrInvalidTransfer indica que el traslado no tiene dos cuentas distintas o un monto válido
+	ErrInvalidTransfer = errors.New("transfer requires two different accounts and an amount greater than zero")
+
+	// ErrPaymentMethodInactive indica que la cuenta está desactivada y no recibe movimientos nuevos
+	ErrPaymentMethodInactive = errors.New("payment method is inactive")
+)
+
+// IsValid indica si el origen del movimiento es conocido
+func (s AccountMovementSource) IsValid() bool {
+	switch s {
+	case AccountMovementCustomerTransaction, AccountMovementFinancialTransaction, AccountMovementTransfer:
+		return true
+	}
+	return false
+}
+
+// AccountTransfer es un traslado de dinero entre dos cuentas (ej: consignar el efectivo en el banco)
+type AccountTransfer struct {
+	ID                  uint
+	FromPaymentMethodID uint
+	ToPaymentMethodID   uint
+	Amount              float64
+	Description         string
+	Date                time.Time
+	CreatedByID         *uint
+	CreatedAt           time.Time
+}
+
+// Validate valida el traslado
+func (t *AccountTransfer) Validate() error {
+	if t.FromPaymentMethodID == 0 || t.ToPaymentMethodID == 0 ||
+		t.FromPaymentMethodID == t.ToPaymentMethodID || t.Amount <= 0 {
+		return ErrInvalidTransfer
+	}
+	t.Description = strings.TrimSpace(t.Description)
+	if t.Description == "" {
+		t.Description = "Traslado entre cuentas"
+	}
+	if t.Date.IsZero() {
+		t.Date = time.Now()
+	}
+	return nil
+}
+
+// AccountMovement es una entrada o salida de dinero de una cuenta
+type AccountMovement struct {
+	Date        time.Time
+	Source      AccountMovementSource
+	SourceID    uint
+	Description string
+	Amount      float64 // Positivo entra, negativo sale
+	Balance     float64 // Saldo de la cuenta después del movimiento
+	Reconciled  bool    // Ya se cruzó con una línea de un extracto
+}
+
+// AccountTotals son las entradas y salidas acumuladas de una cuenta
+type AccountTotals struct {
+	Inflows  float64
+	Outflows float64 // Valor positivo
+}
+
+// AccountBalance es el saldo actual de una cuenta
+type AccountBalance struct {
+	PaymentMethod  PaymentMethodOption
+	OpeningBalance float64
+	Inflows        float64
+	Outflows       float64 // Valor positivo
+	Balance        float64
+}
+
+// NewAccountBalance calcula el saldo de la cuenta a partir de sus entradas y salidas
+func NewAccountBalance(account PaymentMethodOption, totals AccountTotals) AccountBalance {
+	return AccountBalance{
+		PaymentMethod:  account,
+		OpeningBalance: account.OpeningBalance,
+		Inflows:        roundCents(totals.Inflows),
+		Outflows:       roundCents(totals.Outflows),
+		Balance:        roundCents(account.OpeningBalance + totals.Inflows - totals.Outflows),
+	}
+}
+
+// AccountLedger es el libro de una cuenta en un periodo con el saldo después de cada movimiento
+type AccountLedger struct {
+	PaymentMethod  PaymentMethodOption
+	StartDate      time.Time
+	EndDate        time.Time
+	OpeningBalance float64 // Saldo al inicio del periodo
+	Inflows        float64
+	Outflows       float64 // Valor positivo
+	ClosingBalance float64
+	Movements      []AccountMovement
+}
+
+// NewAccountLedger calcula el saldo corrido de los movimientos (ordenados por fecha) desde openingBalance
+func NewAccountLedger(account PaymentMethodOption, start, end time.Time, openingBalance float64, movements []AccountMovement) *AccountLedger {
+	ledger := &AccountLedger{
+		PaymentMethod:  account,
+		StartDate:      start,
+		EndDate:        end,
+		OpeningBalance: roundCents(openingBalance),
+		Movements:      movements,
+	}
+
+	balance := ledger.OpeningBalance
+	for i := range ledger.Movements {
+		movement := &ledger.Movements[i]
+		if movement.Amount >= 0 {
+			ledger.Inflows += movement.Amount
+		} else {
+			ledger.Outflows -= movement.Amount
+		}
+		balance = roundCents(balance + movement.Amount)
+		movement.Balance = balance
+	}
+	ledger.Inflows = roundCents(ledger.Inflows)
+	ledger.Outflows = roundCents(ledger.Outflows)
+	ledger.ClosingBalance = balance
+	return ledger
+}
@@ -0,0 +1,192 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// CashFlowSource identifica de dónde sale cada movimiento proyectado
+type CashFlowSource string
+
+const (
+	CashFlowReceivable       CashFlowSource = "RECEIVABLE"        // Cuota esperada de un cliente con saldo pendiente
+	CashFlowCustomOrder      CashFlowSource = "CUSTOM_ORDER"      // Orden CUSTOM pendiente, cobrada al entregarse
+	CashFlowRecurringExpense CashFlowSource = "RECURRING_EXPENSE" // Gasto fijo estimado con el promedio de los últimos meses
+	CashFlowScheduled        CashFlowSource = "SCHEDULED"         // Ocurrencia de una transacción recurrente programada
+)
+
+const (
+	// DefaultCashFlowWeeks cubre los próximos 90 días
+	DefaultCashFlowWeeks = 13
+
+	// MaxCashFlowWeeks limita la proyección a medio año
+	MaxCashFlowWeeks = 26
+)
+
+// ErrInvalidCashFlowWeeks indica que el horizonte de la proyección está fuera de rango
+var ErrInvalidCashFlowWeeks = errors.New("weeks must be between 1 and 26")
+
+// RecurringExpenseCategories son las categorías de gasto que se repiten cada mes
+// INVENTORY queda por fuera: las compras de tela son justamente lo que se quiere planear
+func RecurringExpenseCategories() []FinancialTransactionCategory {
+	return []FinancialTransactionCategory{
+		FinancialTransactionCategoryPersonnel,
+		FinancialTransactionCategoryRent,
+		FinancialTransactionCategoryUtilities,
+		FinancialTransactionCategoryOperational,
+		FinancialTransactionCategoryMarketing,
+	}
+}
+
+// PendingCustomOrderStatuses son los estados de una orden CUSTOM aprobada que aún no se entrega
+func PendingCustomOrderStatuses() []OrderStatus {
+	return []OrderStatus{
+		OrderStatusApproved,
+		OrderStatusManufacturing,
+		OrderStatusInProduction,
+		OrderStatusFinished,
+	}
+}
+
+// CashFlowItem es un movimiento proyectado
+type CashFlowItem struct {
+	Date        time.Time
+	Source      CashFlowSource
+	ReferenceID *uint // Cliente, orden, plantilla recurrente o nil para gastos estimados
+	Description string
+	Amount      float64 // Positivo entra, negativo sale
+}
+
+// OpenReceivable es el saldo pendiente de un cliente con su comportamiento de pago
+type OpenReceivable struct {
+	Customer       Customer
+	Balance        float64
+	AveragePayment float64 // Promedio de sus abonos recientes; 0 si no ha abonado
+}
+
+// ProjectCollections reparte el saldo en las fechas de pago del cliente entre from y to
+// Cada cuota es su abono promedio (o el saldo dividido entre las fechas si no tiene historial)
+// y nunca se proyecta más del saldo pendiente
+func (r *OpenReceivable) ProjectCollections(from, to time.Time) []CashFlowItem {
+	dates := r.Customer.PaymentDatesBetween(from, to)
+	if len(dates) == 0 || r.Balance <= 0 {
+		return nil
+	}
+
+	installment := r.AveragePayment
+	if installment <= 0 {
+		installment = r.Balance / float64(len(dates))
+	}
+
+	items := []CashFlowItem{}
+	remaining := r.Balance
+	customerID := r.Customer.ID
+	for _, date := range dates {
+		if remaining <= 0 {
+			break
+		}
+		amount := roundCents(math.Min(installment, remaining))
+		remaining -= amount
+		items = append(items, CashFlowItem{
+			Date:        date,
+			Source:      CashFlowReceivable,
+			ReferenceID: &customerID,
+			Description: fmt.Sprintf("Cuota - %s", r.Customer.Name),
+			Amount:      amount,
+		})
+	}
+	return items
+}
+
+// CashFlowWeek es una semana de la proyección
+type CashFlowWeek struct {
+	StartDate      time.Time
+	EndDate        time.Time // Inclusive
+	OpeningBalance float64
+	Inflows        float64
+	Outflows       float64 // Valor positivo
+	ClosingBalance float64
+	Items          []CashFlowItem
+}
+
+// CashFlowProjection es la proyección semanal de caja
+type CashFlowProjection struct {
+	StartDate      time.Time
+	EndDate        time.Time // Inclusive
+	OpeningBalance float64   // Balance actual de ingresos y gastos
+	TotalInflows   float64
+	TotalOutflows  float64
+	ClosingBalance float64
+	LowestBalance  float64 // Menor saldo al cierre de una semana
+	LowestWeek     time.Time
+	Weeks          []CashFlowWeek
+
+	// Lo que no se puede ubicar en una semana queda por fuera de los totales
+	UnscheduledReceivables float64 // Saldos de clientes sin días de pago
+	UndatedOrders          float64 // Órdenes CUSTOM pendientes sin fecha estimada de entrega
+
+	// Meses de historia usados para estimar los gastos recurrentes
+	ExpenseHistoryMonths int
+}
+
+// NewCashFlowProjection ubica los movimientos en semanas de 7 días a partir de start
+// y calcula el saldo de cada semana. Los movimientos fuera del horizonte se ignoran
+func NewCashFlowProjection(start time.Time, weeks int, openingBalance float64, items []CashFlowItem) *CashFlowProjection {
+	end := start.AddDate(0, 0, weeks*7)
+	projection := &CashFlowProjection{
+		StartDate:      start,
+		EndDate:        end.AddDate(0, 0, -1),
+		OpeningBalance: roundCents(openingBalance),
+		Weeks:          make([]CashFlowWeek, weeks),
+	}
+	for i := range projection.Weeks {
+		weekStart := start.AddDate(0, 0, i*7)
+		projection.Weeks[i] = CashFlowWeek{
+			StartDate: weekStart,
+			EndDate:   weekStart.AddDate(0, 0, 6),
+			Items:     []CashFlowItem{},
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Date.Before(items[j].Date) })
+	for _, item := range items {
+		if item.Date.Before(start) || !item.Date.Before(end) {
+			continue
+		}
+		index := 0
+		for !item.Date.Before(projection.Weeks[index].EndDate.AddDate(0, 0, 1)) {
+			index++
+		}
+		week := &projection.Weeks[index]
+		week.Items = append(week.Items, item)
+		if item.Amount >= 0 {
+			week.Inflows += item.Amount
+		} else {
+			week.Outflows -= item.Amount
+		}
+	}
+
+	balance := projection.OpeningBalance
+	for i := range projection.Weeks {
+		week := &projection.Weeks[i]
+		week.Inflows = roundCents(week.Inflows)
+		week.Outflows = roundCents(week.Outflows)
+		week.OpeningBalance = balance
+		balance = roundCents(balance + week.Inflows - week.Outflows)
+		week.ClosingBalance = balance
+
+		projection.TotalInflows += week.Inflows
+		projection.TotalOutflows += week.Outflows
+		if i == 0 || week.ClosingBalance < projection.LowestBalance {
+			projection.LowestBalance = week.ClosingBalance
+			projection.LowestWeek = week.StartDate
+		}
+	}
+	projection.TotalInflows = roundCents(projection.TotalInflows)
+	projection.TotalOutflows = roundCents(projection.TotalOutflows)
+	projection.ClosingBalance = balance
+	return projection
+}
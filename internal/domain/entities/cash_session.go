@@ -0,0 +1,183 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// CashSessionStatus representa el estado de una sesión de caja
+type CashSessionStatus string
+
+const (
+	CashSessionOpen   CashSessionStatus = "OPEN"   // Recibiendo abonos y ventas
+	CashSessionClosed CashSessionStatus = "CLOSED" // Cerrada con el arqueo; sus movimientos ya no se modifican
+)
+
+// CashSessionEntryKind indica de dónde viene un movimiento de la caja
+type CashSessionEntryKind string
+
+const (
+	CashSessionEntryPayment CashSessionEntryKind = "PAYMENT" // ABONO de cliente (o su reverso, en negativo)
+	CashSessionEntrySale    CashSessionEntryKind = "SALE"    // Orden SALE cobrada en el mostrador
+)
+
+var (
+	// ErrCashSessionAlreadyOpen indica que el usuario ya tiene una caja abierta
+	ErrCashSessionAlreadyOpen = errors.New("user already has an open cash session")
+
+	// ErrNoOpenCashSession indica que el usuario no tiene una caja abierta
+	ErrNoOpenCashSession = errors.New("user has no open cash session")
+
+	// ErrCashSessionNotOpen indica que la sesión ya fue cerrada
+	ErrCashSessionNotOpen = errors.New("cash session is already closed")
+
+	// ErrCashSessionLocked indica que el movimiento pertenece a una caja cerrada
+	ErrCashSessionLocked = errors.New("transaction belongs to a closed cash session and cannot be changed")
+
+	// ErrInvalidCashAmount indica una base o un conteo de efectivo negativo
+	ErrInvalidCashAmount = errors.New("opening float and counted cash cannot be negative")
+)
+
+// CashSession es un turno de caja de un usuario en un punto de venta:
+// se abre con una base de efectivo, acumula los abonos y ventas registrados por el usuario
+// y se cierra con el efectivo contado
+type CashSession struct {
+	ID           uint
+	UserID       uint
+	User         *User
+	Location     string // Punto de venta (ej: "Tienda principal", "Feria")
+	Status       CashSessionStatus
+	OpeningFloat float64 // Base de efectivo con la que se abre la caja
+	OpenedAt     time.Time
+	ClosedAt     *time.Time
+	ClosedByID   *uint
+	ExpectedCash float64 // Base más lo cobrado en efectivo
+	CountedCash  float64 // Efectivo contado al cerrar
+	Difference   float64 // Contado menos esperado: positivo sobra, negativo falta
+	Notes        string
+	Totals       []CashSessionTotal // Calculados en vivo mientras está abierta, fijos al cerrar
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// CashSessionTotal es lo cobrado en la sesión con un método de pago
+type CashSessionTotal struct {
+	PaymentMethodID   *uint // nil: ventas sin método de pago (a crédito)
+	PaymentMethodName string
+	IsCash            bool
+	PaymentsCount     int
+	PaymentsAmount    float64 // ABONOS menos sus reversos
+	SalesCount        int
+	SalesAmount       float64
+}
+
+// Total es lo cobrado con el método de pago entre abonos y ventas
+func (t CashSessionTotal) Total() float64 {
+	return roundCents(t.PaymentsAmount + t.SalesAmount)
+}
+
+// CashSessionEntry es un abono o una venta registrado en la sesión
+type CashSessionEntry struct {
+	Date              time.Time
+	Kind              CashSessionEntryKind
+	SourceID          uint // ID de la transacción del cliente o de la orden
+	Description       string
+	PaymentMethodID   *uint
+	PaymentMethodName string
+	IsCash            bool
+	Amount            float64
+}
+
+// CashSessionReport es la sesión con el detalle de los abonos y ventas que la componen
+type CashSessionReport struct {
+	Session CashSession
+	Entries []CashSessionEntry
+}
+
+// NewCashSession abre una sesión de caja para el usuario
+func NewCashSession(userID uint, location string, openingFloat float64, at time.Time) (*CashSession, error) {
+	if openingFloat < 0 {
+		return nil, ErrInvalidCashAmount
+	}
+	return &CashSession{
+		UserID:       userID,
+		Location:     strings.TrimSpace(location),
+		Status:       CashSessionOpen,
+		OpeningFloat: roundCents(openingFloat),
+		OpenedAt:     at,
+		ExpectedCash: roundCents(openingFloat),
+		Totals:       []CashSessionTotal{},
+	}, nil
+}
+
+// IsOpen indica si la sesión sigue recibiendo movimientos
+func (s *CashSession) IsOpen() bool {
+	return s.Status == CashSessionOpen
+}
+
+// Summarize agrupa los movimientos por método de pago y calcula el efectivo esperado
+// Las ventas sin método de pago (a crédito) quedan al final y no suman al efectivo
+func (s *CashSession) Summarize(entries []CashSessionEntry) {
+	totals := []CashSessionTotal{}
+	index := map[uint]int{}
+	credit := CashSessionTotal{PaymentMethodName: "Sin método de pago"}
+	for _, entry := range entries {
+		total := &credit
+		if entry.PaymentMethodID != nil {
+			i, ok := index[*entry.PaymentMethodID]
+			if !ok {
+				totals = append(totals, CashSessionTotal{
+					PaymentMethodID:   entry.PaymentMethodID,
+					PaymentMethodName: entry.PaymentMethodName,
+					IsCash:            entry.IsCash,
+				})
+				i = len(totals) - 1
+				index[*entry.PaymentMethodID] = i
+			}
+			total = &totals[i]
+		}
+
+		if entry.Kind == CashSessionEntrySale {
+			total.SalesCount++
+			total.SalesAmount += entry.Amount
+		} else {
+			total.PaymentsCount++
+			total.PaymentsAmount += entry.Amount
+		}
+	}
+	if credit.PaymentsCount+credit.SalesCount > 0 {
+		totals = append(totals, credit)
+	}
+
+	expected := s.OpeningFloat
+	for i := range totals {
+		totals[i].PaymentsAmount = roundCents(totals[i].PaymentsAmount)
+		totals[i].SalesAmount = roundCents(totals[i].SalesAmount)
+		if totals[i].IsCash {
+			expected += totals[i].Total()
+		}
+	}
+
+	s.Totals = totals
+	s.ExpectedCash = roundCents(expected)
+}
+
+// Close registra el efectivo contado y deja la sesión cerrada con el sobrante o faltante
+// Los totales deben estar calculados con Summarize
+func (s *CashSession) Close(countedCash float64, closedByID *uint, notes string, at time.Time) error {
+	if !s.IsOpen() {
+		return ErrCashSessionNotOpen
+	}
+	if countedCash < 0 {
+		return ErrInvalidCashAmount
+	}
+
+	s.Status = CashSessionClosed
+	s.CountedCash = roundCents(countedCash)
+	s.Difference = roundCents(s.CountedCash - s.ExpectedCash)
+	s.ClosedAt = &at
+	s.ClosedByID = closedByID
+	s.Notes = strings.TrimSpace(notes)
+	return nil
+}
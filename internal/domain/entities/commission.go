@@ -0,0 +1,295 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// CommissionTier es un escalón de la regla: desde MinSales de venta neta del vendedor en el mes
+// se paga Rate en lugar de la tasa base
+type CommissionTier struct {
+	MinSales float64 `json:"minSales"`
+	Rate     float64 `json:"rate"` // Porcentaje (ej: 5 = 5%)
+}
+
+// CommissionRule define el porcentaje de comisión sobre la venta neta
+// SellerID y CategoryID son opcionales: sin ninguno la regla es general.
+// Para cada item aplica la regla más específica (vendedor + categoría, vendedor, categoría, general)
+type CommissionRule struct {
+	ID         uint
+	Name       string
+	SellerID   *uint
+	CategoryID *uint
+	Rate       float64          // Porcentaje base (ej: 3 = 3%)
+	Tiers      []CommissionTier // Escalones por venta neta mensual del vendedor, opcionales
+	IsActive   bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Validate valida los datos de la regla y ordena los escalones de menor a mayor
+func (r *CommissionRule) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return errors.New("rule name is required")
+	}
+	if r.Rate < 0 || r.Rate > 100 {
+		return errors.New("rate must be between 0 and 100")
+	}
+
+	seen := make(map[float64]bool, len(r.Tiers))
+	for _, tier := range r.Tiers {
+		if tier.MinSales <= 0 {
+			return errors.New("tier minSales must be greater than zero")
+		}
+		if tier.Rate < 0 || tier.Rate > 100 {
+			return errors.New("tier rate must be between 0 and 100")
+		}
+		if seen[tier.MinSales] {
+			return errors.New("tiers cannot repeat minSales")
+		}
+		seen[tier.MinSales] = true
+	}
+	sort.Slice(r.Tiers, func(i, j int) bool { return r.Tiers[i].MinSales < r.Tiers[j].MinSales })
+	return nil
+}
+
+// RateFor retorna la tasa que corresponde a la venta neta acumulada del vendedor en el mes
+// Los escalones no son retroactivos: solo cambian la tasa de las ventas que llegan después
+func (r *CommissionRule) RateFor(monthlySales float64) float64 {
+	rate := r.Rate
+	for _, tier := range r.Tiers {
+		if monthlySales >= tier.MinSales {
+			rate = tier.Rate
+		}
+	}
+	return rate
+}
+
+// specificity ordena las reglas: 3 vendedor + categoría, 2 vendedor, 1 categoría, 0 general
+func (r *CommissionRule) specificity() int {
+	score := 0
+	if r.SellerID != nil {
+		score += 2
+	}
+	if r.CategoryID != nil {
+		score++
+	}
+	return score
+}
+
+// matches indica si la regla aplica al vendedor y la categoría del item
+func (r *CommissionRule) matches(sellerID, categoryID uint) bool {
+	if !r.IsActive {
+		return false
+	}
+	if r.SellerID != nil && *r.SellerID != sellerID {
+		return false
+	}
+	if r.CategoryID != nil && *r.CategoryID != categoryID {
+		return false
+	}
+	return true
+}
+
+// MatchCommissionRule busca la regla más específica para el vendedor y la categoría
+// Si dos reglas empatan gana la más reciente (ID mayor). Retorna nil si ninguna aplica
+func MatchCommissionRule(rules []CommissionRule, sellerID, categoryID uint) *CommissionRule {
+	var best *CommissionRule
+	for i := range rules {
+		rule := &rules[i]
+		if !rule.matches(sellerID, categoryID) {
+			continue
+		}
+		if best == nil ||
+			rule.specificity() > best.specificity() ||
+			(rule.specificity() == best.specificity() && rule.ID > best.ID) {
+			best = rule
+		}
+	}
+	return best
+}
+
+// CommissionEntryType representa el tipo de movimiento del libro de comisiones
+type CommissionEntryType string
+
+const (
+	CommissionEntryAccrual  CommissionEntryType = "ACCRUAL"  // Comisión causada por una venta completada
+	CommissionEntryClawback CommissionEntryType = "CLAWBACK" // Reverso por devolución o cancelación
+)
+
+// CommissionEntry es un movimiento del libro de comisiones de un vendedor
+// BaseAmount y Amount son positivos en ACCRUAL y negativos en CLAWBACK.
+// PayoutID queda en nil hasta que el movimiento se liquida
+type CommissionEntry struct {
+	ID           uint
+	SellerID     uint
+	OrderID      uint
+	OrderNumber  string
+	Type         CommissionEntryType
+	BaseAmount   float64 // Venta neta sobre la que se calculó la comisión
+	Amount       float64
+	Description  string
+	ReversalOfID *uint // En CLAWBACK, el ACCRUAL que reversa
+	PayoutID     *uint
+	CreatedAt    time.Time
+}
+
+// IsPaid indica si el movimiento ya fue liquidado
+func (e *CommissionEntry) IsPaid() bool {
+	return e.PayoutID != nil
+}
+
+// NewCommissionClawback crea el reverso de una causación por el mismo valor
+// Si la causación ya se pagó, el reverso queda pendiente y se descuenta de la siguiente liquidación
+func NewCommissionClawback(accrual *CommissionEntry, reason string) *CommissionEntry {
+	return &CommissionEntry{
+		SellerID:     accrual.SellerID,
+		OrderID:      accrual.OrderID,
+		OrderNumber:  accrual.OrderNumber,
+		Type:         CommissionEntryClawback,
+		BaseAmount:   -accrual.BaseAmount,
+		Amount:       -accrual.Amount,
+		Description:  fmt.Sprintf("Reverso - Orden %s (%s)", accrual.OrderNumber, reason),
+		ReversalOfID: &accrual.ID,
+	}
+}
+
+var (
+	// ErrCommissionNotFound indica que la orden no tiene comisión causada
+	ErrCommissionNotFound = errors.New("order has no accrued commission")
+
+	// ErrCommissionAlreadyClawedBack indica que la comisión de la orden ya fue reversada
+	ErrCommissionAlreadyClawedBack = errors.New("commission was already clawed back")
+
+	// ErrNothingToPay indica que el vendedor no tiene saldo de comisiones a favor
+	ErrNothingToPay = errors.New("seller has no commission balance to pay")
+
+	// ErrCommissionsAlreadyPaid indica que otro proceso liquidó los movimientos al mismo tiempo
+	ErrCommissionsAlreadyPaid = errors.New("some commission entries were already paid")
+)
+
+// CommissionAccrual es la comisión calculada para una orden
+type CommissionAccrual struct {
+	BaseAmount float64
+	Amount     float64
+	Details    []string
+}
+
+// CalculateCommission calcula la comisión de una orden item por item
+// La venta neta de cada item es su subtotal menos su parte del descuento de la orden.
+// monthlySales es la venta neta del vendedor en el mes antes de esta orden (para los escalones)
+func CalculateCommission(order *Order, rules []CommissionRule, monthlySales float64) CommissionAccrual {
+	accrual := CommissionAccrual{}
+
+	subtotal := 0.0
+	for _, item := range order.Items {
+		subtotal += item.Subtotal
+	}
+	if subtotal <= 0 || order.TotalAmount <= 0 {
+		return accrual
+	}
+	paidRatio := order.TotalAmount / subtotal
+	salesWithOrder := monthlySales + order.TotalAmount
+
+	amounts := make(map[uint]float64)
+	bases := make(map[uint]float64)
+	rates := make(map[uint]float64)
+	names := make(map[uint]string)
+	var ruleOrder []uint
+
+	for _, item := range order.Items {
+		rule := MatchCommissionRule(rules, order.SellerID, item.CategoryID)
+		if rule == nil {
+			continue
+		}
+		base := item.Subtotal * paidRatio
+		rate := rule.RateFor(salesWithOrder)
+
+		if _, seen := amounts[rule.ID]; !seen {
+			ruleOrder = append(ruleOrder, rule.ID)
+			names[rule.ID] = rule.Name
+			rates[rule.ID] = rate
+		}
+		bases[rule.ID] += base
+		amounts[rule.ID] += base * rate / 100
+		accrual.BaseAmount += base
+	}
+
+	for _, id := range ruleOrder {
+		amount := roundCents(amounts[id])
+		accrual.Amount += amount
+		accrual.Details = append(accrual.Details, fmt.Sprintf("%s: %.1f%% de $%.0f", names[id], rates[id], bases[id]))
+	}
+	accrual.BaseAmount = roundCents(accrual.BaseAmount)
+	accrual.Amount = roundCents(accrual.Amount)
+	return accrual
+}
+
+// CommissionPayout es la liquidación de las comisiones pendientes de un vendedor
+// Se registra también como gasto PERSONNEL en las transacciones financieras
+type CommissionPayout struct {
+	ID                     uint
+	SellerID               uint
+	PeriodStart            time.Time
+	PeriodEnd              time.Time
+	Amount                 float64
+	EntryCount             int
+	FinancialTransactionID uint
+	PaidByID               *uint
+	Notes                  string
+	CreatedAt              time.Time
+}
+
+// CommissionStatement es el estado de cuenta de comisiones de un vendedor en un periodo
+type CommissionStatement struct {
+	SellerID       uint
+	SellerName     string
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+	Entries        []CommissionEntry // Movimientos del periodo
+	Sales          float64           // Venta neta comisionada del periodo (descontando reversos)
+	Accrued        float64
+	ClawedBack     float64 // Valor positivo de los reversos del periodo
+	Net            float64 // Accrued - ClawedBack
+	Paid           float64 // Liquidado en el periodo
+	PendingBalance float64 // Saldo sin liquidar hasta el fin del periodo (incluye periodos anteriores)
+	Payouts        []CommissionPayout
+}
+
+// NewCommissionStatement totaliza los movimientos y liquidaciones del periodo
+func NewCommissionStatement(seller *User, start, end time.Time, entries []CommissionEntry, payouts []CommissionPayout, pending float64) *CommissionStatement {
+	statement := &CommissionStatement{
+		SellerID:       seller.ID,
+		SellerName:     seller.FullName(),
+		PeriodStart:    start,
+		PeriodEnd:      end,
+		Entries:        entries,
+		PendingBalance: roundCents(pending),
+		Payouts:        payouts,
+	}
+	for _, entry := range entries {
+		statement.Sales += entry.BaseAmount
+		if entry.Type == CommissionEntryClawback {
+			statement.ClawedBack -= entry.Amount
+		} else {
+			statement.Accrued += entry.Amount
+		}
+	}
+	for _, payout := range payouts {
+		statement.Paid += payout.Amount
+	}
+	statement.Sales = roundCents(statement.Sales)
+	statement.Accrued = roundCents(statement.Accrued)
+	statement.ClawedBack = roundCents(statement.ClawedBack)
+	statement.Net = roundCents(statement.Accrued - statement.ClawedBack)
+	statement.Paid = roundCents(statement.Paid)
+	return statement
+}
+
+func roundCents(value float64) float64 {
+	return math.Round(value*100) / 100
+}
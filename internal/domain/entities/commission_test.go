@@ -0,0 +1,57 @@
+package entities
+
+import "testing"
+
+func TestCalculateCommission(t *testing.T) {
+	seller := uint(7)
+	otherSeller := uint(8)
+	shirts := uint(1)
+	shoes := uint(2)
+
+	general := CommissionRule{ID: 1, Name: "General", Rate: 3, IsActive: true}
+	shoesRule := CommissionRule{ID: 2, Name: "Calzado", CategoryID: &shoes, Rate: 5, IsActive: true}
+	sellerRule := CommissionRule{ID: 3, Name: "Vendedor", SellerID: &seller, Rate: 4, IsActive: true}
+	otherSellerRule := CommissionRule{ID: 4, Name: "Otro vendedor", SellerID: &otherSeller, Rate: 10, IsActive: true}
+	tiered := CommissionRule{ID: 5, Name: "Escalonada", Rate: 2, IsActive: true, Tiers: []CommissionTier{
+		{MinSales: 1000000, Rate: 4},
+		{MinSales: 3000000, Rate: 6},
+	}}
+	inactive := CommissionRule{ID: 6, Name: "Inactiva", Rate: 50, IsActive: false}
+
+	order := func(total float64, items ...OrderItem) *Order {
+		return &Order{SellerID: seller, TotalAmount: total, Items: items}
+	}
+	shirt := OrderItem{CategoryID: shirts, Subtotal: 100000}
+	shoe := OrderItem{CategoryID: shoes, Subtotal: 200000}
+
+	tests := []struct {
+		name         string
+		order        *Order
+		rules        []CommissionRule
+		monthlySales float64
+		wantBase     float64
+		wantAmount   float64
+	}{
+		{"general rule", order(100000, shirt), []CommissionRule{general}, 0, 100000, 3000},
+		{"category rule wins over general", order(300000, shirt, shoe), []CommissionRule{general, shoesRule}, 0, 300000, 13000},
+		{"seller rule wins over category", order(300000, shirt, shoe), []CommissionRule{general, shoesRule, sellerRule}, 0, 300000, 12000},
+		{"rule of another seller does not apply", order(100000, shirt), []CommissionRule{otherSellerRule}, 0, 0, 0},
+		{"inactive rule is ignored", order(100000, shirt), []CommissionRule{inactive, general}, 0, 100000, 3000},
+		{"order discount is spread across items", order(270000, shirt, shoe), []CommissionRule{general, shoesRule}, 0, 270000, 11700},
+		{"below first tier uses base rate", order(100000, shirt), []CommissionRule{tiered}, 800000, 100000, 2000},
+		{"tier counts the order itself", order(200000, shoe), []CommissionRule{tiered}, 800000, 200000, 8000},
+		{"highest reached tier applies", order(100000, shirt), []CommissionRule{tiered}, 5000000, 100000, 6000},
+		{"no rules", order(100000, shirt), nil, 0, 0, 0},
+		{"zero total", order(0, shirt), []CommissionRule{general}, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CalculateCommission(tt.order, tt.rules, tt.monthlySales)
+			if got.BaseAmount != tt.wantBase || got.Amount != tt.wantAmount {
+				t.Errorf("CalculateCommission = base %.2f amount %.2f, want base %.2f amount %.2f",
+					got.BaseAmount, got.Amount, tt.wantBase, tt.wantAmount)
+			}
+		})
+	}
+}
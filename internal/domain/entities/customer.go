@@ -1,6 +1,11 @@
 package entities
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
 
 // RiskLevel representa el nivel de riesgo del cliente
 type RiskLevel string
@@ -35,6 +40,9 @@ type Customer struct {
 	Notes       string     `json:"notes"`         // Notas adicionales
 	IsActive    bool       `json:"is_active"`     // Si el cliente está activo
 
+	// Vendedor responsable; sin asignar solo lo ven quienes tienen customers:all
+	AssignedSellerID *uint `json:"assigned_seller_id"`
+
 	// Campos para pagos recurrentes
 	PaymentFrequency PaymentFrequency `json:"payment_frequency"` // Frecuencia de pago
 	PaymentDays      string           `json:"payment_days"`      // Días de pago separados por coma (ej: "2,17" para quincenal)
@@ -70,6 +78,11 @@ func (c *Customer) IsBirthday() bool {
 	return now.Month() == c.Birthday.Month() && now.Day() == c.Birthday.Day()
 }
 
+// IsBirthdayMonth verifica si el cliente cumple años en el mes de la fecha dada
+func (c *Customer) IsBirthdayMonth(at time.Time) bool {
+	return c.Birthday != nil && c.Birthday.Month() == at.Month()
+}
+
 // IsHighRisk verifica si el cliente es de alto riesgo
 func (c *Customer) IsHighRisk() bool {
 	return c.RiskLevel == RiskLevelHigh
@@ -111,6 +124,27 @@ func (c *Customer) IsPaymentDue(daysRange int) bool {
 	return false
 }
 
+// PaymentDatesBetween retorna las fechas de pago del cliente desde from (inclusive) hasta to (exclusive)
+// Un día de pago mayor al último día del mes se cobra el último día (ej: 31 en febrero)
+func (c *Customer) PaymentDatesBetween(from, to time.Time) []time.Time {
+	if c.PaymentFrequency == PaymentFrequencyNone || c.PaymentDays == "" {
+		return nil
+	}
+
+	paymentDays := c.GetPaymentDaysAsInts()
+	dates := []time.Time{}
+	for date := from; date.Before(to); date = date.AddDate(0, 0, 1) {
+		lastDay := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, date.Location()).Day()
+		for _, day := range paymentDays {
+			if date.Day() == day || (day > lastDay && date.Day() == lastDay) {
+				dates = append(dates, date)
+				break
+			}
+		}
+	}
+	return dates
+}
+
 // Helper functions
 func splitString(s, sep string) []string {
 	if s == "" {
@@ -161,7 +195,60 @@ type CustomerTransaction struct {
 	Description     string               // Descripción detallada del movimiento
 	PaymentMethodID *uint                // ID del método de pago (solo para ABONO)
 	PaymentMethod   *PaymentMethodOption // Relación con método de pago
+	CashSessionID   *uint                // Sesión de caja en la que se cobró (nil si no había caja abierta)
+	OrderID         *uint                // Venta que generó la deuda; su asiento contable es el de la venta
 	Date            time.Time
+	ReversalOfID    *uint  // Movimiento que este asiento compensa (solo en reversos)
+	ReversedByID    *uint  // Asiento de reverso que anuló este movimiento
+	ReversalReason  string // Motivo del reverso
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 }
+
+var (
+	ErrTransactionAlreadyReversed = errors.New("transaction has already been reversed")
+	ErrCannotReverseReversal      = errors.New("a reversal entry cannot be reversed")
+	ErrReversalReasonRequired     = errors.New("a reason is required to reverse a transaction")
+)
+
+// IsReversal indica si el movimiento es un asiento de reverso
+func (t *CustomerTransaction) IsReversal() bool {
+	return t.ReversalOfID != nil
+}
+
+// IsReversed indica si el movimiento fue anulado por un reverso
+func (t *CustomerTransaction) IsReversed() bool {
+	return t.ReversedByID != nil
+}
+
+// NewReversal crea el asiento compensatorio del movimiento: mismo monto y tipo contrario
+// El movimiento original nunca se elimina, así el saldo y el historial quedan trazables
+func (t *CustomerTransaction) NewReversal(reason string, at time.Time) (*CustomerTransaction, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrReversalReasonRequired
+	}
+	if t.IsReversal() {
+		return nil, ErrCannotReverseReversal
+	}
+	if t.IsReversed() {
+		return nil, ErrTransactionAlreadyReversed
+	}
+
+	reversalType := TransactionTypePayment
+	if t.Type == TransactionTypePayment {
+		reversalType = TransactionTypeDebt
+	}
+
+	originalID := t.ID
+	return &CustomerTransaction{
+		CustomerID:      t.CustomerID,
+		Type:            reversalType,
+		Amount:          t.Amount,
+		Description:     fmt.Sprintf("Reverso del movimiento #%d: %s", t.ID, reason),
+		PaymentMethodID: t.PaymentMethodID,
+		Date:            at,
+		ReversalOfID:    &originalID,
+		ReversalReason:  reason,
+	}, nil
+}
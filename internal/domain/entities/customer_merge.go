@@ -0,0 +1,134 @@
+package entities
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// DuplicateCandidate representa un par de clientes que probablemente son la misma persona
+type DuplicateCandidate struct {
+	Customer       Customer
+	Duplicate      Customer
+	NameSimilarity float64  // 0 a 1
+	SamePhone      bool     // Mismo teléfono normalizado
+	Reasons        []string // Motivos legibles de la coincidencia
+}
+
+// CustomerMerge registra la fusión de un cliente duplicado en el cliente que se conserva
+type CustomerMerge struct {
+	ID                  uint
+	SurvivorID          uint
+	MergedID            uint
+	MergedSnapshot      string // JSON del cliente fusionado antes de la fusión
+	TransactionsMoved   int64
+	OrdersMoved         int64
+	CouponsMoved        int64
+	LoyaltyEntriesMoved int64
+	SurvivorBalance     float64 // Saldo del cliente conservado antes de la fusión
+	MergedBalance       float64 // Saldo del cliente fusionado antes de la fusión
+	ResultingBalance    float64 // Saldo recalculado después de la fusión
+	MergedBy            uint
+	CreatedAt           time.Time
+}
+
+// ErrCannotMergeSameCustomer indica que se intentó fusionar un cliente consigo mismo
+var ErrCannotMergeSameCustomer = errors.New("cannot merge a customer into itself")
+
+// ErrMergeInactiveCustomer indica que uno de los clientes de la fusión está inactivo o ya fue fusionado
+var ErrMergeInactiveCustomer = errors.New("customer is inactive or was already merged")
+
+// NormalizeColombianPhone deja solo los dígitos y elimina el indicativo de Colombia (+57 / 0057)
+// Ej: "+57 300-123 4567" → "3001234567"
+func NormalizeColombianPhone(phone string) string {
+	var builder strings.Builder
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			builder.WriteRune(r)
+		}
+	}
+	digits := builder.String()
+
+	switch {
+	case len(digits) == 14 && strings.HasPrefix(digits, "0057"):
+		digits = digits[4:]
+	case len(digits) == 12 && strings.HasPrefix(digits, "57"):
+		digits = digits[2:]
+	}
+	return digits
+}
+
+// NormalizeCustomerName pasa a minúsculas, quita tildes y espacios repetidos
+// Ej: "  José   PÉREZ " → "jose perez"
+func NormalizeCustomerName(name string) string {
+	var builder strings.Builder
+	for _, r := range accentReplacer.Replace(strings.ToLower(name)) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			builder.WriteRune(r)
+		} else {
+			builder.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(builder.String()), " ")
+}
+
+// accentReplacer quita las tildes usadas en español
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ü", "u", "ñ", "n",
+)
+
+// NameSimilarity compara dos nombres normalizados y retorna un valor entre 0 y 1
+// Toma el mejor resultado entre comparar el texto completo y las palabras ordenadas,
+// para que "Perez Jose" y "Jose Perez" se consideren iguales
+func NameSimilarity(a, b string) float64 {
+	a, b = NormalizeCustomerName(a), NormalizeCustomerName(b)
+	if a == "" || b == "" {
+		return 0
+	}
+
+	similarity := levenshteinRatio(a, b)
+	if sorted := levenshteinRatio(sortedWords(a), sortedWords(b)); sorted > similarity {
+		similarity = sorted
+	}
+	return similarity
+}
+
+// sortedWords ordena alfabéticamente las palabras de un texto
+func sortedWords(s string) string {
+	words := strings.Fields(s)
+	sort.Strings(words)
+	return strings.Join(words, " ")
+}
+
+// levenshteinRatio retorna 1 - distancia/longitud máxima
+func levenshteinRatio(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	maxLen := len(ra)
+	if len(rb) > maxLen {
+		maxLen = len(rb)
+	}
+	if maxLen == 0 {
+		return 1
+	}
+
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return 1 - float64(previous[len(rb)])/float64(maxLen)
+}
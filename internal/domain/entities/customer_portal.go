@@ -0,0 +1,32 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// CustomerPortalScope identifica los tokens emitidos para el portal de clientes
+// Estos tokens no tienen user_id, por lo que nunca son aceptados en las rutas internas
+const CustomerPortalScope = "customer_portal"
+
+// CustomerAccessLink representa un enlace mágico de un solo uso para entrar al portal
+// Solo se guarda el hash del token; el token en claro solo viaja en el enlace enviado
+type CustomerAccessLink struct {
+	ID         uint
+	CustomerID uint
+	TokenHash  string
+	ExpiresAt  time.Time
+	UsedAt     *time.Time
+	CreatedAt  time.Time
+}
+
+// ErrInvalidAccessLink indica que el enlace no existe, ya se usó o está vencido
+var ErrInvalidAccessLink = errors.New("invalid or expired access link")
+
+// ErrInvalidPortalPhone indica que el teléfono para pedir el enlace no tiene suficientes dígitos
+var ErrInvalidPortalPhone = errors.New("invalid phone number")
+
+// IsValid verifica si el enlace puede usarse en la fecha dada
+func (l *CustomerAccessLink) IsValid(at time.Time) bool {
+	return l.UsedAt == nil && at.Before(l.ExpiresAt)
+}
@@ -0,0 +1,409 @@
+package entities
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ElectronicDocumentType es la clase de documento electrónico ante la DIAN
+type ElectronicDocumentType string
+
+const (
+	ElectronicInvoice    ElectronicDocumentType = "INVOICE"     // Factura electrónica de venta (tipo 01)
+	ElectronicCreditNote ElectronicDocumentType = "CREDIT_NOTE" // Nota crédito de factura electrónica (tipo 91)
+)
+
+// ElectronicDocumentStatus es el estado del documento frente a la DIAN
+type ElectronicDocumentStatus string
+
+const (
+	ElectronicDocumentPending  ElectronicDocumentStatus = "PENDING"  // Firmado y numerado, sin respuesta del proveedor (se puede reenviar)
+	ElectronicDocumentAccepted ElectronicDocumentStatus = "ACCEPTED" // Validado por la DIAN
+	ElectronicDocumentRejected ElectronicDocumentStatus = "REJECTED" // Rechazado: se corrige con un documento nuevo
+)
+
+// Ambientes de la DIAN (TipoAmbiente del CUFE)
+const (
+	DianEnvironmentProduction = "1"
+	DianEnvironmentTesting    = "2"
+)
+
+// DianLocation es la hora legal de Colombia (UTC-5) con la que se reportan fecha y hora de emisión
+var DianLocation = time.FixedZone("COT", -5*60*60)
+
+// Tipos de documento del adquiriente (tabla 13.2.1 del anexo técnico)
+const (
+	BuyerIDTypeCitizenCard   = "13" // Cédula de ciudadanía
+	BuyerIDTypeNIT           = "31" // NIT
+	BuyerIDTypeForeignerCard = "22" // Cédula de extranjería
+	BuyerIDTypePassport      = "41" // Pasaporte
+	BuyerIDTypeForeignID     = "42" // Documento de identificación extranjero
+)
+
+// Datos del consumidor final: se usan cuando el cliente no pide la factura a su nombre
+const (
+	FinalConsumerIDNumber = "222222222222"
+	FinalConsumerName     = "CONSUMIDOR FINAL"
+)
+
+// Medios de pago (tabla 13.3.4.2 del anexo técnico)
+const (
+	PaymentMeansCash            = "10"  // Efectivo
+	PaymentMeansTransfer        = "47"  // Transferencia débito bancaria (Nequi, Daviplata, bancos)
+	PaymentMeansMutualAgreement = "ZZZ" // Acuerdo mutuo: ventas a crédito
+)
+
+// Conceptos de corrección de las notas crédito (tabla 13.2.4 del anexo técnico)
+const (
+	CreditNotePartialReturn = "1" // Devolución parcial de los bienes
+	CreditNoteCancellation  = "2" // Anulación de la factura
+	CreditNoteDiscount      = "3" // Rebaja o descuento parcial o total
+	CreditNotePriceAdjust   = "4" // Ajuste de precio
+	CreditNoteOther         = "5" // Otros
+)
+
+var (
+	// ErrInvalidInvoiceResolution indica que la resolución de numeración está incompleta o tiene un rango inválido
+	ErrInvalidInvoiceResolution = errors.New("invoice resolution requires a type, an alphanumeric prefix of up to 4 characters, a valid number range and validity dates; invoices also need the resolution number and technical key")
+
+	// ErrNoActiveInvoiceResolution indica que no hay resolución vigente con números disponibles
+	ErrNoActiveInvoiceResolution = errors.New("there is no active numbering resolution with available numbers for this document type")
+
+	// ErrInvoiceResolutionOverlap indica que el rango se cruza con otra resolución del mismo prefijo
+	ErrInvoiceResolutionOverlap = errors.New("the number range overlaps another resolution with the same prefix")
+
+	// ErrOrderNotInvoiceable indica que la orden no es una venta (cotización, pendiente, cancelada o de inventario)
+	// o no tiene valor a facturar
+	ErrOrderNotInvoiceable = errors.New("only sold orders with an amount can be invoiced; quotes, pending, cancelled and inventory orders cannot")
+
+	// ErrOrderAlreadyInvoiced indica que la orden ya tiene una factura que no ha sido anulada con nota crédito
+	ErrOrderAlreadyInvoiced = errors.New("order already has an electronic invoice; cancel it with a credit note before invoicing again")
+
+	// ErrInvalidBuyer indica que el adquiriente no tiene tipo, número de documento o nombre válidos
+	ErrInvalidBuyer = errors.New("buyer requires a known document type, a numeric document number and a name")
+
+	// ErrInvalidCreditNote indica un concepto de corrección desconocido, sin motivo o con líneas inválidas
+	ErrInvalidCreditNote = errors.New("credit note requires a correction code (1-5), a reason and lines that exist in the invoice with quantities within the invoiced ones")
+
+	// ErrCreditNoteRequiresInvoice indica que la nota crédito no referencia una factura válida
+	ErrCreditNoteRequiresInvoice = errors.New("credit notes can only reference electronic invoices that were not rejected")
+
+	// ErrCreditExceedsInvoice indica que las notas crédito superarían el valor de la factura
+	ErrCreditExceedsInvoice = errors.New("credit notes cannot exceed the invoice total")
+
+	// ErrElectronicDocumentNotPending indica que el documento ya tiene respuesta de la DIAN y no se reenvía
+	ErrElectronicDocumentNotPending = errors.New("only pending electronic documents can be submitted again")
+)
+
+// InvoiceIssuer son los datos del facturador (emisor) de los documentos de cobro
+type InvoiceIssuer struct {
+	Name           string // Razón social o nombre completo
+	NIT            string // NIT o cédula sin dígito de verificación
+	PersonType     string // "1" persona jurídica, "2" persona natural
+	TaxLevelCode   string // Responsabilidades fiscales del RUT (ej: R-99-PN)
+	IVAResponsible bool   // Responsable de IVA: si no lo es, los documentos no discriminan impuestos
+	Address        string
+	City           string
+	CityCode       string // Código DANE del municipio
+	Department     string
+	DepartmentCode string // Código DANE del departamento
+	Email          string
+	Phone          string
+	BankAccount    string // Cuenta donde se consignan los pagos (cuenta de cobro)
+}
+
+// CheckDigit calcula el dígito de verificación del NIT del facturador
+func (i InvoiceIssuer) CheckDigit() string {
+	return NITCheckDigit(i.NIT)
+}
+
+// NITCheckDigit calcula el dígito de verificación de un NIT con el algoritmo de módulo 11 de la DIAN
+func NITCheckDigit(nit string) string {
+	weights := []int{3, 7, 13, 17, 19, 23, 29, 37, 41, 43, 47, 53, 59, 67, 71}
+	digits := strings.TrimSpace(nit)
+	sum := 0
+	for i := 0; i < len(digits) && i < len(weights); i++ {
+		digit := digits[len(digits)-1-i]
+		if digit < '0' || digit > '9' {
+			return ""
+		}
+		sum += int(digit-'0') * weights[i]
+	}
+	remainder := sum % 11
+	if remainder > 1 {
+		return fmt.Sprint(11 - remainder)
+	}
+	return fmt.Sprint(remainder)
+}
+
+// InvoiceResolution es un rango de numeración autorizado por la DIAN
+// Las notas crédito no requieren resolución, pero usan un rango propio con su prefijo
+type InvoiceResolution struct {
+	ID               uint
+	DocumentType     ElectronicDocumentType
+	ResolutionNumber string // Número de la resolución de facturación (solo facturas)
+	Prefix           string
+	RangeFrom        int64
+	RangeTo          int64
+	NextNumber       int64  // Siguiente consecutivo a asignar
+	TechnicalKey     string // Clave técnica de la resolución para el CUFE (solo facturas)
+	ValidFrom        time.Time
+	ValidTo          time.Time
+	IsActive         bool
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// Validate valida los datos de la resolución
+func (r *InvoiceResolution) Validate() error {
+	r.Prefix = strings.ToUpper(strings.TrimSpace(r.Prefix))
+	r.ResolutionNumber = strings.TrimSpace(r.ResolutionNumber)
+	r.TechnicalKey = strings.TrimSpace(r.TechnicalKey)
+
+	if r.DocumentType != ElectronicInvoice && r.DocumentType != ElectronicCreditNote {
+		return ErrInvalidInvoiceResolution
+	}
+	if len(r.Prefix) > 4 {
+		return ErrInvalidInvoiceResolution
+	}
+	for _, char := range r.Prefix {
+		if (char < 'A' || char > 'Z') && (char < '0' || char > '9') {
+			return ErrInvalidInvoiceResolution
+		}
+	}
+	if r.RangeFrom <= 0 || r.RangeTo < r.RangeFrom {
+		return ErrInvalidInvoiceResolution
+	}
+	if r.NextNumber < r.RangeFrom || r.NextNumber > r.RangeTo+1 {
+		return ErrInvalidInvoiceResolution
+	}
+	if r.ValidFrom.IsZero() || r.ValidTo.Before(r.ValidFrom) {
+		return ErrInvalidInvoiceResolution
+	}
+	if r.DocumentType == ElectronicInvoice && (r.ResolutionNumber == "" || r.TechnicalKey == "") {
+		return ErrInvalidInvoiceResolution
+	}
+	return nil
+}
+
+// Remaining retorna cuántos números quedan por asignar
+func (r *InvoiceResolution) Remaining() int64 {
+	if r.NextNumber > r.RangeTo {
+		return 0
+	}
+	return r.RangeTo - r.NextNumber + 1
+}
+
+// IsUsableAt indica si la resolución está activa, vigente en la fecha y con números disponibles
+func (r *InvoiceResolution) IsUsableAt(date time.Time) bool {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	validFrom := time.Date(r.ValidFrom.Year(), r.ValidFrom.Month(), r.ValidFrom.Day(), 0, 0, 0, 0, date.Location())
+	validTo := time.Date(r.ValidTo.Year(), r.ValidTo.Month(), r.ValidTo.Day(), 0, 0, 0, 0, date.Location())
+	return r.IsActive && r.Remaining() > 0 && !day.Before(validFrom) && !day.After(validTo)
+}
+
+// ElectronicDocument es una factura o nota crédito electrónica con el XML UBL 2.1 firmado
+type ElectronicDocument struct {
+	ID           uint
+	Type         ElectronicDocumentType
+	Status       ElectronicDocumentStatus
+	ResolutionID uint
+	Prefix       string
+	Number       int64
+	Environment  string // DianEnvironmentProduction o DianEnvironmentTesting
+	OrderID      *uint  // Orden facturada (la nota crédito conserva la de su factura)
+	CustomerID   *uint
+
+	// Factura que corrige la nota crédito, con su número, CUFE y fecha para la referencia del XML
+	ReferenceID        *uint
+	ReferenceNumber    string
+	ReferenceCUFE      string
+	ReferenceIssueDate *time.Time
+	CorrectionCode     string // Concepto de corrección (CreditNotePartialReturn, CreditNoteCancellation...)
+	CorrectionReason   string
+
+	// Adquiriente (snapshot al momento de la emisión)
+	BuyerIDType   string
+	BuyerIDNumber string
+	BuyerName     string
+	BuyerEmail    string
+	BuyerAddress  string
+	BuyerPhone    string
+
+	IssueDate       time.Time
+	PaymentMeans    string     // Código del medio de pago (PaymentMeansCash, PaymentMeansTransfer...)
+	PaymentDueDate  *time.Time // Vencimiento de las ventas a crédito (nil: contado)
+	Notes           string
+	TaxResponsible  bool // El emisor es responsable de IVA: el XML discrimina el impuesto de cada línea
+	Lines           []ElectronicDocumentLine
+	Subtotal        float64 // Base gravable: suma de las líneas sin impuestos
+	TaxTotal        float64
+	Total           float64 // Valor a pagar
+	CUFE            string  // CUFE de la factura o CUDE de la nota crédito (SHA-384)
+	XML             string  // Documento UBL 2.1 firmado
+	ProviderTrackID string
+	ProviderMessage string
+	SubmittedAt     *time.Time
+	CreatedByID     *uint
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// ElectronicDocumentLine es un renglón de la factura o nota crédito (valores sin IVA)
+type ElectronicDocumentLine struct {
+	ID          uint
+	DocumentID  uint
+	LineNumber  int
+	OrderItemID *uint
+	Code        string // Código interno del producto (StandardItemIdentification)
+	Description string
+	Quantity    int
+	UnitPrice   float64 // Precio unitario sin IVA
+	Subtotal    float64 // Base gravable del renglón
+	TaxPercent  float64 // Tarifa de IVA (0 si el emisor no es responsable)
+	TaxAmount   float64
+}
+
+// ElectronicTaxSubtotal agrupa la base y el impuesto de una tarifa de IVA
+type ElectronicTaxSubtotal struct {
+	Percent       float64
+	TaxableAmount float64
+	TaxAmount     float64
+}
+
+// ElectronicSubmission es la respuesta del proveedor al enviar un documento a la DIAN
+type ElectronicSubmission struct {
+	Accepted bool
+	TrackID  string // Identificador de la transacción en la DIAN o en el proveedor tecnológico
+	Message  string
+	Errors   []string // Reglas de validación que falló el documento
+}
+
+// FullNumber retorna el número del documento con su prefijo (ej: SETP990000001)
+func (d *ElectronicDocument) FullNumber() string {
+	return fmt.Sprintf("%s%d", d.Prefix, d.Number)
+}
+
+// IsCreditNote indica si el documento es una nota crédito
+func (d *ElectronicDocument) IsCreditNote() bool {
+	return d.Type == ElectronicCreditNote
+}
+
+// ValidateBuyer normaliza y valida los datos del adquiriente; sin documento se factura al consumidor final
+func (d *ElectronicDocument) ValidateBuyer() error {
+	d.BuyerIDType = strings.TrimSpace(d.BuyerIDType)
+	d.BuyerIDNumber = strings.TrimSpace(d.BuyerIDNumber)
+	d.BuyerName = strings.TrimSpace(d.BuyerName)
+	d.BuyerEmail = strings.TrimSpace(d.BuyerEmail)
+
+	if d.BuyerIDNumber == "" {
+		d.BuyerIDType = BuyerIDTypeCitizenCard
+		d.BuyerIDNumber = FinalConsumerIDNumber
+		if d.BuyerName == "" {
+			d.BuyerName = FinalConsumerName
+		}
+	}
+	if d.BuyerIDType == "" {
+		d.BuyerIDType = BuyerIDTypeCitizenCard
+	}
+
+	switch d.BuyerIDType {
+	case BuyerIDTypeCitizenCard, BuyerIDTypeNIT, BuyerIDTypeForeignerCard, BuyerIDTypePassport, BuyerIDTypeForeignID:
+	default:
+		return ErrInvalidBuyer
+	}
+	if d.BuyerName == "" {
+		return ErrInvalidBuyer
+	}
+	// Pasaportes y documentos extranjeros pueden tener letras; los demás son numéricos
+	numeric := d.BuyerIDType != BuyerIDTypePassport && d.BuyerIDType != BuyerIDTypeForeignID
+	for _, char := range d.BuyerIDNumber {
+		if numeric && (char < '0' || char > '9') {
+			return ErrInvalidBuyer
+		}
+	}
+	return nil
+}
+
+// CalculateTotals calcula el subtotal, el IVA y el total a partir de las líneas
+func (d *ElectronicDocument) CalculateTotals() {
+	subtotal, tax := 0.0, 0.0
+	for i := range d.Lines {
+		d.Lines[i].LineNumber = i + 1
+		subtotal += d.Lines[i].Subtotal
+		tax += d.Lines[i].TaxAmount
+	}
+	d.Subtotal = roundCents(subtotal)
+	d.TaxTotal = roundCents(tax)
+	d.Total = roundCents(d.Subtotal + d.TaxTotal)
+}
+
+// TaxSubtotals agrupa las líneas por tarifa de IVA; vacío si el emisor no es responsable de IVA
+func (d *ElectronicDocument) TaxSubtotals() []ElectronicTaxSubtotal {
+	if !d.TaxResponsible {
+		return nil
+	}
+
+	byPercent := map[float64]*ElectronicTaxSubtotal{}
+	for _, line := range d.Lines {
+		subtotal, ok := byPercent[line.TaxPercent]
+		if !ok {
+			subtotal = &ElectronicTaxSubtotal{Percent: line.TaxPercent}
+			byPercent[line.TaxPercent] = subtotal
+		}
+		subtotal.TaxableAmount = roundCents(subtotal.TaxableAmount + line.Subtotal)
+		subtotal.TaxAmount = roundCents(subtotal.TaxAmount + line.TaxAmount)
+	}
+
+	subtotals := make([]ElectronicTaxSubtotal, 0, len(byPercent))
+	for _, subtotal := range byPercent {
+		subtotals = append(subtotals, *subtotal)
+	}
+	sort.Slice(subtotals, func(i, j int) bool { return subtotals[i].Percent > subtotals[j].Percent })
+	return subtotals
+}
+
+// CUFEInput arma la cadena del CUFE (facturas) o CUDE (notas crédito) según el anexo técnico 1.9:
+// NumFac + FecFac + HorFac + ValFac + 01 + ValIVA + 04 + ValINC + 03 + ValICA + ValTot + NitOFE + NumAdq + Clave + TipoAmbiente
+// La clave es la clave técnica de la resolución en las facturas y el PIN del software en las notas crédito
+func (d *ElectronicDocument) CUFEInput(issuerNIT, key string) string {
+	issueDate := d.IssueDate.In(DianLocation)
+	return d.FullNumber() +
+		issueDate.Format("2006-01-02") +
+		issueDate.Format("15:04:05-07:00") +
+		FormatDianAmount(d.Subtotal) +
+		"01" + FormatDianAmount(d.TaxTotal) +
+		"04" + FormatDianAmount(0) +
+		"03" + FormatDianAmount(0) +
+		FormatDianAmount(d.Total) +
+		issuerNIT +
+		d.BuyerIDNumber +
+		key +
+		d.Environment
+}
+
+// ComputeCUFE calcula y asigna el CUFE/CUDE del documento (SHA-384 en hexadecimal)
+func (d *ElectronicDocument) ComputeCUFE(issuerNIT, key string) string {
+	sum := sha512.Sum384([]byte(d.CUFEInput(issuerNIT, key)))
+	d.CUFE = hex.EncodeToString(sum[:])
+	return d.CUFE
+}
+
+// FormatDianAmount formatea un valor con dos decimales y punto decimal, como lo exige la DIAN
+func FormatDianAmount(value float64) string {
+	return fmt.Sprintf("%.2f", roundCents(value))
+}
+
+// ValidCorrectionCode indica si el concepto de corrección de la nota crédito es conocido
+func ValidCorrectionCode(code string) bool {
+	switch code {
+	case CreditNotePartialReturn, CreditNoteCancellation, CreditNoteDiscount, CreditNotePriceAdjust, CreditNoteOther:
+		return true
+	}
+	return false
+}
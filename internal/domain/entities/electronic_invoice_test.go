@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNITCheckDigit(t *testing.T) {
+	tests := []struct {
+		nit  string
+		want string
+	}{
+		{"800197268", "4"},
+		{"890903938", "8"},
+		{"899999068", "1"},
+		{" 800197268 ", "4"},
+		{"1", "8"},
+		{"0", "0"},
+		{"800.197.268", ""},
+		{"80019726A", ""},
+	}
+
+	for _, tt := range tests {
+		if got := NITCheckDigit(tt.nit); got != tt.want {
+			t.Errorf("NITCheckDigit(%q) = %q, want %q", tt.nit, got, tt.want)
+		}
+	}
+}
+
+func TestComputeCUFEDianExample(t *testing.T) {
+	// Ejemplo del anexo técnico de la DIAN para el cálculo del CUFE
+	document := &ElectronicDocument{
+		Number:        323200000129,
+		IssueDate:     time.Date(2019, 1, 16, 10, 53, 10, 0, DianLocation),
+		Subtotal:      1500000,
+		TaxTotal:      285000,
+		Total:         1785000,
+		BuyerIDNumber: "800199436",
+		Environment:   "1",
+	}
+	const key = "693ff6f2a553c3646a063436fd4dd9ded0311471"
+
+	wantInput := "323200000129" + "2019-01-16" + "10:53:10-05:00" + "1500000.00" +
+		"01" + "285000.00" + "04" + "0.00" + "03" + "0.00" + "1785000.00" +
+		"700085371" + "800199436" + key + "1"
+	if got := document.CUFEInput("700085371", key); got != wantInput {
+		t.Errorf("CUFEInput = %s, want %s", got, wantInput)
+	}
+
+	const wantCUFE = "8bb918b19ba22a694f1da11c643b5e9de39adf60311cf179179e9b33381030bcd4c3c3f156c506ed5908f9276f5bd9b4"
+	if got := document.ComputeCUFE("700085371", key); got != wantCUFE || document.CUFE != wantCUFE {
+		t.Errorf("ComputeCUFE = %s, want %s", got, wantCUFE)
+	}
+}
+
+func TestCUFEInputUsesColombianTime(t *testing.T) {
+	// La fecha y la hora van en la hora de Colombia aunque el documento se emita con otra zona
+	document := &ElectronicDocument{
+		Prefix:    "SETP",
+		Number:    990000001,
+		IssueDate: time.Date(2024, 3, 1, 2, 30, 0, 0, time.UTC),
+	}
+
+	want := "SETP990000001" + "2024-02-29" + "21:30:00-05:00"
+	if got := document.CUFEInput("", ""); got[:len(want)] != want {
+		t.Errorf("CUFEInput = %s, want prefix %s", got, want)
+	}
+}
@@ -42,6 +42,15 @@ type FinancialTransaction struct {
 	Date        time.Time                    // Fecha de la transacción
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+
+	// Plantilla que generó la transacción (nil si se registró manualmente)
+	RecurringTransactionID *uint
+
+	// Cuenta (método de pago) por la que entró o salió el dinero; nil si no se especificó
+	PaymentMethodID *uint
+
+	// Venta que generó el ingreso automático; su asiento contable es el de la venta
+	OrderID *uint
 }
 
 // Validate valida los datos de la transacción
@@ -0,0 +1,572 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// LedgerAccountType es la clase de una cuenta contable
+type LedgerAccountType string
+
+const (
+	LedgerAccountAsset     LedgerAccountType = "ASSET"     // Activo
+	LedgerAccountLiability LedgerAccountType = "LIABILITY" // Pasivo
+	LedgerAccountEquity    LedgerAccountType = "EQUITY"    // Patrimonio
+	LedgerAccountRevenue   LedgerAccountType = "REVENUE"   // Ingresos
+	LedgerAccountExpense   LedgerAccountType = "EXPENSE"   // Gastos
+	LedgerAccountCost      LedgerAccountType = "COST"      // Costo de ventas
+)
+
+// Códigos del plan de cuentas base (PUC colombiano)
+const (
+	LedgerCodeCash            = "1105" // Caja general: dinero recibido sin cuenta asignada
+	LedgerCodeBanks           = "1110" // Bancos y billeteras: cuentas de métodos de pago que no son efectivo
+	LedgerCodeReceivables     = "1305" // Clientes
+	LedgerCodeInventory       = "1435" // Mercancías no fabricadas por la empresa e insumos
+	LedgerCodeLoans           = "2105" // Obligaciones financieras
+	LedgerCodeCapital         = "3105" // Capital
+	LedgerCodeRetainedProfits = "3705" // Utilidades acumuladas
+	LedgerCodeSales           = "4135" // Ventas
+	LedgerCodeOtherIncome     = "4295" // Ingresos diversos
+	LedgerCodePersonnel       = "5105" // Gastos de personal
+	LedgerCodeRent            = "5120" // Arrendamientos
+	LedgerCodeUtilities       = "5135" // Servicios
+	LedgerCodeOperational     = "5195" // Gastos operacionales diversos
+	LedgerCodeMarketing       = "5230" // Publicidad y mercadeo
+	LedgerCodeOtherExpenses   = "5395" // Gastos diversos no operacionales
+	LedgerCodeCostOfSales     = "6135" // Costo de ventas
+)
+
+// JournalSourceType identifica el hecho económico que originó un asiento
+type JournalSourceType string
+
+const (
+	JournalSourceSale                 JournalSourceType = "SALE"                  // Orden entregada
+	JournalSourceCustomerTransaction  JournalSourceType = "CUSTOMER_TRANSACTION"  // ABONO o cargo manual a un cliente
+	JournalSourceFinancialTransaction JournalSourceType = "FINANCIAL_TRANSACTION" // Ingreso o gasto (compras, nómina, capital...)
+	JournalSourceAccountTransfer      JournalSourceType = "ACCOUNT_TRANSFER"      // Traslado entre cuentas
+	JournalSourceOpeningBalance       JournalSourceType = "OPENING_BALANCE"       // Saldo inicial de una cuenta (método de pago)
+	JournalSourceManual               JournalSourceType = "MANUAL"                // Ajuste registrado a mano
+)
+
+var (
+	// ErrUnbalancedJournalEntry indica que los débitos no suman lo mismo que los créditos
+	ErrUnbalancedJournalEntry = errors.New("journal entry debits must equal credits")
+
+	// ErrJournalDescriptionRequired indica un asiento sin descripción
+	ErrJournalDescriptionRequired = errors.New("journal entry description is required")
+
+	// ErrInvalidJournalLine indica una línea sin cuenta o que no es solo débito o solo crédito positivo
+	ErrInvalidJournalLine = errors.New("each journal line needs an account and either a debit or a credit greater than zero")
+
+	// ErrJournalEntryAlreadyReversed indica que el asiento ya fue anulado
+	ErrJournalEntryAlreadyReversed = errors.New("journal entry has already been reversed")
+
+	// ErrOnlyManualEntriesReversible indica que los asientos automáticos se corrigen desde su origen
+	ErrOnlyManualEntriesReversible = errors.New("only manual journal entries can be reversed; correct the source record instead")
+
+	// ErrInvalidLedgerAccount indica que la cuenta contable no tiene código, nombre o clase válidos
+	ErrInvalidLedgerAccount = errors.New("ledger account requires a numeric code, a name and a valid type")
+
+	// ErrLedgerAccountInactive indica que la cuenta está desactivada y no recibe asientos manuales
+	ErrLedgerAccountInactive = errors.New("ledger account is inactive")
+
+	// ErrLedgerAccountAlreadyExists indica que ya hay una cuenta con el mismo código
+	ErrLedgerAccountAlreadyExists = errors.New("a ledger account with this code already exists")
+)
+
+// IsValid indica si la clase de cuenta es conocida
+func (t LedgerAccountType) IsValid() bool {
+	switch t {
+	case LedgerAccountAsset, LedgerAccountLiability, LedgerAccountEquity,
+		LedgerAccountRevenue, LedgerAccountExpense, LedgerAccountCost:
+		return true
+	}
+	return false
+}
+
+// IsDebitNormal indica si la cuenta aumenta con débitos (activo, gastos y costos)
+func (t LedgerAccountType) IsDebitNormal() bool {
+	return t == LedgerAccountAsset || t == LedgerAccountExpense || t == LedgerAccountCost
+}
+
+// LedgerAccount es una cuenta del plan de cuentas
+type LedgerAccount struct {
+	ID              uint
+	Code            string
+	Name            string
+	Type            LedgerAccountType
+	PaymentMethodID *uint // Cuenta de caja o banco de un método de pago
+	System          bool  // Creada por el sistema: los asientos automáticos dependen de ella
+	IsActive        bool
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Validate valida los datos de la cuenta
+func (a *LedgerAccount) Validate() error {
+	a.Code = strings.TrimSpace(a.Code)
+	a.Name = strings.TrimSpace(a.Name)
+	if a.Code == "" || a.Name == "" || !a.Type.IsValid() {
+		return ErrInvalidLedgerAccount
+	}
+	for _, digit := range a.Code {
+		if digit < '0' || digit > '9' {
+			return ErrInvalidLedgerAccount
+		}
+	}
+	return nil
+}
+
+// DefaultLedgerAccounts retorna el plan de cuentas que usan los asientos automáticos
+func DefaultLedgerAccounts() []LedgerAccount {
+	accounts := []LedgerAccount{
+		{Code: LedgerCodeCash, Name: "Caja general", Type: LedgerAccountAsset},
+		{Code: LedgerCodeBanks, Name: "Bancos y billeteras digitales", Type: LedgerAccountAsset},
+		{Code: LedgerCodeReceivables, Name: "Clientes", Type: LedgerAccountAsset},
+		{Code: LedgerCodeInventory, Name: "Inventario de mercancías e insumos", Type: LedgerAccountAsset},
+		{Code: LedgerCodeLoans, Name: "Obligaciones financieras", Type: LedgerAccountLiability},
+		{Code: LedgerCodeCapital, Name: "Capital", Type: LedgerAccountEquity},
+		{Code: LedgerCodeRetainedProfits, Name: "Utilidades acumuladas", Type: LedgerAccountEquity},
+		{Code: LedgerCodeSales, Name: "Ventas", Type: LedgerAccountRevenue},
+		{Code: LedgerCodeOtherIncome, Name: "Ingresos diversos", Type: LedgerAccountRevenue},
+		{Code: LedgerCodePersonnel, Name: "Gastos de personal", Type: LedgerAccountExpense},
+		{Code: LedgerCodeRent, Name: "Arrendamientos", Type: LedgerAccountExpense},
+		{Code: LedgerCodeUtilities, Name: "Servicios públicos", Type: LedgerAccountExpense},
+		{Code: LedgerCodeOperational, Name: "Gastos operacionales diversos", Type: LedgerAccountExpense},
+		{Code: LedgerCodeMarketing, Name: "Publicidad y mercadeo", Type: LedgerAccountExpense},
+		{Code: LedgerCodeOtherExpenses, Name: "Gastos diversos", Type: LedgerAccountExpense},
+		{Code: LedgerCodeCostOfSales, Name: "Costo de ventas", Type: LedgerAccountCost},
+	}
+	for i := range accounts {
+		accounts[i].System = true
+		accounts[i].IsActive = true
+	}
+	return accounts
+}
+
+// NewPaymentMethodLedgerAccount crea la subcuenta de caja (efectivo) o de bancos de un método de pago
+func NewPaymentMethodLedgerAccount(paymentMethod *PaymentMethodOption) LedgerAccount {
+	parent := LedgerCodeBanks
+	if paymentMethod.IsCash {
+		parent = LedgerCodeCash
+	}
+	return LedgerAccount{
+		Code:            fmt.Sprintf("%s%02d", parent, paymentMethod.ID),
+		Name:            paymentMethod.Name,
+		Type:            LedgerAccountAsset,
+		PaymentMethodID: &paymentMethod.ID,
+		System:          true,
+		IsActive:        true,
+	}
+}
+
+// FinancialTransactionAccountCode retorna la cuenta de contrapartida de un ingreso o gasto según su categoría
+// Las compras de inventario van al activo: pasan al costo cuando se vende la mercancía
+func FinancialTransactionAccountCode(transaction *FinancialTransaction) string {
+	if transaction.IsIncome() {
+		switch transaction.Category {
+		case FinancialTransactionCategoryInvestment:
+			return LedgerCodeCapital
+		case FinancialTransactionCategoryLoan:
+			return LedgerCodeLoans
+		case FinancialTransactionCategoryProfit:
+			return LedgerCodeRetainedProfits
+		case FinancialTransactionCategorySales:
+			return LedgerCodeSales
+		default:
+			return LedgerCodeOtherIncome
+		}
+	}
+
+	switch transaction.Category {
+	case FinancialTransactionCategoryInventory:
+		return LedgerCodeInventory
+	case FinancialTransactionCategoryPersonnel:
+		return LedgerCodePersonnel
+	case FinancialTransactionCategoryRent:
+		return LedgerCodeRent
+	case FinancialTransactionCategoryUtilities:
+		return LedgerCodeUtilities
+	case FinancialTransactionCategoryOperational:
+		return LedgerCodeOperational
+	case FinancialTransactionCategoryMarketing:
+		return LedgerCodeMarketing
+	default:
+		return LedgerCodeOtherExpenses
+	}
+}
+
+// JournalEntry es un asiento contable: los débitos siempre suman lo mismo que los créditos
+// Los asientos no se editan ni se eliminan; se anulan con un asiento de reverso
+type JournalEntry struct {
+	ID           uint
+	Date         time.Time
+	Description  string
+	SourceType   JournalSourceType
+	SourceID     *uint // Registro que originó el asiento (nil en asientos manuales)
+	ReversalOfID *uint // Asiento que este reverso anula
+	ReversedByID *uint // Reverso que anuló este asiento
+	CreatedByID  *uint
+	Lines        []JournalLine
+	CreatedAt    time.Time
+}
+
+// JournalLine es un débito o un crédito a una cuenta
+type JournalLine struct {
+	ID          uint
+	EntryID     uint
+	AccountID   uint
+	Account     *LedgerAccount
+	Debit       float64
+	Credit      float64
+	Description string
+}
+
+// AddDebit agrega un débito a la cuenta; los valores en cero se omiten
+func (e *JournalEntry) AddDebit(account *LedgerAccount, amount float64) {
+	if amount = roundCents(amount); amount > 0 {
+		e.Lines = append(e.Lines, JournalLine{AccountID: account.ID, Account: account, Debit: amount})
+	}
+}
+
+// AddCredit agrega un crédito a la cuenta; los valores en cero se omiten
+func (e *JournalEntry) AddCredit(account *LedgerAccount, amount float64) {
+	if amount = roundCents(amount); amount > 0 {
+		e.Lines = append(e.Lines, JournalLine{AccountID: account.ID, Account: account, Credit: amount})
+	}
+}
+
+// TotalDebit suma los débitos del asiento
+func (e *JournalEntry) TotalDebit() float64 {
+	total := 0.0
+	for _, line := range e.Lines {
+		total += line.Debit
+	}
+	return roundCents(total)
+}
+
+// TotalCredit suma los créditos del asiento
+func (e *JournalEntry) TotalCredit() float64 {
+	total := 0.0
+	for _, line := range e.Lines {
+		total += line.Credit
+	}
+	return roundCents(total)
+}
+
+// IsEmpty indica si el asiento no mueve ninguna cuenta (ej: una venta en cero)
+func (e *JournalEntry) IsEmpty() bool {
+	return len(e.Lines) == 0
+}
+
+// IsReversed indica si el asiento fue anulado
+func (e *JournalEntry) IsReversed() bool {
+	return e.ReversedByID != nil
+}
+
+// IsReversal indica si el asiento es el reverso de otro
+func (e *JournalEntry) IsReversal() bool {
+	return e.ReversalOfID != nil
+}
+
+// Validate verifica que cada línea sea un débito o un crédito positivo y que el asiento cuadre
+func (e *JournalEntry) Validate() error {
+	e.Description = strings.TrimSpace(e.Description)
+	if e.Description == "" {
+		return ErrJournalDescriptionRequired
+	}
+	if len(e.Lines) < 2 {
+		return ErrUnbalancedJournalEntry
+	}
+	for i := range e.Lines {
+		line := &e.Lines[i]
+		line.Debit = roundCents(line.Debit)
+		line.Credit = roundCents(line.Credit)
+		if line.AccountID == 0 || line.Debit < 0 || line.Credit < 0 || (line.Debit > 0) == (line.Credit > 0) {
+			return ErrInvalidJournalLine
+		}
+	}
+	if math.Abs(e.TotalDebit()-e.TotalCredit()) >= 0.005 {
+		return ErrUnbalancedJournalEntry
+	}
+	if e.Date.IsZero() {
+		e.Date = time.Now()
+	}
+	return nil
+}
+
+// NewReversal crea el asiento que anula este: las mismas cuentas con débitos y créditos intercambiados
+func (e *JournalEntry) NewReversal(description string, at time.Time) (*JournalEntry, error) {
+	if e.IsReversed() {
+		return nil, ErrJournalEntryAlreadyReversed
+	}
+
+	reversal := &JournalEntry{
+		Date:         at,
+		Description:  description,
+		SourceType:   e.SourceType,
+		SourceID:     e.SourceID,
+		ReversalOfID: &e.ID,
+		Lines:        make([]JournalLine, len(e.Lines)),
+	}
+	for i, line := range e.Lines {
+		reversal.Lines[i] = JournalLine{
+			AccountID:   line.AccountID,
+			Account:     line.Account,
+			Debit:       line.Credit,
+			Credit:      line.Debit,
+			Description: line.Description,
+		}
+	}
+	return reversal, nil
+}
+
+// SaleDate retorna la fecha contable de la venta: la entrega real o, sin ella, la última actualización
+func SaleDate(order *Order) time.Time {
+	if order.ActualDeliveryDate != nil {
+		return *order.ActualDeliveryDate
+	}
+	if !order.UpdatedAt.IsZero() {
+		return order.UpdatedAt
+	}
+	return time.Now()
+}
+
+// LedgerTotals son los débitos y créditos acumulados de una cuenta
+type LedgerTotals struct {
+	Debit  float64
+	Credit float64
+}
+
+// Balance retorna el saldo en el sentido natural de la cuenta (positivo si es deudor en un activo)
+func (t LedgerTotals) Balance(accountType LedgerAccountType) float64 {
+	if accountType.IsDebitNormal() {
+		return roundCents(t.Debit - t.Credit)
+	}
+	return roundCents(t.Credit - t.Debit)
+}
+
+// TrialBalanceLine es el movimiento de una cuenta en el periodo
+type TrialBalanceLine struct {
+	Account        LedgerAccount
+	OpeningBalance float64 // Saldo al inicio del periodo en el sentido natural de la cuenta
+	Debit          float64
+	Credit         float64
+	ClosingBalance float64
+}
+
+// TrialBalance es el balance de prueba: la suma de débitos siempre debe ser igual a la de créditos
+type TrialBalance struct {
+	StartDate   time.Time
+	EndDate     time.Time
+	Lines       []TrialBalanceLine
+	TotalDebit  float64
+	TotalCredit float64
+}
+
+// NewTrialBalance arma el balance de prueba con los acumulados antes del periodo y dentro de él
+// Solo incluye las cuentas con saldo o movimiento
+func NewTrialBalance(start, end time.Time, accounts []LedgerAccount, opening, period map[uint]LedgerTotals) *TrialBalance {
+	trial := &TrialBalance{StartDate: start, EndDate: end, Lines: []TrialBalanceLine{}}
+	for _, account := range accounts {
+		before := opening[account.ID]
+		during := period[account.ID]
+		if before == (LedgerTotals{}) && during == (LedgerTotals{}) {
+			continue
+		}
+
+		closing := LedgerTotals{Debit: before.Debit + during.Debit, Credit: before.Credit + during.Credit}
+		trial.Lines = append(trial.Lines, TrialBalanceLine{
+			Account:        account,
+			OpeningBalance: before.Balance(account.Type),
+			Debit:          roundCents(during.Debit),
+			Credit:         roundCents(during.Credit),
+			ClosingBalance: closing.Balance(account.Type),
+		})
+		trial.TotalDebit += during.Debit
+		trial.TotalCredit += during.Credit
+	}
+	trial.TotalDebit = roundCents(trial.TotalDebit)
+	trial.TotalCredit = roundCents(trial.TotalCredit)
+	return trial
+}
+
+// IsBalanced indica si los débitos del periodo son iguales a los créditos
+func (t *TrialBalance) IsBalanced() bool {
+	return math.Abs(t.TotalDebit-t.TotalCredit) < 0.005
+}
+
+// StatementLine es el saldo de una cuenta en un estado financiero
+type StatementLine struct {
+	Account LedgerAccount
+	Amount  float64
+}
+
+// IncomeStatement es el estado de resultados (P&G) del periodo
+type IncomeStatement struct {
+	StartDate     time.Time
+	EndDate       time.Time
+	Revenue       []StatementLine
+	CostOfSales   []StatementLine
+	Expenses      []StatementLine
+	TotalRevenue  float64
+	TotalCost     float64
+	GrossProfit   float64
+	TotalExpenses float64
+	NetIncome     float64
+}
+
+// NewIncomeStatement arma el estado de resultados con los movimientos del periodo
+func NewIncomeStatement(start, end time.Time, accounts []LedgerAccount, period map[uint]LedgerTotals) *IncomeStatement {
+	statement := &IncomeStatement{
+		StartDate:   start,
+		EndDate:     end,
+		Revenue:     []StatementLine{},
+		CostOfSales: []StatementLine{},
+		Expenses:    []StatementLine{},
+	}
+	for _, account := range accounts {
+		totals, ok := period[account.ID]
+		if !ok {
+			continue
+		}
+		line := StatementLine{Account: account, Amount: totals.Balance(account.Type)}
+		switch account.Type {
+		case LedgerAccountRevenue:
+			statement.Revenue = append(statement.Revenue, line)
+			statement.TotalRevenue += line.Amount
+		case LedgerAccountCost:
+			statement.CostOfSales = append(statement.CostOfSales, line)
+			statement.TotalCost += line.Amount
+		case LedgerAccountExpense:
+			statement.Expenses = append(statement.Expenses, line)
+			statement.TotalExpenses += line.Amount
+		}
+	}
+	statement.TotalRevenue = roundCents(statement.TotalRevenue)
+	statement.TotalCost = roundCents(statement.TotalCost)
+	statement.TotalExpenses = roundCents(statement.TotalExpenses)
+	statement.GrossProfit = roundCents(statement.TotalRevenue - statement.TotalCost)
+	statement.NetIncome = roundCents(statement.GrossProfit - statement.TotalExpenses)
+	return statement
+}
+
+// BalanceSheet es el balance general a una fecha
+// El resultado acumulado de ingresos, costos y gastos se muestra dentro del patrimonio
+type BalanceSheet struct {
+	Date             time.Time
+	Assets           []StatementLine
+	Liabilities      []StatementLine
+	Equity           []StatementLine
+	NetIncome        float64 // Resultado acumulado aún no trasladado a utilidades
+	TotalAssets      float64
+	TotalLiabilities float64
+	TotalEquity      float64 // Incluye NetIncome
+}
+
+// NewBalanceSheet arma el balance general con los acumulados hasta la fecha
+func NewBalanceSheet(date time.Time, accounts []LedgerAccount, totals map[uint]LedgerTotals) *BalanceSheet {
+	sheet := &BalanceSheet{
+		Date:        date,
+		Assets:      []StatementLine{},
+		Liabilities: []StatementLine{},
+		Equity:      []StatementLine{},
+	}
+	for _, account := range accounts {
+		accountTotals, ok := totals[account.ID]
+		if !ok {
+			continue
+		}
+		line := StatementLine{Account: account, Amount: accountTotals.Balance(account.Type)}
+		switch account.Type {
+		case LedgerAccountAsset:
+			sheet.Assets = append(sheet.Assets, line)
+			sheet.TotalAssets += line.Amount
+		case LedgerAccountLiability:
+			sheet.Liabilities = append(sheet.Liabilities, line)
+			sheet.TotalLiabilities += line.Amount
+		case LedgerAccountEquity:
+			sheet.Equity = append(sheet.Equity, line)
+			sheet.TotalEquity += line.Amount
+		case LedgerAccountRevenue:
+			sheet.NetIncome += line.Amount
+		case LedgerAccountCost, LedgerAccountExpense:
+			sheet.NetIncome -= line.Amount
+		}
+	}
+	sheet.NetIncome = roundCents(sheet.NetIncome)
+	sheet.TotalAssets = roundCents(sheet.TotalAssets)
+	sheet.TotalLiabilities = roundCents(sheet.TotalLiabilities)
+	sheet.TotalEquity = roundCents(sheet.TotalEquity + sheet.NetIncome)
+	return sheet
+}
+
+// IsBalanced indica si el activo es igual al pasivo más el patrimonio
+func (s *BalanceSheet) IsBalanced() bool {
+	return math.Abs(s.TotalAssets-s.TotalLiabilities-s.TotalEquity) < 0.005
+}
+
+// LedgerCheck compara el saldo de una cuenta de control con el del registro auxiliar que la respalda
+type LedgerCheck struct {
+	Name          string
+	Account       LedgerAccount
+	LedgerBalance float64
+	SourceBalance float64 // Saldo según las transacciones de clientes o las cuentas de caja
+	Difference    float64 // LedgerBalance - SourceBalance
+}
+
+// NewLedgerCheck calcula la diferencia entre el libro mayor y el auxiliar
+func NewLedgerCheck(name string, account LedgerAccount, ledgerBalance, sourceBalance float64) LedgerCheck {
+	return LedgerCheck{
+		Name:          name,
+		Account:       account,
+		LedgerBalance: roundCents(ledgerBalance),
+		SourceBalance: roundCents(sourceBalance),
+		Difference:    roundCents(ledgerBalance - sourceBalance),
+	}
+}
+
+// Matches indica si el libro mayor y el auxiliar coinciden
+func (c LedgerCheck) Matches() bool {
+	return math.Abs(c.Difference) < 0.005
+}
+
+// LedgerCrossCheck es el cruce del libro mayor con los saldos que muestran los demás módulos
+type LedgerCrossCheck struct {
+	TrialBalanced bool // Débitos totales iguales a créditos totales
+	TotalDebit    float64
+	TotalCredit   float64
+	Checks        []LedgerCheck
+}
+
+// IsConsistent indica si el libro cuadra y todas las cuentas de control coinciden con sus auxiliares
+func (c *LedgerCrossCheck) IsConsistent() bool {
+	if !c.TrialBalanced {
+		return false
+	}
+	for _, check := range c.Checks {
+		if !check.Matches() {
+			return false
+		}
+	}
+	return true
+}
+
+// LedgerSyncResult cuenta los asientos creados al contabilizar los registros pendientes
+type LedgerSyncResult struct {
+	Sales                 int
+	CustomerTransactions  int
+	FinancialTransactions int
+	Transfers             int
+	OpeningBalances       int
+	Failed                int
+}
+
+// Total retorna el total de asientos creados
+func (r LedgerSyncResult) Total() int {
+	return r.Sales + r.CustomerTransactions + r.FinancialTransactions + r.Transfers + r.OpeningBalances
+}
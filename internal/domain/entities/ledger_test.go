@@ -0,0 +1,46 @@
+package entities
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestJournalEntryValidate(t *testing.T) {
+	debit := func(account uint, amount float64) JournalLine {
+		return JournalLine{AccountID: account, Debit: amount}
+	}
+	credit := func(account uint, amount float64) JournalLine {
+		return JournalLine{AccountID: account, Credit: amount}
+	}
+
+	tests := []struct {
+		name        string
+		description string
+		lines       []JournalLine
+		want        error
+	}{
+		{"balanced", "Venta", []JournalLine{debit(1, 100000), credit(2, 100000)}, nil},
+		{"split credit", "Venta", []JournalLine{debit(1, 119000), credit(2, 100000), credit(3, 19000)}, nil},
+		{"rounding within half a cent", "Venta", []JournalLine{debit(1, 0.1), debit(1, 0.2), credit(2, 0.3)}, nil},
+		{"unbalanced", "Venta", []JournalLine{debit(1, 100000), credit(2, 99999)}, ErrUnbalancedJournalEntry},
+		{"single line", "Venta", []JournalLine{debit(1, 100000)}, ErrUnbalancedJournalEntry},
+		{"blank description", "   ", []JournalLine{debit(1, 100000), credit(2, 100000)}, ErrJournalDescriptionRequired},
+		{"line without account", "Venta", []JournalLine{debit(0, 100000), credit(2, 100000)}, ErrInvalidJournalLine},
+		{"debit and credit on the same line", "Venta", []JournalLine{{AccountID: 1, Debit: 100, Credit: 100}, credit(2, 0)}, ErrInvalidJournalLine},
+		{"zero line", "Venta", []JournalLine{debit(1, 100000), credit(2, 100000), debit(3, 0)}, ErrInvalidJournalLine},
+		{"negative amount", "Venta", []JournalLine{debit(1, -100), credit(2, -100)}, ErrInvalidJournalLine},
+		{"amount rounded to zero", "Venta", []JournalLine{debit(1, 100), credit(2, 100), credit(3, 0.001)}, ErrInvalidJournalLine},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := &JournalEntry{Description: tt.description, Lines: tt.lines}
+			if err := entry.Validate(); !errors.Is(err, tt.want) {
+				t.Errorf("Validate() = %v, want %v", err, tt.want)
+			}
+			if tt.want == nil && entry.Date.IsZero() {
+				t.Error("Validate() did not set the entry date")
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// LoyaltyRuleType representa la forma en que una regla otorga puntos
+type LoyaltyRuleType string
+
+const (
+	LoyaltyRulePerAmount          LoyaltyRuleType = "PER_AMOUNT"          // Puntos por cada monto gastado en la orden
+	LoyaltyRulePerCategory        LoyaltyRuleType = "PER_CATEGORY"        // Puntos por cada monto gastado en una categoría
+	LoyaltyRuleBirthdayMultiplier LoyaltyRuleType = "BIRTHDAY_MULTIPLIER" // Multiplicador en el mes de cumpleaños
+)
+
+// LoyaltyRule representa una regla configurable para ganar puntos
+// PER_AMOUNT / PER_CATEGORY: Points puntos por cada AmountStep gastado
+// BIRTHDAY_MULTIPLIER: multiplica los puntos de la orden por Multiplier
+type LoyaltyRule struct {
+	ID         uint
+	Name       string
+	Type       LoyaltyRuleType
+	CategoryID *uint   // Solo para PER_CATEGORY
+	AmountStep float64 // Monto que otorga Points puntos (ej: cada $10.000)
+	Points     int
+	Multiplier float64 // Solo para BIRTHDAY_MULTIPLIER (ej: 2 = puntos dobles)
+	IsActive   bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Validate valida los datos de la regla
+func (r *LoyaltyRule) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return errors.New("rule name is required")
+	}
+	switch r.Type {
+	case LoyaltyRulePerAmount, LoyaltyRulePerCategory:
+		if r.AmountStep <= 0 {
+			return errors.New("amount step must be greater than zero")
+		}
+		if r.Points <= 0 {
+			return errors.New("points must be greater than zero")
+		}
+		if r.Type == LoyaltyRulePerCategory && r.CategoryID == nil {
+			return errors.New("category rules require category_id")
+		}
+	case LoyaltyRuleBirthdayMultiplier:
+		if r.Multiplier <= 1 {
+			return errors.New("birthday multiplier must be greater than 1")
+		}
+	default:
+		return errors.New("invalid rule type: must be PER_AMOUNT, PER_CATEGORY or BIRTHDAY_MULTIPLIER")
+	}
+	return nil
+}
+
+// LoyaltyEntryType representa el tipo de movimiento de puntos
+type LoyaltyEntryType string
+
+const (
+	LoyaltyEntryEarn   LoyaltyEntryType = "EARN"   // Puntos ganados por una compra
+	LoyaltyEntryRedeem LoyaltyEntryType = "REDEEM" // Puntos usados como descuento
+	LoyaltyEntryExpire LoyaltyEntryType = "EXPIRE" // Puntos vencidos
+)
+
+// LoyaltyPointsEntry representa un movimiento en el libro de puntos del cliente
+// Points es positivo para EARN y negativo para REDEEM/EXPIRE.
+// Remaining indica cuántos puntos de un EARN aún no se han usado ni vencido (FIFO).
+type LoyaltyPointsEntry struct {
+	ID          uint
+	CustomerID  uint
+	OrderID     *uint
+	Type        LoyaltyEntryType
+	Points      int
+	Remaining   int
+	Description string
+	ExpiresAt   *time.Time
+	CreatedAt   time.Time
+}
+
+var (
+	// ErrInsufficientPoints indica que el cliente no tiene puntos suficientes
+	ErrInsufficientPoints = errors.New("insufficient loyalty points")
+
+	// ErrLoyaltyRequiresCustomer indica que solo se pueden usar puntos con un cliente registrado
+	ErrLoyaltyRequiresCustomer = errors.New("loyalty points require a registered customer")
+)
+
+// LoyaltyEarning resume los puntos calculados para una orden
+type LoyaltyEarning struct {
+	BasePoints int
+	Multiplier float64
+	Points     int
+	Details    []string
+}
+
+// CalculateLoyaltyPoints calcula los puntos que gana una orden según las reglas activas
+// El descuento de la orden se distribuye proporcionalmente entre los items
+func CalculateLoyaltyPoints(order *Order, rules []LoyaltyRule, isBirthdayMonth bool) LoyaltyEarning {
+	earning := LoyaltyEarning{Multiplier: 1}
+
+	subtotal := 0.0
+	for _, item := range order.Items {
+		subtotal += item.Subtotal
+	}
+	netAmount := order.TotalAmount
+	if netAmount <= 0 {
+		return earning
+	}
+
+	// Proporción pagada después de descuentos (para reglas por categoría)
+	paidRatio := 1.0
+	if subtotal > 0 {
+		paidRatio = netAmount / subtotal
+	}
+
+	for _, rule := range rules {
+		if !rule.IsActive {
+			continue
+		}
+		switch rule.Type {
+		case LoyaltyRulePerAmount:
+			points := int(math.Floor(netAmount/rule.AmountStep)) * rule.Points
+			if points > 0 {
+				earning.BasePoints += points
+				earning.Details = append(earning.Details, fmt.Sprintf("%s: %d", rule.Name, points))
+			}
+		case LoyaltyRulePerCategory:
+			categoryAmount := 0.0
+			for _, item := range order.Items {
+				if rule.CategoryID != nil && item.CategoryID == *rule.CategoryID {
+					categoryAmount += item.Subtotal * paidRatio
+				}
+			}
+			points := int(math.Floor(categoryAmount/rule.AmountStep)) * rule.Points
+			if points > 0 {
+				earning.BasePoints += points
+				earning.Details = append(earning.Details, fmt.Sprintf("%s: %d", rule.Name, points))
+			}
+		case LoyaltyRuleBirthdayMultiplier:
+			if isBirthdayMonth && rule.Multiplier > earning.Multiplier {
+				earning.Multiplier = rule.Multiplier
+			}
+		}
+	}
+
+	earning.Points = int(math.Floor(float64(earning.BasePoints) * earning.Multiplier))
+	if earning.Multiplier > 1 && earning.BasePoints > 0 {
+		earning.Details = append(earning.Details, fmt.Sprintf("Cumpleaños x%.1f", earning.Multiplier))
+	}
+	return earning
+}
+
+// LoyaltyStatement representa el estado de cuenta de puntos de un cliente
+type LoyaltyStatement struct {
+	CustomerID   uint
+	CustomerName string
+	Balance      int
+	PointValue   float64 // Valor en pesos de cada punto
+	BalanceValue float64
+	ExpiringSoon int // Puntos que vencen en los próximos 30 días
+	Entries      []LoyaltyPointsEntry
+}
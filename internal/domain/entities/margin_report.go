@@ -0,0 +1,150 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// MarginGrouping es el eje por el que se agrupa el reporte de márgenes
+type MarginGrouping string
+
+const (
+	MarginByOrder   MarginGrouping = "ORDER"   // Cada orden vendida
+	MarginByProduct MarginGrouping = "PRODUCT" // Nombre del producto base (snapshot del item)
+	MarginByPeriod  MarginGrouping = "PERIOD"  // Día, semana o mes de la orden
+)
+
+// MarginInterval es el tamaño de los periodos cuando el reporte se agrupa por periodo
+type MarginInterval string
+
+const (
+	MarginIntervalDay   MarginInterval = "DAY"
+	MarginIntervalWeek  MarginInterval = "WEEK"
+	MarginIntervalMonth MarginInterval = "MONTH"
+)
+
+var (
+	ErrInvalidMarginGrouping = errors.New("groupBy must be ORDER, PRODUCT or PERIOD")
+	ErrInvalidMarginInterval = errors.New("interval must be DAY, WEEK or MONTH")
+)
+
+// IsValid verifica si la agrupación es soportada
+func (g MarginGrouping) IsValid() bool {
+	switch g {
+	case MarginByOrder, MarginByProduct, MarginByPeriod:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValid verifica si el intervalo es soportado
+func (i MarginInterval) IsValid() bool {
+	switch i {
+	case MarginIntervalDay, MarginIntervalWeek, MarginIntervalMonth:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarginReportFilters define el periodo y el universo del reporte de márgenes
+// Usa las mismas órdenes que el reporte de ventas (SoldOrderStatuses, sin INVENTORY por defecto)
+type MarginReportFilters struct {
+	GroupBy         MarginGrouping
+	Interval        MarginInterval // Solo aplica a MarginByPeriod
+	StartDate       time.Time
+	EndDate         time.Time // Inclusive: se toma el día completo
+	SellerID        *uint
+	CategoryID      *uint
+	OrderType       OrderType
+	VisibleToUserID *uint // Alcance del usuario sin orders:all (propias o compartidas)
+}
+
+// Validate verifica la agrupación, el intervalo y el periodo
+func (f *MarginReportFilters) Validate() error {
+	if !f.GroupBy.IsValid() {
+		return ErrInvalidMarginGrouping
+	}
+	if f.GroupBy == MarginByPeriod && !f.Interval.IsValid() {
+		return ErrInvalidMarginInterval
+	}
+	if f.StartDate.IsZero() || f.EndDate.IsZero() || f.EndDate.Before(f.StartDate) {
+		return ErrInvalidReportPeriod
+	}
+	return nil
+}
+
+// MarginRow son los totales de venta y costo de un grupo del reporte
+type MarginRow struct {
+	Key              string
+	Label            string
+	Quantity         int
+	Orders           int
+	Revenue          float64 // Suma de subtotales de los items
+	Discount         float64 // Descuento de la orden (manual, cupón y puntos) prorrateado por subtotal
+	Cost             float64 // Suma de costo unitario congelado × cantidad
+	UncostedQuantity int     // Unidades vendidas sin costo registrado
+}
+
+// MarginReportLine agrega al grupo la venta neta, la utilidad bruta y el margen
+type MarginReportLine struct {
+	MarginRow
+	NetRevenue    float64  // Revenue - Discount
+	GrossProfit   float64  // NetRevenue - Cost
+	MarginPercent *float64 // GrossProfit / NetRevenue × 100; nil sin venta neta
+}
+
+// NewMarginReportLine calcula la venta neta, la utilidad y el margen de un grupo
+// El margen es sobre la venta (no el markup sobre el costo de Product.GetProfitMargin)
+func NewMarginReportLine(row MarginRow) MarginReportLine {
+	line := MarginReportLine{MarginRow: row}
+	line.NetRevenue = row.Revenue - row.Discount
+	line.GrossProfit = line.NetRevenue - row.Cost
+	if line.NetRevenue > 0 {
+		margin := line.GrossProfit / line.NetRevenue * 100
+		line.MarginPercent = &margin
+	}
+	return line
+}
+
+// HasUncostedItems indica si parte de las unidades no tiene costo y el margen está sobreestimado
+func (l *MarginReportLine) HasUncostedItems() bool {
+	return l.UncostedQuantity > 0
+}
+
+// MarginReport es el reporte de utilidad bruta de un periodo
+type MarginReport struct {
+	GroupBy   MarginGrouping
+	Interval  MarginInterval
+	StartDate time.Time
+	EndDate   time.Time
+	Lines     []MarginReportLine
+	Totals    MarginReportLine
+}
+
+// NewMarginReport arma el reporte con las filas en el orden recibido y el total general
+// orders es el número de órdenes distintas: por producto una orden puede aparecer en varios grupos
+func NewMarginReport(filters MarginReportFilters, rows []MarginRow, orders int) *MarginReport {
+	report := &MarginReport{
+		GroupBy:   filters.GroupBy,
+		StartDate: truncateDay(filters.StartDate),
+		EndDate:   truncateDay(filters.EndDate),
+		Lines:     make([]MarginReportLine, 0, len(rows)),
+	}
+	if filters.GroupBy == MarginByPeriod {
+		report.Interval = filters.Interval
+	}
+
+	total := MarginRow{Key: "TOTAL", Label: "TOTAL", Orders: orders}
+	for _, row := range rows {
+		report.Lines = append(report.Lines, NewMarginReportLine(row))
+		total.Quantity += row.Quantity
+		total.Revenue += row.Revenue
+		total.Discount += row.Discount
+		total.Cost += row.Cost
+		total.UncostedQuantity += row.UncostedQuantity
+	}
+	report.Totals = NewMarginReportLine(total)
+	return report
+}
@@ -51,7 +51,11 @@ type Order struct {
 	Status                OrderStatus
 	TotalAmount           float64
 	Discount              float64
+	CouponCode            string // Código de campaña aplicado (opcional)
+	LoyaltyPointsRedeemed int    // Puntos de fidelización usados como descuento
 	Notes                 string
+	PaymentMethodID       *uint // Método con el que se pagó una venta de contado (nil: a crédito o por cobrar)
+	CashSessionID         *uint // Sesión de caja en la que se registró la venta SALE
 	OrderDate             time.Time
 	EstimatedDeliveryDate *time.Time
 	ActualDeliveryDate    *time.Time
@@ -153,3 +157,17 @@ func (o *Order) HasFullStockCoverage() bool {
 func (o *Order) IsInternalCustomer() bool {
 	return o.CustomerID != nil && *o.CustomerID > 0
 }
+
+// IsSold determina si la orden cuenta como venta: está en uno de los SoldOrderStatuses
+// y no es producción para stock
+func (o *Order) IsSold() bool {
+	if o.Type == OrderTypeInventory {
+		return false
+	}
+	for _, status := range SoldOrderStatuses() {
+		if o.Status == status {
+			return true
+		}
+	}
+	return false
+}
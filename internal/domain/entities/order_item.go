@@ -19,6 +19,7 @@ type OrderItem struct {
 	Quantity         int             // Cantidad total solicitada
 	ReservedQuantity int             // Cantidad reservada del stock existente
 	UnitPrice        float64
+	UnitCost         float64 // Snapshot del costo de producción unitario al momento de la venta (0 = sin costo)
 	Subtotal         float64
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
@@ -48,6 +49,16 @@ func (oi *OrderItem) CalculateSubtotal() {
 	oi.Subtotal = float64(oi.Quantity) * oi.UnitPrice
 }
 
+// TotalCost calcula el costo de producción del item con el costo congelado
+func (oi *OrderItem) TotalCost() float64 {
+	return float64(oi.Quantity) * oi.UnitCost
+}
+
+// HasCost indica si el item tiene costo de producción registrado
+func (oi *OrderItem) HasCost() bool {
+	return oi.UnitCost > 0
+}
+
 // GetQuantityToManufacture retorna cuántas unidades faltan fabricar
 // (Cantidad solicitada - Cantidad reservada del stock)
 func (oi *OrderItem) GetQuantityToManufacture(cantReserved int) int {
@@ -0,0 +1,26 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// PasswordResetToken representa una solicitud de recuperación de contraseña
+// Solo se guarda el hash del token; el token en claro solo viaja en el enlace enviado al usuario
+type PasswordResetToken struct {
+	ID          uint
+	UserID      uint
+	TokenHash   string
+	ExpiresAt   time.Time
+	UsedAt      *time.Time
+	RequestedIP string
+	CreatedAt   time.Time
+}
+
+// ErrInvalidResetToken indica que el token no existe, ya se usó o está vencido
+var ErrInvalidResetToken = errors.New("invalid or expired password reset token")
+
+// IsValid verifica si el token puede usarse en la fecha dada
+func (t *PasswordResetToken) IsValid(at time.Time) bool {
+	return t.UsedAt == nil && at.Before(t.ExpiresAt)
+}
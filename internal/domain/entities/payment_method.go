@@ -7,12 +7,15 @@ import (
 
 // PaymentMethodOption representa una opción de método de pago disponible
 // (ej: NEQUI Sonia, NEQUI Jhon, Daviplata, Efectivo)
+// Cada método de pago es también una cuenta de caja o banco con su propio saldo
 type PaymentMethodOption struct {
-	ID        uint
-	Name      string
-	IsActive  bool
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID             uint
+	Name           string
+	IsActive       bool
+	OpeningBalance float64 // Saldo de la cuenta antes del primer movimiento registrado
+	IsCash         bool    // Efectivo: es lo que se cuenta físicamente al cerrar la caja
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
 // Validate valida los datos del método de pago
@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// ShareResourceType identifica el tipo de registro compartido
+type ShareResourceType string
+
+const (
+	ShareResourceOrder    ShareResourceType = "ORDER"
+	ShareResourceCustomer ShareResourceType = "CUSTOMER"
+)
+
+var (
+	ErrRecordAccessDenied    = errors.New("you do not have access to this record")
+	ErrRecordShareNotFound   = errors.New("record is not shared with this user")
+	ErrInvalidShareResource  = errors.New("invalid share resource type")
+	ErrInvalidOwnershipTaker = errors.New("target user does not exist or is inactive")
+)
+
+// RecordShare da acceso a un usuario sobre una orden o cliente que no le pertenece
+type RecordShare struct {
+	ID           uint
+	ResourceType ShareResourceType
+	ResourceID   uint
+	UserID       uint
+	SharedBy     uint
+	CreatedAt    time.Time
+}
+
+// IsValidShareResource verifica si el tipo de registro se puede compartir
+func IsValidShareResource(resourceType ShareResourceType) bool {
+	return resourceType == ShareResourceOrder || resourceType == ShareResourceCustomer
+}
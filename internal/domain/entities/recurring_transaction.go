@@ -0,0 +1,164 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// RecurrenceFrequency representa cada cuánto se repite una transacción programada
+type RecurrenceFrequency string
+
+const (
+	RecurrenceWeekly   RecurrenceFrequency = "WEEKLY"   // Cada 7 días desde la fecha de inicio
+	RecurrenceBiweekly RecurrenceFrequency = "BIWEEKLY" // Quincenal: el día indicado y 15 días después (ej: 15 y 30)
+	RecurrenceMonthly  RecurrenceFrequency = "MONTHLY"  // Mensual: el día indicado de cada mes
+)
+
+var (
+	// ErrInvalidRecurrence indica que la frecuencia o el día de la transacción programada no son válidos
+	ErrInvalidRecurrence = errors.New("frequency must be WEEKLY, BIWEEKLY (dayOfMonth 1-15) or MONTHLY (dayOfMonth 1-31)")
+
+	// ErrRecurringAlreadyGenerated indica que otro proceso ya generó la misma ocurrencia
+	ErrRecurringAlreadyGenerated = errors.New("recurring transaction occurrence was already generated")
+)
+
+// RecurringTransaction es una plantilla que genera transacciones financieras automáticamente
+// (arriendo el día N, nómina quincenal, servicios...). NextRunDate es la próxima fecha a generar
+type RecurringTransaction struct {
+	ID          uint
+	Type        FinancialTransactionType
+	Category    FinancialTransactionCategory
+	Amount      float64
+	Description string
+	Frequency   RecurrenceFrequency
+	DayOfMonth  int // MONTHLY y BIWEEKLY; si el mes es más corto se usa el último día
+	StartDate   time.Time
+	EndDate     *time.Time // Opcional: después de esta fecha no se genera más
+	NextRunDate time.Time
+	LastRunDate *time.Time
+	IsActive    bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Validate valida la plantilla y normaliza las fechas al inicio del día
+func (r *RecurringTransaction) Validate() error {
+	if r.Amount <= 0 {
+		return errors.New("amount must be greater than zero")
+	}
+	if strings.TrimSpace(r.Description) == "" {
+		return errors.New("description is required")
+	}
+	if r.Type != FinancialTransactionTypeIncome && r.Type != FinancialTransactionTypeExpense {
+		return errors.New("type must be INCOME or EXPENSE")
+	}
+	if r.Category == "" {
+		return errors.New("category is required")
+	}
+	if r.StartDate.IsZero() {
+		return errors.New("startDate is required")
+	}
+
+	switch r.Frequency {
+	case RecurrenceWeekly:
+		r.DayOfMonth = 0
+	case RecurrenceBiweekly:
+		if r.DayOfMonth < 1 || r.DayOfMonth > 15 {
+			return ErrInvalidRecurrence
+		}
+	case RecurrenceMonthly:
+		if r.DayOfMonth < 1 || r.DayOfMonth > 31 {
+			return ErrInvalidRecurrence
+		}
+	default:
+		return ErrInvalidRecurrence
+	}
+
+	r.StartDate = startOfDay(r.StartDate)
+	if r.EndDate != nil {
+		endDate := startOfDay(*r.EndDate)
+		if endDate.Before(r.StartDate) {
+			return errors.New("endDate cannot be before startDate")
+		}
+		r.EndDate = &endDate
+	}
+	return nil
+}
+
+// FirstRunDate retorna la primera ocurrencia en o después de la fecha de inicio
+func (r *RecurringTransaction) FirstRunDate() time.Time {
+	if r.Frequency == RecurrenceWeekly {
+		return r.StartDate
+	}
+	return r.nextOnDays(r.StartDate)
+}
+
+// NextAfter retorna la ocurrencia siguiente a date
+func (r *RecurringTransaction) NextAfter(date time.Time) time.Time {
+	if r.Frequency == RecurrenceWeekly {
+		return date.AddDate(0, 0, 7)
+	}
+	return r.nextOnDays(date.AddDate(0, 0, 1))
+}
+
+// nextOnDays busca desde from (inclusive) el siguiente día del mes que corresponde a la plantilla
+func (r *RecurringTransaction) nextOnDays(from time.Time) time.Time {
+	days := []int{r.DayOfMonth}
+	if r.Frequency == RecurrenceBiweekly {
+		days = append(days, r.DayOfMonth+15)
+	}
+
+	for date := from; ; date = date.AddDate(0, 0, 1) {
+		lastDay := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, date.Location()).Day()
+		for _, day := range days {
+			if date.Day() == day || (day > lastDay && date.Day() == lastDay) {
+				return date
+			}
+		}
+	}
+}
+
+// IsFinished indica si la ocurrencia pendiente ya pasó la fecha de fin
+func (r *RecurringTransaction) IsFinished() bool {
+	return r.EndDate != nil && r.NextRunDate.After(*r.EndDate)
+}
+
+// IsDue indica si la plantilla tiene una ocurrencia pendiente de generar hasta today
+func (r *RecurringTransaction) IsDue(today time.Time) bool {
+	return r.IsActive && !r.IsFinished() && !r.NextRunDate.After(today)
+}
+
+// OccurrencesBetween retorna las fechas en que la plantilla generará transacciones entre from (inclusive) y to (exclusive)
+func (r *RecurringTransaction) OccurrencesBetween(from, to time.Time) []time.Time {
+	dates := []time.Time{}
+	if !r.IsActive {
+		return dates
+	}
+	for date := r.NextRunDate; date.Before(to); date = r.NextAfter(date) {
+		if r.EndDate != nil && date.After(*r.EndDate) {
+			break
+		}
+		if !date.Before(from) {
+			dates = append(dates, date)
+		}
+	}
+	return dates
+}
+
+// NewTransaction crea la transacción financiera de la ocurrencia pendiente
+func (r *RecurringTransaction) NewTransaction() *FinancialTransaction {
+	recurringID := r.ID
+	return &FinancialTransaction{
+		Type:                   r.Type,
+		Category:               r.Category,
+		Amount:                 r.Amount,
+		Description:            r.Description,
+		Date:                   r.NextRunDate,
+		RecurringTransactionID: &recurringID,
+	}
+}
+
+func startOfDay(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+}
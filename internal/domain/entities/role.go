@@ -0,0 +1,233 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// Permission representa una acción concreta del sistema (ej: "orders:change-status")
+type Permission string
+
+// PermissionAll otorga todos los permisos; solo lo tiene SUPER_ADMIN
+const PermissionAll Permission = "*"
+
+// Permisos disponibles para armar roles
+const (
+	PermissionCatalogRead          Permission = "catalog:read"
+	PermissionCatalogWrite         Permission = "catalog:write"
+	PermissionProductPhotos        Permission = "products:photos"
+	PermissionCustomersRead        Permission = "customers:read"
+	PermissionCustomersWrite       Permission = "customers:write"
+	PermissionCustomersDelete      Permission = "customers:delete"
+	PermissionCustomersMerge       Permission = "customers:merge"
+	PermissionCustomersAll         Permission = "customers:all"
+	PermissionPaymentsCreate       Permission = "payments:create"
+	PermissionTransactionsReverse  Permission = "transactions:reverse"
+	PermissionOrdersRead           Permission = "orders:read"
+	PermissionOrdersWrite          Permission = "orders:write"
+	PermissionOrdersChangeStatus   Permission = "orders:change-status"
+	PermissionOrdersManufacturing  Permission = "orders:manufacturing"
+	PermissionOrdersAll            Permission = "orders:all"
+	PermissionOwnershipManage      Permission = "ownership:manage"
+	PermissionFinanceRead          Permission = "finance:read"
+	PermissionFinanceWrite         Permission = "finance:write"
+	PermissionCampaignsManage      Permission = "campaigns:manage"
+	PermissionLoyaltyManage        Permission = "loyalty:manage"
+	PermissionAnalyticsRead        Permission = "analytics:read"
+	PermissionAuditRead            Permission = "audit:read"
+	PermissionUsersManage          Permission = "users:manage"
+	PermissionRolesManage          Permission = "roles:manage"
+	PermissionAPIKeysManage        Permission = "api-keys:manage"
+	PermissionCategoryAccessManage Permission = "category-access:manage"
+)
+
+// PermissionDefinition describe un permiso para mostrarlo al armar roles
+type PermissionDefinition struct {
+	Code        Permission
+	Description string
+}
+
+// PermissionCatalog lista todos los permisos que se pueden asignar a un rol
+var PermissionCatalog = []PermissionDefinition{
+	{PermissionCatalogRead, "Consultar productos, categorías, tallas, métodos de pago y proveedores"},
+	{PermissionCatalogWrite, "Crear, editar y eliminar productos, categorías y proveedores"},
+	{PermissionProductPhotos, "Subir y administrar fotos de productos"},
+	{PermissionCustomersRead, "Consultar clientes, saldos, historial y estados de cuenta"},
+	{PermissionCustomersWrite, "Crear y editar clientes y compartir el enlace del portal"},
+	{PermissionCustomersDelete, "Eliminar clientes"},
+	{PermissionCustomersMerge, "Detectar y fusionar clientes duplicados"},
+	{PermissionCustomersAll, "Ver y operar todos los clientes, no solo los asignados o compartidos"},
+	{PermissionPaymentsCreate, "Registrar abonos y movimientos de cuenta de clientes"},
+	{PermissionTransactionsReverse, "Reversar movimientos de clientes"},
+	{PermissionOrdersRead, "Consultar órdenes"},
+	{PermissionOrdersWrite, "Crear órdenes, editar sus ítems y generar cuentas de cobro"},
+	{PermissionOrdersChangeStatus, "Cambiar el estado de las órdenes a cualquier estado permitido"},
+	{PermissionOrdersManufacturing, "Mover órdenes solo entre los estados de fabricación"},
+	{PermissionOrdersAll, "Ver y operar todas las órdenes, no solo las propias o compartidas"},
+	{PermissionOwnershipManage, "Compartir órdenes y clientes con otros usuarios y reasignar su vendedor"},
+	{PermissionFinanceRead, "Consultar ingresos, gastos, balance y reportes financieros"},
+	{PermissionFinanceWrite, "Registrar y editar ingresos y gastos"},
+	{PermissionCampaignsManage, "Administrar campañas y cupones"},
+	{PermissionLoyaltyManage, "Administrar el programa de puntos"},
+	{PermissionAnalyticsRead, "Ver métricas y tablero de analítica"},
+	{PermissionAuditRead, "Consultar la auditoría"},
+	{PermissionUsersManage, "Administrar usuarios e invitaciones"},
+	{PermissionRolesManage, "Administrar roles y sus permisos"},
+	{PermissionAPIKeysManage, "Crear, rotar y revocar llaves de API para integraciones"},
+	{PermissionCategoryAccessManage, "Asignar a los usuarios las categorías que pueden ver"},
+}
+
+// Roles adicionales incluidos por defecto (los administradores pueden crear otros)
+const (
+	RoleCashier    UserRole = "CASHIER"
+	RoleWorkshop   UserRole = "WORKSHOP"
+	RoleAccountant UserRole = "ACCOUNTANT"
+)
+
+var (
+	ErrRoleNotFound      = errors.New("role not found")
+	ErrRoleAlreadyExists = errors.New("role already exists")
+	ErrRoleInUse         = errors.New("role is assigned to users")
+	ErrSystemRole        = errors.New("system roles cannot be deleted")
+	ErrSuperAdminLocked  = errors.New("SUPER_ADMIN permissions cannot be changed")
+	ErrUnknownPermission = errors.New("unknown permission")
+	ErrInvalidRoleName   = errors.New("role name must be uppercase letters, digits or underscores")
+	ErrRoleEscalation    = errors.New("cannot grant a role with permissions you do not have")
+	ErrSelfRoleChange    = errors.New("users cannot change their own role")
+	ErrManufacturingOnly = errors.New("only manufacturing status changes are allowed for your role")
+)
+
+// roleNamePattern exige nombres en mayúsculas como los roles existentes (SUPER_ADMIN, SELLER)
+var roleNamePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{1,49}$`)
+
+// Role agrupa un conjunto de permisos; User.Role guarda el nombre del rol
+type Role struct {
+	ID          uint
+	Name        UserRole
+	Description string
+	Permissions []Permission
+	IsSystem    bool // Los roles incluidos por defecto no se pueden eliminar
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// HasPermission verifica si el rol otorga el permiso
+func (r *Role) HasPermission(permission Permission) bool {
+	for _, p := range r.Permissions {
+		if p == PermissionAll || p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// CanGrant verifica que el rol incluya todos los permisos de otro (para asignarlo, crearlo o editarlo)
+// El comodín solo lo otorga quien también lo tiene
+func (r *Role) CanGrant(other *Role) bool {
+	for _, p := range other.Permissions {
+		if !r.HasPermission(p) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate verifica el nombre del rol y que todos sus permisos existan
+func (r *Role) Validate() error {
+	if !roleNamePattern.MatchString(string(r.Name)) {
+		return ErrInvalidRoleName
+	}
+	for _, p := range r.Permissions {
+		if !IsKnownPermission(p) {
+			return fmt.Errorf("%w: %s", ErrUnknownPermission, p)
+		}
+	}
+	return nil
+}
+
+// IsKnownPermission verifica si el permiso está en el catálogo
+func IsKnownPermission(permission Permission) bool {
+	for _, def := range PermissionCatalog {
+		if def.Code == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultRoles retorna los roles que se crean al iniciar si no existen
+// SELLER no tiene orders:all ni customers:all: solo ve sus órdenes y los clientes asignados o compartidos
+func DefaultRoles() []Role {
+	return []Role{
+		{
+			Name:        RoleSuperAdmin,
+			Description: "Acceso total al sistema",
+			Permissions: []Permission{PermissionAll},
+			IsSystem:    true,
+		},
+		{
+			Name:        RoleSeller,
+			Description: "Vendedor: clientes, órdenes y abonos",
+			Permissions: []Permission{
+				PermissionCatalogRead,
+				PermissionProductPhotos,
+				PermissionCustomersRead,
+				PermissionCustomersWrite,
+				PermissionPaymentsCreate,
+				PermissionOrdersRead,
+				PermissionOrdersWrite,
+				PermissionOrdersChangeStatus,
+			},
+			IsSystem: true,
+		},
+		{
+			Name:        RoleCashier,
+			Description: "Caja: solo registra abonos de clientes",
+			Permissions: []Permission{
+				PermissionCustomersRead,
+				PermissionCustomersAll,
+				PermissionPaymentsCreate,
+				PermissionOrdersRead,
+				PermissionOrdersAll,
+			},
+			IsSystem: true,
+		},
+		{
+			Name:        RoleWorkshop,
+			Description: "Taller: solo mueve órdenes entre los estados de fabricación",
+			Permissions: []Permission{
+				PermissionCatalogRead,
+				PermissionOrdersRead,
+				PermissionOrdersAll,
+				PermissionOrdersManufacturing,
+			},
+			IsSystem: true,
+		},
+		{
+			Name:        RoleAccountant,
+			Description: "Contabilidad: consulta de finanzas y reportes, sin modificar",
+			Permissions: []Permission{
+				PermissionCustomersRead,
+				PermissionCustomersAll,
+				PermissionOrdersRead,
+				PermissionOrdersAll,
+				PermissionFinanceRead,
+				PermissionAnalyticsRead,
+			},
+			IsSystem: true,
+		},
+	}
+}
+
+// IsManufacturingTransition indica si el cambio de estado es parte de la fabricación
+// (lo que puede hacer un rol con orders:manufacturing sin orders:change-status)
+func IsManufacturingTransition(newStatus OrderStatus) bool {
+	switch newStatus {
+	case OrderStatusManufacturing, OrderStatusInProduction, OrderStatusFinished:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,179 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// SalesDimension es el eje por el que se agrupan las ventas en el reporte
+type SalesDimension string
+
+const (
+	SalesByProduct  SalesDimension = "PRODUCT"  // Nombre del producto base (snapshot del item)
+	SalesByVariant  SalesDimension = "VARIANT"  // Producto + color + talla
+	SalesBySize     SalesDimension = "SIZE"     // Talla del item
+	SalesByColor    SalesDimension = "COLOR"    // Color del item
+	SalesByCategory SalesDimension = "CATEGORY" // Categoría del item
+	SalesBySeller   SalesDimension = "SELLER"   // Vendedor de la orden
+)
+
+var (
+	ErrInvalidSalesDimension = errors.New("dimension must be PRODUCT, VARIANT, SIZE, COLOR, CATEGORY or SELLER")
+	ErrInvalidReportPeriod   = errors.New("report period requires startDate and endDate, with endDate not before startDate")
+	ErrInvalidExportFormat   = errors.New("format must be csv, xlsx or pdf")
+)
+
+// IsValid verifica si la dimensión es soportada
+func (d SalesDimension) IsValid() bool {
+	switch d {
+	case SalesByProduct, SalesByVariant, SalesBySize, SalesByColor, SalesByCategory, SalesBySeller:
+		return true
+	default:
+		return false
+	}
+}
+
+// SoldOrderStatuses son los estados en los que una orden cuenta como venta
+// Las cotizaciones, ventas pendientes y canceladas no cuentan
+func SoldOrderStatuses() []OrderStatus {
+	return []OrderStatus{
+		OrderStatusApproved,
+		OrderStatusManufacturing,
+		OrderStatusInProduction,
+		OrderStatusFinished,
+		OrderStatusConfirmed,
+		OrderStatusDelivered,
+	}
+}
+
+// SalesReportFilters define el periodo y el universo del reporte de ventas
+// Sin OrderType se excluyen las órdenes INVENTORY (producción para stock, no son ventas)
+type SalesReportFilters struct {
+	Dimension       SalesDimension
+	StartDate       time.Time
+	EndDate         time.Time // Inclusive: se toma el día completo
+	SellerID        *uint
+	CategoryID      *uint
+	OrderType       OrderType
+	VisibleToUserID *uint // Alcance del usuario sin orders:all (propias o compartidas)
+}
+
+// Validate verifica la dimensión y el periodo
+func (f *SalesReportFilters) Validate() error {
+	if !f.Dimension.IsValid() {
+		return ErrInvalidSalesDimension
+	}
+	if f.StartDate.IsZero() || f.EndDate.IsZero() || f.EndDate.Before(f.StartDate) {
+		return ErrInvalidReportPeriod
+	}
+	return nil
+}
+
+// PreviousPeriod retorna el periodo con el que se compara el reporte
+// Un mes calendario completo se compara con el mes anterior; cualquier otro rango,
+// con el mismo número de días inmediatamente antes
+func (f *SalesReportFilters) PreviousPeriod() (time.Time, time.Time) {
+	start := truncateDay(f.StartDate)
+	end := truncateDay(f.EndDate)
+
+	if start.Day() == 1 && end.Equal(start.AddDate(0, 1, -1)) {
+		previousStart := start.AddDate(0, -1, 0)
+		return previousStart, start.AddDate(0, 0, -1)
+	}
+
+	days := int(end.Sub(start).Hours()/24) + 1
+	return start.AddDate(0, 0, -days), start.AddDate(0, 0, -1)
+}
+
+// SalesRow es el total vendido de un valor de la dimensión en un periodo
+type SalesRow struct {
+	Key      string // Identificador estable del grupo (ID o texto)
+	Label    string // Texto para mostrar
+	Quantity int
+	Orders   int
+	Revenue  float64 // Suma de subtotales de los items (sin prorratear el descuento de la orden)
+}
+
+// SalesReportLine compara un valor de la dimensión entre el periodo y el anterior
+type SalesReportLine struct {
+	Key              string
+	Label            string
+	Quantity         int
+	Orders           int
+	Revenue          float64
+	PreviousQuantity int
+	PreviousRevenue  float64
+	RevenueChange    *float64 // Variación porcentual; nil si en el periodo anterior no hubo ventas
+	Share            float64  // Participación porcentual en las ventas del periodo
+}
+
+// SalesReport es el reporte de ventas agrupado por una dimensión
+type SalesReport struct {
+	Dimension       SalesDimension
+	StartDate       time.Time
+	EndDate         time.Time
+	PreviousStart   time.Time
+	PreviousEnd     time.Time
+	Lines           []SalesReportLine
+	TotalQuantity   int
+	TotalRevenue    float64
+	PreviousRevenue float64
+	RevenueChange   *float64
+}
+
+// NewSalesReport combina las ventas del periodo y del anterior, ordenadas por ventas del periodo
+// Los valores que solo vendieron en el periodo anterior también aparecen (con ventas en cero)
+func NewSalesReport(filters SalesReportFilters, current, previous []SalesRow) *SalesReport {
+	report := &SalesReport{
+		Dimension: filters.Dimension,
+		StartDate: truncateDay(filters.StartDate),
+		EndDate:   truncateDay(filters.EndDate),
+	}
+	report.PreviousStart, report.PreviousEnd = filters.PreviousPeriod()
+
+	index := make(map[string]int, len(current))
+	for _, row := range current {
+		index[row.Key] = len(report.Lines)
+		report.Lines = append(report.Lines, SalesReportLine{
+			Key:      row.Key,
+			Label:    row.Label,
+			Quantity: row.Quantity,
+			Orders:   row.Orders,
+			Revenue:  row.Revenue,
+		})
+		report.TotalQuantity += row.Quantity
+		report.TotalRevenue += row.Revenue
+	}
+	for _, row := range previous {
+		report.PreviousRevenue += row.Revenue
+		i, exists := index[row.Key]
+		if !exists {
+			i = len(report.Lines)
+			report.Lines = append(report.Lines, SalesReportLine{Key: row.Key, Label: row.Label})
+		}
+		report.Lines[i].PreviousQuantity = row.Quantity
+		report.Lines[i].PreviousRevenue = row.Revenue
+	}
+
+	for i := range report.Lines {
+		line := &report.Lines[i]
+		line.RevenueChange = percentChange(line.PreviousRevenue, line.Revenue)
+		if report.TotalRevenue > 0 {
+			line.Share = line.Revenue / report.TotalRevenue * 100
+		}
+	}
+	report.RevenueChange = percentChange(report.PreviousRevenue, report.TotalRevenue)
+	return report
+}
+
+func percentChange(previous, current float64) *float64 {
+	if previous == 0 {
+		return nil
+	}
+	change := (current - previous) / previous * 100
+	return &change
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
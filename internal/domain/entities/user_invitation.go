@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// UserInvitation representa la invitación de un SUPER_ADMIN para crear una cuenta
+// Define de antemano el rol y los permisos por categoría; el invitado solo elige su contraseña
+// Solo se guarda el hash del token; el token en claro solo viaja en el enlace enviado
+type UserInvitation struct {
+	ID             uint
+	Email          string
+	FirstName      string
+	LastName       string
+	Role           UserRole
+	Permissions    []UserCategoryPermission // Permisos por categoría que recibirá el usuario
+	TokenHash      string
+	ExpiresAt      time.Time
+	AcceptedAt     *time.Time
+	AcceptedUserID *uint
+	RevokedAt      *time.Time
+	InvitedBy      uint
+	CreatedAt      time.Time
+}
+
+var (
+	ErrInvalidInvitation       = errors.New("invalid or expired invitation")
+	ErrInvitationPending       = errors.New("there is already a pending invitation for this email")
+	ErrRegistrationDisabled    = errors.New("public registration is disabled, ask an administrator for an invitation")
+	ErrInvalidRole             = errors.New("invalid role")
+	ErrInvitationAlreadyClosed = errors.New("invitation was already accepted or revoked")
+)
+
+// IsValid verifica si la invitación puede aceptarse en la fecha dada
+func (i *UserInvitation) IsValid(at time.Time) bool {
+	return i.AcceptedAt == nil && i.RevokedAt == nil && at.Before(i.ExpiresAt)
+}
+
+// Status retorna el estado legible de la invitación
+func (i *UserInvitation) Status(at time.Time) string {
+	switch {
+	case i.AcceptedAt != nil:
+		return "ACCEPTED"
+	case i.RevokedAt != nil:
+		return "REVOKED"
+	case !at.Before(i.ExpiresAt):
+		return "EXPIRED"
+	default:
+		return "PENDING"
+	}
+}
@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// Motivos de revocación de una sesión
+const (
+	SessionRevokedLogout          = "LOGOUT"
+	SessionRevokedByUser          = "REVOKED_BY_USER"
+	SessionRevokedPasswordChanged = "PASSWORD_CHANGED"
+	SessionRevokedUserDeactivated = "USER_DEACTIVATED"
+	SessionRevokedTokenReuse      = "REFRESH_TOKEN_REUSE"
+	SessionRevokedPasswordReset   = "PASSWORD_RESET"
+)
+
+// UserSession representa la sesión de un usuario en un dispositivo
+// Solo se guarda el hash del refresh token; el token en claro solo lo conoce el cliente
+type UserSession struct {
+	ID                uint
+	UserID            uint
+	RefreshTokenHash  string // Hash del refresh token vigente
+	PreviousTokenHash string // Hash del refresh token anterior, para detectar reutilización
+	UserAgent         string
+	IPAddress         string
+	ExpiresAt         time.Time
+	LastUsedAt        time.Time
+	RevokedAt         *time.Time
+	RevokedReason     string
+	CreatedAt         time.Time
+}
+
+var (
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	ErrSessionRevoked      = errors.New("session has been revoked")
+)
+
+// IsActive verifica si la sesión sigue vigente en la fecha dada
+func (s *UserSession) IsActive(at time.Time) bool {
+	return s.RevokedAt == nil && at.Before(s.ExpiresAt)
+}
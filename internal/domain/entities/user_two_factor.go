@@ -0,0 +1,120 @@
+package entities
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TwoFactorChallengeScope identifica los tokens del segundo paso del login
+// Solo sirven para verificar el código TOTP o enrolarse; nunca dan acceso a las rutas internas
+const TwoFactorChallengeScope = "two_factor_challenge"
+
+// Parámetros TOTP (RFC 6238) compatibles con Google Authenticator, Authy, etc.
+const (
+	TOTPPeriod = 30 // Segundos de vigencia de cada código
+	TOTPDigits = 6
+	TOTPSkew   = 1 // Pasos de tolerancia hacia atrás y adelante por desfase de reloj
+)
+
+var (
+	ErrTwoFactorNotEnabled      = errors.New("two-factor authentication is not enabled")
+	ErrTwoFactorAlreadyEnabled  = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotSetUp        = errors.New("two-factor authentication has not been set up")
+	ErrInvalidTwoFactorCode     = errors.New("invalid two-factor code")
+	ErrInvalidTwoFactorSession  = errors.New("invalid or expired two-factor challenge")
+	ErrTwoFactorRequiredForRole = errors.New("two-factor authentication is required for this role")
+)
+
+// UserTwoFactor representa la configuración TOTP de un usuario
+// Secret se guarda cifrado; solo se descifra para validar códigos
+type UserTwoFactor struct {
+	ID           uint
+	UserID       uint
+	Secret       string
+	EnabledAt    *time.Time // nil mientras el enrolamiento no se confirme con un código
+	LastUsedStep int64      // Último paso TOTP aceptado, para impedir reutilizar un código
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// IsEnabled verifica si el segundo factor ya está activo
+func (t *UserTwoFactor) IsEnabled() bool {
+	return t.EnabledAt != nil
+}
+
+// TwoFactorRequiredError indica que la contraseña fue correcta pero falta el segundo paso
+// ChallengeToken se canjea junto con el código TOTP (o se usa para enrolarse si EnrollmentRequired)
+type TwoFactorRequiredError struct {
+	ChallengeToken     string
+	ExpiresAt          time.Time
+	EnrollmentRequired bool
+}
+
+func (e *TwoFactorRequiredError) Error() string {
+	if e.EnrollmentRequired {
+		return "two-factor enrollment required"
+	}
+	return "two-factor code required"
+}
+
+// DecodeTOTPSecret convierte el secreto base32 (sin relleno) a bytes
+func DecodeTOTPSecret(secret string) ([]byte, error) {
+	normalized := strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	return base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(normalized)
+}
+
+// EncodeTOTPSecret convierte bytes aleatorios al formato base32 que usan las apps autenticadoras
+func EncodeTOTPSecret(raw []byte) string {
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(raw)
+}
+
+// TOTPStep retorna el paso de tiempo TOTP de la fecha dada
+func TOTPStep(at time.Time) int64 {
+	return at.Unix() / TOTPPeriod
+}
+
+// GenerateTOTPCode calcula el código de un paso (HOTP con HMAC-SHA1)
+func GenerateTOTPCode(secret []byte, step int64) string {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < TOTPDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", TOTPDigits, value%mod)
+}
+
+// MatchTOTPCode busca el código dentro de la ventana de tolerancia y retorna el paso que coincidió
+// Los pasos iguales o anteriores a lastUsedStep se ignoran para que un código no sirva dos veces
+func MatchTOTPCode(secret []byte, code string, at time.Time, lastUsedStep int64) (int64, bool) {
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if len(code) != TOTPDigits {
+		return 0, false
+	}
+
+	current := TOTPStep(at)
+	for step := current - TOTPSkew; step <= current+TOTPSkew; step++ {
+		if step <= lastUsedStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(GenerateTOTPCode(secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,88 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+// rfcSecret es el secreto de los vectores de prueba de RFC 4226 y RFC 6238 (SHA-1)
+var rfcSecret = []byte("12345678901234567890")
+
+func TestGenerateTOTPCodeRFC6238(t *testing.T) {
+	// Vectores del apéndice B de RFC 6238 (8 dígitos); con 6 dígitos son los últimos seis
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		step := TOTPStep(time.Unix(tt.unix, 0))
+		if got := GenerateTOTPCode(rfcSecret, step); got != tt.code {
+			t.Errorf("GenerateTOTPCode(T=%d) = %s, want %s", tt.unix, got, tt.code)
+		}
+	}
+}
+
+func TestGenerateTOTPCodeRFC4226(t *testing.T) {
+	// Vectores del apéndice D de RFC 4226 (HOTP, contador 0 a 9)
+	codes := []string{"755224", "287082", "359152", "969429", "338314", "254676", "287922", "162583", "399871", "520489"}
+
+	for counter, want := range codes {
+		if got := GenerateTOTPCode(rfcSecret, int64(counter)); got != want {
+			t.Errorf("GenerateTOTPCode(counter=%d) = %s, want %s", counter, got, want)
+		}
+	}
+}
+
+func TestMatchTOTPCode(t *testing.T) {
+	at := time.Unix(1111111111, 0)
+	current := TOTPStep(at)
+
+	tests := []struct {
+		name         string
+		code         string
+		lastUsedStep int64
+		wantStep     int64
+		wantOK       bool
+	}{
+		{"current step", "050471", 0, current, true},
+		{"previous step within skew", GenerateTOTPCode(rfcSecret, current-1), 0, current - 1, true},
+		{"next step within skew", GenerateTOTPCode(rfcSecret, current+1), 0, current + 1, true},
+		{"outside skew", GenerateTOTPCode(rfcSecret, current+2), 0, 0, false},
+		{"spaces are ignored", " 050 471 ", 0, current, true},
+		{"already used step", "050471", current, 0, false},
+		{"wrong length", "50471", 0, 0, false},
+		{"wrong code", "000000", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := MatchTOTPCode(rfcSecret, tt.code, at, tt.lastUsedStep)
+			if ok != tt.wantOK || step != tt.wantStep {
+				t.Errorf("MatchTOTPCode(%q) = (%d, %v), want (%d, %v)", tt.code, step, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestTOTPSecretEncoding(t *testing.T) {
+	encoded := EncodeTOTPSecret(rfcSecret)
+	if encoded != "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ" {
+		t.Fatalf("EncodeTOTPSecret = %s", encoded)
+	}
+
+	decoded, err := DecodeTOTPSecret("gezd gnbv gy3t qojq gezd gnbv gy3t qojq")
+	if err != nil {
+		t.Fatalf("DecodeTOTPSecret: %v", err)
+	}
+	if string(decoded) != string(rfcSecret) {
+		t.Errorf("DecodeTOTPSecret = %q, want %q", decoded, rfcSecret)
+	}
+}
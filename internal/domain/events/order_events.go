@@ -14,9 +14,20 @@ type OrderEvent struct {
 	OldStatus entities.OrderStatus
 	NewStatus entities.OrderStatus
 	Data      map[string]interface{} // Datos adicionales del evento
+	Actor     *Actor                 // Quién originó el evento; nil en procesos internos sin petición
 	Timestamp time.Time
 }
 
+// Actor identifica quién originó un evento y desde dónde
+type Actor struct {
+	UserID    *uint
+	UserName  string
+	APIKeyID  *uint // Llave de API con la que se hizo la petición, si aplica
+	IPAddress string
+	UserAgent string
+	RequestID string // X-Request-ID de la petición, para cruzar auditoría con los logs del servidor
+}
+
 // OrderEventType representa el tipo de evento
 type OrderEventType string
 
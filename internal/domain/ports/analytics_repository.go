@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// AnalyticsRepository calcula métricas con consultas sobre órdenes, items, movimientos y auditoría
+// Los valores salen siempre de la base de datos: sobreviven a reinicios y no dependen de los eventos
+type AnalyticsRepository interface {
+	// CountOrders cuenta las órdenes por estado y por tipo
+	CountOrders(ctx context.Context, filters entities.AnalyticsFilters) (map[entities.OrderStatus]int, map[entities.OrderType]int, error)
+
+	// GetDeliveredRevenue suma las ventas de las órdenes entregadas
+	GetDeliveredRevenue(ctx context.Context, filters entities.AnalyticsFilters) (float64, error)
+
+	// GetPaymentsCollected suma los abonos de clientes no reversados del rango
+	GetPaymentsCollected(ctx context.Context, filters entities.AnalyticsFilters) (float64, error)
+
+	// GetManufacturingTime retorna la duración promedio de fabricación y cuántas órdenes se midieron
+	GetManufacturingTime(ctx context.Context, filters entities.AnalyticsFilters) (avgSeconds float64, orders int, err error)
+
+	// GetRevenueByCategory agrupa las ventas entregadas por categoría
+	GetRevenueByCategory(ctx context.Context, filters entities.AnalyticsFilters) ([]entities.CategorySales, error)
+
+	// GetRevenueBySeller agrupa las ventas entregadas por vendedor
+	GetRevenueBySeller(ctx context.Context, filters entities.AnalyticsFilters) ([]entities.SellerSales, error)
+}
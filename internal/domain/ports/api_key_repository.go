@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// APIKeyRepository define las operaciones para llaves de API
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *entities.APIKey) error
+
+	// GetByID y GetByPrefix retornan ErrAPIKeyNotFound si la llave no existe
+	GetByID(ctx context.Context, id uint) (*entities.APIKey, error)
+	GetByPrefix(ctx context.Context, prefix string) (*entities.APIKey, error)
+	List(ctx context.Context) ([]entities.APIKey, error)
+
+	// Revoke marca la llave como revocada; retorna ErrAPIKeyRevoked si ya lo estaba
+	Revoke(ctx context.Context, id uint) error
+
+	// SetExpiration adelanta el vencimiento (ej: periodo de gracia al rotar)
+	SetExpiration(ctx context.Context, id uint, expiresAt time.Time) error
+
+	// TouchLastUsed registra el último uso de la llave
+	TouchLastUsed(ctx context.Context, id uint, usedAt time.Time, ipAddress string) error
+}
@@ -20,6 +20,9 @@ type AuditLogRepository interface {
 	// GetByOrderID obtiene todos los logs de una orden
 	GetByOrderID(ctx context.Context, orderID uint) ([]entities.AuditLog, error)
 
+	// GetByEntity obtiene el historial de cambios de una entidad (ej: PRODUCT #12)
+	GetByEntity(ctx context.Context, entityType string, entityID uint) ([]entities.AuditLog, error)
+
 	// GetByUserID obtiene todos los logs de un usuario
 	GetByUserID(ctx context.Context, userID uint) ([]entities.AuditLog, error)
 
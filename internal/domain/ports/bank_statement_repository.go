@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// BankStatementRepository define las operaciones de persistencia de los extractos importados para conciliar
+type BankStatementRepository interface {
+	Create(ctx context.Context, statement *entities.BankStatement) error
+	GetByID(ctx context.Context, id uint) (*entities.BankStatement, error)
+	ListByPaymentMethod(ctx context.Context, paymentMethodID uint) ([]entities.BankStatement, error)
+
+	// UpdateLine guarda el estado de conciliación de la línea; falla con ErrMovementAlreadyReconciled
+	// si el movimiento ya está cruzado con otra línea de la misma cuenta
+	UpdateLine(ctx context.Context, line *entities.BankStatementLine) error
+
+	// IsReconciled indica si el movimiento está cruzado con alguna línea de un extracto
+	IsReconciled(ctx context.Context, source entities.AccountMovementSource, sourceID uint) (bool, error)
+}
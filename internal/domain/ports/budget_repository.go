@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// BudgetRepository define las operaciones para los presupuestos mensuales por categoría
+type BudgetRepository interface {
+	// Upsert crea o reemplaza el presupuesto de la categoría en el mes; guardarlo de nuevo rearma la alerta
+	Upsert(ctx context.Context, budget *entities.Budget) error
+	GetByID(ctx context.Context, id uint) (*entities.Budget, error)
+	ListByMonth(ctx context.Context, month time.Time) ([]entities.Budget, error)
+	Delete(ctx context.Context, id uint) error
+
+	// MarkAlerted registra que ya se avisó el sobregiro del presupuesto
+	MarkAlerted(ctx context.Context, id uint, at time.Time) error
+}
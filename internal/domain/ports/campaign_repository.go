@@ -0,0 +1,45 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// CampaignRepository define las operaciones de persistencia para campañas
+type CampaignRepository interface {
+	Create(ctx context.Context, campaign *entities.Campaign) error
+	GetByID(ctx context.Context, id uint) (*entities.Campaign, error)
+	List(ctx context.Context, filters map[string]interface{}) ([]entities.Campaign, error)
+	Update(ctx context.Context, campaign *entities.Campaign) error
+
+	// FindCustomers selecciona los clientes activos que cumplen los criterios de la campaña
+	FindCustomers(ctx context.Context, criteria entities.CampaignCriteria) ([]entities.Customer, error)
+}
+
+// CampaignCouponRepository define las operaciones de persistencia para cupones de campaña
+type CampaignCouponRepository interface {
+	Create(ctx context.Context, coupon *entities.CampaignCoupon) error
+
+	// GetByCode retorna el cupón con su campaña y su cliente (la campaña se necesita para validar la redención)
+	GetByCode(ctx context.Context, code string) (*entities.CampaignCoupon, error)
+
+	ListByCampaign(ctx context.Context, campaignID uint) ([]entities.CampaignCoupon, error)
+	ListByCustomer(ctx context.Context, customerID uint) ([]entities.CampaignCoupon, error)
+
+	// ExistsForCustomer verifica si el cliente ya tiene un cupón de la campaña
+	ExistsForCustomer(ctx context.Context, campaignID, customerID uint) (bool, error)
+
+	// MarkRedeemed marca el cupón como redimido solo si aún no lo estaba
+	MarkRedeemed(ctx context.Context, couponID, orderID uint, discount float64) error
+
+	// ReleaseRedemption deja el cupón disponible de nuevo (cuando la orden no se pudo completar)
+	ReleaseRedemption(ctx context.Context, couponID uint) error
+
+	// ReleaseOrderRedemption deja disponible el cupón redimido por la orden (cuando la orden se cancela)
+	// No falla si la orden no usó cupón
+	ReleaseOrderRedemption(ctx context.Context, orderID uint) error
+
+	// GetStats calcula las estadísticas de redención de una campaña
+	GetStats(ctx context.Context, campaignID uint) (*entities.CampaignStats, error)
+}
@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// CashAccountRepository define las consultas de movimientos por cuenta (método de pago) y los traslados
+// Los movimientos salen de los ABONO de clientes, las transacciones financieras con cuenta y los traslados
+type CashAccountRepository interface {
+	CreateTransfer(ctx context.Context, transfer *entities.AccountTransfer) error
+	ListTransfers(ctx context.Context, paymentMethodID *uint, from, to time.Time) ([]entities.AccountTransfer, error)
+
+	// SumByAccount retorna las entradas y salidas acumuladas de cada cuenta con movimientos
+	SumByAccount(ctx context.Context) (map[uint]entities.AccountTotals, error)
+
+	// SumBefore retorna el neto de los movimientos de la cuenta anteriores a before
+	SumBefore(ctx context.Context, paymentMethodID uint, before time.Time) (float64, error)
+
+	// ListMovements retorna los movimientos de la cuenta entre from (inclusive) y to (exclusive) ordenados por fecha
+	ListMovements(ctx context.Context, paymentMethodID uint, from, to time.Time) ([]entities.AccountMovement, error)
+	GetMovement(ctx context.Context, paymentMethodID uint, source entities.AccountMovementSource, sourceID uint) (*entities.AccountMovement, error)
+}
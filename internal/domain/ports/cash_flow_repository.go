@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// CashFlowRepository obtiene las cuentas por cobrar y los gastos que alimentan la proyección de caja
+type CashFlowRepository interface {
+	// ListOpenReceivables retorna los clientes activos con saldo pendiente y el promedio
+	// de sus abonos desde since (sin contar reversos ni abonos reversados)
+	ListOpenReceivables(ctx context.Context, since time.Time) ([]entities.OpenReceivable, error)
+
+	// ListPendingCustomOrders retorna las órdenes CUSTOM aprobadas que aún no se entregan
+	ListPendingCustomOrders(ctx context.Context) ([]entities.Order, error)
+
+	// SumExpensesByCategory retorna el total de gastos por categoría entre from (inclusive) y to (exclusive)
+	SumExpensesByCategory(ctx context.Context, categories []entities.FinancialTransactionCategory, from, to time.Time) (map[entities.FinancialTransactionCategory]float64, error)
+}
@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// CashSessionRepository define las operaciones para las sesiones de caja (cierre de caja)
+type CashSessionRepository interface {
+	Create(ctx context.Context, session *entities.CashSession) error
+	GetByID(ctx context.Context, id uint) (*entities.CashSession, error)
+
+	// GetOpenByUser retorna la caja abierta del usuario (gorm.ErrRecordNotFound si no tiene)
+	GetOpenByUser(ctx context.Context, userID uint) (*entities.CashSession, error)
+	List(ctx context.Context, filters map[string]interface{}) ([]entities.CashSession, error)
+
+	// Close bloquea la sesión, vuelve a leer sus movimientos y se los pasa a reconcile para calcular
+	// el arqueo; luego guarda la sesión y los totales por método de pago en la misma transacción
+	// Retorna los movimientos arqueados, o entities.ErrCashSessionNotOpen si la sesión ya estaba cerrada
+	Close(ctx context.Context, session *entities.CashSession, reconcile func(entries []entities.CashSessionEntry) error) ([]entities.CashSessionEntry, error)
+
+	// ListEntries retorna los abonos (y sus reversos) y las ventas SALE no canceladas de la sesión, por fecha
+	ListEntries(ctx context.Context, sessionID uint) ([]entities.CashSessionEntry, error)
+}
@@ -0,0 +1,44 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// CommissionRuleRepository define las operaciones de persistencia para reglas de comisión
+type CommissionRuleRepository interface {
+	Create(ctx context.Context, rule *entities.CommissionRule) error
+	GetByID(ctx context.Context, id uint) (*entities.CommissionRule, error)
+	List(ctx context.Context, activeOnly bool) ([]entities.CommissionRule, error)
+	Update(ctx context.Context, rule *entities.CommissionRule) error
+	Delete(ctx context.Context, id uint) error
+}
+
+// CommissionEntryRepository define las operaciones sobre el libro de comisiones de los vendedores
+type CommissionEntryRepository interface {
+	Create(ctx context.Context, entry *entities.CommissionEntry) error
+
+	// GetByOrder retorna el movimiento del tipo dado para la orden (gorm.ErrRecordNotFound si no existe)
+	GetByOrder(ctx context.Context, orderID uint, entryType entities.CommissionEntryType) (*entities.CommissionEntry, error)
+
+	// SumBaseAmount suma la venta neta comisionada del vendedor en [from, to) descontando reversos
+	SumBaseAmount(ctx context.Context, sellerID uint, from, to time.Time) (float64, error)
+
+	// ListBySeller retorna los movimientos del vendedor creados en [from, to), del más antiguo al más reciente
+	ListBySeller(ctx context.Context, sellerID uint, from, to time.Time) ([]entities.CommissionEntry, error)
+
+	// ListUnpaid retorna los movimientos sin liquidar del vendedor creados antes de la fecha dada
+	ListUnpaid(ctx context.Context, sellerID uint, before time.Time) ([]entities.CommissionEntry, error)
+}
+
+// CommissionPayoutRepository define las operaciones de las liquidaciones de comisiones
+type CommissionPayoutRepository interface {
+	// Settle guarda el gasto financiero y la liquidación, y marca los movimientos como pagados,
+	// todo en una transacción. Retorna ErrCommissionsAlreadyPaid si algún movimiento ya tenía pago
+	Settle(ctx context.Context, payout *entities.CommissionPayout, transaction *entities.FinancialTransaction, entryIDs []uint) error
+
+	// List retorna las liquidaciones (de un vendedor si sellerID no es nil) creadas en [from, to)
+	List(ctx context.Context, sellerID *uint, from, to time.Time) ([]entities.CommissionPayout, error)
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// CustomerAccessLinkRepository define las operaciones para enlaces de acceso al portal
+type CustomerAccessLinkRepository interface {
+	Create(ctx context.Context, link *entities.CustomerAccessLink) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entities.CustomerAccessLink, error)
+
+	// MarkUsed marca el enlace como usado solo si aún no lo estaba
+	// Retorna ErrInvalidAccessLink si otro proceso lo usó primero
+	MarkUsed(ctx context.Context, id uint) error
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// CustomerMergeRepository define las operaciones para fusionar clientes duplicados
+type CustomerMergeRepository interface {
+	// Merge mueve transacciones, órdenes, cupones y puntos del cliente fusionado al conservado,
+	// guarda los datos completados del conservado, desactiva el fusionado y registra la fusión.
+	// Todo ocurre en una sola transacción; completa los contadores y el saldo resultante del registro.
+	Merge(ctx context.Context, survivor *entities.Customer, record *entities.CustomerMerge) error
+
+	// ListByCustomer lista las fusiones donde el cliente fue conservado o fusionado
+	ListByCustomer(ctx context.Context, customerID uint) ([]entities.CustomerMerge, error)
+}
@@ -12,11 +12,16 @@ type CustomerRepository interface {
 	GetByID(ctx context.Context, id uint) (*entities.Customer, error)
 	GetByEmail(ctx context.Context, email string) (*entities.Customer, error)
 	GetByDocument(ctx context.Context, documentNum string) (*entities.Customer, error)
+	ListByPhone(ctx context.Context, phoneDigits string) ([]entities.Customer, error)
 	List(ctx context.Context, filters map[string]interface{}) ([]entities.Customer, error)
 	Update(ctx context.Context, customer *entities.Customer) error
+	// UpdateAssignedSeller cambia el vendedor responsable (nil deja el cliente sin asignar)
+	UpdateAssignedSeller(ctx context.Context, id uint, sellerID *uint) error
 	Delete(ctx context.Context, id uint) error
 	GetUpcomingPayments(ctx context.Context, daysRange int) ([]entities.Customer, error)
 	GetBalance(ctx context.Context, customerID uint) (float64, error)
+	// GetTotalBalance suma el saldo de todos los clientes (la cartera total)
+	GetTotalBalance(ctx context.Context) (float64, error)
 }
 
 // CustomerTransactionRepository define las operaciones para transacciones de clientes
@@ -25,4 +30,6 @@ type CustomerTransactionRepository interface {
 	GetByID(ctx context.Context, id uint) (*entities.CustomerTransaction, error)
 	ListByCustomer(ctx context.Context, customerID uint) ([]entities.CustomerTransaction, error)
 	List(ctx context.Context, filters map[string]interface{}) ([]entities.CustomerTransaction, error)
+	// Reverse crea el asiento compensatorio y marca el original como reversado en una sola transacción
+	Reverse(ctx context.Context, originalID uint, reversal *entities.CustomerTransaction) error
 }
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// DocumentSigner firma el XML de los documentos electrónicos con el certificado del facturador
+type DocumentSigner interface {
+	Sign(ctx context.Context, xml []byte) ([]byte, error)
+}
+
+// ElectronicInvoiceProvider envía los documentos firmados a la DIAN, directamente o por un proveedor tecnológico
+// Un error indica que no hubo respuesta (el documento queda pendiente); un rechazo llega en la respuesta
+type ElectronicInvoiceProvider interface {
+	Submit(ctx context.Context, document *entities.ElectronicDocument) (*entities.ElectronicSubmission, error)
+}
@@ -0,0 +1,45 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// InvoiceResolutionRepository define las operaciones de las resoluciones de numeración
+type InvoiceResolutionRepository interface {
+	// Create retorna entities.ErrInvoiceResolutionOverlap si el rango se cruza con otro del mismo prefijo
+	Create(ctx context.Context, resolution *entities.InvoiceResolution) error
+	Update(ctx context.Context, resolution *entities.InvoiceResolution) error
+	GetByID(ctx context.Context, id uint) (*entities.InvoiceResolution, error)
+
+	// List retorna las resoluciones del tipo indicado (todas si es vacío), las más recientes primero
+	List(ctx context.Context, documentType entities.ElectronicDocumentType) ([]entities.InvoiceResolution, error)
+}
+
+// ElectronicDocumentRepository define las operaciones de las facturas y notas crédito electrónicas
+type ElectronicDocumentRepository interface {
+	// CreateNumbered bloquea la resolución vigente del tipo de documento, asigna el siguiente consecutivo
+	// y guarda el documento con sus líneas en una sola transacción. prepare recibe la resolución con el
+	// número ya asignado al documento para calcular el CUFE y firmar el XML; si falla no se consume el número
+	// Una factura bloquea además su orden y retorna entities.ErrOrderAlreadyInvoiced si la orden ya tiene
+	// una factura que no fue anulada por completo con notas crédito
+	// Retorna entities.ErrNoActiveInvoiceResolution si no hay resolución vigente con números disponibles
+	CreateNumbered(ctx context.Context, document *entities.ElectronicDocument, prepare func(resolution *entities.InvoiceResolution) error) error
+
+	// UpdateSubmission guarda el estado, la respuesta del proveedor y la fecha de envío
+	UpdateSubmission(ctx context.Context, document *entities.ElectronicDocument) error
+
+	// GetByID retorna el documento con sus líneas
+	GetByID(ctx context.Context, id uint) (*entities.ElectronicDocument, error)
+
+	// GetLastInvoiceByOrder retorna la factura más reciente de la orden que no fue rechazada
+	// Retorna gorm.ErrRecordNotFound si la orden no se ha facturado
+	GetLastInvoiceByOrder(ctx context.Context, orderID uint) (*entities.ElectronicDocument, error)
+
+	// ListCreditNotes retorna las notas crédito de la factura que no fueron rechazadas, con sus líneas
+	ListCreditNotes(ctx context.Context, invoiceID uint) ([]entities.ElectronicDocument, error)
+
+	// List filtra por type, status, order_id, customer_id, start_date y end_date (sin líneas ni XML), del más reciente al más antiguo
+	List(ctx context.Context, filters map[string]interface{}) ([]entities.ElectronicDocument, error)
+}
@@ -0,0 +1,56 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// LedgerAccountRepository define las operaciones del plan de cuentas
+type LedgerAccountRepository interface {
+	// EnsureDefaults crea las cuentas que falten por código (no modifica las existentes)
+	EnsureDefaults(ctx context.Context, accounts []entities.LedgerAccount) error
+	Create(ctx context.Context, account *entities.LedgerAccount) error
+	Update(ctx context.Context, account *entities.LedgerAccount) error
+	GetByID(ctx context.Context, id uint) (*entities.LedgerAccount, error)
+
+	// GetByCode y GetByPaymentMethod retornan gorm.ErrRecordNotFound si la cuenta no existe
+	GetByCode(ctx context.Context, code string) (*entities.LedgerAccount, error)
+	GetByPaymentMethod(ctx context.Context, paymentMethodID uint) (*entities.LedgerAccount, error)
+
+	// List retorna las cuentas ordenadas por código
+	List(ctx context.Context, activeOnly bool) ([]entities.LedgerAccount, error)
+}
+
+// JournalRepository define las operaciones del libro diario
+type JournalRepository interface {
+	// Create guarda el asiento con sus líneas. Un registro de origen solo puede tener un asiento vigente
+	Create(ctx context.Context, entry *entities.JournalEntry) error
+
+	// Reverse guarda el reverso, marca el original como anulado y, si replacement no es nil,
+	// guarda el asiento que lo reemplaza, todo en una sola transacción
+	// Retorna entities.ErrJournalEntryAlreadyReversed si el original ya estaba anulado
+	Reverse(ctx context.Context, originalID uint, reversal, replacement *entities.JournalEntry) error
+
+	// GetByID retorna el asiento con sus líneas y cuentas
+	GetByID(ctx context.Context, id uint) (*entities.JournalEntry, error)
+
+	// GetActiveBySource retorna el asiento vigente (no anulado ni reverso) del registro de origen
+	// Retorna gorm.ErrRecordNotFound si el registro no se ha contabilizado
+	GetActiveBySource(ctx context.Context, sourceType entities.JournalSourceType, sourceID uint) (*entities.JournalEntry, error)
+
+	// List filtra por start_date, end_date, source_type, source_id y account_id, del más reciente al más antiguo
+	List(ctx context.Context, filters map[string]interface{}) ([]entities.JournalEntry, error)
+
+	// SumByAccount retorna los débitos y créditos de cada cuenta con fecha entre from (inclusive) y to (exclusive)
+	// Con from en cero suma desde el primer asiento y con to en cero hasta el último
+	SumByAccount(ctx context.Context, from, to time.Time) (map[uint]entities.LedgerTotals, error)
+
+	// Registros de origen que todavía no tienen asiento
+	ListUnpostedSales(ctx context.Context) ([]entities.Order, error)
+	ListUnpostedCustomerTransactions(ctx context.Context) ([]entities.CustomerTransaction, error)
+	ListUnpostedFinancialTransactions(ctx context.Context) ([]entities.FinancialTransaction, error)
+	ListUnpostedTransfers(ctx context.Context) ([]entities.AccountTransfer, error)
+	ListUnpostedOpeningBalances(ctx context.Context) ([]entities.PaymentMethodOption, error)
+}
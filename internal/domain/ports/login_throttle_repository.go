@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// LoginThrottleRepository define las operaciones para el control de intentos de login
+type LoginThrottleRepository interface {
+	// Get obtiene el contador de la cuenta o IP; retorna nil si no tiene intentos fallidos
+	Get(ctx context.Context, scope entities.LoginThrottleScope, key string) (*entities.LoginThrottle, error)
+
+	// RegisterFailure suma un intento fallido de forma atómica y retorna el contador actualizado
+	// y si este intento activó un bloqueo
+	RegisterFailure(ctx context.Context, scope entities.LoginThrottleScope, key string, policy entities.LockoutPolicy, at time.Time) (*entities.LoginThrottle, bool, error)
+
+	// Reset borra el contador (ej: después de un login exitoso)
+	Reset(ctx context.Context, scope entities.LoginThrottleScope, key string) error
+}
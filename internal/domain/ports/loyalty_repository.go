@@ -0,0 +1,38 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// LoyaltyRuleRepository define las operaciones de persistencia para reglas de puntos
+type LoyaltyRuleRepository interface {
+	Create(ctx context.Context, rule *entities.LoyaltyRule) error
+	GetByID(ctx context.Context, id uint) (*entities.LoyaltyRule, error)
+	List(ctx context.Context, activeOnly bool) ([]entities.LoyaltyRule, error)
+	Update(ctx context.Context, rule *entities.LoyaltyRule) error
+	Delete(ctx context.Context, id uint) error
+}
+
+// LoyaltyPointsRepository define las operaciones sobre el libro de puntos de clientes
+type LoyaltyPointsRepository interface {
+	// Earn registra puntos ganados (Remaining = Points)
+	Earn(ctx context.Context, entry *entities.LoyaltyPointsEntry) error
+
+	// Redeem descuenta puntos consumiendo primero los que vencen antes (FIFO)
+	// Retorna ErrInsufficientPoints si el saldo no alcanza
+	Redeem(ctx context.Context, customerID uint, orderID *uint, points int, description string) error
+
+	// ExpirePoints vence los puntos con ExpiresAt anterior a la fecha dada
+	// Si customerID es nil se procesan todos los clientes. Retorna los puntos vencidos.
+	ExpirePoints(ctx context.Context, customerID *uint, at time.Time) (int, error)
+
+	GetBalance(ctx context.Context, customerID uint) (int, error)
+	GetExpiringPoints(ctx context.Context, customerID uint, before time.Time) (int, error)
+	ListByCustomer(ctx context.Context, customerID uint) ([]entities.LoyaltyPointsEntry, error)
+
+	// ExistsForOrder verifica si ya existe un movimiento del tipo dado para la orden
+	ExistsForOrder(ctx context.Context, orderID uint, entryType entities.LoyaltyEntryType) (bool, error)
+}
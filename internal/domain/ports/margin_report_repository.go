@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// MarginReportRepository calcula venta, descuento y costo a partir de los snapshots de los items
+type MarginReportRepository interface {
+	// GetMargins retorna los grupos del reporte: por orden y por periodo en orden cronológico,
+	// por producto de mayor a menor venta
+	GetMargins(ctx context.Context, filters entities.MarginReportFilters) ([]entities.MarginRow, error)
+
+	// CountOrders retorna cuántas órdenes distintas entran en el reporte
+	CountOrders(ctx context.Context, filters entities.MarginReportFilters) (int, error)
+}
@@ -0,0 +1,8 @@
+package ports
+
+import "context"
+
+// MessageSender define el envío de mensajes a clientes (WhatsApp, SMS, log, etc.)
+type MessageSender interface {
+	Send(ctx context.Context, to, message string) error
+}
@@ -0,0 +1,15 @@
+package ports
+
+import "context"
+
+// Notification es un mensaje dirigido a un usuario del back office
+type Notification struct {
+	To      string // Destinatario según el canal (email, teléfono...)
+	Subject string
+	Body    string
+}
+
+// Notifier define el envío de notificaciones a usuarios (email, WhatsApp, log, etc.)
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
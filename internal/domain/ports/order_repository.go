@@ -13,5 +13,7 @@ type OrderRepository interface {
 	List(ctx context.Context, filters map[string]interface{}) ([]entities.Order, error)
 	Update(ctx context.Context, order *entities.Order) error
 	UpdateStatus(ctx context.Context, id uint, status entities.OrderStatus) error
+	// UpdateSeller reasigna la orden a otro vendedor (retorna gorm.ErrRecordNotFound si no existe)
+	UpdateSeller(ctx context.Context, id uint, sellerID uint) error
 	Delete(ctx context.Context, id uint) error
 }
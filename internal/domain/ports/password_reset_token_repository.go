@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// PasswordResetTokenRepository define las operaciones para tokens de recuperación de contraseña
+type PasswordResetTokenRepository interface {
+	Create(ctx context.Context, token *entities.PasswordResetToken) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entities.PasswordResetToken, error)
+
+	// MarkUsed marca el token como usado solo si aún no lo estaba
+	// Retorna ErrInvalidResetToken si otro proceso lo usó primero
+	MarkUsed(ctx context.Context, id uint) error
+
+	// InvalidatePending marca como usados los tokens pendientes del usuario (al emitir uno nuevo o tras restablecer)
+	InvalidatePending(ctx context.Context, userID uint) error
+}
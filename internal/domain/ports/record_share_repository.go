@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// RecordShareRepository define las operaciones para compartir órdenes y clientes entre usuarios
+type RecordShareRepository interface {
+	// Share es idempotente: compartir dos veces con el mismo usuario no crea duplicados
+	Share(ctx context.Context, share *entities.RecordShare) error
+
+	// Unshare retorna ErrRecordShareNotFound si el registro no estaba compartido con el usuario
+	Unshare(ctx context.Context, resourceType entities.ShareResourceType, resourceID, userID uint) error
+	ListByResource(ctx context.Context, resourceType entities.ShareResourceType, resourceID uint) ([]entities.RecordShare, error)
+	IsShared(ctx context.Context, resourceType entities.ShareResourceType, resourceID, userID uint) (bool, error)
+
+	// ListSharedIDs retorna los IDs de los registros del tipo dado compartidos con el usuario
+	ListSharedIDs(ctx context.Context, resourceType entities.ShareResourceType, userID uint) ([]uint, error)
+}
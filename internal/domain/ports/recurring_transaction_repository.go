@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// RecurringTransactionRepository define las operaciones para las plantillas de transacciones recurrentes
+type RecurringTransactionRepository interface {
+	Create(ctx context.Context, recurring *entities.RecurringTransaction) error
+	GetByID(ctx context.Context, id uint) (*entities.RecurringTransaction, error)
+	List(ctx context.Context, activeOnly bool) ([]entities.RecurringTransaction, error)
+	Update(ctx context.Context, recurring *entities.RecurringTransaction) error
+	Delete(ctx context.Context, id uint) error
+
+	// ListDue retorna las plantillas activas con una ocurrencia pendiente hasta today
+	ListDue(ctx context.Context, today time.Time) ([]entities.RecurringTransaction, error)
+
+	// Generate crea la transacción de la ocurrencia y avanza NextRunDate en una sola transacción.
+	// Si otro proceso ya la generó (NextRunDate cambió) retorna ErrRecurringAlreadyGenerated
+	Generate(ctx context.Context, recurring *entities.RecurringTransaction, transaction *entities.FinancialTransaction, nextRunDate time.Time) error
+}
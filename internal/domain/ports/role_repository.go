@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// RoleRepository define las operaciones para roles y sus permisos
+type RoleRepository interface {
+	// EnsureDefaults crea los roles que no existan; no modifica los que ya están
+	EnsureDefaults(ctx context.Context, roles []entities.Role) error
+
+	Create(ctx context.Context, role *entities.Role) error
+
+	// GetByName retorna ErrRoleNotFound si el rol no existe
+	GetByName(ctx context.Context, name entities.UserRole) (*entities.Role, error)
+	List(ctx context.Context) ([]entities.Role, error)
+	Update(ctx context.Context, role *entities.Role) error
+
+	// Delete elimina el rol solo si ningún usuario lo tiene asignado (retorna ErrRoleInUse)
+	Delete(ctx context.Context, name entities.UserRole) error
+}
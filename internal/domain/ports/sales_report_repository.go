@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// SalesReportRepository agrupa las ventas a partir de los snapshots de los items de las órdenes
+type SalesReportRepository interface {
+	// GetSales retorna las ventas del periodo [start, end] agrupadas por la dimensión de los filtros,
+	// ordenadas de mayor a menor venta. Las fechas de los filtros se ignoran: el periodo va aparte
+	// para poder consultar también el periodo de comparación
+	GetSales(ctx context.Context, filters entities.SalesReportFilters, start, end time.Time) ([]entities.SalesRow, error)
+}
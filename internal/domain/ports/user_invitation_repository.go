@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// UserInvitationRepository define las operaciones para invitaciones de usuario
+type UserInvitationRepository interface {
+	Create(ctx context.Context, invitation *entities.UserInvitation) error
+	GetByID(ctx context.Context, id uint) (*entities.UserInvitation, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entities.UserInvitation, error)
+
+	// GetPendingByEmail obtiene la invitación vigente (no aceptada, no revocada, no vencida) del email
+	GetPendingByEmail(ctx context.Context, email string) (*entities.UserInvitation, error)
+
+	List(ctx context.Context, filters map[string]interface{}) ([]entities.UserInvitation, error)
+
+	// Accept crea el usuario con los permisos de la invitación y la marca como aceptada en una sola transacción
+	// Retorna ErrInvitationAlreadyClosed si otro proceso la cerró primero
+	Accept(ctx context.Context, invitation *entities.UserInvitation, user *entities.User) error
+
+	// Revoke revoca la invitación solo si sigue abierta
+	Revoke(ctx context.Context, id uint) error
+}
@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// UserSessionRepository define las operaciones para sesiones de usuario
+type UserSessionRepository interface {
+	Create(ctx context.Context, session *entities.UserSession) error
+	GetByID(ctx context.Context, id uint) (*entities.UserSession, error)
+
+	// GetByTokenHash busca la sesión cuyo refresh token vigente o anterior coincide con el hash
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entities.UserSession, error)
+
+	// Rotate reemplaza el refresh token solo si el vigente sigue siendo currentHash
+	// Retorna ErrInvalidRefreshToken si otra petición lo rotó primero
+	Rotate(ctx context.Context, id uint, currentHash, newHash string, expiresAt time.Time) error
+
+	ListActiveByUser(ctx context.Context, userID uint) ([]entities.UserSession, error)
+	Revoke(ctx context.Context, id uint, reason string) error
+	RevokeAllByUser(ctx context.Context, userID uint, reason string) error
+}
@@ -0,0 +1,36 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
+)
+
+// UserTwoFactorRepository define las operaciones para el segundo factor (TOTP y códigos de recuperación)
+type UserTwoFactorRepository interface {
+	// GetByUserID obtiene la configuración del usuario; retorna nil si nunca la inició
+	GetByUserID(ctx context.Context, userID uint) (*entities.UserTwoFactor, error)
+
+	// SavePending guarda un secreto nuevo sin activar (reemplaza uno pendiente anterior)
+	SavePending(ctx context.Context, twoFactor *entities.UserTwoFactor) error
+
+	// Enable activa el segundo factor y reemplaza los códigos de recuperación en una transacción
+	Enable(ctx context.Context, userID uint, step int64, recoveryCodeHashes []string, at time.Time) error
+
+	// Disable elimina el secreto y los códigos de recuperación del usuario
+	Disable(ctx context.Context, userID uint) error
+
+	// MarkStepUsed registra el paso TOTP aceptado solo si es posterior al último usado
+	// Retorna ErrInvalidTwoFactorCode si el código ya se había usado
+	MarkStepUsed(ctx context.Context, userID uint, step int64) error
+
+	// ReplaceRecoveryCodes invalida los códigos anteriores y guarda los nuevos
+	ReplaceRecoveryCodes(ctx context.Context, userID uint, recoveryCodeHashes []string) error
+
+	// UseRecoveryCode marca el código como usado; retorna ErrInvalidTwoFactorCode si no existe o ya se usó
+	UseRecoveryCode(ctx context.Context, userID uint, codeHash string) error
+
+	// CountRecoveryCodes retorna cuántos códigos de recuperación siguen sin usar
+	CountRecoveryCodes(ctx context.Context, userID uint) (int, error)
+}
@@ -0,0 +1,11 @@
+-- Agregar a audit_logs la llave de API y el request ID de la petición que originó el evento
+-- audit_logs no está en AutoMigrate, por eso se agregan las columnas aquí
+
+ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS api_key_id INTEGER;
+ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS request_id VARCHAR(64);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_api_key_id ON audit_logs(api_key_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_request_id ON audit_logs(request_id);
+
+COMMENT ON COLUMN audit_logs.api_key_id IS 'ID de la llave de API con la que se hizo la petición (si aplica)';
+COMMENT ON COLUMN audit_logs.request_id IS 'X-Request-ID de la petición, para cruzar con los logs del servidor';
@@ -0,0 +1,5 @@
+-- Índices para las métricas de /analytics calculadas con consultas
+-- El tiempo de fabricación busca el primer paso de cada orden a MANUFACTURING y a FINISHED
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_new_status_order ON audit_logs(new_status, order_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_orders_status_order_date ON orders(status, order_date);
@@ -0,0 +1,18 @@
+-- Historial de cambios de entidades (productos, clientes, movimientos, usuarios, permisos)
+-- Los registros que no son de órdenes ya no llevan order_id; los que se guardaron con 0 pasan a NULL
+
+ALTER TABLE audit_logs ALTER COLUMN order_id DROP NOT NULL;
+UPDATE audit_logs SET order_id = NULL WHERE order_id = 0;
+
+ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS entity_type VARCHAR(50);
+ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS entity_id INTEGER;
+ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS action VARCHAR(20);
+ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS changes JSONB;
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_entity ON audit_logs(entity_type, entity_id);
+
+COMMENT ON COLUMN audit_logs.order_id IS 'ID de la orden relacionada (NULL en eventos que no son de órdenes)';
+COMMENT ON COLUMN audit_logs.entity_type IS 'Tipo de entidad modificada (ej: PRODUCT, CUSTOMER, USER)';
+COMMENT ON COLUMN audit_logs.entity_id IS 'ID de la entidad modificada';
+COMMENT ON COLUMN audit_logs.action IS 'Acción sobre la entidad: CREATE, UPDATE, DELETE o REVERSE';
+COMMENT ON COLUMN audit_logs.changes IS 'Campos modificados: {"campo": {"before": x, "after": y}}';
@@ -0,0 +1,16 @@
+-- Agregar columna unit_cost a order_items
+-- Snapshot del costo de producción unitario al momento de la venta, base del reporte de márgenes
+
+ALTER TABLE order_items
+ADD COLUMN IF NOT EXISTS unit_cost DECIMAL NOT NULL DEFAULT 0;
+
+-- Las ventas anteriores no tienen snapshot: se aproximan con el costo vigente del producto.
+-- Los items sin producto base (variantes aún no creadas) quedan en 0 y el reporte los marca sin costo
+UPDATE order_items oi
+SET unit_cost = p.production_cost
+FROM product_variants pv
+JOIN products p ON p.id = pv.product_id
+WHERE pv.id = oi.product_variant_id
+  AND oi.unit_cost = 0;
+
+COMMENT ON COLUMN order_items.unit_cost IS 'Snapshot del costo de producción unitario al momento de crear el item';
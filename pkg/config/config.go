@@ -2,10 +2,13 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/bryanarroyaveortiz/fashion-blue/internal/domain/entities"
 	"github.com/joho/godotenv"
 )
 
@@ -18,14 +21,52 @@ type Config struct {
 	Cloudinary CloudinaryConfig
 	CORS       CORSConfig
 	Log        LogConfig
+	Loyalty    LoyaltyConfig
+	Portal     PortalConfig
+	Auth       AuthConfig
+	Notifier   NotifierConfig
+	TwoFactor  TwoFactorConfig
+	Finance    FinanceConfig
+	Invoicing  InvoicingConfig
 }
 
 // AppConfig configuración de la aplicación
 type AppConfig struct {
-	Name string
-	Env  string
-	Port string
-	Host string
+	Name           string
+	Env            string
+	Port           string
+	Host           string
+	TrustedProxies string // IPs o rangos CIDR separados por coma de los proxies que pueden enviar X-Forwarded-For
+}
+
+// GetTrustedProxies convierte los proxies de confianza a rangos (una IP sola equivale a /32 o /128)
+func (a *AppConfig) GetTrustedProxies() ([]*net.IPNet, error) {
+	var ranges []*net.IPNet
+	for _, value := range strings.Split(a.TrustedProxies, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if !strings.Contains(value, "/") {
+			ip := net.ParseIP(value)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", value)
+			}
+			bits := 128
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 32
+			}
+			ranges = append(ranges, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipRange, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy range %q: %w", value, err)
+		}
+		ranges = append(ranges, ipRange)
+	}
+	return ranges, nil
 }
 
 // DatabaseConfig configuración de la base de datos
@@ -41,17 +82,19 @@ type DatabaseConfig struct {
 
 // JWTConfig configuración de JWT
 type JWTConfig struct {
-	Secret     string
-	Expiration string
+	Secret            string
+	Expiration        string // Vigencia del access token (corta)
+	RefreshExpiration string // Vigencia de la sesión / refresh token
 }
 
 // GetExpiration convierte la expiración de string a time.Duration
 func (j *JWTConfig) GetExpiration() time.Duration {
-	duration, err := time.ParseDuration(j.Expiration)
-	if err != nil {
-		return 24 * time.Hour // Default 24 horas
-	}
-	return duration
+	return parseDurationOr(j.Expiration, 15*time.Minute)
+}
+
+// GetRefreshExpiration convierte la vigencia del refresh token a time.Duration
+func (j *JWTConfig) GetRefreshExpiration() time.Duration {
+	return parseDurationOr(j.RefreshExpiration, 30*24*time.Hour)
 }
 
 // UploadConfig configuración de uploads
@@ -80,19 +123,233 @@ type LogConfig struct {
 	Format string
 }
 
+// LoyaltyConfig configuración del programa de puntos
+type LoyaltyConfig struct {
+	PointValue       float64 // Valor en pesos de cada punto al redimir
+	ExpirationMonths int     // Meses de vigencia de los puntos ganados (0 = no vencen)
+}
+
+// PortalConfig configuración del portal de clientes
+type PortalConfig struct {
+	URL               string // URL del frontend del portal (el enlace agrega ?token=...)
+	LinkExpiration    string // Vigencia del enlace mágico
+	SessionExpiration string // Vigencia de la sesión del cliente
+	LinkMaxRequests   int    // Enlaces que se pueden pedir para un teléfono antes de bloquear
+	LinkIPMaxRequests int    // Enlaces que se pueden pedir desde una IP antes de bloquear
+	LinkLockout       string // Duración del primer bloqueo (se duplica en cada bloqueo siguiente)
+}
+
+// GetLinkExpiration convierte la vigencia del enlace a time.Duration
+func (p *PortalConfig) GetLinkExpiration() time.Duration {
+	return parseDurationOr(p.LinkExpiration, 15*time.Minute)
+}
+
+// GetSessionExpiration convierte la vigencia de la sesión a time.Duration
+func (p *PortalConfig) GetSessionExpiration() time.Duration {
+	return parseDurationOr(p.SessionExpiration, 24*time.Hour)
+}
+
+// GetPhoneLinkPolicy retorna el límite de enlaces pedidos para un mismo teléfono
+func (p *PortalConfig) GetPhoneLinkPolicy() entities.LockoutPolicy {
+	return p.linkPolicy(p.LinkMaxRequests)
+}
+
+// GetIPLinkPolicy retorna el límite de enlaces pedidos desde una misma IP
+func (p *PortalConfig) GetIPLinkPolicy() entities.LockoutPolicy {
+	return p.linkPolicy(p.LinkIPMaxRequests)
+}
+
+func (p *PortalConfig) linkPolicy(maxRequests int) entities.LockoutPolicy {
+	return entities.LockoutPolicy{
+		MaxAttempts: maxRequests,
+		BaseLockout: parseDurationOr(p.LinkLockout, 15*time.Minute),
+		MaxLockout:  24 * time.Hour,
+		ResetAfter:  24 * time.Hour,
+	}
+}
+
+// AuthConfig configuración del alta de usuarios del back office
+type AuthConfig struct {
+	AllowPublicRegistration bool   // Habilita /auth/register (por defecto deshabilitado; usar invitaciones)
+	InvitationURL           string // URL del frontend para aceptar invitaciones (el enlace agrega ?token=...)
+	InvitationExpiration    string // Vigencia del enlace de invitación
+
+	LoginMaxAttempts      int    // Intentos fallidos por cuenta antes de bloquear
+	LoginIPMaxAttempts    int    // Intentos fallidos por IP antes de bloquear
+	LoginLockoutBase      string // Duración del primer bloqueo (se duplica en cada bloqueo siguiente)
+	LoginLockoutMax       string // Duración máxima de un bloqueo
+	LoginAttemptReset     string // Tiempo sin fallos tras el cual se reinicia el contador
+	PasswordMinLength     int
+	PasswordRequireUpper  bool
+	PasswordRequireLower  bool
+	PasswordRequireDigit  bool
+	PasswordRequireSymbol bool
+
+	PasswordResetURL           string // URL del frontend para restablecer la contraseña (el enlace agrega ?token=...)
+	PasswordResetExpiration    string // Vigencia del enlace de recuperación
+	PasswordResetMaxRequests   int    // Recuperaciones que se pueden pedir para un email antes de bloquear
+	PasswordResetIPMaxRequests int    // Recuperaciones que se pueden pedir desde una IP antes de bloquear
+}
+
+// GetPasswordResetExpiration convierte la vigencia del enlace de recuperación a time.Duration
+func (a *AuthConfig) GetPasswordResetExpiration() time.Duration {
+	return parseDurationOr(a.PasswordResetExpiration, 30*time.Minute)
+}
+
+// TwoFactorConfig configuración del segundo factor (TOTP)
+type TwoFactorConfig struct {
+	RequiredForSuperAdmin bool   // Obliga a los SUPER_ADMIN a enrolarse antes de entrar
+	Issuer                string // Nombre que muestra la app autenticadora
+	EncryptionKey         string // Clave para cifrar los secretos TOTP (si está vacía se usa JWT_SECRET)
+	ChallengeExpiration   string // Vigencia del token del segundo paso del login
+}
+
+// GetChallengeExpiration convierte la vigencia del segundo paso a time.Duration
+func (t *TwoFactorConfig) GetChallengeExpiration() time.Duration {
+	return parseDurationOr(t.ChallengeExpiration, 5*time.Minute)
+}
+
+// NotifierConfig configuración del envío de notificaciones a usuarios del back office
+type NotifierConfig struct {
+	Channel      string // "log" (por defecto) o "email"
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	SMTPFrom     string
+}
+
+// FinanceConfig configuración de los procesos automáticos de finanzas
+type FinanceConfig struct {
+	BudgetAlertRecipients string // Correos separados por coma que reciben las alertas de presupuesto excedido
+}
+
+// GetBudgetAlertRecipients retorna la lista de destinatarios de las alertas de presupuesto
+func (f *FinanceConfig) GetBudgetAlertRecipients() []string {
+	recipients := []string{}
+	for _, recipient := range strings.Split(f.BudgetAlertRecipients, ",") {
+		if recipient = strings.TrimSpace(recipient); recipient != "" {
+			recipients = append(recipients, recipient)
+		}
+	}
+	return recipients
+}
+
+// InvoicingConfig configuración de la facturación electrónica (DIAN)
+type InvoicingConfig struct {
+	IssuerName           string
+	IssuerNIT            string // Sin dígito de verificación
+	IssuerPersonType     string // 1 persona jurídica, 2 persona natural
+	IssuerTaxLevel       string // Responsabilidades fiscales del RUT (ej. R-99-PN)
+	IssuerIVAResponsible bool
+	IssuerAddress        string
+	IssuerCity           string
+	IssuerCityCode       string // Código DANE del municipio
+	IssuerDepartment     string
+	IssuerDepartmentCode string
+	IssuerEmail          string
+	IssuerPhone          string
+	BankAccount          string // Cuenta que se imprime en la cuenta de cobro
+	Environment          string // 1 producción, 2 habilitación (pruebas)
+	SoftwareID           string
+	SoftwarePIN          string
+	SoftwareProviderNIT  string
+	IVARate              float64
+	PricesIncludeIVA     bool
+	CertificatePath      string // Certificado de firma en PEM (vacío = autofirmado de pruebas)
+	PrivateKeyPath       string
+	Provider             string // "test" (por defecto, no envía a la DIAN)
+}
+
+// GetIssuer retorna los datos del facturador
+func (i *InvoicingConfig) GetIssuer() entities.InvoiceIssuer {
+	return entities.InvoiceIssuer{
+		Name:           i.IssuerName,
+		NIT:            i.IssuerNIT,
+		PersonType:     i.IssuerPersonType,
+		TaxLevelCode:   i.IssuerTaxLevel,
+		IVAResponsible: i.IssuerIVAResponsible,
+		Address:        i.IssuerAddress,
+		City:           i.IssuerCity,
+		CityCode:       i.IssuerCityCode,
+		Department:     i.IssuerDepartment,
+		DepartmentCode: i.IssuerDepartmentCode,
+		Email:          i.IssuerEmail,
+		Phone:          i.IssuerPhone,
+		BankAccount:    i.BankAccount,
+	}
+}
+
+// GetInvitationExpiration convierte la vigencia de la invitación a time.Duration
+func (a *AuthConfig) GetInvitationExpiration() time.Duration {
+	return parseDurationOr(a.InvitationExpiration, 72*time.Hour)
+}
+
+// GetAccountLockoutPolicy retorna la política de bloqueo por cuenta
+func (a *AuthConfig) GetAccountLockoutPolicy() entities.LockoutPolicy {
+	return a.lockoutPolicy(a.LoginMaxAttempts)
+}
+
+// GetIPLockoutPolicy retorna la política de bloqueo por dirección IP
+func (a *AuthConfig) GetIPLockoutPolicy() entities.LockoutPolicy {
+	return a.lockoutPolicy(a.LoginIPMaxAttempts)
+}
+
+// GetPasswordResetPolicy retorna el límite de recuperaciones pedidas para un mismo email
+func (a *AuthConfig) GetPasswordResetPolicy() entities.LockoutPolicy {
+	return a.lockoutPolicy(a.PasswordResetMaxRequests)
+}
+
+// GetPasswordResetIPPolicy retorna el límite de recuperaciones pedidas desde una misma IP
+func (a *AuthConfig) GetPasswordResetIPPolicy() entities.LockoutPolicy {
+	return a.lockoutPolicy(a.PasswordResetIPMaxRequests)
+}
+
+func (a *AuthConfig) lockoutPolicy(maxAttempts int) entities.LockoutPolicy {
+	return entities.LockoutPolicy{
+		MaxAttempts: maxAttempts,
+		BaseLockout: parseDurationOr(a.LoginLockoutBase, time.Minute),
+		MaxLockout:  parseDurationOr(a.LoginLockoutMax, time.Hour),
+		ResetAfter:  parseDurationOr(a.LoginAttemptReset, 24*time.Hour),
+	}
+}
+
+// GetPasswordPolicy retorna la política de contraseñas configurada
+func (a *AuthConfig) GetPasswordPolicy() entities.PasswordPolicy {
+	return entities.PasswordPolicy{
+		MinLength:     a.PasswordMinLength,
+		RequireUpper:  a.PasswordRequireUpper,
+		RequireLower:  a.PasswordRequireLower,
+		RequireDigit:  a.PasswordRequireDigit,
+		RequireSymbol: a.PasswordRequireSymbol,
+	}
+}
+
 // Load carga la configuración desde variables de entorno
 func Load() (*Config, error) {
 	// Cargar archivo .env si existe
 	_ = godotenv.Load()
 
 	maxUploadSize, _ := strconv.ParseInt(getEnv("MAX_UPLOAD_SIZE", "10485760"), 10, 64)
+	loyaltyPointValue, _ := strconv.ParseFloat(getEnv("LOYALTY_POINT_VALUE", "10"), 64)
+	loyaltyExpirationMonths, _ := strconv.Atoi(getEnv("LOYALTY_EXPIRATION_MONTHS", "12"))
+	allowPublicRegistration, _ := strconv.ParseBool(getEnv("AUTH_ALLOW_PUBLIC_REGISTRATION", "false"))
+	loginMaxAttempts, _ := strconv.Atoi(getEnv("LOGIN_MAX_ATTEMPTS", "5"))
+	loginIPMaxAttempts, _ := strconv.Atoi(getEnv("LOGIN_IP_MAX_ATTEMPTS", "20"))
+	passwordResetMaxRequests, _ := strconv.Atoi(getEnv("PASSWORD_RESET_MAX_REQUESTS", "3"))
+	passwordResetIPMaxRequests, _ := strconv.Atoi(getEnv("PASSWORD_RESET_IP_MAX_REQUESTS", "20"))
+	portalLinkMaxRequests, _ := strconv.Atoi(getEnv("PORTAL_LINK_MAX_REQUESTS", "3"))
+	portalLinkIPMaxRequests, _ := strconv.Atoi(getEnv("PORTAL_LINK_IP_MAX_REQUESTS", "20"))
+	passwordMinLength, _ := strconv.Atoi(getEnv("PASSWORD_MIN_LENGTH", "8"))
+	invoiceIVARate, _ := strconv.ParseFloat(getEnv("INVOICE_IVA_RATE", "19"), 64)
 
 	config := &Config{
 		App: AppConfig{
-			Name: getEnv("APP_NAME", "fashion-blue"),
-			Env:  getEnv("APP_ENV", "development"),
-			Port: getEnv("APP_PORT", "8080"),
-			Host: getEnv("APP_HOST", "0.0.0.0"),
+			Name:           getEnv("APP_NAME", "fashion-blue"),
+			Env:            getEnv("APP_ENV", "development"),
+			Port:           getEnv("APP_PORT", "8080"),
+			Host:           getEnv("APP_HOST", "0.0.0.0"),
+			TrustedProxies: getEnv("APP_TRUSTED_PROXIES", ""),
 		},
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
@@ -104,8 +361,9 @@ func Load() (*Config, error) {
 			DSN:      getEnv("DB_DSN", ""),
 		},
 		JWT: JWTConfig{
-			Secret:     getEnv("JWT_SECRET", "your-super-secret-jwt-key"),
-			Expiration: getEnv("JWT_EXPIRATION", "24h"),
+			Secret:            getEnv("JWT_SECRET", "your-super-secret-jwt-key"),
+			Expiration:        getEnv("JWT_EXPIRATION", "15m"),
+			RefreshExpiration: getEnv("JWT_REFRESH_EXPIRATION", "720h"),
 		},
 		Upload: UploadConfig{
 			MaxSize: maxUploadSize,
@@ -125,6 +383,78 @@ func Load() (*Config, error) {
 			Level:  getEnv("LOG_LEVEL", "debug"),
 			Format: getEnv("LOG_FORMAT", "json"),
 		},
+		Loyalty: LoyaltyConfig{
+			PointValue:       loyaltyPointValue,
+			ExpirationMonths: loyaltyExpirationMonths,
+		},
+		Portal: PortalConfig{
+			URL:               getEnv("PORTAL_URL", "http://localhost:3000/portal/login"),
+			LinkExpiration:    getEnv("PORTAL_LINK_EXPIRATION", "15m"),
+			SessionExpiration: getEnv("PORTAL_SESSION_EXPIRATION", "24h"),
+			LinkMaxRequests:   portalLinkMaxRequests,
+			LinkIPMaxRequests: portalLinkIPMaxRequests,
+			LinkLockout:       getEnv("PORTAL_LINK_LOCKOUT", "15m"),
+		},
+		Auth: AuthConfig{
+			AllowPublicRegistration:    allowPublicRegistration,
+			InvitationURL:              getEnv("INVITATION_URL", "http://localhost:3000/accept-invitation"),
+			InvitationExpiration:       getEnv("INVITATION_EXPIRATION", "72h"),
+			LoginMaxAttempts:           loginMaxAttempts,
+			LoginIPMaxAttempts:         loginIPMaxAttempts,
+			LoginLockoutBase:           getEnv("LOGIN_LOCKOUT_BASE", "1m"),
+			LoginLockoutMax:            getEnv("LOGIN_LOCKOUT_MAX", "1h"),
+			LoginAttemptReset:          getEnv("LOGIN_ATTEMPT_RESET", "24h"),
+			PasswordMinLength:          passwordMinLength,
+			PasswordRequireUpper:       getEnv("PASSWORD_REQUIRE_UPPER", "true") == "true",
+			PasswordRequireLower:       getEnv("PASSWORD_REQUIRE_LOWER", "true") == "true",
+			PasswordRequireDigit:       getEnv("PASSWORD_REQUIRE_DIGIT", "true") == "true",
+			PasswordRequireSymbol:      getEnv("PASSWORD_REQUIRE_SYMBOL", "false") == "true",
+			PasswordResetURL:           getEnv("PASSWORD_RESET_URL", "http://localhost:3000/reset-password"),
+			PasswordResetExpiration:    getEnv("PASSWORD_RESET_EXPIRATION", "30m"),
+			PasswordResetMaxRequests:   passwordResetMaxRequests,
+			PasswordResetIPMaxRequests: passwordResetIPMaxRequests,
+		},
+		Notifier: NotifierConfig{
+			Channel:      getEnv("NOTIFIER_CHANNEL", "log"),
+			SMTPHost:     getEnv("SMTP_HOST", ""),
+			SMTPPort:     getEnv("SMTP_PORT", "587"),
+			SMTPUsername: getEnv("SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+			SMTPFrom:     getEnv("SMTP_FROM", "Fashion Blue <no-reply@fashionblue.co>"),
+		},
+		TwoFactor: TwoFactorConfig{
+			RequiredForSuperAdmin: getEnv("TWO_FACTOR_REQUIRED_FOR_SUPER_ADMIN", "false") == "true",
+			Issuer:                getEnv("TWO_FACTOR_ISSUER", "Fashion Blue"),
+			EncryptionKey:         getEnv("TWO_FACTOR_ENCRYPTION_KEY", ""),
+			ChallengeExpiration:   getEnv("TWO_FACTOR_CHALLENGE_EXPIRATION", "5m"),
+		},
+		Finance: FinanceConfig{
+			BudgetAlertRecipients: getEnv("FINANCE_ALERT_RECIPIENTS", ""),
+		},
+		Invoicing: InvoicingConfig{
+			IssuerName:           getEnv("INVOICE_ISSUER_NAME", "SONIA PATRICIA ORTIZ"),
+			IssuerNIT:            getEnv("INVOICE_ISSUER_NIT", "30323685"),
+			IssuerPersonType:     getEnv("INVOICE_ISSUER_PERSON_TYPE", "2"),
+			IssuerTaxLevel:       getEnv("INVOICE_ISSUER_TAX_LEVEL", "R-99-PN"),
+			IssuerIVAResponsible: getEnv("INVOICE_ISSUER_IVA_RESPONSIBLE", "false") == "true",
+			IssuerAddress:        getEnv("INVOICE_ISSUER_ADDRESS", ""),
+			IssuerCity:           getEnv("INVOICE_ISSUER_CITY", "MANIZALES"),
+			IssuerCityCode:       getEnv("INVOICE_ISSUER_CITY_CODE", "17001"),
+			IssuerDepartment:     getEnv("INVOICE_ISSUER_DEPARTMENT", "Caldas"),
+			IssuerDepartmentCode: getEnv("INVOICE_ISSUER_DEPARTMENT_CODE", "17"),
+			IssuerEmail:          getEnv("INVOICE_ISSUER_EMAIL", ""),
+			IssuerPhone:          getEnv("INVOICE_ISSUER_PHONE", ""),
+			BankAccount:          getEnv("INVOICE_BANK_ACCOUNT", "3122684372"),
+			Environment:          getEnv("INVOICE_ENVIRONMENT", entities.DianEnvironmentTesting),
+			SoftwareID:           getEnv("INVOICE_SOFTWARE_ID", ""),
+			SoftwarePIN:          getEnv("INVOICE_SOFTWARE_PIN", ""),
+			SoftwareProviderNIT:  getEnv("INVOICE_SOFTWARE_PROVIDER_NIT", ""),
+			IVARate:              invoiceIVARate,
+			PricesIncludeIVA:     getEnv("INVOICE_PRICES_INCLUDE_IVA", "true") == "true",
+			CertificatePath:      getEnv("INVOICE_CERTIFICATE_PATH", ""),
+			PrivateKeyPath:       getEnv("INVOICE_PRIVATE_KEY_PATH", ""),
+			Provider:             getEnv("INVOICE_PROVIDER", "test"),
+		},
 	}
 
 	return config, nil
@@ -158,6 +488,15 @@ func (c *Config) IsProduction() bool {
 	return c.App.Env == "production"
 }
 
+// parseDurationOr convierte una duración o retorna el valor por defecto si es inválida
+func parseDurationOr(value string, fallback time.Duration) time.Duration {
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return fallback
+	}
+	return duration
+}
+
 // getEnv obtiene una variable de entorno o retorna un valor por defecto
 func getEnv(key, defaultValue string) string {
 	value := os.Getenv(key)
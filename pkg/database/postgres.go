@@ -65,6 +65,37 @@ func AutoMigrate(db *gorm.DB) error {
 		&models.OrderItemModel{},              // Tabla de items de órdenes
 		&models.OrderPhotoModel{},             // Tabla de fotos de órdenes
 		&models.UserCategoryPermissionModel{}, // Tabla de permisos de usuario por categoría
+		&models.CampaignModel{},               // Tabla de campañas de fidelización
+		&models.CampaignCouponModel{},         // Tabla de cupones de campañas
+		&models.LoyaltyRuleModel{},            // Tabla de reglas de puntos
+		&models.LoyaltyPointsEntryModel{},     // Tabla del libro de puntos de clientes
+		&models.CustomerAccessLinkModel{},     // Tabla de enlaces de acceso al portal de clientes
+		&models.CustomerMergeModel{},          // Tabla de auditoría de fusiones de clientes
+		&models.UserSessionModel{},            // Tabla de sesiones de usuario (refresh tokens)
+		&models.UserInvitationModel{},         // Tabla de invitaciones de usuario
+		&models.LoginThrottleModel{},          // Tabla de intentos fallidos de login (bloqueo por cuenta/IP)
+		&models.PasswordResetTokenModel{},     // Tabla de tokens de recuperación de contraseña
+		&models.UserTwoFactorModel{},          // Tabla de segundo factor (TOTP) de usuarios
+		&models.UserRecoveryCodeModel{},       // Tabla de códigos de recuperación de 2FA
+		&models.RoleModel{},                   // Tabla de roles y sus permisos
+		&models.APIKeyModel{},                 // Tabla de llaves de API (solo el hash) para integraciones
+		&models.RecordShareModel{},            // Tabla de órdenes y clientes compartidos entre usuarios
+		&models.CommissionRuleModel{},         // Tabla de reglas de comisión de vendedores
+		&models.CommissionEntryModel{},        // Tabla del libro de comisiones (causación y reversos)
+		&models.CommissionPayoutModel{},       // Tabla de liquidaciones de comisiones
+		&models.RecurringTransactionModel{},   // Tabla de plantillas de ingresos y gastos recurrentes
+		&models.BudgetModel{},                 // Tabla de presupuestos mensuales por categoría
+		&models.AccountTransferModel{},        // Tabla de traslados entre cuentas (métodos de pago)
+		&models.BankStatementModel{},          // Tabla de extractos bancarios importados para conciliar
+		&models.BankStatementLineModel{},      // Tabla de líneas de los extractos y su cruce con movimientos
+		&models.CashSessionModel{},            // Tabla de sesiones de caja (apertura y cierre por usuario)
+		&models.CashSessionTotalModel{},       // Tabla de totales por método de pago de cada cierre de caja
+		&models.LedgerAccountModel{},          // Tabla del plan de cuentas contable
+		&models.JournalEntryModel{},           // Tabla de asientos del libro diario (partida doble)
+		&models.JournalLineModel{},            // Tabla de débitos y créditos de cada asiento
+		&models.InvoiceResolutionModel{},      // Tabla de resoluciones de numeración de la DIAN
+		&models.ElectronicDocumentModel{},     // Tabla de facturas y notas crédito electrónicas (XML UBL firmado)
+		&models.ElectronicDocumentLineModel{}, // Tabla de renglones de facturas y notas crédito
 	)
 }
 
@@ -0,0 +1,169 @@
+// Package xlsx genera hojas de cálculo .xlsx sencillas (una hoja, texto y números)
+// sin dependencias externas: el archivo es un zip con el XML mínimo de SpreadsheetML
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+)
+
+// Sheet es una hoja de cálculo que se llena fila por fila
+type Sheet struct {
+	name string
+	rows [][]cell
+}
+
+type cell struct {
+	text   string
+	number *float64
+	bold   bool
+}
+
+// NewSheet crea una hoja con el nombre dado (Excel admite hasta 31 caracteres)
+func NewSheet(name string) *Sheet {
+	if len([]rune(name)) > 31 {
+		name = string([]rune(name)[:31])
+	}
+	return &Sheet{name: name}
+}
+
+// AddHeader agrega una fila en negrita
+func (s *Sheet) AddHeader(values ...string) {
+	row := make([]cell, len(values))
+	for i, value := range values {
+		row[i] = cell{text: value, bold: true}
+	}
+	s.rows = append(s.rows, row)
+}
+
+// AddRow agrega una fila; los números (int, uint, float64) quedan como celdas numéricas
+// y nil deja la celda vacía
+func (s *Sheet) AddRow(values ...interface{}) {
+	row := make([]cell, len(values))
+	for i, value := range values {
+		switch v := value.(type) {
+		case nil:
+		case int:
+			n := float64(v)
+			row[i] = cell{number: &n}
+		case uint:
+			n := float64(v)
+			row[i] = cell{number: &n}
+		case float64:
+			n := v
+			row[i] = cell{number: &n}
+		case *float64:
+			row[i] = cell{number: v}
+		case string:
+			row[i] = cell{text: v}
+		default:
+			row[i] = cell{text: fmt.Sprint(v)}
+		}
+	}
+	s.rows = append(s.rows, row)
+}
+
+// Bytes retorna el archivo .xlsx
+func (s *Sheet) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+
+	files := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", contentTypesXML},
+		{"_rels/.rels", rootRelsXML},
+		{"xl/workbook.xml", fmt.Sprintf(workbookXML, escape(s.name))},
+		{"xl/_rels/workbook.xml.rels", workbookRelsXML},
+		{"xl/styles.xml", stylesXML},
+		{"xl/worksheets/sheet1.xml", s.sheetXML()},
+	}
+	for _, file := range files {
+		w, err := archive.Create(file.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write([]byte(file.content)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *Sheet) sheetXML() string {
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for r, row := range s.rows {
+		fmt.Fprintf(&b, `<row r="%d">`, r+1)
+		for c, value := range row {
+			ref := columnName(c) + strconv.Itoa(r+1)
+			style := ""
+			if value.bold {
+				style = ` s="1"`
+			}
+			switch {
+			case value.number != nil:
+				fmt.Fprintf(&b, `<c r="%s"%s><v>%s</v></c>`, ref, style, strconv.FormatFloat(*value.number, 'f', -1, 64))
+			case value.text != "":
+				fmt.Fprintf(&b, `<c r="%s" t="inlineStr"%s><is><t xml:space="preserve">%s</t></is></c>`, ref, style, escape(value.text))
+			}
+		}
+		b.WriteString(`</row>`)
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.String()
+}
+
+// columnName convierte el índice de columna (desde 0) en letras: 0 → A, 26 → AA
+func columnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
+
+func escape(value string) string {
+	var b bytes.Buffer
+	_ = xml.EscapeText(&b, []byte(value))
+	return b.String()
+}
+
+const contentTypesXML = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`</Types>`
+
+const rootRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const workbookXML = xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+const workbookRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`</Relationships>`
+
+// stylesXML define dos formatos de celda: 0 normal y 1 en negrita (encabezados)
+const stylesXML = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/><xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>` +
+	`</styleSheet>`
@@ -0,0 +1,42 @@
+-- ============================================================================
+-- Migración 006: Enlazar los movimientos automáticos de ventas con su orden
+-- Fecha: 2026-10-19
+-- Descripción:
+--   - Agrega order_id a financial_transactions y customer_transactions
+--   - Enlaza los ingresos (SALES) y las deudas creados al entregar una venta
+--     con su orden, a partir de la descripción que genera el sistema
+--   - El libro mayor contabiliza la venta una sola vez (desde la orden) y
+--     omite estos movimientos, que son su reflejo en los módulos anteriores
+-- ============================================================================
+
+BEGIN;
+
+ALTER TABLE financial_transactions ADD COLUMN IF NOT EXISTS order_id INTEGER;
+ALTER TABLE customer_transactions ADD COLUMN IF NOT EXISTS order_id INTEGER;
+
+CREATE INDEX IF NOT EXISTS idx_financial_transactions_order_id ON financial_transactions(order_id);
+CREATE INDEX IF NOT EXISTS idx_customer_transactions_order_id ON customer_transactions(order_id);
+
+-- Ingresos automáticos: "Venta - Orden <número> (<tipo>)"
+UPDATE financial_transactions ft
+SET order_id = o.id
+FROM orders o
+WHERE ft.order_id IS NULL
+  AND ft.type = 'INCOME'
+  AND ft.category = 'SALES'
+  AND (ft.description = 'Venta - Orden ' || o.order_number
+       OR ft.description LIKE 'Venta - Orden ' || o.order_number || ' %');
+
+-- Deudas automáticas de clientes internos: "Venta - Orden #<número> (<tipo>)"
+-- Los reversos conservan order_id NULL: anulan la deuda con su propio asiento
+UPDATE customer_transactions ct
+SET order_id = o.id
+FROM orders o
+WHERE ct.order_id IS NULL
+  AND ct.type = 'DEUDA'
+  AND ct.reversal_of_id IS NULL
+  AND ct.customer_id = o.customer_id
+  AND (ct.description = 'Venta - Orden #' || o.order_number
+       OR ct.description LIKE 'Venta - Orden #' || o.order_number || ' %');
+
+COMMIT;
@@ -3,12 +3,12 @@
 -- =============================================
 -- Crear métodos de pago disponibles
 
-INSERT INTO payment_methods (name, is_active, created_at, updated_at)
+INSERT INTO payment_methods (name, is_active, is_cash, created_at, updated_at)
 VALUES 
-    ('NEQUI Sonia', true, NOW(), NOW()),
-    ('NEQUI Jhon', true, NOW(), NOW()),
-    ('Daviplata', true, NOW(), NOW()),
-    ('Efectivo', true, NOW(), NOW())
+    ('NEQUI Sonia', true, false, NOW(), NOW()),
+    ('NEQUI Jhon', true, false, NOW(), NOW()),
+    ('Daviplata', true, false, NOW(), NOW()),
+    ('Efectivo', true, true, NOW(), NOW())
 ON CONFLICT (name) DO NOTHING;
 
 -- Resumen: